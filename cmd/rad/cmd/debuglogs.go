@@ -25,8 +25,8 @@ import (
 	"path/filepath"
 
 	"github.com/radius-project/radius/pkg/cli"
-	"github.com/radius-project/radius/pkg/cli/clierrors"
 	"github.com/radius-project/radius/pkg/cli/kubernetes"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
 	k8slabels "github.com/radius-project/radius/pkg/kubernetes"
 	"github.com/spf13/cobra"
 	corev1 "k8s.io/api/core/v1"
@@ -63,7 +63,7 @@ func debugLogs(cmd *cobra.Command, args []string) error {
 
 	context, ok := w.KubernetesContext()
 	if !ok {
-		return clierrors.Message("A Kubernetes connection is required.")
+		return workspaces.ErrKubernetesConnectionRequired
 	}
 
 	k8sClient, _, err := kubernetes.NewClientset(context)
@@ -27,6 +27,7 @@ import (
 	"github.com/radius-project/radius/pkg/cli/clierrors"
 	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
 	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
 	"github.com/spf13/cobra"
 )
 
@@ -52,6 +53,10 @@ rad resource expose --application icecream-store containers orders --port 5000 -
 			return err
 		}
 
+		if _, ok := workspace.KubernetesContext(); !ok {
+			return workspaces.ErrKubernetesConnectionRequired
+		}
+
 		scope, err := cli.RequireScope(cmd, *workspace)
 		if err != nil {
 			return err
@@ -28,6 +28,7 @@ import (
 	"github.com/radius-project/radius/pkg/cli/clients"
 	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
 	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
 	"github.com/spf13/cobra"
 )
 
@@ -59,6 +60,10 @@ rad resource logs containers orders --application icecream-store --container dap
 			return err
 		}
 
+		if _, ok := workspace.KubernetesContext(); !ok {
+			return workspaces.ErrKubernetesConnectionRequired
+		}
+
 		scope, err := cli.RequireScope(cmd, *workspace)
 		if err != nil {
 			return err
@@ -19,6 +19,7 @@ package cmd
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"strings"
@@ -30,15 +31,19 @@ import (
 	"github.com/radius-project/radius/pkg/cli/azure"
 	"github.com/radius-project/radius/pkg/cli/bicep"
 	"github.com/radius-project/radius/pkg/cli/clierrors"
+	app_import "github.com/radius-project/radius/pkg/cli/cmd/app/appimport"
 	app_switch "github.com/radius-project/radius/pkg/cli/cmd/app/appswitch"
 	app_connections "github.com/radius-project/radius/pkg/cli/cmd/app/connections"
 	app_delete "github.com/radius-project/radius/pkg/cli/cmd/app/delete"
+	app_export "github.com/radius-project/radius/pkg/cli/cmd/app/export"
+	app_graph "github.com/radius-project/radius/pkg/cli/cmd/app/graph"
 	app_list "github.com/radius-project/radius/pkg/cli/cmd/app/list"
 	app_show "github.com/radius-project/radius/pkg/cli/cmd/app/show"
 	app_status "github.com/radius-project/radius/pkg/cli/cmd/app/status"
 	bicep_publish "github.com/radius-project/radius/pkg/cli/cmd/bicep/publish"
 	credential "github.com/radius-project/radius/pkg/cli/cmd/credential"
 	cmd_deploy "github.com/radius-project/radius/pkg/cli/cmd/deploy"
+	cmd_diff "github.com/radius-project/radius/pkg/cli/cmd/diff"
 	env_create "github.com/radius-project/radius/pkg/cli/cmd/env/create"
 	env_delete "github.com/radius-project/radius/pkg/cli/cmd/env/delete"
 	env_switch "github.com/radius-project/radius/pkg/cli/cmd/env/envswitch"
@@ -49,6 +54,7 @@ import (
 	group "github.com/radius-project/radius/pkg/cli/cmd/group"
 	"github.com/radius-project/radius/pkg/cli/cmd/install"
 	install_kubernetes "github.com/radius-project/radius/pkg/cli/cmd/install/kubernetes"
+	"github.com/radius-project/radius/pkg/cli/cmd/operation"
 	"github.com/radius-project/radius/pkg/cli/cmd/radinit"
 	recipe_list "github.com/radius-project/radius/pkg/cli/cmd/recipe/list"
 	recipe_register "github.com/radius-project/radius/pkg/cli/cmd/recipe/register"
@@ -152,7 +158,11 @@ func Execute() error {
 	ctx, span := tr.Start(ctx, spanName)
 	defer span.End()
 	err = RootCmd.ExecuteContext(ctx)
-	if clierrors.IsFriendlyError(err) {
+	var exitErr *clierrors.ExitError
+	if errors.As(err, &exitErr) {
+		// The command already reported its outcome.
+		return err
+	} else if clierrors.IsFriendlyError(err) {
 		fmt.Println(err.Error())
 		fmt.Println("") // Output an extra blank line for readability
 		return err
@@ -209,6 +219,9 @@ func initSubCommands() {
 	deployCmd, _ := cmd_deploy.NewCommand(framework)
 	RootCmd.AddCommand(deployCmd)
 
+	diffCmd, _ := cmd_diff.NewCommand(framework)
+	RootCmd.AddCommand(diffCmd)
+
 	runCmd, _ := run.NewCommand(framework)
 	RootCmd.AddCommand(runCmd)
 
@@ -239,6 +252,9 @@ func initSubCommands() {
 	groupCmd := group.NewCommand(framework)
 	RootCmd.AddCommand(groupCmd)
 
+	operationCmd := operation.NewCommand(framework)
+	RootCmd.AddCommand(operationCmd)
+
 	initCmd, _ := radinit.NewCommand(framework)
 	RootCmd.AddCommand(initCmd)
 
@@ -290,6 +306,15 @@ func initSubCommands() {
 	appConnectionsCmd, _ := app_connections.NewCommand(framework)
 	applicationCmd.AddCommand(appConnectionsCmd)
 
+	appGraphCmd, _ := app_graph.NewCommand(framework)
+	applicationCmd.AddCommand(appGraphCmd)
+
+	appExportCmd, _ := app_export.NewCommand(framework)
+	applicationCmd.AddCommand(appExportCmd)
+
+	appImportCmd, _ := app_import.NewCommand(framework)
+	applicationCmd.AddCommand(appImportCmd)
+
 	envSwitchCmd, _ := env_switch.NewCommand(framework)
 	envCmd.AddCommand(envSwitchCmd)
 
@@ -17,14 +17,19 @@ limitations under the License.
 package main
 
 import (
+	"errors"
 	"os"
 
 	"github.com/radius-project/radius/cmd/rad/cmd"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
 )
 
 func main() {
 	err := cmd.Execute()
-	if err != nil {
+	var exitErr *clierrors.ExitError
+	if errors.As(err, &exitErr) {
+		os.Exit(exitErr.Code) //nolint:forbidigo // this is OK inside the main function.
+	} else if err != nil {
 		os.Exit(1) //nolint:forbidigo // this is OK inside the main function.
 	}
 }
@@ -42,6 +42,10 @@ const (
 
 	// TopParameterName is an optional query parameter that defines the number of records requested by the client.
 	TopParameterName = "top"
+
+	// FilterParameterName is an optional query parameter that restricts the records returned by the server to those
+	// matching the filter expression.
+	FilterParameterName = "filter"
 )
 
 // The constants below define the default, max, and min values for the number of records to be returned by the server.
@@ -170,6 +174,8 @@ type ARMRequestContext struct {
 	SkipToken string
 	// Top is the maximum number of records to be returned by the server. The validation will be handled downstream.
 	Top int
+	// Filter is the filter expression requested by the client. The validation will be handled downstream.
+	Filter string
 
 	// HTTPMethod represents the original method.
 	HTTPMethod string
@@ -228,6 +234,7 @@ func FromARMRequest(r *http.Request, pathBase, location string) (*ARMRequestCont
 
 		SkipToken: r.URL.Query().Get(SkipTokenParameterName),
 		Top:       queryItemCount,
+		Filter:    r.URL.Query().Get(FilterParameterName),
 
 		HTTPMethod: r.Method,
 		OrignalURL: *r.URL,
@@ -39,4 +39,14 @@ type AsyncOperationStatus struct {
 
 	// Error represents the error occurred during provisioning.
 	Error *ErrorDetails `json:"error,omitempty"`
+
+	// NextAttemptTime represents the time when the async operation is retried. It is set only while the retry is
+	// scheduled, and Error represents the error of the previous attempt.
+	NextAttemptTime *time.Time `json:"nextAttemptTime,omitempty"`
+}
+
+// CancelAsyncOperationRequest represents the request body to cancel an async operation.
+type CancelAsyncOperationRequest struct {
+	// Reason represents the reason why the async operation is canceled.
+	Reason string `json:"reason,omitempty"`
 }
@@ -0,0 +1,64 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"time"
+)
+
+// DeadLetteredOperation represents an async operation request which was moved to the dead-letter queue because
+// it could not be processed.
+type DeadLetteredOperation struct {
+	// ID represents the resource id of the dead-lettered operation.
+	ID string `json:"id,omitempty"`
+
+	// Name represents the id of the dead-lettered queue message.
+	Name string `json:"name,omitempty"`
+
+	// Type represents the resource type of the dead-lettered operation.
+	Type string `json:"type,omitempty"`
+
+	// Properties represents the properties of the dead-lettered operation.
+	Properties DeadLetteredOperationProperties `json:"properties"`
+}
+
+// DeadLetteredOperationProperties represents the properties of DeadLetteredOperation.
+type DeadLetteredOperationProperties struct {
+	// OperationID represents the async operation id. Empty if the message could not be decoded.
+	OperationID string `json:"operationId,omitempty"`
+
+	// OperationType represents the async operation type such as "APPLICATIONS.CORE/CONTAINERS|PUT".
+	OperationType string `json:"operationType,omitempty"`
+
+	// ResourceID represents the id of the resource which the async operation is processing.
+	ResourceID string `json:"resourceId,omitempty"`
+
+	// Reason represents the reason why the operation was dead-lettered.
+	Reason string `json:"reason,omitempty"`
+
+	// DequeueCount represents the number of times the operation was dequeued.
+	DequeueCount int `json:"dequeueCount"`
+
+	// EnqueuedAt represents the time when the operation was queued.
+	EnqueuedAt time.Time `json:"enqueuedAt,omitempty"`
+
+	// DeadLetteredAt represents the time when the operation was dead-lettered.
+	DeadLetteredAt time.Time `json:"deadLetteredAt,omitempty"`
+
+	// Message represents the raw queue message.
+	Message string `json:"message,omitempty"`
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"math"
+	"math/rand"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+)
+
+const (
+	// DefaultRetryInitialInterval is the default delay before the first retry of the requeued operation.
+	DefaultRetryInitialInterval = time.Duration(5) * time.Second
+
+	// DefaultRetryMaxInterval is the default maximum delay between the retries of the requeued operation.
+	DefaultRetryMaxInterval = time.Duration(5) * time.Minute
+
+	// DefaultRetryMultiplier is the default factor by which the delay grows after each attempt.
+	DefaultRetryMultiplier = 2.0
+
+	// DefaultRetryJitter is the default fraction of the delay which is randomized.
+	DefaultRetryJitter = 0.2
+)
+
+// defaultTerminalCodes are the error codes which are never retried by default because retrying can't change the result.
+var defaultTerminalCodes = []string{
+	v1.CodeInvalid,
+	v1.CodeInvalidAuthenticationInfo,
+	v1.CodeInvalidProperties,
+	v1.CodeInvalidRequestContent,
+	v1.CodeInvalidResourceType,
+	v1.CodeOperationCanceled,
+	v1.CodeHTTPRequestPayloadAPISpecValidationFailed,
+}
+
+// RetryPolicy configures how the worker retries the async operation when its controller returns a Result with Requeue
+// set. The delay before each attempt grows exponentially from InitialInterval up to MaxInterval, and is randomized
+// by Jitter so that the operations failed together are not retried at the same time.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts to process the operation. Zero uses the maximum retry count of the worker.
+	MaxAttempts int
+
+	// InitialInterval is the delay before the first retry.
+	InitialInterval time.Duration
+
+	// MaxInterval is the maximum delay between the retries.
+	MaxInterval time.Duration
+
+	// Multiplier is the factor by which the delay grows after each attempt.
+	Multiplier float64
+
+	// Jitter is the fraction of the delay which is randomized, between 0 and 1.
+	Jitter float64
+
+	// RetryableCodes are the error codes which are retried. Every error code which is not in TerminalCodes is retried
+	// if it is empty.
+	RetryableCodes []string
+
+	// TerminalCodes are the error codes which are never retried. The operation fails immediately with the error.
+	TerminalCodes []string
+}
+
+// DefaultRetryPolicy returns the retry policy used when the operation type has no override.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		InitialInterval: DefaultRetryInitialInterval,
+		MaxInterval:     DefaultRetryMaxInterval,
+		Multiplier:      DefaultRetryMultiplier,
+		Jitter:          DefaultRetryJitter,
+		TerminalCodes:   defaultTerminalCodes,
+	}
+}
+
+// Backoff returns the delay before the next attempt after the given number of attempts.
+func (p RetryPolicy) Backoff(attempt int) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+
+	multiplier := p.Multiplier
+	if multiplier < 1 {
+		multiplier = 1
+	}
+
+	delay := float64(p.InitialInterval) * math.Pow(multiplier, float64(attempt-1))
+	if p.MaxInterval > 0 && delay > float64(p.MaxInterval) {
+		delay = float64(p.MaxInterval)
+	}
+
+	if p.Jitter > 0 {
+		jitter := math.Min(p.Jitter, 1)
+		delay = delay * (1 - jitter + 2*jitter*rand.Float64())
+		if p.MaxInterval > 0 && delay > float64(p.MaxInterval) {
+			delay = float64(p.MaxInterval)
+		}
+	}
+
+	return time.Duration(delay)
+}
+
+// IsRetryable returns true if the operation failed with the error can be retried. The operation requeued without
+// an error is always retried.
+func (p RetryPolicy) IsRetryable(err *v1.ErrorDetails) bool {
+	if err == nil {
+		return true
+	}
+
+	for _, code := range p.TerminalCodes {
+		if code == err.Code {
+			return false
+		}
+	}
+
+	if len(p.RetryableCodes) == 0 {
+		return true
+	}
+
+	for _, code := range p.RetryableCodes {
+		if code == err.Code {
+			return true
+		}
+	}
+
+	return false
+}
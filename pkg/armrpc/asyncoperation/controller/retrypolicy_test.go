@@ -0,0 +1,91 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	policy := RetryPolicy{
+		InitialInterval: time.Second,
+		MaxInterval:     10 * time.Second,
+		Multiplier:      2,
+	}
+
+	backoffTests := []struct {
+		attempt int
+		want    time.Duration
+	}{
+		{0, time.Second},
+		{1, time.Second},
+		{2, 2 * time.Second},
+		{3, 4 * time.Second},
+		{4, 8 * time.Second},
+		{5, 10 * time.Second},
+		{20, 10 * time.Second},
+	}
+
+	for _, tt := range backoffTests {
+		require.Equal(t, tt.want, policy.Backoff(tt.attempt), "attempt %d", tt.attempt)
+	}
+}
+
+func TestRetryPolicy_BackoffJitter(t *testing.T) {
+	policy := RetryPolicy{
+		InitialInterval: 10 * time.Second,
+		MaxInterval:     time.Minute,
+		Multiplier:      2,
+		Jitter:          0.5,
+	}
+
+	for i := 0; i < 100; i++ {
+		d := policy.Backoff(2)
+		require.GreaterOrEqual(t, d, 10*time.Second)
+		require.LessOrEqual(t, d, 30*time.Second)
+
+		// The delay never exceeds the maximum interval.
+		require.LessOrEqual(t, policy.Backoff(10), time.Minute)
+	}
+}
+
+func TestRetryPolicy_IsRetryable(t *testing.T) {
+	retryableTests := []struct {
+		desc   string
+		policy RetryPolicy
+		err    *v1.ErrorDetails
+		want   bool
+	}{
+		{"requeued without error", DefaultRetryPolicy(), nil, true},
+		{"internal error", DefaultRetryPolicy(), &v1.ErrorDetails{Code: v1.CodeInternal}, true},
+		{"validation error", DefaultRetryPolicy(), &v1.ErrorDetails{Code: v1.CodeInvalid}, false},
+		{"canceled", DefaultRetryPolicy(), &v1.ErrorDetails{Code: v1.CodeOperationCanceled}, false},
+		{"retryable code", RetryPolicy{RetryableCodes: []string{v1.CodeConflict}}, &v1.ErrorDetails{Code: v1.CodeConflict}, true},
+		{"not retryable code", RetryPolicy{RetryableCodes: []string{v1.CodeConflict}}, &v1.ErrorDetails{Code: v1.CodeInternal}, false},
+		{"terminal code wins", RetryPolicy{RetryableCodes: []string{v1.CodeConflict}, TerminalCodes: []string{v1.CodeConflict}}, &v1.ErrorDetails{Code: v1.CodeConflict}, false},
+	}
+
+	for _, tt := range retryableTests {
+		t.Run(tt.desc, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.policy.IsRetryable(tt.err))
+		})
+	}
+}
@@ -13,6 +13,7 @@ import (
 	uuid "github.com/google/uuid"
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	resources "github.com/radius-project/radius/pkg/ucp/resources"
+	store "github.com/radius-project/radius/pkg/ucp/store"
 )
 
 // MockStatusManager is a mock of StatusManager interface.
@@ -81,6 +82,48 @@ func (mr *MockStatusManagerMockRecorder) QueueAsyncOperation(arg0, arg1, arg2 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueueAsyncOperation", reflect.TypeOf((*MockStatusManager)(nil).QueueAsyncOperation), arg0, arg1, arg2)
 }
 
+// RequestCancel mocks base method.
+func (m *MockStatusManager) RequestCancel(arg0 context.Context, arg1 resources.ID, arg2 uuid.UUID, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestCancel", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequestCancel indicates an expected call of RequestCancel.
+func (mr *MockStatusManagerMockRecorder) RequestCancel(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestCancel", reflect.TypeOf((*MockStatusManager)(nil).RequestCancel), arg0, arg1, arg2, arg3)
+}
+
+// ResetWithResource mocks base method.
+func (m *MockStatusManager) ResetWithResource(arg0 context.Context, arg1 store.StorageClient, arg2 *store.Object, arg3 resources.ID, arg4 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetWithResource", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetWithResource indicates an expected call of ResetWithResource.
+func (mr *MockStatusManagerMockRecorder) ResetWithResource(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetWithResource", reflect.TypeOf((*MockStatusManager)(nil).ResetWithResource), arg0, arg1, arg2, arg3, arg4)
+}
+
+// ScheduleRetry mocks base method.
+func (m *MockStatusManager) ScheduleRetry(arg0 context.Context, arg1 resources.ID, arg2 uuid.UUID, arg3 time.Time, arg4 *v1.ErrorDetails) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScheduleRetry", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ScheduleRetry indicates an expected call of ScheduleRetry.
+func (mr *MockStatusManagerMockRecorder) ScheduleRetry(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleRetry", reflect.TypeOf((*MockStatusManager)(nil).ScheduleRetry), arg0, arg1, arg2, arg3, arg4)
+}
+
 // Update mocks base method.
 func (m *MockStatusManager) Update(arg0 context.Context, arg1 resources.ID, arg2 uuid.UUID, arg3 v1.ProvisioningState, arg4 *time.Time, arg5 *v1.ErrorDetails) error {
 	m.ctrl.T.Helper()
@@ -94,3 +137,17 @@ func (mr *MockStatusManagerMockRecorder) Update(arg0, arg1, arg2, arg3, arg4, ar
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockStatusManager)(nil).Update), arg0, arg1, arg2, arg3, arg4, arg5)
 }
+
+// UpdateWithResource mocks base method.
+func (m *MockStatusManager) UpdateWithResource(arg0 context.Context, arg1 store.StorageClient, arg2 *store.Object, arg3 resources.ID, arg4 uuid.UUID, arg5 v1.ProvisioningState, arg6 *time.Time, arg7 *v1.ErrorDetails) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWithResource", arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWithResource indicates an expected call of UpdateWithResource.
+func (mr *MockStatusManagerMockRecorder) UpdateWithResource(arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWithResource", reflect.TypeOf((*MockStatusManager)(nil).UpdateWithResource), arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7)
+}
@@ -43,4 +43,11 @@ type Status struct {
 
 	// LastUpdatedTime represents the async operation last updated time.
 	LastUpdatedTime time.Time `json:"lastUpdatedTime,omitempty"`
+
+	// CancelRequested represents whether the cancellation of the async operation is requested. The worker processing
+	// the operation polls this field and cancels the operation when it is set.
+	CancelRequested bool `json:"cancelRequested,omitempty"`
+
+	// CancelReason represents the reason of the cancellation request.
+	CancelReason string `json:"cancelReason,omitempty"`
 }
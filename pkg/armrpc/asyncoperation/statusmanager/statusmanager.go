@@ -35,6 +35,10 @@ import (
 	"github.com/google/uuid"
 )
 
+// ErrOperationCompleted represents the error returned when the cancellation is requested for the async operation
+// which is already in the terminal state.
+var ErrOperationCompleted = errors.New("async operation is already completed")
+
 // statusManager includes the necessary functions to manage asynchronous operations.
 type statusManager struct {
 	storeProvider dataprovider.DataStorageProvider
@@ -48,6 +52,12 @@ type QueueOperationOptions struct {
 	OperationTimeout time.Duration
 	// RetryAfter specifies the value of the Retry-After header that will be used for async operations.
 	RetryAfter time.Duration
+	// Priority specifies the priority of the async operation. DELETE operations are queued with queue.PriorityHigh
+	// when the priority is queue.PriorityNormal so that they are processed ahead of the other operations.
+	Priority queue.Priority
+	// PartitionKey specifies the key of the partition which the worker uses to limit the concurrency of the
+	// async operations. The resource group of the resource is used if it is empty.
+	PartitionKey string
 }
 
 //go:generate mockgen -destination=./mock_statusmanager.go -package=statusmanager -self_package github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager StatusManager
@@ -60,6 +70,15 @@ type StatusManager interface {
 	QueueAsyncOperation(ctx context.Context, sCtx *v1.ARMRequestContext, options QueueOperationOptions) error
 	// Update updates an async operation status.
 	Update(ctx context.Context, id resources.ID, operationID uuid.UUID, state v1.ProvisioningState, endTime *time.Time, opError *v1.ErrorDetails) error
+	// UpdateWithResource updates an async operation status and saves the resource together with it.
+	UpdateWithResource(ctx context.Context, resourceClient store.StorageClient, resource *store.Object, id resources.ID, operationID uuid.UUID, state v1.ProvisioningState, endTime *time.Time, opError *v1.ErrorDetails) error
+	// ScheduleRetry records the time of the next attempt and the error of the previous attempt in an async operation status.
+	ScheduleRetry(ctx context.Context, id resources.ID, operationID uuid.UUID, nextAttemptTime time.Time, opError *v1.ErrorDetails) error
+	// ResetWithResource resets an async operation status to Accepted so that the operation can be processed again, and
+	// saves the resource together with it.
+	ResetWithResource(ctx context.Context, resourceClient store.StorageClient, resource *store.Object, id resources.ID, operationID uuid.UUID) error
+	// RequestCancel requests the cancellation of an async operation. The worker processing the operation cancels it.
+	RequestCancel(ctx context.Context, id resources.ID, operationID uuid.UUID, reason string) error
 	// Delete deletes an async operation status.
 	Delete(ctx context.Context, id resources.ID, operationID uuid.UUID) error
 }
@@ -125,7 +144,7 @@ func (aom *statusManager) QueueAsyncOperation(ctx context.Context, sCtx *v1.ARMR
 		return err
 	}
 
-	if err = aom.queueRequestMessage(ctx, sCtx, aos, options.OperationTimeout); err != nil {
+	if err = aom.queueRequestMessage(ctx, sCtx, aos, options); err != nil {
 		delErr := storeClient.Delete(ctx, opID)
 		if delErr != nil {
 			return delErr
@@ -161,20 +180,78 @@ func (aom *statusManager) Get(ctx context.Context, id resources.ID, operationID
 // Update retrieves an existing operation status resource from the store, updates its fields with the
 // given parameters, and saves it back to the store.
 func (aom *statusManager) Update(ctx context.Context, id resources.ID, operationID uuid.UUID, state v1.ProvisioningState, endTime *time.Time, opError *v1.ErrorDetails) error {
-	opID := aom.operationStatusResourceID(id, operationID)
 	storeClient, err := aom.getClient(ctx, id)
 	if err != nil {
 		return err
 	}
 
-	obj, err := storeClient.Get(ctx, opID)
+	obj, err := aom.updatedStatus(ctx, storeClient, id, operationID, state, endTime, opError)
+	if err != nil {
+		return err
+	}
+
+	return storeClient.Save(ctx, obj, store.WithETag(obj.ETag))
+}
+
+// UpdateWithResource updates the operation status like Update, and saves the resource in the same batch so that
+// either both are saved or neither is. The resource is saved with its ETag as the precondition, and is skipped
+// when nil. resourceClient must come from the same data storage provider as the operation status.
+//
+// When the storage client does not support batches the resource is saved first using resourceClient, followed by
+// the operation status.
+func (aom *statusManager) UpdateWithResource(ctx context.Context, resourceClient store.StorageClient, resource *store.Object, id resources.ID, operationID uuid.UUID, state v1.ProvisioningState, endTime *time.Time, opError *v1.ErrorDetails) error {
+	storeClient, err := aom.getClient(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	obj, err := aom.updatedStatus(ctx, storeClient, id, operationID, state, endTime, opError)
 	if err != nil {
 		return err
 	}
 
+	return saveWithResource(ctx, storeClient, resourceClient, resource, obj)
+}
+
+// saveWithResource saves the operation status object and the resource in the same batch, or sequentially, resource
+// first, when the storage client does not support batches. The resource is skipped when nil.
+func saveWithResource(ctx context.Context, storeClient store.StorageClient, resourceClient store.StorageClient, resource *store.Object, obj *store.Object) error {
+	if resource == nil {
+		return storeClient.Save(ctx, obj, store.WithETag(obj.ETag))
+	}
+
+	if batch, ok := storeClient.(store.BatchStorageClient); ok {
+		return batch.SaveBatch(ctx, []store.BatchItem{
+			{Object: resource, ETag: resource.ETag},
+			{Object: obj, ETag: obj.ETag},
+		})
+	}
+
+	err := resourceClient.Save(ctx, resource, store.WithETag(resource.ETag))
+	if err != nil {
+		return err
+	}
+
+	return storeClient.Save(ctx, obj, store.WithETag(obj.ETag))
+}
+
+// updatedStatus retrieves the operation status resource from the store and updates its fields with the given
+// parameters without saving it.
+func (aom *statusManager) updatedStatus(ctx context.Context, storeClient store.StorageClient, id resources.ID, operationID uuid.UUID, state v1.ProvisioningState, endTime *time.Time, opError *v1.ErrorDetails) (*store.Object, error) {
+	obj, err := storeClient.Get(ctx, aom.operationStatusResourceID(id, operationID))
+	if err != nil {
+		return nil, err
+	}
+
 	s := &Status{}
 	if err := obj.As(s); err != nil {
-		return err
+		return nil, err
+	}
+
+	// The error of the previous attempt is cleared when the scheduled retry starts.
+	if s.NextAttemptTime != nil {
+		s.NextAttemptTime = nil
+		s.Error = nil
 	}
 
 	s.Status = state
@@ -189,7 +266,93 @@ func (aom *statusManager) Update(ctx context.Context, id resources.ID, operation
 	s.LastUpdatedTime = time.Now().UTC()
 
 	obj.Data = s
+	return obj, nil
+}
+
+// ScheduleRetry sets the time of the next attempt and the error of the previous attempt in the operation status.
+// The status keeps its state so that the operation is not treated as completed while the retry is scheduled.
+func (aom *statusManager) ScheduleRetry(ctx context.Context, id resources.ID, operationID uuid.UUID, nextAttemptTime time.Time, opError *v1.ErrorDetails) error {
+	storeClient, err := aom.getClient(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	obj, err := storeClient.Get(ctx, aom.operationStatusResourceID(id, operationID))
+	if err != nil {
+		return err
+	}
+
+	s := &Status{}
+	if err := obj.As(s); err != nil {
+		return err
+	}
 
+	nextAttemptTime = nextAttemptTime.UTC()
+	s.NextAttemptTime = &nextAttemptTime
+	s.Error = opError
+	s.LastUpdatedTime = time.Now().UTC()
+
+	obj.Data = s
+	return storeClient.Save(ctx, obj, store.WithETag(obj.ETag))
+}
+
+// ResetWithResource resets the operation status to Accepted and clears its end time and error, so that the worker does
+// not treat the operation as completed when its request message is replayed. The resource, whose provisioning state is
+// reset by the caller, is saved in the same batch like UpdateWithResource, and is skipped when nil.
+func (aom *statusManager) ResetWithResource(ctx context.Context, resourceClient store.StorageClient, resource *store.Object, id resources.ID, operationID uuid.UUID) error {
+	storeClient, err := aom.getClient(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	obj, err := storeClient.Get(ctx, aom.operationStatusResourceID(id, operationID))
+	if err != nil {
+		return err
+	}
+
+	s := &Status{}
+	if err := obj.As(s); err != nil {
+		return err
+	}
+
+	s.Status = v1.ProvisioningStateAccepted
+	s.EndTime = nil
+	s.Error = nil
+	s.NextAttemptTime = nil
+	s.LastUpdatedTime = time.Now().UTC()
+
+	obj.Data = s
+	return saveWithResource(ctx, storeClient, resourceClient, resource, obj)
+}
+
+// RequestCancel records the cancellation request and its reason in the operation status. The status record is shared
+// by all replicas, so the worker processing the operation observes the request regardless of which replica received it.
+// ErrOperationCompleted is returned if the operation is already in the terminal state.
+func (aom *statusManager) RequestCancel(ctx context.Context, id resources.ID, operationID uuid.UUID, reason string) error {
+	storeClient, err := aom.getClient(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	obj, err := storeClient.Get(ctx, aom.operationStatusResourceID(id, operationID))
+	if err != nil {
+		return err
+	}
+
+	s := &Status{}
+	if err := obj.As(s); err != nil {
+		return err
+	}
+
+	if s.Status.IsTerminal() {
+		return ErrOperationCompleted
+	}
+
+	s.CancelRequested = true
+	s.CancelReason = reason
+	s.LastUpdatedTime = time.Now().UTC()
+
+	obj.Data = s
 	return storeClient.Save(ctx, obj, store.WithETag(obj.ETag))
 }
 
@@ -204,7 +367,8 @@ func (aom *statusManager) Delete(ctx context.Context, id resources.ID, operation
 }
 
 // queueRequestMessage function is to put the async operation message to the queue to be worked on.
-func (aom *statusManager) queueRequestMessage(ctx context.Context, sCtx *v1.ARMRequestContext, aos *Status, operationTimeout time.Duration) error {
+func (aom *statusManager) queueRequestMessage(ctx context.Context, sCtx *v1.ARMRequestContext, aos *Status, options QueueOperationOptions) error {
+	operationTimeout := options.OperationTimeout
 	msg := &ctrl.Request{
 		APIVersion:       sCtx.APIVersion,
 		OperationID:      sCtx.OperationID,
@@ -218,5 +382,15 @@ func (aom *statusManager) queueRequestMessage(ctx context.Context, sCtx *v1.ARMR
 		OperationTimeout: &operationTimeout,
 	}
 
-	return aom.queue.Enqueue(ctx, queue.NewMessage(msg))
+	priority := options.Priority
+	if priority == queue.PriorityNormal && sCtx.OperationType.Method == v1.OperationDelete {
+		priority = queue.PriorityHigh
+	}
+
+	partitionKey := options.PartitionKey
+	if partitionKey == "" {
+		partitionKey = sCtx.ResourceID.RootScope()
+	}
+
+	return aom.queue.Enqueue(ctx, queue.NewMessage(msg), queue.WithPriority(priority), queue.WithPartitionKey(partitionKey))
 }
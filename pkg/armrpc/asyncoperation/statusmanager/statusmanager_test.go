@@ -191,6 +191,60 @@ func TestCreateAsyncOperationStatus(t *testing.T) {
 	}
 }
 
+func TestQueueAsyncOperation_PriorityAndPartition(t *testing.T) {
+	deleteCtx := *reqCtx
+	deleteCtx.OperationType = rpctest.MustParseOperationType("APPLICATIONS.CORE/ENVIRONMENTS|DELETE")
+
+	queueCases := []struct {
+		desc             string
+		reqCtx           *v1.ARMRequestContext
+		options          QueueOperationOptions
+		expectedPriority queue.Priority
+		expectedKey      string
+	}{
+		{
+			desc:             "default",
+			reqCtx:           reqCtx,
+			expectedPriority: queue.PriorityNormal,
+			expectedKey:      "/planes/radius/local/resourceGroups/radius-test-rg",
+		},
+		{
+			desc:             "delete",
+			reqCtx:           &deleteCtx,
+			expectedPriority: queue.PriorityHigh,
+			expectedKey:      "/planes/radius/local/resourceGroups/radius-test-rg",
+		},
+		{
+			desc:             "explicit options",
+			reqCtx:           &deleteCtx,
+			options:          QueueOperationOptions{Priority: queue.PriorityLow, PartitionKey: "test-partition"},
+			expectedPriority: queue.PriorityLow,
+			expectedKey:      "test-partition",
+		},
+	}
+
+	for _, tt := range queueCases {
+		t.Run(tt.desc, func(t *testing.T) {
+			aomTest, mctrl := setup(t)
+			defer mctrl.Finish()
+
+			aomTest.storeClient.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+			var cfg queue.EnqueueConfig
+			aomTest.queue.EXPECT().Enqueue(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, msg *queue.Message, opts ...queue.EnqueueOptions) error {
+					cfg = queue.NewEnqueueConfig(opts...)
+					return nil
+				})
+
+			err := aomTest.manager.QueueAsyncOperation(context.TODO(), tt.reqCtx, tt.options)
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedPriority, cfg.Priority)
+			require.Equal(t, tt.expectedKey, cfg.PartitionKey)
+		})
+	}
+}
+
 func TestDeleteAsyncOperationStatus(t *testing.T) {
 	deleteCases := []struct {
 		Desc      string
@@ -328,3 +382,301 @@ func TestUpdateAsyncOperationStatus(t *testing.T) {
 		})
 	}
 }
+
+// batchStorageClient adds batch support to the mock storage client.
+type batchStorageClient struct {
+	*store.MockStorageClient
+	items []store.BatchItem
+}
+
+func (c *batchStorageClient) SaveBatch(ctx context.Context, items []store.BatchItem) error {
+	c.items = items
+	return nil
+}
+
+func TestUpdateWithResource(t *testing.T) {
+	rid, err := resources.ParseResource(azureEnvResourceID)
+	require.NoError(t, err)
+
+	newStatus := func() *store.Object {
+		return &store.Object{Metadata: store.Metadata{ID: opID.String(), ETag: "status-etag"}, Data: testAos}
+	}
+
+	t.Run("batch", func(t *testing.T) {
+		mctrl := gomock.NewController(t)
+		defer mctrl.Finish()
+
+		sc := &batchStorageClient{MockStorageClient: store.NewMockStorageClient(mctrl)}
+		sc.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(newStatus(), nil)
+		dp := dataprovider.NewMockDataStorageProvider(mctrl)
+		dp.EXPECT().GetStorageClient(gomock.Any(), "Applications.Core/operationstatuses").Return(sc, nil)
+
+		// The resource must be saved in the batch, not through the resource client.
+		resourceClient := store.NewMockStorageClient(mctrl)
+		resource := &store.Object{Metadata: store.Metadata{ID: azureEnvResourceID, ETag: "resource-etag"}}
+
+		manager := New(dp, nil, "test-location")
+		err := manager.UpdateWithResource(context.TODO(), resourceClient, resource, rid, opID, v1.ProvisioningStateSucceeded, nil, nil)
+		require.NoError(t, err)
+
+		require.Len(t, sc.items, 2)
+		require.Equal(t, resource, sc.items[0].Object)
+		require.Equal(t, store.ETag("resource-etag"), sc.items[0].ETag)
+		require.Equal(t, store.ETag("status-etag"), sc.items[1].ETag)
+		require.Equal(t, v1.ProvisioningStateSucceeded, sc.items[1].Object.Data.(*Status).Status)
+	})
+
+	t.Run("sequential", func(t *testing.T) {
+		aomTest, mctrl := setup(t)
+		defer mctrl.Finish()
+
+		resourceClient := store.NewMockStorageClient(mctrl)
+		resource := &store.Object{Metadata: store.Metadata{ID: azureEnvResourceID, ETag: "resource-etag"}}
+
+		aomTest.storeClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(newStatus(), nil)
+		gomock.InOrder(
+			resourceClient.EXPECT().Save(gomock.Any(), resource, gomock.Any()).Return(nil),
+			aomTest.storeClient.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil),
+		)
+
+		err := aomTest.manager.UpdateWithResource(context.TODO(), resourceClient, resource, rid, opID, v1.ProvisioningStateSucceeded, nil, nil)
+		require.NoError(t, err)
+	})
+
+	t.Run("resource_save_fails", func(t *testing.T) {
+		aomTest, mctrl := setup(t)
+		defer mctrl.Finish()
+
+		resourceClient := store.NewMockStorageClient(mctrl)
+		resource := &store.Object{Metadata: store.Metadata{ID: azureEnvResourceID, ETag: "resource-etag"}}
+
+		aomTest.storeClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(newStatus(), nil)
+		resourceClient.EXPECT().Save(gomock.Any(), resource, gomock.Any()).Return(&store.ErrConcurrency{})
+
+		err := aomTest.manager.UpdateWithResource(context.TODO(), resourceClient, resource, rid, opID, v1.ProvisioningStateSucceeded, nil, nil)
+		require.ErrorIs(t, err, &store.ErrConcurrency{})
+	})
+
+	t.Run("nil_resource", func(t *testing.T) {
+		aomTest, mctrl := setup(t)
+		defer mctrl.Finish()
+
+		aomTest.storeClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(newStatus(), nil)
+		aomTest.storeClient.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+		err := aomTest.manager.UpdateWithResource(context.TODO(), store.NewMockStorageClient(mctrl), nil, rid, opID, v1.ProvisioningStateSucceeded, nil, nil)
+		require.NoError(t, err)
+	})
+}
+
+func TestResetWithResource(t *testing.T) {
+	rid, err := resources.ParseResource(ucpEnvResourceID)
+	require.NoError(t, err)
+	statusID := "/planes/radius/local/providers/applications.core/locations/test-location/operationstatuses/" + opID.String()
+
+	newFailedStatus := func() *store.Object {
+		endTime := time.Now().UTC()
+		return &store.Object{
+			Metadata: store.Metadata{ID: opID.String(), ETag: "etag"},
+			Data: &Status{
+				AsyncOperationStatus: v1.AsyncOperationStatus{
+					ID:        opID.String(),
+					Name:      opID.String(),
+					Status:    v1.ProvisioningStateFailed,
+					StartTime: time.Now().UTC(),
+					EndTime:   &endTime,
+					Error:     &v1.ErrorDetails{Code: v1.CodeInternal, Message: "failed"},
+				},
+			},
+		}
+	}
+
+	requireReset := func(t *testing.T, obj *store.Object) {
+		s := &Status{}
+		require.NoError(t, obj.As(s))
+		require.Equal(t, v1.ProvisioningStateAccepted, s.Status)
+		require.Nil(t, s.EndTime)
+		require.Nil(t, s.Error)
+	}
+
+	t.Run("batch", func(t *testing.T) {
+		mctrl := gomock.NewController(t)
+		defer mctrl.Finish()
+
+		sc := &batchStorageClient{MockStorageClient: store.NewMockStorageClient(mctrl)}
+		sc.EXPECT().Get(gomock.Any(), statusID, gomock.Any()).Return(newFailedStatus(), nil)
+		dp := dataprovider.NewMockDataStorageProvider(mctrl)
+		dp.EXPECT().GetStorageClient(gomock.Any(), "Applications.Core/operationstatuses").Return(sc, nil)
+
+		// The resource must be saved in the batch, not through the resource client.
+		resourceClient := store.NewMockStorageClient(mctrl)
+		resource := &store.Object{
+			Metadata: store.Metadata{ID: ucpEnvResourceID, ETag: "resource-etag"},
+			Data:     map[string]any{"provisioningState": string(v1.ProvisioningStateAccepted)},
+		}
+
+		manager := New(dp, nil, "test-location")
+		err := manager.ResetWithResource(context.TODO(), resourceClient, resource, rid, opID)
+		require.NoError(t, err)
+
+		require.Len(t, sc.items, 2)
+		require.Equal(t, resource, sc.items[0].Object)
+		require.Equal(t, store.ETag("resource-etag"), sc.items[0].ETag)
+		require.Equal(t, store.ETag("etag"), sc.items[1].ETag)
+		requireReset(t, sc.items[1].Object)
+	})
+
+	t.Run("nil_resource", func(t *testing.T) {
+		aomTest, mctrl := setup(t)
+		defer mctrl.Finish()
+
+		aomTest.storeClient.
+			EXPECT().
+			Get(gomock.Any(), statusID, gomock.Any()).
+			Return(newFailedStatus(), nil)
+		aomTest.storeClient.
+			EXPECT().
+			Save(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, obj *store.Object, opts ...store.SaveOptions) error {
+				requireReset(t, obj)
+				require.Equal(t, "etag", store.NewSaveConfig(opts...).ETag)
+				return nil
+			})
+
+		err := aomTest.manager.ResetWithResource(context.TODO(), store.NewMockStorageClient(mctrl), nil, rid, opID)
+		require.NoError(t, err)
+	})
+
+	t.Run("status_not_found", func(t *testing.T) {
+		aomTest, mctrl := setup(t)
+		defer mctrl.Finish()
+
+		// The resource isn't saved when there is no operation status to reset.
+		aomTest.storeClient.
+			EXPECT().
+			Get(gomock.Any(), statusID, gomock.Any()).
+			Return(nil, &store.ErrNotFound{ID: statusID})
+
+		resource := &store.Object{Metadata: store.Metadata{ID: ucpEnvResourceID}}
+		err := aomTest.manager.ResetWithResource(context.TODO(), store.NewMockStorageClient(mctrl), resource, rid, opID)
+		require.ErrorIs(t, err, &store.ErrNotFound{})
+	})
+}
+
+func TestRequestCancelAsyncOperation(t *testing.T) {
+	rid, err := resources.ParseResource(ucpEnvResourceID)
+	require.NoError(t, err)
+	statusID := "/planes/radius/local/providers/applications.core/locations/test-location/operationstatuses/" + opID.String()
+
+	t.Run("in-flight operation", func(t *testing.T) {
+		aomTest, mctrl := setup(t)
+		defer mctrl.Finish()
+
+		updating := &Status{
+			AsyncOperationStatus: v1.AsyncOperationStatus{
+				ID:        opID.String(),
+				Name:      opID.String(),
+				Status:    v1.ProvisioningStateUpdating,
+				StartTime: time.Now().UTC(),
+			},
+		}
+
+		aomTest.storeClient.
+			EXPECT().
+			Get(gomock.Any(), statusID, gomock.Any()).
+			Return(&store.Object{Metadata: store.Metadata{ID: opID.String(), ETag: "etag"}, Data: updating}, nil)
+		aomTest.storeClient.
+			EXPECT().
+			Save(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, obj *store.Object, opts ...store.SaveOptions) error {
+				s := &Status{}
+				require.NoError(t, obj.As(s))
+				require.Equal(t, v1.ProvisioningStateUpdating, s.Status)
+				require.True(t, s.CancelRequested)
+				require.Equal(t, "no longer needed", s.CancelReason)
+				require.Equal(t, "etag", store.NewSaveConfig(opts...).ETag)
+				return nil
+			})
+
+		err := aomTest.manager.RequestCancel(context.TODO(), rid, opID, "no longer needed")
+		require.NoError(t, err)
+	})
+
+	t.Run("completed operation", func(t *testing.T) {
+		aomTest, mctrl := setup(t)
+		defer mctrl.Finish()
+
+		succeeded := &Status{
+			AsyncOperationStatus: v1.AsyncOperationStatus{
+				ID:     opID.String(),
+				Name:   opID.String(),
+				Status: v1.ProvisioningStateSucceeded,
+			},
+		}
+
+		aomTest.storeClient.
+			EXPECT().
+			Get(gomock.Any(), statusID, gomock.Any()).
+			Return(&store.Object{Metadata: store.Metadata{ID: opID.String(), ETag: "etag"}, Data: succeeded}, nil)
+
+		err := aomTest.manager.RequestCancel(context.TODO(), rid, opID, "no longer needed")
+		require.ErrorIs(t, err, ErrOperationCompleted)
+	})
+}
+
+func TestScheduleRetryAsyncOperation(t *testing.T) {
+	rid, err := resources.ParseResource(ucpEnvResourceID)
+	require.NoError(t, err)
+	statusID := "/planes/radius/local/providers/applications.core/locations/test-location/operationstatuses/" + opID.String()
+	nextAttemptTime := time.Now().Add(time.Minute).UTC()
+	opError := &v1.ErrorDetails{Code: v1.CodeInternal, Message: "transient"}
+
+	aomTest, mctrl := setup(t)
+	defer mctrl.Finish()
+
+	updating := &Status{
+		AsyncOperationStatus: v1.AsyncOperationStatus{
+			ID:     opID.String(),
+			Name:   opID.String(),
+			Status: v1.ProvisioningStateUpdating,
+		},
+	}
+
+	// setup expects a single call to GetStorageClient, and the test calls the status manager twice.
+	aomTest.storeProvider.EXPECT().GetStorageClient(gomock.Any(), gomock.Any()).Return(aomTest.storeClient, nil).Times(1)
+
+	var saved *Status
+	aomTest.storeClient.
+		EXPECT().
+		Get(gomock.Any(), statusID, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
+			if saved != nil {
+				return &store.Object{Metadata: store.Metadata{ID: opID.String(), ETag: "etag"}, Data: saved}, nil
+			}
+			return &store.Object{Metadata: store.Metadata{ID: opID.String(), ETag: "etag"}, Data: updating}, nil
+		}).
+		Times(2)
+	aomTest.storeClient.
+		EXPECT().
+		Save(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, obj *store.Object, opts ...store.SaveOptions) error {
+			saved = &Status{}
+			require.NoError(t, obj.As(saved))
+			return nil
+		}).
+		Times(2)
+
+	err = aomTest.manager.ScheduleRetry(context.TODO(), rid, opID, nextAttemptTime, opError)
+	require.NoError(t, err)
+	require.Equal(t, v1.ProvisioningStateUpdating, saved.Status)
+	require.NotNil(t, saved.NextAttemptTime)
+	require.True(t, nextAttemptTime.Equal(*saved.NextAttemptTime))
+	require.Equal(t, opError, saved.Error)
+
+	// The next attempt clears the scheduled retry.
+	err = aomTest.manager.Update(context.TODO(), rid, opID, v1.ProvisioningStateSucceeded, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, v1.ProvisioningStateSucceeded, saved.Status)
+	require.Nil(t, saved.NextAttemptTime)
+	require.Nil(t, saved.Error)
+}
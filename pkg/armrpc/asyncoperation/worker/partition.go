@@ -0,0 +1,95 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package worker
+
+import (
+	"sort"
+	"sync"
+)
+
+// partitionLimiter tracks the number of async operations in progress for each partition and limits the concurrency
+// of a partition so that a single partition can't take every worker slot.
+type partitionLimiter struct {
+	mu       sync.Mutex
+	inflight map[string]int
+
+	// defaultLimit is the maximum concurrency of the partition without override. Zero means unlimited.
+	defaultLimit int
+	// limits overrides defaultLimit for the specific partitions.
+	limits map[string]int
+}
+
+func newPartitionLimiter(defaultLimit int, limits map[string]int) *partitionLimiter {
+	return &partitionLimiter{
+		inflight:     map[string]int{},
+		defaultLimit: defaultLimit,
+		limits:       limits,
+	}
+}
+
+// limit returns the maximum concurrency of the partition. Zero means unlimited.
+func (p *partitionLimiter) limit(key string) int {
+	if l, ok := p.limits[key]; ok {
+		return l
+	}
+	return p.defaultLimit
+}
+
+// saturated returns the sorted keys of the partitions which reached their concurrency limit.
+func (p *partitionLimiter) saturated() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	keys := []string{}
+	for key, n := range p.inflight {
+		if l := p.limit(key); l > 0 && n >= l {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// acquire increases the number of async operations in progress for the partition. It returns true if the partition
+// reached its concurrency limit. The messages without partition key are not limited.
+func (p *partitionLimiter) acquire(key string) bool {
+	if key == "" {
+		return false
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.inflight[key]++
+	l := p.limit(key)
+	return l > 0 && p.inflight[key] >= l
+}
+
+// release decreases the number of async operations in progress for the partition.
+func (p *partitionLimiter) release(key string) {
+	if key == "" {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.inflight[key]--
+	if p.inflight[key] <= 0 {
+		delete(p.inflight, key)
+	}
+}
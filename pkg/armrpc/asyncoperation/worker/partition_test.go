@@ -0,0 +1,59 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package worker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPartitionLimiter(t *testing.T) {
+	p := newPartitionLimiter(2, map[string]int{"rg-small": 1, "rg-unlimited": 0})
+
+	require.False(t, p.acquire("rg-a"))
+	require.Empty(t, p.saturated())
+	require.True(t, p.acquire("rg-a"))
+	require.Equal(t, []string{"rg-a"}, p.saturated())
+
+	require.True(t, p.acquire("rg-small"))
+	require.Equal(t, []string{"rg-a", "rg-small"}, p.saturated())
+
+	for i := 0; i < 5; i++ {
+		require.False(t, p.acquire("rg-unlimited"))
+		require.False(t, p.acquire(""))
+	}
+	require.Equal(t, []string{"rg-a", "rg-small"}, p.saturated())
+
+	p.release("rg-a")
+	require.Equal(t, []string{"rg-small"}, p.saturated())
+	p.release("rg-small")
+	require.Empty(t, p.saturated())
+
+	p.release("rg-a")
+	p.release("")
+	require.NotContains(t, p.inflight, "rg-a")
+}
+
+func TestPartitionLimiter_Unlimited(t *testing.T) {
+	p := newPartitionLimiter(0, nil)
+
+	for i := 0; i < 100; i++ {
+		require.False(t, p.acquire("rg-a"))
+	}
+	require.Empty(t, p.saturated())
+}
@@ -32,13 +32,17 @@ type ControllerRegistry struct {
 	ctrlMap   map[string]ctrl.Controller
 	ctrlMapMu sync.RWMutex
 	sp        dataprovider.DataStorageProvider
+
+	// retryPolicies holds the retry policy overrides by operation type. It is guarded by ctrlMapMu.
+	retryPolicies map[string]ctrl.RetryPolicy
 }
 
 // NewControllerRegistry creates an ControllerRegistry instance.
 func NewControllerRegistry(sp dataprovider.DataStorageProvider) *ControllerRegistry {
 	return &ControllerRegistry{
-		ctrlMap: map[string]ctrl.Controller{},
-		sp:      sp,
+		ctrlMap:       map[string]ctrl.Controller{},
+		sp:            sp,
+		retryPolicies: map[string]ctrl.RetryPolicy{},
 	}
 }
 
@@ -76,3 +80,22 @@ func (h *ControllerRegistry) Get(operationType v1.OperationType) ctrl.Controller
 
 	return nil
 }
+
+// SetRetryPolicy overrides the retry policy of the worker for the operation type.
+func (h *ControllerRegistry) SetRetryPolicy(resourceType string, method v1.OperationMethod, policy ctrl.RetryPolicy) {
+	h.ctrlMapMu.Lock()
+	defer h.ctrlMapMu.Unlock()
+
+	ot := v1.OperationType{Type: resourceType, Method: method}
+	h.retryPolicies[ot.String()] = policy
+}
+
+// GetRetryPolicy gets the retry policy override for the operation type. It returns false if the operation type
+// has no override.
+func (h *ControllerRegistry) GetRetryPolicy(operationType v1.OperationType) (ctrl.RetryPolicy, bool) {
+	h.ctrlMapMu.RLock()
+	defer h.ctrlMapMu.RUnlock()
+
+	policy, ok := h.retryPolicies[operationType.String()]
+	return policy, ok
+}
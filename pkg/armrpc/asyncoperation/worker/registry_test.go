@@ -19,6 +19,7 @@ package worker
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
@@ -68,3 +69,20 @@ func TestRegister_Get(t *testing.T) {
 	ctrl = registry.Get(opPut)
 	require.NotNil(t, ctrl)
 }
+
+func TestSetRetryPolicy_GetRetryPolicy(t *testing.T) {
+	registry := NewControllerRegistry(nil)
+
+	opPut := v1.OperationType{Type: "Applications.Core/environments", Method: v1.OperationPut}
+	opDelete := v1.OperationType{Type: "Applications.Core/environments", Method: v1.OperationDelete}
+
+	policy := ctrl.RetryPolicy{MaxAttempts: 10, InitialInterval: time.Second}
+	registry.SetRetryPolicy("applications.core/environments", v1.OperationPut, policy)
+
+	actual, ok := registry.GetRetryPolicy(opPut)
+	require.True(t, ok)
+	require.Equal(t, policy, actual)
+
+	_, ok = registry.GetRetryPolicy(opDelete)
+	require.False(t, ok)
+}
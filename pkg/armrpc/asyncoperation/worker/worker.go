@@ -59,6 +59,9 @@ const (
 
 	// defaultDequeueInterval is the default duration for the dequeue interval.
 	defaultDequeueInterval = time.Duration(200) * time.Millisecond
+
+	// defaultCancelPollInterval is the default interval to check the cancellation request of the running operation.
+	defaultCancelPollInterval = time.Duration(5) * time.Second
 )
 
 // Options configures AsyncRequestProcessorWorker
@@ -80,6 +83,21 @@ type Options struct {
 
 	// DequeueIntervalDuration is the duration for the dequeue interval.
 	DequeueIntervalDuration time.Duration
+
+	// MaxPartitionConcurrency is the maximum concurrency to process async request operations of the same partition,
+	// which is the resource group of the resource by default. Zero means unlimited.
+	MaxPartitionConcurrency int
+
+	// PartitionConcurrency overrides MaxPartitionConcurrency for the specific partitions by partition key.
+	PartitionConcurrency map[string]int
+
+	// CancelPollInterval is the interval to check the operation status for the cancellation request while the
+	// operation is running.
+	CancelPollInterval time.Duration
+
+	// RetryPolicy is the policy to retry the operations requeued by their controllers. ctrl.DefaultRetryPolicy is used
+	// if it is nil. The policy can be overridden for each operation type by ControllerRegistry.SetRetryPolicy.
+	RetryPolicy *ctrl.RetryPolicy
 }
 
 // AsyncRequestProcessWorker is the worker to process async requests.
@@ -89,7 +107,8 @@ type AsyncRequestProcessWorker struct {
 	registry     *ControllerRegistry
 	requestQueue queue.Client
 
-	sem *semaphore.Weighted
+	sem        *semaphore.Weighted
+	partitions *partitionLimiter
 }
 
 // New creates AsyncRequestProcessWorker server instance.
@@ -116,6 +135,13 @@ func New(
 	if options.DequeueIntervalDuration == time.Duration(0) {
 		options.DequeueIntervalDuration = defaultDequeueInterval
 	}
+	if options.CancelPollInterval == time.Duration(0) {
+		options.CancelPollInterval = defaultCancelPollInterval
+	}
+	if options.RetryPolicy == nil {
+		policy := ctrl.DefaultRetryPolicy()
+		options.RetryPolicy = &policy
+	}
 
 	return &AsyncRequestProcessWorker{
 		options:      options,
@@ -123,96 +149,148 @@ func New(
 		registry:     ctrlRegistry,
 		requestQueue: qu,
 		sem:          semaphore.NewWeighted(int64(options.MaxOperationConcurrency)),
+		partitions:   newPartitionLimiter(options.MaxPartitionConcurrency, options.PartitionConcurrency),
 	}
 }
 
 // Start starts worker's message loop - it starts a loop to process messages from a queue concurrently, and handles deduplication, updating
-// resource and operation status, and running the operation. It returns an error if it fails to start the dequeuer.
+// resource and operation status, and running the operation. Messages are dequeued in the order of priority, and the messages of the
+// partitions which reached their concurrency limit are left in the queue so that a single partition can't starve the others.
 func (w *AsyncRequestProcessWorker) Start(ctx context.Context) error {
 	logger := ucplog.FromContextOrDiscard(ctx)
-	msgCh, err := queue.StartDequeuer(ctx, w.requestQueue, queue.WithDequeueInterval(w.options.DequeueIntervalDuration))
-	if err != nil {
-		return err
-	}
 
-	// this loop will run until msgCh is closed (or when ctx is canceled)
-	for msg := range msgCh {
+	// this loop will run until ctx is canceled.
+	for ctx.Err() == nil {
 		// This semaphore will maintain the number of go routines to process the messages concurrently.
 		if err := w.sem.Acquire(ctx, 1); err != nil {
 			break
 		}
 
-		go func(msgreq *queue.Message) {
-			defer w.sem.Release(1)
+		msg, err := w.dequeue(ctx)
+		if err != nil {
+			w.sem.Release(1)
+		} else {
+			go func(msgreq *queue.Message) {
+				defer w.sem.Release(1)
+				defer w.partitions.release(msgreq.PartitionKey)
+				w.processMessage(ctx, msgreq)
+			}(msg)
+		}
 
-			op := &ctrl.Request{}
-			if err := json.Unmarshal(msgreq.Data, op); err != nil {
-				logger.Error(err, "failed to unmarshal queue message.")
-				return
-			}
+		select {
+		case <-ctx.Done():
+		case <-time.After(w.options.DequeueIntervalDuration):
+		}
+	}
 
-			reqCtx := trace.WithTraceparent(ctx, op.TraceparentID)
+	logger.Info("Message loop stopped...")
+	return nil
+}
 
-			// Populate the default attributes in the current context so all logs will have these fields.
-			reqCtx = ucplog.WrapLogContext(reqCtx,
-				logging.LogFieldResourceID, op.ResourceID,
-				logging.LogFieldOperationID, op.OperationID,
-				logging.LogFieldOperationType, op.OperationType,
-				logging.LogFieldDequeueCount, msgreq.DequeueCount)
+// dequeue leases the next message from the queue excluding the partitions which reached their concurrency limit, and
+// acquires the slot of the partition of the message.
+func (w *AsyncRequestProcessWorker) dequeue(ctx context.Context) (*queue.Message, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
 
-			opLogger := ucplog.FromContextOrDiscard(reqCtx)
+	msg, err := w.requestQueue.Dequeue(ctx, queue.QueueClientConfig{ExcludedPartitions: w.partitions.saturated()})
+	if err != nil {
+		if !errors.Is(err, queue.ErrMessageNotFound) && ctx.Err() == nil {
+			logger.Error(err, "fails to dequeue the message")
+		}
+		return nil, err
+	}
 
-			armReqCtx, err := op.ARMRequestContext()
-			if err != nil {
-				opLogger.Error(err, "failed to get ARM request context.")
-				return
-			}
-			reqCtx = v1.WithARMRequestContext(reqCtx, armReqCtx)
-
-			asyncCtrl := w.registry.Get(armReqCtx.OperationType)
-			if asyncCtrl == nil {
-				opLogger.Error(nil, "cannot process unknown operation: "+armReqCtx.OperationType.String())
-				if err := w.requestQueue.FinishMessage(reqCtx, msgreq); err != nil {
-					opLogger.Error(err, "failed to finish the message")
-				}
-				return
-			}
+	if w.partitions.acquire(msg.PartitionKey) {
+		logger.Info("Partition reached the concurrency limit.", "partitionKey", msg.PartitionKey)
+		metrics.DefaultAsyncOperationMetrics.RecordThrottledPartition(ctx)
+	}
 
-			if msgreq.DequeueCount > w.options.MaxOperationRetryCount {
-				errMsg := fmt.Sprintf("exceeded max retry count to process async operation message: %d", msgreq.DequeueCount)
-				opLogger.Error(nil, errMsg)
-				failed := ctrl.NewFailedResult(v1.ErrorDetails{
-					Code:    v1.CodeInternal,
-					Message: errMsg,
-				})
-				w.completeOperation(reqCtx, msgreq, failed, asyncCtrl.StorageClient())
-				return
-			}
+	return msg, nil
+}
 
-			// TODO: Handle the edge cases:
-			// 1. The same message is delivered twice in multiple instances.
-			// 2. provisioningState is not matched between resource and operationStatuses
+// processMessage decodes the message and runs the controller of the async operation.
+func (w *AsyncRequestProcessWorker) processMessage(ctx context.Context, msgreq *queue.Message) {
+	logger := ucplog.FromContextOrDiscard(ctx)
 
-			dup, err := w.isDuplicated(reqCtx, asyncCtrl.StorageClient(), op.ResourceID, op.OperationID)
-			if err != nil {
-				opLogger.Error(err, "failed to check potential deduplication.")
-				return
-			}
-			if dup {
-				opLogger.Info("duplicated message detected")
-				return
-			}
+	op := &ctrl.Request{}
+	if err := json.Unmarshal(msgreq.Data, op); err != nil {
+		logger.Error(err, "failed to unmarshal queue message.")
+		w.deadLetterMessage(ctx, msgreq, fmt.Sprintf("failed to unmarshal queue message: %s", err.Error()))
+		return
+	}
 
-			if err = w.updateResourceAndOperationStatus(reqCtx, asyncCtrl.StorageClient(), op, v1.ProvisioningStateUpdating, nil); err != nil {
-				return
-			}
+	reqCtx := trace.WithTraceparent(ctx, op.TraceparentID)
+
+	// Populate the default attributes in the current context so all logs will have these fields.
+	reqCtx = ucplog.WrapLogContext(reqCtx,
+		logging.LogFieldResourceID, op.ResourceID,
+		logging.LogFieldOperationID, op.OperationID,
+		logging.LogFieldOperationType, op.OperationType,
+		logging.LogFieldDequeueCount, msgreq.DequeueCount)
 
-			w.runOperation(reqCtx, msgreq, asyncCtrl)
-		}(msg)
+	opLogger := ucplog.FromContextOrDiscard(reqCtx)
+
+	armReqCtx, err := op.ARMRequestContext()
+	if err != nil {
+		opLogger.Error(err, "failed to get ARM request context.")
+		w.deadLetterMessage(reqCtx, msgreq, fmt.Sprintf("failed to get ARM request context: %s", err.Error()))
+		return
 	}
+	reqCtx = v1.WithARMRequestContext(reqCtx, armReqCtx)
 
-	logger.Info("Message loop stopped...")
-	return nil
+	asyncCtrl := w.registry.Get(armReqCtx.OperationType)
+	if asyncCtrl == nil {
+		opLogger.Error(nil, "cannot process unknown operation: "+armReqCtx.OperationType.String())
+		if err := w.requestQueue.FinishMessage(reqCtx, msgreq); err != nil {
+			opLogger.Error(err, "failed to finish the message")
+		}
+		return
+	}
+
+	if msgreq.DequeueCount > w.maxRetryCount(w.retryPolicy(armReqCtx.OperationType)) {
+		errMsg := fmt.Sprintf("exceeded max retry count to process async operation message: %d", msgreq.DequeueCount)
+		opLogger.Error(nil, errMsg)
+		failed := ctrl.NewFailedResult(v1.ErrorDetails{
+			Code:    v1.CodeInternal,
+			Message: errMsg,
+		})
+		// Keep the exhausted message in the dead-letter queue instead of dropping it so that it can be
+		// inspected and replayed.
+		if err := w.updateResourceAndOperationStatus(reqCtx, asyncCtrl.StorageClient(), op, failed.ProvisioningState(), failed.Error); err != nil {
+			return
+		}
+		w.deadLetterMessage(reqCtx, msgreq, errMsg)
+		metrics.DefaultAsyncOperationMetrics.RecordAsyncOperation(reqCtx, op, &failed)
+		return
+	}
+
+	// TODO: Handle the edge cases:
+	// 1. The same message is delivered twice in multiple instances.
+	// 2. provisioningState is not matched between resource and operationStatuses
+
+	dup, err := w.isDuplicated(reqCtx, asyncCtrl.StorageClient(), op.ResourceID, op.OperationID)
+	if err != nil {
+		opLogger.Error(err, "failed to check potential deduplication.")
+		return
+	}
+	if dup {
+		opLogger.Info("duplicated message detected")
+		return
+	}
+
+	// The operation can be canceled before it is dequeued. Complete it without running the controller.
+	if reason, ok := w.cancelRequested(reqCtx, op); ok {
+		opLogger.Info("Operation was canceled before it started.", "reason", reason)
+		w.completeOperation(reqCtx, msgreq, newCanceledResult(op, reason), asyncCtrl.StorageClient())
+		return
+	}
+
+	if err = w.updateResourceAndOperationStatus(reqCtx, asyncCtrl.StorageClient(), op, v1.ProvisioningStateUpdating, nil); err != nil {
+		return
+	}
+
+	metrics.DefaultAsyncOperationMetrics.RecordScheduledAsyncOperation(reqCtx, op, msgreq.Priority.String(), msgreq.EnqueueAt)
+	w.runOperation(reqCtx, msgreq, asyncCtrl)
 }
 
 func (w *AsyncRequestProcessWorker) runOperation(ctx context.Context, message *queue.Message, asyncCtrl ctrl.Controller) {
@@ -268,18 +346,23 @@ func (w *AsyncRequestProcessWorker) runOperation(ctx context.Context, message *q
 	}()
 
 	operationTimeoutAfter := time.After(asyncReq.Timeout())
-	messageExtendAfter := w.getMessageExtendDuration(message.NextVisibleAt)
+	messageExtendAfter := time.After(w.getMessageExtendDuration(message.NextVisibleAt))
+
+	// The cancellation request is recorded in the operation status so that it reaches the worker regardless of
+	// which replica received the request.
+	cancelPoll := time.NewTicker(w.options.CancelPollInterval)
+	defer cancelPoll.Stop()
 
 	for {
 		select {
-		case <-time.After(messageExtendAfter):
+		case <-messageExtendAfter:
 			if err := w.requestQueue.ExtendMessage(ctx, message); err != nil {
 				logger.Error(err, "fails to extend message lock")
 			} else {
 				logger.Info("Extended message lock duration.", "nextVisibleTime", message.NextVisibleAt.UTC().String())
 				metrics.DefaultAsyncOperationMetrics.RecordExtendedAsyncOperation(ctx, asyncReq)
 			}
-			messageExtendAfter = w.getMessageExtendDuration(message.NextVisibleAt)
+			messageExtendAfter = time.After(w.getMessageExtendDuration(message.NextVisibleAt))
 
 		case <-operationTimeoutAfter:
 			logger.Info("Cancelling async operation.")
@@ -291,6 +374,17 @@ func (w *AsyncRequestProcessWorker) runOperation(ctx context.Context, message *q
 			w.completeOperation(ctx, message, result, asyncCtrl.StorageClient())
 			return
 
+		case <-cancelPoll.C:
+			reason, ok := w.cancelRequested(ctx, asyncReq)
+			if !ok {
+				continue
+			}
+
+			logger.Info("Cancelling async operation by request.", "reason", reason)
+			opCancel()
+			w.completeOperation(ctx, message, newCanceledResult(asyncReq, reason), asyncCtrl.StorageClient())
+			return
+
 		case <-ctx.Done():
 			logger.Info("Stopping processing async operation. This operation will be reprocessed.")
 			return
@@ -306,6 +400,33 @@ func (w *AsyncRequestProcessWorker) runOperation(ctx context.Context, message *q
 	}
 }
 
+// cancelRequested returns the cancellation reason and true if the cancellation of the operation is requested.
+// Errors are logged and treated as no request, so that the operation is checked again later.
+func (w *AsyncRequestProcessWorker) cancelRequested(ctx context.Context, req *ctrl.Request) (string, bool) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	rID, err := resources.ParseResource(req.ResourceID)
+	if err != nil {
+		logger.Error(err, "failed to parse resource ID")
+		return "", false
+	}
+
+	status, err := w.sm.Get(ctx, rID, req.OperationID)
+	if err != nil {
+		logger.Error(err, "failed to check the cancellation request of the operation")
+		return "", false
+	}
+
+	return status.CancelReason, status.CancelRequested
+}
+
+// newCanceledResult creates the result of the operation canceled by the request with the given reason.
+func newCanceledResult(req *ctrl.Request, reason string) ctrl.Result {
+	result := ctrl.NewCanceledResult(reason)
+	result.Error.Target = req.ResourceID
+	return result
+}
+
 func extractError(err error) v1.ErrorDetails {
 	if clientErr, ok := err.(*v1.ErrClientRP); ok {
 		return v1.ErrorDetails{Code: clientErr.Code, Message: clientErr.Message}
@@ -322,20 +443,113 @@ func (w *AsyncRequestProcessWorker) completeOperation(ctx context.Context, messa
 		return
 	}
 
+	if result.Requeue {
+		w.requeueOperation(ctx, message, req, result, sc)
+		return
+	}
+
 	err := w.updateResourceAndOperationStatus(ctx, sc, req, result.ProvisioningState(), result.Error)
 	if err != nil {
 		logger.Error(err, "failed to update resource and/or operation status")
 		return
 	}
 
-	// Finish the message only if Requeue is false. Otherwise, AsyncRequestProcessWorker will requeue the message and process it again.
-	if !result.Requeue {
+	if err := w.requestQueue.FinishMessage(ctx, message); err != nil {
+		logger.Error(err, "failed to finish the message")
+	}
+
+	metrics.DefaultAsyncOperationMetrics.RecordAsyncOperation(ctx, req, &result)
+}
+
+// requeueOperation schedules the next attempt of the operation requeued by its controller after the backoff of the
+// retry policy, and records the next attempt time in the operation status. The operation fails immediately if the
+// error is not retryable. If the queue can't requeue messages, the operation is retried when the message lock expires.
+func (w *AsyncRequestProcessWorker) requeueOperation(ctx context.Context, message *queue.Message, req *ctrl.Request, result ctrl.Result, sc store.StorageClient) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	opType, _ := v1.ParseOperationType(req.OperationType)
+	policy := w.retryPolicy(opType)
+	if !policy.IsRetryable(result.Error) {
+		logger.Info("The operation is not retried because the error is not retryable.", "code", result.Error.Code)
+		result.Requeue = false
+		result.SetProvisioningState(v1.ProvisioningStateFailed)
+		w.completeOperation(ctx, message, result, sc)
+		return
+	}
+
+	// Queues which can't requeue messages dequeue the message again when its lock expires.
+	requeuer, ok := w.requestQueue.(queue.RequeueClient)
+	if !ok {
+		logger.Info("The operation is retried when the message lock expires.", "attempt", message.DequeueCount)
+		metrics.DefaultAsyncOperationMetrics.RecordAsyncOperation(ctx, req, &result)
+		return
+	}
+
+	rID, err := resources.ParseResource(req.ResourceID)
+	if err != nil {
+		logger.Error(err, "failed to parse resource ID")
+		return
+	}
+
+	// The message which exhausted the attempts is dequeued immediately so that it is moved to the dead-letter queue
+	// without waiting for the backoff.
+	nextAttemptTime := time.Now()
+	if message.DequeueCount < w.maxRetryCount(policy) {
+		nextAttemptTime = nextAttemptTime.Add(policy.Backoff(message.DequeueCount))
+	}
+
+	// The message lock expires and the operation is retried even if the retry can't be scheduled.
+	if err := w.sm.ScheduleRetry(ctx, rID, req.OperationID, nextAttemptTime, result.Error); err != nil {
+		logger.Error(err, "failed to update the operation status with the next attempt time")
+		return
+	}
+
+	if err := requeuer.RequeueMessage(ctx, message, nextAttemptTime); err != nil {
+		logger.Error(err, "failed to requeue the message")
+		return
+	}
+
+	logger.Info("Scheduled the next attempt of the operation.", "attempt", message.DequeueCount, "nextAttemptTime", nextAttemptTime.UTC())
+	metrics.DefaultAsyncOperationMetrics.RecordAsyncOperation(ctx, req, &result)
+}
+
+// retryPolicy returns the retry policy for the operation type, which is the override in the registry if it exists.
+func (w *AsyncRequestProcessWorker) retryPolicy(operationType v1.OperationType) ctrl.RetryPolicy {
+	if w.registry != nil {
+		if policy, ok := w.registry.GetRetryPolicy(operationType); ok {
+			return policy
+		}
+	}
+	return *w.options.RetryPolicy
+}
+
+// maxRetryCount returns the maximum number of attempts of the retry policy, or the maximum retry count of the worker
+// if the policy does not set it.
+func (w *AsyncRequestProcessWorker) maxRetryCount(policy ctrl.RetryPolicy) int {
+	if policy.MaxAttempts > 0 {
+		return policy.MaxAttempts
+	}
+	return w.options.MaxOperationRetryCount
+}
+
+// deadLetterMessage moves the message which cannot be processed to the dead-letter queue. The message is finished
+// if the queue does not support dead-lettering.
+func (w *AsyncRequestProcessWorker) deadLetterMessage(ctx context.Context, message *queue.Message, reason string) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	dlq, ok := w.requestQueue.(queue.DeadLetterClient)
+	if !ok {
 		if err := w.requestQueue.FinishMessage(ctx, message); err != nil {
 			logger.Error(err, "failed to finish the message")
 		}
+		return
 	}
 
-	metrics.DefaultAsyncOperationMetrics.RecordAsyncOperation(ctx, req, &result)
+	if err := dlq.DeadLetter(ctx, message, reason); err != nil {
+		logger.Error(err, "failed to move the message to the dead-letter queue")
+		return
+	}
+	logger.Info("Moved the message to the dead-letter queue.", "messageID", message.ID, "reason", reason)
 }
 
 func (w *AsyncRequestProcessWorker) updateResourceAndOperationStatus(ctx context.Context, sc store.StorageClient, req *ctrl.Request, state v1.ProvisioningState, opErr *v1.ErrorDetails) error {
@@ -349,17 +563,18 @@ func (w *AsyncRequestProcessWorker) updateResourceAndOperationStatus(ctx context
 
 	opType, _ := v1.ParseOperationType(req.OperationType)
 
-	err = updateResourceState(ctx, sc, rID.String(), state)
+	resource, err := resourceWithState(ctx, sc, rID.String(), state)
 	if err != nil && !(opType.Method == http.MethodDelete && errors.Is(&store.ErrNotFound{ID: rID.String()}, err)) {
-		logger.Error(err, "failed to update the provisioningState in resource.")
+		logger.Error(err, "failed to get the resource to update the provisioningState.")
 		return err
 	}
 
-	// Otherwise we update the operationStatus to the result.
+	// Save the provisioningState of the resource and the operationStatus together so that a failure between the
+	// two writes can't leave them inconsistent.
 	now := time.Now().UTC()
-	err = w.sm.Update(ctx, rID, req.OperationID, state, &now, opErr)
+	err = w.sm.UpdateWithResource(ctx, sc, resource, rID, req.OperationID, state, &now, opErr)
 	if err != nil {
-		logger.Error(err, "failed to update operationstatus", "operationID", req.OperationID.String())
+		logger.Error(err, "failed to update the provisioningState and operationstatus", "operationID", req.OperationID.String())
 		return err
 	}
 
@@ -396,10 +611,12 @@ func (w *AsyncRequestProcessWorker) getMessageExtendDuration(visibleAt time.Time
 	return d
 }
 
-func updateResourceState(ctx context.Context, sc store.StorageClient, id string, state v1.ProvisioningState) error {
+// resourceWithState gets the resource and sets its provisioningState. nil is returned if the resource is already
+// in the given state.
+func resourceWithState(ctx context.Context, sc store.StorageClient, id string, state v1.ProvisioningState) (*store.Object, error) {
 	obj, err := sc.Get(ctx, id)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	objmap := obj.Data.(map[string]any)
@@ -408,15 +625,9 @@ func updateResourceState(ctx context.Context, sc store.StorageClient, id string,
 		// Do not update it if provisioning state is already the target state.
 		// This happens when redeploying worker can stop completing message.
 		// So, provisioningState in Resource is updated but not in operationStatus record.
-		return nil
+		return nil, nil
 	}
 
 	objmap["provisioningState"] = string(state)
-
-	err = sc.Save(ctx, obj, store.WithETag(obj.ETag))
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return obj, nil
 }
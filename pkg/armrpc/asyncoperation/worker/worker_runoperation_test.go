@@ -18,9 +18,11 @@ package worker
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -213,8 +215,7 @@ func TestStart_MaxDequeueCount(t *testing.T) {
 		DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
 			return newTestResourceObject(), nil
 		}).AnyTimes()
-	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
-	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Eq(v1.ProvisioningStateFailed), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	tCtx.mockSM.EXPECT().UpdateWithResource(gomock.Any(), gomock.Eq(tCtx.mockSC), gomock.Not(gomock.Nil()), gomock.Any(), gomock.Any(), gomock.Eq(v1.ProvisioningStateFailed), gomock.Any(), gomock.Any()).Return(nil).Times(1)
 	tCtx.mockSP.EXPECT().GetStorageClient(gomock.Any(), gomock.Any()).Return(store.StorageClient(tCtx.mockSC), nil).Times(1)
 
 	expectedDequeueCount := 2
@@ -265,6 +266,45 @@ func TestStart_MaxDequeueCount(t *testing.T) {
 	<-done
 
 	require.Equal(t, expectedDequeueCount+2, testMessage.DequeueCount)
+
+	// The exhausted message must be kept in the dead-letter queue.
+	deadLetters, err := tCtx.testQueue.ListDeadLetters(tCtx.ctx)
+	require.NoError(t, err)
+	require.Len(t, deadLetters, 1)
+	require.Equal(t, testMessage.ID, deadLetters[0].ID)
+	require.Equal(t, "exceeded max retry count to process async operation message: 4", deadLetters[0].DeadLetterReason)
+}
+
+func TestStart_UndecodableMessage(t *testing.T) {
+	tCtx, mctrl := newTestContext(t, defaultTestLockTime)
+	defer mctrl.Finish()
+
+	registry := NewControllerRegistry(tCtx.mockSP)
+	worker := New(Options{DequeueIntervalDuration: defaultTestDequeueInterval}, tCtx.mockSM, tCtx.testQueue, registry)
+
+	ctx, cancel := tCtx.cancellable(0)
+
+	err := tCtx.testQueue.Enqueue(ctx, queue.NewMessage("not a json"))
+	require.NoError(t, err)
+
+	done := make(chan struct{}, 1)
+	go func() {
+		err = worker.Start(ctx)
+		require.NoError(t, err)
+		close(done)
+	}()
+
+	tCtx.drainQueueOrAssert(t)
+
+	// Cancelling worker loop
+	cancel()
+	<-done
+
+	deadLetters, err := tCtx.testQueue.ListDeadLetters(tCtx.ctx)
+	require.NoError(t, err)
+	require.Len(t, deadLetters, 1)
+	require.Equal(t, []byte("not a json"), deadLetters[0].Data)
+	require.True(t, strings.HasPrefix(deadLetters[0].DeadLetterReason, "failed to unmarshal queue message"))
 }
 
 func TestStart_MaxConcurrency(t *testing.T) {
@@ -276,9 +316,8 @@ func TestStart_MaxConcurrency(t *testing.T) {
 		DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
 			return newTestResourceObject(), nil
 		}).AnyTimes()
-	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	tCtx.mockSM.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(testOperationStatus, nil).AnyTimes()
-	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	tCtx.mockSM.EXPECT().UpdateWithResource(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	tCtx.mockSP.EXPECT().GetStorageClient(gomock.Any(), gomock.Any()).Return(store.StorageClient(tCtx.mockSC), nil).AnyTimes()
 
 	registry := NewControllerRegistry(tCtx.mockSP)
@@ -346,6 +385,110 @@ func TestStart_MaxConcurrency(t *testing.T) {
 	require.Equal(t, int32(defaultMaxOperationConcurrency), maxConcurrency.Load())
 }
 
+func TestStart_MaxPartitionConcurrency(t *testing.T) {
+	tCtx, mctrl := newTestContext(t, defaultTestLockTime)
+	defer mctrl.Finish()
+
+	// set up mocks
+	tCtx.mockSC.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
+			return newTestResourceObject(), nil
+		}).AnyTimes()
+	tCtx.mockSM.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(testOperationStatus, nil).AnyTimes()
+	tCtx.mockSM.EXPECT().UpdateWithResource(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	tCtx.mockSP.EXPECT().GetStorageClient(gomock.Any(), gomock.Any()).Return(store.StorageClient(tCtx.mockSC), nil).AnyTimes()
+
+	registry := NewControllerRegistry(tCtx.mockSP)
+	worker := New(Options{
+		DequeueIntervalDuration: defaultTestDequeueInterval,
+		MaxPartitionConcurrency: 2,
+		PartitionConcurrency:    map[string]int{"rg-b": 1},
+	}, tCtx.mockSM, tCtx.testQueue, registry)
+
+	opts := ctrl.Options{
+		StorageClient: tCtx.mockSC,
+		DataProvider:  tCtx.mockSP,
+		GetDeploymentProcessor: func() deployment.DeploymentProcessor {
+			return deployment.NewMockDeploymentProcessor(mctrl)
+		},
+	}
+
+	// partitions maps the resource id to the partition key of the message.
+	partitions := map[string]string{}
+	mu := sync.Mutex{}
+	inflight := map[string]int{}
+	maxInflight := map[string]int{}
+	total := atomic.NewInt32(0)
+	maxTotal := atomic.NewInt32(0)
+
+	testCtrl := &testAsyncController{
+		BaseController: ctrl.NewBaseAsyncController(opts),
+		fn: func(ctx context.Context) (ctrl.Result, error) {
+			key := partitions[v1.ARMRequestContextFromContext(ctx).ResourceID.String()]
+
+			mu.Lock()
+			inflight[key]++
+			if inflight[key] > maxInflight[key] {
+				maxInflight[key] = inflight[key]
+			}
+			mu.Unlock()
+			if n := total.Inc(); n > maxTotal.Load() {
+				maxTotal.Store(n)
+			}
+
+			time.Sleep(100 * time.Millisecond)
+
+			total.Dec()
+			mu.Lock()
+			inflight[key]--
+			mu.Unlock()
+			return ctrl.Result{}, nil
+		},
+	}
+	ctx, cancel := tCtx.cancellable(time.Duration(0))
+	err := registry.Register(
+		ctx,
+		testResourceType,
+		v1.OperationPut,
+		func(opts ctrl.Options) (ctrl.Controller, error) {
+			return testCtrl, nil
+		}, opts)
+	require.NoError(t, err)
+
+	// queue asyncoperation messages before starting the worker so that rg-a can take every worker slot
+	// without the partition limit.
+	for i := 0; i < 8; i++ {
+		key := "rg-a"
+		if i%2 == 1 {
+			key = "rg-b"
+		}
+		testMessage := genTestMessage(uuid.New(), ctrl.DefaultAsyncOperationTimeout)
+		req := &ctrl.Request{}
+		require.NoError(t, json.Unmarshal(testMessage.Data, req))
+		partitions[req.ResourceID] = key
+
+		err = tCtx.testQueue.Enqueue(ctx, testMessage, queue.WithPartitionKey(key))
+		require.NoError(t, err)
+	}
+
+	done := make(chan struct{}, 1)
+	go func() {
+		err = worker.Start(ctx)
+		require.NoError(t, err)
+		close(done)
+	}()
+
+	tCtx.drainQueueOrAssert(t)
+
+	// Cancelling worker loop.
+	cancel()
+	<-done
+
+	require.Equal(t, 2, maxInflight["rg-a"])
+	require.Equal(t, 1, maxInflight["rg-b"])
+	require.LessOrEqual(t, maxTotal.Load(), int32(3))
+}
+
 func TestStart_RunOperation(t *testing.T) {
 	tCtx, mctrl := newTestContext(t, defaultTestLockTime)
 	defer mctrl.Finish()
@@ -355,9 +498,8 @@ func TestStart_RunOperation(t *testing.T) {
 		DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
 			return newTestResourceObject(), nil
 		}).AnyTimes()
-	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	tCtx.mockSM.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(testOperationStatus, nil).AnyTimes()
-	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	tCtx.mockSM.EXPECT().UpdateWithResource(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	tCtx.mockSP.EXPECT().GetStorageClient(gomock.Any(), gomock.Any()).Return(store.StorageClient(tCtx.mockSC), nil).AnyTimes()
 
 	registry := NewControllerRegistry(tCtx.mockSP)
@@ -427,8 +569,7 @@ func TestRunOperation_Successfully(t *testing.T) {
 		DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
 			return newTestResourceObject(), nil
 		}).AnyTimes()
-	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
-	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	tCtx.mockSM.EXPECT().UpdateWithResource(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 	testMessage := genTestMessage(uuid.New(), ctrl.DefaultAsyncOperationTimeout)
 	err := tCtx.testQueue.Enqueue(tCtx.ctx, testMessage)
@@ -466,8 +607,8 @@ func TestRunOperation_ExtendMessageLock(t *testing.T) {
 		DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
 			return newTestResourceObject(), nil
 		}).AnyTimes()
-	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
-	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	tCtx.mockSM.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(testOperationStatus, nil).AnyTimes()
+	tCtx.mockSM.EXPECT().UpdateWithResource(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 	testMessage := genTestMessage(uuid.New(), ctrl.DefaultAsyncOperationTimeout)
 	err := tCtx.testQueue.Enqueue(tCtx.ctx, testMessage)
@@ -554,9 +695,8 @@ func TestRunOperation_Timeout(t *testing.T) {
 		DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
 			return newTestResourceObject(), nil
 		}).AnyTimes()
-	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
-	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
-		DoAndReturn(func(_ context.Context, _ resources.ID, _ uuid.UUID, state v1.ProvisioningState, _ *time.Time, opError *v1.ErrorDetails) error {
+	tCtx.mockSM.EXPECT().UpdateWithResource(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ store.StorageClient, _ *store.Object, _ resources.ID, _ uuid.UUID, state v1.ProvisioningState, _ *time.Time, opError *v1.ErrorDetails) error {
 			if state == v1.ProvisioningStateCanceled && strings.HasPrefix(opError.Message, "Operation (APPLICATIONS.CORE/ENVIRONMENTS|PUT) has timed out because it was processing longer than") &&
 				strings.HasPrefix(opError.Target, "/subscriptions/00000000-0000-0000-0000-000000000000") {
 				return nil
@@ -595,6 +735,278 @@ func TestRunOperation_Timeout(t *testing.T) {
 	require.Equal(t, 0, tCtx.internalQ.Len(), "message is finished")
 }
 
+func TestRunOperation_CancelRequested(t *testing.T) {
+	tCtx, mctrl := newTestContext(t, defaultTestLockTime)
+	defer mctrl.Finish()
+
+	canceled := &manager.Status{
+		AsyncOperationStatus: testOperationStatus.AsyncOperationStatus,
+		CancelRequested:      true,
+		CancelReason:         "no longer needed",
+	}
+	requested := atomic.NewBool(false)
+
+	// set up mocks
+	tCtx.mockSC.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
+			return newTestResourceObject(), nil
+		}).AnyTimes()
+	tCtx.mockSM.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ resources.ID, _ uuid.UUID) (*manager.Status, error) {
+			if requested.Load() {
+				return canceled, nil
+			}
+			return testOperationStatus, nil
+		}).AnyTimes()
+	tCtx.mockSM.EXPECT().UpdateWithResource(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Eq(v1.ProvisioningStateCanceled), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ store.StorageClient, _ *store.Object, _ resources.ID, _ uuid.UUID, _ v1.ProvisioningState, _ *time.Time, opError *v1.ErrorDetails) error {
+			require.Equal(t, v1.CodeOperationCanceled, opError.Code)
+			require.Equal(t, "no longer needed", opError.Message)
+			return nil
+		}).Times(1)
+
+	testMessage := genTestMessage(uuid.New(), ctrl.DefaultAsyncOperationTimeout)
+	err := tCtx.testQueue.Enqueue(tCtx.ctx, testMessage)
+	require.NoError(t, err)
+	worker := New(Options{CancelPollInterval: 10 * time.Millisecond}, tCtx.mockSM, tCtx.testQueue, nil)
+
+	opts := ctrl.Options{
+		StorageClient: tCtx.mockSC,
+		DataProvider:  tCtx.mockSP,
+		GetDeploymentProcessor: func() deployment.DeploymentProcessor {
+			return deployment.NewMockDeploymentProcessor(mctrl)
+		},
+	}
+
+	done := make(chan struct{}, 1)
+	testCtrl := &testAsyncController{
+		BaseController: ctrl.NewBaseAsyncController(opts),
+		fn: func(ctx context.Context) (ctrl.Result, error) {
+			requested.Store(true)
+			<-ctx.Done()
+			close(done)
+			return ctrl.Result{}, nil
+		},
+	}
+
+	msg, err := tCtx.testQueue.Dequeue(tCtx.ctx, queue.QueueClientConfig{})
+	require.NoError(t, err)
+	worker.runOperation(context.Background(), msg, testCtrl)
+	<-done
+
+	require.Equal(t, 0, tCtx.internalQ.Len(), "message is finished")
+}
+
+func TestStart_CanceledBeforeStart(t *testing.T) {
+	tCtx, mctrl := newTestContext(t, defaultTestLockTime)
+	defer mctrl.Finish()
+
+	canceled := &manager.Status{
+		AsyncOperationStatus: v1.AsyncOperationStatus{Status: v1.ProvisioningStateAccepted},
+		CancelRequested:      true,
+		CancelReason:         "no longer needed",
+	}
+
+	// set up mocks
+	tCtx.mockSC.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
+			return newTestResourceObject(), nil
+		}).AnyTimes()
+	tCtx.mockSM.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(canceled, nil).AnyTimes()
+	tCtx.mockSM.EXPECT().UpdateWithResource(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Eq(v1.ProvisioningStateCanceled), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	tCtx.mockSP.EXPECT().GetStorageClient(gomock.Any(), gomock.Any()).Return(store.StorageClient(tCtx.mockSC), nil).AnyTimes()
+
+	registry := NewControllerRegistry(tCtx.mockSP)
+	worker := New(Options{}, tCtx.mockSM, tCtx.testQueue, registry)
+
+	opts := ctrl.Options{
+		StorageClient: tCtx.mockSC,
+		DataProvider:  tCtx.mockSP,
+		GetDeploymentProcessor: func() deployment.DeploymentProcessor {
+			return deployment.NewMockDeploymentProcessor(mctrl)
+		},
+	}
+
+	called := atomic.NewBool(false)
+	testCtrl := &testAsyncController{
+		BaseController: ctrl.NewBaseAsyncController(opts),
+		fn: func(ctx context.Context) (ctrl.Result, error) {
+			called.Store(true)
+			return ctrl.Result{}, nil
+		},
+	}
+
+	ctx, cancel := tCtx.cancellable(time.Duration(0))
+	err := registry.Register(
+		ctx,
+		testResourceType, v1.OperationPut,
+		func(opts ctrl.Options) (ctrl.Controller, error) {
+			return testCtrl, nil
+		}, opts)
+	require.NoError(t, err)
+
+	done := make(chan struct{}, 1)
+	go func() {
+		err = worker.Start(ctx)
+		require.NoError(t, err)
+		close(done)
+	}()
+
+	testMessage := genTestMessage(uuid.New(), ctrl.DefaultAsyncOperationTimeout)
+	err = tCtx.testQueue.Enqueue(ctx, testMessage)
+	require.NoError(t, err)
+
+	tCtx.drainQueueOrAssert(t)
+
+	// Cancelling worker loop
+	cancel()
+	<-done
+
+	require.False(t, called.Load(), "controller must not run for the canceled operation")
+}
+
+func TestRunOperation_RequeueWithBackoff(t *testing.T) {
+	tCtx, mctrl := newTestContext(t, defaultTestLockTime)
+	defer mctrl.Finish()
+
+	opError := v1.ErrorDetails{Code: v1.CodeInternal, Message: "transient"}
+	policy := ctrl.RetryPolicy{InitialInterval: time.Minute, MaxInterval: time.Hour, Multiplier: 2}
+
+	// set up mocks
+	var scheduledAt time.Time
+	tCtx.mockSM.EXPECT().ScheduleRetry(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Eq(&opError)).
+		DoAndReturn(func(_ context.Context, _ resources.ID, _ uuid.UUID, nextAttemptTime time.Time, _ *v1.ErrorDetails) error {
+			scheduledAt = nextAttemptTime
+			return nil
+		}).Times(1)
+
+	registry := NewControllerRegistry(tCtx.mockSP)
+	registry.SetRetryPolicy(testResourceType, v1.OperationPut, policy)
+
+	testMessage := genTestMessage(uuid.New(), ctrl.DefaultAsyncOperationTimeout)
+	err := tCtx.testQueue.Enqueue(tCtx.ctx, testMessage)
+	require.NoError(t, err)
+	worker := New(Options{}, tCtx.mockSM, tCtx.testQueue, registry)
+
+	opts := ctrl.Options{
+		StorageClient: tCtx.mockSC,
+		DataProvider:  tCtx.mockSP,
+		GetDeploymentProcessor: func() deployment.DeploymentProcessor {
+			return deployment.NewMockDeploymentProcessor(mctrl)
+		},
+	}
+
+	testCtrl := &testAsyncController{
+		BaseController: ctrl.NewBaseAsyncController(opts),
+		fn: func(ctx context.Context) (ctrl.Result, error) {
+			r := ctrl.Result{}
+			r.SetFailed(opError, true)
+			return r, nil
+		},
+	}
+
+	msg, err := tCtx.testQueue.Dequeue(tCtx.ctx, queue.QueueClientConfig{})
+	require.NoError(t, err)
+	start := time.Now()
+	worker.runOperation(context.Background(), msg, testCtrl)
+
+	// The message is kept in the queue and is not visible until the next attempt time of the override policy.
+	require.Equal(t, 1, tCtx.internalQ.Len(), "message is not finished")
+	require.WithinDuration(t, start.Add(time.Minute), scheduledAt, 10*time.Second)
+	_, err = tCtx.testQueue.Dequeue(tCtx.ctx, queue.QueueClientConfig{})
+	require.ErrorIs(t, err, queue.ErrMessageNotFound)
+
+	// The message of the worker is not modified by the queue.
+	require.True(t, msg.NextVisibleAt.Before(scheduledAt))
+}
+
+// queueWithoutRequeue hides the optional interfaces of the wrapped queue client.
+type queueWithoutRequeue struct {
+	queue.Client
+}
+
+func TestRunOperation_RequeueUnsupportedByQueue(t *testing.T) {
+	tCtx, mctrl := newTestContext(t, defaultTestLockTime)
+	defer mctrl.Finish()
+
+	opError := v1.ErrorDetails{Code: v1.CodeInternal, Message: "transient"}
+
+	testMessage := genTestMessage(uuid.New(), ctrl.DefaultAsyncOperationTimeout)
+	err := tCtx.testQueue.Enqueue(tCtx.ctx, testMessage)
+	require.NoError(t, err)
+
+	// The next attempt is not scheduled since the queue can't requeue the message.
+	worker := New(Options{}, tCtx.mockSM, &queueWithoutRequeue{Client: tCtx.testQueue}, nil)
+
+	opts := ctrl.Options{
+		StorageClient: tCtx.mockSC,
+		DataProvider:  tCtx.mockSP,
+		GetDeploymentProcessor: func() deployment.DeploymentProcessor {
+			return deployment.NewMockDeploymentProcessor(mctrl)
+		},
+	}
+
+	testCtrl := &testAsyncController{
+		BaseController: ctrl.NewBaseAsyncController(opts),
+		fn: func(ctx context.Context) (ctrl.Result, error) {
+			r := ctrl.Result{}
+			r.SetFailed(opError, true)
+			return r, nil
+		},
+	}
+
+	msg, err := tCtx.testQueue.Dequeue(tCtx.ctx, queue.QueueClientConfig{})
+	require.NoError(t, err)
+	worker.runOperation(context.Background(), msg, testCtrl)
+
+	// The message is neither finished nor requeued, it is dequeued again when its lock expires.
+	require.Equal(t, 1, tCtx.internalQ.Len(), "message is not finished")
+	_, err = tCtx.testQueue.Dequeue(tCtx.ctx, queue.QueueClientConfig{})
+	require.ErrorIs(t, err, queue.ErrMessageNotFound)
+}
+
+func TestRunOperation_RequeueTerminalError(t *testing.T) {
+	tCtx, mctrl := newTestContext(t, defaultTestLockTime)
+	defer mctrl.Finish()
+
+	opError := v1.ErrorDetails{Code: v1.CodeInvalid, Message: "invalid"}
+
+	// set up mocks
+	tCtx.mockSC.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
+			return newTestResourceObject(), nil
+		}).AnyTimes()
+	tCtx.mockSM.EXPECT().UpdateWithResource(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Eq(v1.ProvisioningStateFailed), gomock.Any(), gomock.Eq(&opError)).Return(nil).Times(1)
+
+	testMessage := genTestMessage(uuid.New(), ctrl.DefaultAsyncOperationTimeout)
+	err := tCtx.testQueue.Enqueue(tCtx.ctx, testMessage)
+	require.NoError(t, err)
+	worker := New(Options{}, tCtx.mockSM, tCtx.testQueue, nil)
+
+	opts := ctrl.Options{
+		StorageClient: tCtx.mockSC,
+		DataProvider:  tCtx.mockSP,
+		GetDeploymentProcessor: func() deployment.DeploymentProcessor {
+			return deployment.NewMockDeploymentProcessor(mctrl)
+		},
+	}
+
+	testCtrl := &testAsyncController{
+		BaseController: ctrl.NewBaseAsyncController(opts),
+		fn: func(ctx context.Context) (ctrl.Result, error) {
+			r := ctrl.Result{}
+			r.SetFailed(opError, true)
+			return r, nil
+		},
+	}
+
+	msg, err := tCtx.testQueue.Dequeue(tCtx.ctx, queue.QueueClientConfig{})
+	require.NoError(t, err)
+	worker.runOperation(context.Background(), msg, testCtrl)
+
+	require.Equal(t, 0, tCtx.internalQ.Len(), "message is finished")
+}
+
 func TestRunOperation_PanicController(t *testing.T) {
 	tCtx, _ := newTestContext(t, defaultTestLockTime)
 
@@ -24,6 +24,7 @@ import (
 
 	"github.com/golang/mock/gomock"
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	queue "github.com/radius-project/radius/pkg/ucp/queue/client"
 	"github.com/radius-project/radius/pkg/ucp/store"
 	"github.com/stretchr/testify/require"
 )
@@ -37,13 +38,13 @@ func TestDefaultOptions(t *testing.T) {
 	require.Equal(t, defaultMaxOperationConcurrency, worker.options.MaxOperationConcurrency)
 }
 
-func TestUpdateResourceState(t *testing.T) {
+func TestResourceWithState(t *testing.T) {
 	updateStates := []struct {
 		tc          string
 		in          map[string]any
 		updateState v1.ProvisioningState
 		outErr      error
-		callSave    bool
+		updated     bool
 	}{
 		{
 			tc: "not found provisioningState",
@@ -53,7 +54,7 @@ func TestUpdateResourceState(t *testing.T) {
 			},
 			updateState: v1.ProvisioningStateAccepted,
 			outErr:      nil,
-			callSave:    true,
+			updated:     true,
 		},
 		{
 			tc: "not update state",
@@ -64,7 +65,7 @@ func TestUpdateResourceState(t *testing.T) {
 			},
 			updateState: v1.ProvisioningStateAccepted,
 			outErr:      nil,
-			callSave:    false,
+			updated:     false,
 		},
 		{
 			tc: "update state",
@@ -75,7 +76,7 @@ func TestUpdateResourceState(t *testing.T) {
 			},
 			updateState: v1.ProvisioningStateAccepted,
 			outErr:      nil,
-			callSave:    true,
+			updated:     true,
 		},
 	}
 
@@ -96,19 +97,14 @@ func TestUpdateResourceState(t *testing.T) {
 					}, nil
 				})
 
-			if tt.callSave {
-				mStorageClient.
-					EXPECT().
-					Save(gomock.Any(), gomock.Any(), gomock.Any()).
-					DoAndReturn(func(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
-						k := obj.Data.(map[string]any)
-						require.Equal(t, k["provisioningState"].(string), string(tt.updateState))
-						return nil
-					})
-			}
-
-			err := updateResourceState(ctx, mStorageClient, "fakeid", tt.updateState)
+			obj, err := resourceWithState(ctx, mStorageClient, "fakeid", tt.updateState)
 			require.ErrorIs(t, err, tt.outErr)
+			if tt.updated {
+				require.NotNil(t, obj)
+				require.Equal(t, string(tt.updateState), obj.Data.(map[string]any)["provisioningState"])
+			} else {
+				require.Nil(t, obj)
+			}
 		})
 	}
 
@@ -158,3 +154,28 @@ func TestErrorHandling(t *testing.T) {
 		require.Equal(t, tt.expectedArmErr, armErr)
 	}
 }
+
+func TestDeadLetterMessage_Unsupported(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	defer mctrl.Finish()
+
+	// The message is finished when the queue does not support dead-lettering.
+	msg := &queue.Message{Metadata: queue.Metadata{ID: "message"}}
+	mockQueue := queue.NewMockClient(mctrl)
+	mockQueue.EXPECT().FinishMessage(gomock.Any(), msg).Return(nil).Times(1)
+
+	worker := New(Options{}, nil, mockQueue, nil)
+	worker.deadLetterMessage(context.Background(), msg, "poison message")
+}
+
+func TestDeadLetterMessage(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	defer mctrl.Finish()
+
+	msg := &queue.Message{Metadata: queue.Metadata{ID: "message"}}
+	mockQueue := queue.NewMockDeadLetterClient(mctrl)
+	mockQueue.EXPECT().DeadLetter(gomock.Any(), msg, "poison message").Return(nil).Times(1)
+
+	worker := New(Options{}, nil, mockQueue, nil)
+	worker.deadLetterMessage(context.Background(), msg, "poison message")
+}
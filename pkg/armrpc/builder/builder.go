@@ -41,7 +41,7 @@ type Builder struct {
 }
 
 // defaultHandlerOptions returns HandlerOption for the default operations such as getting operationStatuses and
-// operationResults.
+// operationResults, and managing the dead-lettered operations.
 func defaultHandlerOptions(
 	ctx context.Context,
 	rootRouter chi.Router,
@@ -67,14 +67,23 @@ func defaultHandlerOptions(
 
 	statusType := namespace + "/operationstatuses"
 	resultType := namespace + "/operationresults"
+	statusPath := fmt.Sprintf("%s/providers/%s/locations/{location}/operationstatuses/{operationId}", rootScopePath, namespace)
 	handlers = append(handlers, server.HandlerOptions{
 		ParentRouter:      rootRouter,
-		Path:              fmt.Sprintf("%s/providers/%s/locations/{location}/operationstatuses/{operationId}", rootScopePath, namespace),
+		Path:              statusPath,
 		ResourceType:      statusType,
 		Method:            v1.OperationGet,
 		ControllerFactory: defaultoperation.NewGetOperationStatus,
 	})
 
+	handlers = append(handlers, server.HandlerOptions{
+		ParentRouter:      rootRouter,
+		Path:              statusPath + "/cancel",
+		ResourceType:      statusType,
+		Method:            defaultoperation.OperationCancel,
+		ControllerFactory: defaultoperation.NewCancelOperationStatus,
+	})
+
 	handlers = append(handlers, server.HandlerOptions{
 		ParentRouter:      rootRouter,
 		Path:              fmt.Sprintf("%s/providers/%s/locations/{location}/operationresults/{operationId}", rootScopePath, namespace),
@@ -83,6 +92,40 @@ func defaultHandlerOptions(
 		ControllerFactory: defaultoperation.NewGetOperationResult,
 	})
 
+	deadLetterType := namespace + "/" + defaultoperation.DeadLetteredOperationsType
+	deadLetterCollectionPath := fmt.Sprintf("%s/providers/%s/locations/{location}/%s", rootScopePath, namespace, defaultoperation.DeadLetteredOperationsType)
+	deadLetterPath := deadLetterCollectionPath + "/{messageId}"
+	handlers = append(handlers,
+		server.HandlerOptions{
+			ParentRouter:      rootRouter,
+			Path:              deadLetterCollectionPath,
+			ResourceType:      deadLetterType,
+			Method:            v1.OperationList,
+			ControllerFactory: defaultoperation.NewListDeadLetteredOperations,
+		},
+		server.HandlerOptions{
+			ParentRouter:      rootRouter,
+			Path:              deadLetterPath,
+			ResourceType:      deadLetterType,
+			Method:            v1.OperationGet,
+			ControllerFactory: defaultoperation.NewGetDeadLetteredOperation,
+		},
+		server.HandlerOptions{
+			ParentRouter:      rootRouter,
+			Path:              deadLetterPath,
+			ResourceType:      deadLetterType,
+			Method:            v1.OperationDelete,
+			ControllerFactory: defaultoperation.NewPurgeDeadLetteredOperation,
+		},
+		server.HandlerOptions{
+			ParentRouter:      rootRouter,
+			Path:              deadLetterPath + "/replay",
+			ResourceType:      deadLetterType,
+			Method:            defaultoperation.OperationReplay,
+			ControllerFactory: defaultoperation.NewReplayDeadLetteredOperation,
+		},
+	)
+
 	return handlers
 }
 
@@ -160,10 +160,30 @@ var defaultHandlerTests = []rpctest.HandlerTestSpec{
 		OperationType: v1.OperationType{Type: "Applications.Compute/operationStatuses", Method: v1.OperationGet},
 		Path:          "/providers/applications.compute/locations/global/operationstatuses/00000000-0000-0000-0000-000000000000",
 		Method:        http.MethodGet,
+	}, {
+		OperationType: v1.OperationType{Type: "Applications.Compute/operationStatuses", Method: "CANCEL"},
+		Path:          "/providers/applications.compute/locations/global/operationstatuses/00000000-0000-0000-0000-000000000000/cancel",
+		Method:        http.MethodPost,
 	}, {
 		OperationType: v1.OperationType{Type: "Applications.Compute/operationResults", Method: v1.OperationGet},
 		Path:          "/providers/applications.compute/locations/global/operationresults/00000000-0000-0000-0000-000000000000",
 		Method:        http.MethodGet,
+	}, {
+		OperationType: v1.OperationType{Type: "Applications.Compute/operationDeadLetters", Method: v1.OperationList},
+		Path:          "/providers/applications.compute/locations/global/operationdeadletters",
+		Method:        http.MethodGet,
+	}, {
+		OperationType: v1.OperationType{Type: "Applications.Compute/operationDeadLetters", Method: v1.OperationGet},
+		Path:          "/providers/applications.compute/locations/global/operationdeadletters/message",
+		Method:        http.MethodGet,
+	}, {
+		OperationType: v1.OperationType{Type: "Applications.Compute/operationDeadLetters", Method: v1.OperationDelete},
+		Path:          "/providers/applications.compute/locations/global/operationdeadletters/message",
+		Method:        http.MethodDelete,
+	}, {
+		OperationType: v1.OperationType{Type: "Applications.Compute/operationDeadLetters", Method: "REPLAY"},
+		Path:          "/providers/applications.compute/locations/global/operationdeadletters/message/replay",
+		Method:        http.MethodPost,
 	},
 }
 
@@ -26,6 +26,7 @@ import (
 	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
 	"github.com/radius-project/radius/pkg/armrpc/rest"
 	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	queue "github.com/radius-project/radius/pkg/ucp/queue/client"
 	"github.com/radius-project/radius/pkg/ucp/store"
 
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -67,6 +68,10 @@ type Options struct {
 
 	// StatusManager is the async operation status manager.
 	StatusManager sm.StatusManager
+
+	// RequestQueue is the queue client of async operation requests. May be nil if the controller does not
+	// inspect the queue.
+	RequestQueue queue.Client
 }
 
 // ResourceOptions represents the options and filters for resource.
@@ -142,6 +147,11 @@ func (b *BaseController) StatusManager() sm.StatusManager {
 	return b.options.StatusManager
 }
 
+// RequestQueue gets the async operation request queue client of this controller.
+func (b *BaseController) RequestQueue() queue.Client {
+	return b.options.RequestQueue
+}
+
 // GetResource gets a resource from data store for id, set the retrieved resource to out argument and returns
 // the ETag of the resource and an error if one occurs.
 func (c *BaseController) GetResource(ctx context.Context, id string, out any) (etag string, err error) {
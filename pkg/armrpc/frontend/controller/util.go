@@ -143,6 +143,9 @@ func GetNextLinkURL(ctx context.Context, req *http.Request, paginationToken stri
 	qps.Add("api-version", serviceCtx.APIVersion)
 	qps.Add("skipToken", paginationToken)
 	qps.Add("top", strconv.Itoa(serviceCtx.Top))
+	if serviceCtx.Filter != "" {
+		qps.Add(v1.FilterParameterName, serviceCtx.Filter)
+	}
 
 	return GetURLFromReqWithQueryParameters(req, qps).String()
 }
@@ -0,0 +1,117 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	manager "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+const (
+	// OperationCancel is the custom action to cancel the async operation.
+	OperationCancel v1.OperationMethod = "CANCEL"
+
+	// defaultCancelReason is the reason recorded when the cancellation request does not specify one.
+	defaultCancelReason = "Operation was canceled by the user."
+)
+
+var _ ctrl.Controller = (*CancelOperationStatus)(nil)
+
+// CancelOperationStatus is the controller implementation to cancel an in-flight async operation.
+type CancelOperationStatus struct {
+	ctrl.BaseController
+}
+
+// NewCancelOperationStatus creates a new CancelOperationStatus controller.
+func NewCancelOperationStatus(opts ctrl.Options) (ctrl.Controller, error) {
+	return &CancelOperationStatus{ctrl.NewBaseController(opts)}, nil
+}
+
+// Run records the cancellation request in the operation status and returns the operation status with 202 Accepted.
+// The worker processing the operation cancels it and completes it with Canceled state. It returns a NotFound response
+// if the operation is not found, and a Conflict response if the operation is already completed.
+func (e *CancelOperationStatus) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	// The request body is optional.
+	cancelReq := &v1.CancelAsyncOperationRequest{}
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	if len(bytes.TrimSpace(body)) > 0 {
+		if err := json.Unmarshal(body, cancelReq); err != nil {
+			return rest.NewBadRequestResponse(err.Error()), nil
+		}
+	}
+	if cancelReq.Reason == "" {
+		cancelReq.Reason = defaultCancelReason
+	}
+
+	os := &manager.Status{}
+	_, err = e.GetResource(ctx, serviceCtx.ResourceID.String(), os)
+	if errors.Is(err, &store.ErrNotFound{}) {
+		return rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if os.Status.IsTerminal() {
+		return newOperationCompletedResponse(os), nil
+	}
+
+	linkedID, err := resources.ParseResource(os.LinkedResourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	operationID, err := uuid.Parse(serviceCtx.ResourceID.Name())
+	if err != nil {
+		return nil, err
+	}
+
+	err = e.StatusManager().RequestCancel(ctx, linkedID, operationID, cancelReq.Reason)
+	if errors.Is(err, manager.ErrOperationCompleted) {
+		return newOperationCompletedResponse(os), nil
+	} else if errors.Is(err, &store.ErrNotFound{}) {
+		return rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
+	} else if errors.Is(err, &store.ErrConcurrency{}) {
+		return rest.NewConflictResponse("The operation status was updated concurrently. Please retry the request."), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return rest.NewAcceptedAsyncResponse(os.AsyncOperationStatus, strings.TrimSuffix(req.URL.Path, "/cancel"), req.URL.Scheme), nil
+}
+
+// newOperationCompletedResponse returns the response when the cancellation is requested for the completed operation.
+func newOperationCompletedResponse(os *manager.Status) rest.Response {
+	return rest.NewConflictResponse("The operation " + os.Name + " cannot be canceled because it is already in " + string(os.Status) + " state.")
+}
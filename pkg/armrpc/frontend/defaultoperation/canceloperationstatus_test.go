@@ -0,0 +1,120 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	manager "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testCancelOperationID = "00000000-0000-0000-0000-000000000001"
+	testCancelStatusID    = "/planes/radius/local/providers/Applications.Core/locations/global/operationstatuses/" + testCancelOperationID
+	testCancelResourceID  = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/containers/test-container"
+)
+
+func newCancelTestStatus(state v1.ProvisioningState) *manager.Status {
+	return &manager.Status{
+		AsyncOperationStatus: v1.AsyncOperationStatus{
+			ID:     testCancelStatusID,
+			Name:   testCancelOperationID,
+			Status: state,
+		},
+		LinkedResourceID: testCancelResourceID,
+	}
+}
+
+func TestCancelOperationStatusRun(t *testing.T) {
+	cancelTests := []struct {
+		desc       string
+		body       io.Reader
+		status     *manager.Status
+		cancelErr  error
+		cancelled  bool
+		wantReason string
+		code       int
+	}{
+		{"cancel in-flight operation", strings.NewReader(`{"reason":"no longer needed"}`), newCancelTestStatus(v1.ProvisioningStateUpdating), nil, true, "no longer needed", http.StatusAccepted},
+		{"cancel without reason", nil, newCancelTestStatus(v1.ProvisioningStateAccepted), nil, true, defaultCancelReason, http.StatusAccepted},
+		{"cancel completed operation", nil, newCancelTestStatus(v1.ProvisioningStateSucceeded), nil, false, "", http.StatusConflict},
+		{"operation completed while cancelling", nil, newCancelTestStatus(v1.ProvisioningStateUpdating), manager.ErrOperationCompleted, true, defaultCancelReason, http.StatusConflict},
+		{"concurrent update", nil, newCancelTestStatus(v1.ProvisioningStateUpdating), &store.ErrConcurrency{}, true, defaultCancelReason, http.StatusConflict},
+		{"operation not found", nil, nil, nil, false, "", http.StatusNotFound},
+		{"invalid request body", strings.NewReader("{"), nil, nil, false, "", http.StatusBadRequest},
+	}
+
+	for _, tt := range cancelTests {
+		t.Run(tt.desc, func(t *testing.T) {
+			mctrl := gomock.NewController(t)
+			defer mctrl.Finish()
+
+			sc := store.NewMockStorageClient(mctrl)
+			sc.EXPECT().
+				Get(gomock.Any(), testCancelStatusID).
+				DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
+					if tt.status == nil {
+						return nil, &store.ErrNotFound{ID: id}
+					}
+					return &store.Object{Metadata: store.Metadata{ID: id}, Data: tt.status}, nil
+				}).
+				MaxTimes(1)
+
+			sm := manager.NewMockStatusManager(mctrl)
+			if tt.cancelled {
+				sm.EXPECT().
+					RequestCancel(gomock.Any(), resources.MustParse(testCancelResourceID), uuid.MustParse(testCancelOperationID), tt.wantReason).
+					Return(tt.cancelErr).
+					Times(1)
+			}
+
+			ctl, err := NewCancelOperationStatus(ctrl.Options{StorageClient: sc, StatusManager: sm})
+			require.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, testCancelStatusID+"/cancel", tt.body)
+			ctx := v1.WithARMRequestContext(context.Background(), &v1.ARMRequestContext{
+				ResourceID: resources.MustParse(testCancelStatusID),
+			})
+
+			resp, err := ctl.Run(ctx, w, req)
+			require.NoError(t, err)
+			_ = resp.Apply(ctx, w, req)
+			require.Equal(t, tt.code, w.Result().StatusCode)
+
+			if tt.code == http.StatusAccepted {
+				actual := &v1.AsyncOperationStatus{}
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), actual))
+				require.Equal(t, testCancelOperationID, actual.Name)
+				require.True(t, strings.HasSuffix(w.Result().Header.Get("Location"), testCancelStatusID))
+			}
+		})
+	}
+}
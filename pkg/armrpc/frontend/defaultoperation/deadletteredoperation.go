@@ -0,0 +1,94 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"encoding/json"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	asyncctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	queue "github.com/radius-project/radius/pkg/ucp/queue/client"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	// DeadLetteredOperationsType is the type segment of dead-lettered operations.
+	DeadLetteredOperationsType = "operationdeadletters"
+)
+
+// deadLetterClient returns the request queue of the controller if it supports dead-lettering.
+func deadLetterClient(c *ctrl.BaseController) (queue.DeadLetterClient, bool) {
+	dlq, ok := c.RequestQueue().(queue.DeadLetterClient)
+	return dlq, ok
+}
+
+// newDeadLetterUnsupportedResponse returns the response when the request queue does not support dead-lettering.
+func newDeadLetterUnsupportedResponse() rest.Response {
+	return rest.NewBadRequestResponse("The request queue of this resource provider does not support dead-lettered operations.")
+}
+
+// decodeRequest decodes the async operation request in the queue message. nil is returned if the message cannot be decoded.
+func decodeRequest(msg *queue.Message) *asyncctrl.Request {
+	req := &asyncctrl.Request{}
+	if err := json.Unmarshal(msg.Data, req); err != nil {
+		return nil
+	}
+	return req
+}
+
+// inNamespace returns true if the dead-lettered message belongs to the provider namespace. Messages which cannot
+// be decoded are shown for every provider namespace sharing the queue.
+func inNamespace(req *asyncctrl.Request, namespace string) bool {
+	if req == nil {
+		return true
+	}
+
+	id, err := resources.ParseResource(req.ResourceID)
+	if err != nil {
+		return true
+	}
+
+	return strings.EqualFold(id.ProviderNamespace(), namespace)
+}
+
+// toDeadLetteredOperation converts the dead-lettered queue message to the API model. id is the resource id of the
+// dead-lettered operation.
+func toDeadLetteredOperation(id resources.ID, msg *queue.Message, req *asyncctrl.Request) *v1.DeadLetteredOperation {
+	op := &v1.DeadLetteredOperation{
+		ID:   id.String(),
+		Name: msg.ID,
+		Type: id.Type(),
+		Properties: v1.DeadLetteredOperationProperties{
+			Reason:         msg.DeadLetterReason,
+			DequeueCount:   msg.DequeueCount,
+			EnqueuedAt:     msg.EnqueueAt,
+			DeadLetteredAt: msg.DeadLetteredAt,
+			Message:        string(msg.Data),
+		},
+	}
+
+	if req != nil {
+		op.Properties.OperationID = req.OperationID.String()
+		op.Properties.OperationType = req.OperationType
+		op.Properties.ResourceID = req.ResourceID
+	}
+
+	return op
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	asyncctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	queue "github.com/radius-project/radius/pkg/ucp/queue/client"
+	"github.com/radius-project/radius/pkg/ucp/queue/inmemory"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testDeadLetterCollectionID = "/planes/radius/local/providers/Applications.Core/locations/global/operationdeadletters"
+	testDeadLetterResourceID   = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/containers/test-container"
+	testDeadLetterReason       = "exceeded max retry count to process async operation message: 4"
+)
+
+// newDeadLetterTestQueue creates the in-memory queue with a dead-lettered operation of testDeadLetterResourceID and
+// returns the queue and the dead-lettered message.
+func newDeadLetterTestQueue(t *testing.T, resourceID string) (*inmemory.Client, *queue.Message) {
+	q := inmemory.New(inmemory.NewInMemQueue(time.Minute))
+	ctx := context.Background()
+
+	err := q.Enqueue(ctx, queue.NewMessage(&asyncctrl.Request{
+		OperationID:   uuid.New(),
+		OperationType: "APPLICATIONS.CORE/CONTAINERS|PUT",
+		ResourceID:    resourceID,
+	}))
+	require.NoError(t, err)
+
+	msg, err := q.Dequeue(ctx, queue.QueueClientConfig{})
+	require.NoError(t, err)
+	err = q.DeadLetter(ctx, msg, testDeadLetterReason)
+	require.NoError(t, err)
+
+	return q, msg
+}
+
+// newDeadLetterTestContext creates the request context for the dead-lettered operation id.
+func newDeadLetterTestContext(id string) context.Context {
+	return v1.WithARMRequestContext(context.Background(), &v1.ARMRequestContext{
+		ResourceID: resources.MustParse(id),
+	})
+}
+
+func TestDecodeRequest(t *testing.T) {
+	req := decodeRequest(&queue.Message{Data: []byte(`{"resourceID":"` + testDeadLetterResourceID + `"}`)})
+	require.NotNil(t, req)
+	require.Equal(t, testDeadLetterResourceID, req.ResourceID)
+
+	require.Nil(t, decodeRequest(&queue.Message{Data: []byte("not a json")}))
+}
+
+func TestInNamespace(t *testing.T) {
+	req := &asyncctrl.Request{ResourceID: testDeadLetterResourceID}
+	require.True(t, inNamespace(req, "applications.core"))
+	require.False(t, inNamespace(req, "Applications.Dapr"))
+
+	// Messages which cannot be decoded are shown for every namespace.
+	require.True(t, inNamespace(nil, "Applications.Dapr"))
+	require.True(t, inNamespace(&asyncctrl.Request{ResourceID: "invalid"}, "Applications.Dapr"))
+}
+
+func TestDeadLetteredOperation_UnsupportedQueue(t *testing.T) {
+	factories := []func(ctrl.Options) (ctrl.Controller, error){
+		NewListDeadLetteredOperations,
+		NewGetDeadLetteredOperation,
+		NewReplayDeadLetteredOperation,
+		NewPurgeDeadLetteredOperation,
+	}
+
+	for _, factory := range factories {
+		ctl, err := factory(ctrl.Options{RequestQueue: nil})
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, testDeadLetterCollectionID+"/message", nil)
+		ctx := newDeadLetterTestContext(testDeadLetterCollectionID + "/message")
+
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+	}
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	queue "github.com/radius-project/radius/pkg/ucp/queue/client"
+)
+
+var _ ctrl.Controller = (*GetDeadLetteredOperation)(nil)
+
+// GetDeadLetteredOperation is the controller implementation to get an async operation in the dead-letter queue.
+type GetDeadLetteredOperation struct {
+	ctrl.BaseController
+}
+
+// NewGetDeadLetteredOperation creates a new GetDeadLetteredOperation controller.
+func NewGetDeadLetteredOperation(opts ctrl.Options) (ctrl.Controller, error) {
+	return &GetDeadLetteredOperation{ctrl.NewBaseController(opts)}, nil
+}
+
+// Run returns the dead-lettered operation, or a NotFound response if the operation is not in the dead-letter queue.
+func (e *GetDeadLetteredOperation) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	dlq, ok := deadLetterClient(&e.BaseController)
+	if !ok {
+		return newDeadLetterUnsupportedResponse(), nil
+	}
+
+	msg, err := dlq.GetDeadLetter(ctx, serviceCtx.ResourceID.Name())
+	if errors.Is(err, queue.ErrDeadLetterNotFound) {
+		return rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	asyncReq := decodeRequest(msg)
+	if !inNamespace(asyncReq, serviceCtx.ResourceID.ProviderNamespace()) {
+		return rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
+	}
+
+	return rest.NewOKResponse(toDeadLetteredOperation(serviceCtx.ResourceID, msg, asyncReq)), nil
+}
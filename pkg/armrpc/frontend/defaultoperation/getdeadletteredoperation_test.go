@@ -0,0 +1,77 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetDeadLetteredOperationRun(t *testing.T) {
+	q, msg := newDeadLetterTestQueue(t, testDeadLetterResourceID)
+
+	ctl, err := NewGetDeadLetteredOperation(ctrl.Options{RequestQueue: q})
+	require.NoError(t, err)
+
+	t.Run("get existing operation", func(t *testing.T) {
+		id := testDeadLetterCollectionID + "/" + msg.ID
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, id, nil)
+		ctx := newDeadLetterTestContext(id)
+
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+		actual := &v1.DeadLetteredOperation{}
+		err = json.Unmarshal(w.Body.Bytes(), actual)
+		require.NoError(t, err)
+		require.Equal(t, id, actual.ID)
+		require.Equal(t, "Applications.Core/locations/operationdeadletters", actual.Type)
+		require.Equal(t, testDeadLetterResourceID, actual.Properties.ResourceID)
+		require.Equal(t, testDeadLetterReason, actual.Properties.Reason)
+		require.Equal(t, string(msg.Data), actual.Properties.Message)
+	})
+
+	notFoundTests := []struct {
+		desc string
+		id   string
+	}{
+		{"get non-existing operation", testDeadLetterCollectionID + "/unknown"},
+		{"get operation of the other namespace", "/planes/radius/local/providers/Applications.Dapr/locations/global/operationdeadletters/" + msg.ID},
+	}
+
+	for _, tt := range notFoundTests {
+		t.Run(tt.desc, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, tt.id, nil)
+			ctx := newDeadLetterTestContext(tt.id)
+
+			resp, err := ctl.Run(ctx, w, req)
+			require.NoError(t, err)
+			_ = resp.Apply(ctx, w, req)
+			require.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+		})
+	}
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+var _ ctrl.Controller = (*ListDeadLetteredOperations)(nil)
+
+// ListDeadLetteredOperations is the controller implementation to list the async operations in the dead-letter queue.
+type ListDeadLetteredOperations struct {
+	ctrl.BaseController
+}
+
+// NewListDeadLetteredOperations creates a new ListDeadLetteredOperations controller.
+func NewListDeadLetteredOperations(opts ctrl.Options) (ctrl.Controller, error) {
+	return &ListDeadLetteredOperations{ctrl.NewBaseController(opts)}, nil
+}
+
+// Run returns the list of dead-lettered operations of the provider namespace. It returns a BadRequest response if the
+// request queue does not support dead-lettering.
+func (e *ListDeadLetteredOperations) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	dlq, ok := deadLetterClient(&e.BaseController)
+	if !ok {
+		return newDeadLetterUnsupportedResponse(), nil
+	}
+
+	msgs, err := dlq.ListDeadLetters(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The resource id of the request is the collection, so the type segment is appended with the message id.
+	collection := serviceCtx.ResourceID.Truncate()
+	items := []any{}
+	for _, msg := range msgs {
+		asyncReq := decodeRequest(msg)
+		if !inNamespace(asyncReq, serviceCtx.ResourceID.ProviderNamespace()) {
+			continue
+		}
+
+		id := collection.Append(resources.TypeSegment{Type: DeadLetteredOperationsType, Name: msg.ID})
+		items = append(items, toDeadLetteredOperation(id, msg, asyncReq))
+	}
+
+	return rest.NewOKResponse(&v1.PaginatedList{Value: items}), nil
+}
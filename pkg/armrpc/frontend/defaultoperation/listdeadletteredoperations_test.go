@@ -0,0 +1,74 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListDeadLetteredOperationsRun(t *testing.T) {
+	listTests := []struct {
+		desc       string
+		collection string
+		count      int
+	}{
+		{"list operations of the namespace", testDeadLetterCollectionID, 1},
+		{"list operations of the other namespace", "/planes/radius/local/providers/Applications.Dapr/locations/global/operationdeadletters", 0},
+	}
+
+	for _, tt := range listTests {
+		t.Run(tt.desc, func(t *testing.T) {
+			q, msg := newDeadLetterTestQueue(t, testDeadLetterResourceID)
+
+			ctl, err := NewListDeadLetteredOperations(ctrl.Options{RequestQueue: q})
+			require.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, tt.collection, nil)
+			ctx := newDeadLetterTestContext(tt.collection)
+
+			resp, err := ctl.Run(ctx, w, req)
+			require.NoError(t, err)
+			_ = resp.Apply(ctx, w, req)
+			require.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+			actual := struct {
+				Value []v1.DeadLetteredOperation `json:"value"`
+			}{}
+			err = json.Unmarshal(w.Body.Bytes(), &actual)
+			require.NoError(t, err)
+			require.Len(t, actual.Value, tt.count)
+
+			if tt.count > 0 {
+				op := actual.Value[0]
+				require.Equal(t, tt.collection+"/"+msg.ID, op.ID)
+				require.Equal(t, msg.ID, op.Name)
+				require.Equal(t, testDeadLetterResourceID, op.Properties.ResourceID)
+				require.Equal(t, "APPLICATIONS.CORE/CONTAINERS|PUT", op.Properties.OperationType)
+				require.Equal(t, testDeadLetterReason, op.Properties.Reason)
+				require.Equal(t, 1, op.Properties.DequeueCount)
+			}
+		})
+	}
+}
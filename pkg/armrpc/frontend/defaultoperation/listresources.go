@@ -18,7 +18,9 @@ package defaultoperation
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"strings"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
@@ -26,6 +28,13 @@ import (
 	"github.com/radius-project/radius/pkg/ucp/store"
 )
 
+// filterableFields are the fields that can be used in the filter expression of a list request. They have the same path
+// in the versioned resources and in the datamodels, so the filter can be passed to the store as-is.
+var filterableFields = map[string]bool{
+	"properties.application": true,
+	"properties.environment": true,
+}
+
 // ListResources is the controller implementation to get the list of resources in resource group.
 type ListResources[P interface {
 	*T
@@ -43,15 +52,21 @@ func NewListResources[P interface {
 	return &ListResources[P, T]{ctrl.NewOperation[P](opts, ctrlOpts), ctrlOpts.ListRecursiveQuery}, nil
 }
 
-// Run queries the resource data store with a given type and scope and returns the paginated resource list. An internal error
-// is returned if the query fails.
+// Run queries the resource data store with a given type and scope and returns the paginated resource list. A bad request
+// is returned if the filter expression is invalid, and an internal error is returned if the query fails.
 func (e *ListResources[P, T]) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
 	serviceCtx := v1.ARMRequestContextFromContext(ctx)
 
+	filters, err := parseFilter(serviceCtx.Filter)
+	if err != nil {
+		return rest.NewBadRequestResponse(err.Error()), nil
+	}
+
 	query := store.Query{
 		RootScope:      serviceCtx.ResourceID.RootScope(),
 		ResourceType:   serviceCtx.ResourceID.Type(),
 		ScopeRecursive: e.listRecursiveQuery,
+		Filters:        filters,
 	}
 
 	result, err := e.StorageClient().Query(ctx, query, store.WithPaginationToken(serviceCtx.SkipToken), store.WithMaxQueryItemCount(serviceCtx.Top))
@@ -87,3 +102,68 @@ func (e *ListResources[P, T]) createPaginationResponse(ctx context.Context, req
 		NextLink: ctrl.GetNextLinkURL(ctx, req, result.PaginationToken),
 	}, nil
 }
+
+// parseFilter parses a filter expression of the form "<field> eq '<value>' and <field> ne '<value>'" into store
+// filters. A single quote in a value is escaped by doubling it.
+func parseFilter(expression string) ([]store.QueryFilter, error) {
+	if strings.TrimSpace(expression) == "" {
+		return nil, nil
+	}
+
+	filters := []store.QueryFilter{}
+	remaining := strings.TrimSpace(expression)
+	for {
+		field, rest, found := strings.Cut(remaining, " ")
+		if !found || !filterableFields[field] {
+			return nil, fmt.Errorf("invalid filter %q: the supported fields are 'properties.application' and 'properties.environment'", expression)
+		}
+
+		operator, rest, found := strings.Cut(strings.TrimLeft(rest, " "), " ")
+		if !found || (operator != string(store.FilterOperatorEquals) && operator != string(store.FilterOperatorNotEquals)) {
+			return nil, fmt.Errorf("invalid filter %q: the supported operators are 'eq' and 'ne'", expression)
+		}
+
+		value, rest, err := parseFilterValue(strings.TrimLeft(rest, " "))
+		if err != nil {
+			return nil, fmt.Errorf("invalid filter %q: %w", expression, err)
+		}
+
+		filters = append(filters, store.QueryFilter{Field: field, Operator: store.FilterOperator(operator), Value: value})
+
+		rest = strings.TrimSpace(rest)
+		if rest == "" {
+			return filters, nil
+		}
+
+		conjunction, next, found := strings.Cut(rest, " ")
+		if !found || conjunction != "and" {
+			return nil, fmt.Errorf("invalid filter %q: clauses must be separated by 'and'", expression)
+		}
+		remaining = strings.TrimLeft(next, " ")
+	}
+}
+
+// parseFilterValue parses a single quoted value at the start of the input and returns the value and the rest of the input.
+func parseFilterValue(input string) (string, string, error) {
+	if !strings.HasPrefix(input, "'") {
+		return "", "", fmt.Errorf("values must be enclosed in single quotes")
+	}
+
+	value := strings.Builder{}
+	for i := 1; i < len(input); i++ {
+		if input[i] != '\'' {
+			value.WriteByte(input[i])
+			continue
+		}
+
+		if i+1 < len(input) && input[i+1] == '\'' {
+			value.WriteByte('\'')
+			i++
+			continue
+		}
+
+		return value.String(), input[i+1:], nil
+	}
+
+	return "", "", fmt.Errorf("missing closing quote")
+}
@@ -184,3 +184,147 @@ func TestListResourcesRun(t *testing.T) {
 		})
 	}
 }
+
+func TestListResourcesRun_Filter(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	defer mctrl.Finish()
+
+	mStorageClient := store.NewMockStorageClient(mctrl)
+	ctx := context.Background()
+
+	opts := ctrl.Options{
+		StorageClient: mStorageClient,
+	}
+
+	ctrlOpts := ctrl.ResourceOptions[testDataModel]{
+		ResponseConverter: resourceToVersioned,
+	}
+
+	t.Run("valid filter", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, err := rpctest.NewHTTPRequestFromJSON(ctx, http.MethodGet, resourceTestHeaderFile, nil)
+		require.NoError(t, err)
+
+		q := req.URL.Query()
+		q.Add("filter", "properties.application eq '/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/applications/app'")
+		req.URL.RawQuery = q.Encode()
+		ctx := rpctest.NewARMRequestContext(req)
+
+		mStorageClient.
+			EXPECT().
+			Query(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, query store.Query, options ...store.QueryOptions) (*store.ObjectQueryResult, error) {
+				expected := []store.QueryFilter{
+					{
+						Field:    "properties.application",
+						Operator: store.FilterOperatorEquals,
+						Value:    "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/applications/app",
+					},
+				}
+				require.Equal(t, expected, query.Filters)
+				return &store.ObjectQueryResult{
+					Items: []store.Object{},
+				}, nil
+			})
+
+		ctl, err := NewListResources(opts, ctrlOpts)
+		require.NoError(t, err)
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, http.StatusOK, w.Result().StatusCode)
+	})
+
+	t.Run("invalid filter", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, err := rpctest.NewHTTPRequestFromJSON(ctx, http.MethodGet, resourceTestHeaderFile, nil)
+		require.NoError(t, err)
+
+		q := req.URL.Query()
+		q.Add("filter", "name eq 'test'")
+		req.URL.RawQuery = q.Encode()
+		ctx := rpctest.NewARMRequestContext(req)
+
+		ctl, err := NewListResources(opts, ctrlOpts)
+		require.NoError(t, err)
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+	})
+}
+
+func Test_parseFilter(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		expected   []store.QueryFilter
+		err        string
+	}{
+		{
+			name:       "empty",
+			expression: "",
+			expected:   nil,
+		},
+		{
+			name:       "single clause",
+			expression: "properties.environment eq 'env'",
+			expected: []store.QueryFilter{
+				{Field: "properties.environment", Operator: store.FilterOperatorEquals, Value: "env"},
+			},
+		},
+		{
+			name:       "multiple clauses",
+			expression: "properties.application eq 'app' and properties.environment ne 'env'",
+			expected: []store.QueryFilter{
+				{Field: "properties.application", Operator: store.FilterOperatorEquals, Value: "app"},
+				{Field: "properties.environment", Operator: store.FilterOperatorNotEquals, Value: "env"},
+			},
+		},
+		{
+			name:       "escaped quote and spaces",
+			expression: "properties.application eq 'it''s an app'",
+			expected: []store.QueryFilter{
+				{Field: "properties.application", Operator: store.FilterOperatorEquals, Value: "it's an app"},
+			},
+		},
+		{
+			name:       "unsupported field",
+			expression: "properties.status eq 'ready'",
+			err:        "the supported fields are",
+		},
+		{
+			name:       "unsupported operator",
+			expression: "properties.application gt 'app'",
+			err:        "the supported operators are",
+		},
+		{
+			name:       "unquoted value",
+			expression: "properties.application eq app",
+			err:        "values must be enclosed in single quotes",
+		},
+		{
+			name:       "missing closing quote",
+			expression: "properties.application eq 'app",
+			err:        "missing closing quote",
+		},
+		{
+			name:       "invalid conjunction",
+			expression: "properties.application eq 'app' or properties.environment eq 'env'",
+			err:        "clauses must be separated by 'and'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filters, err := parseFilter(tt.expression)
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, filters)
+		})
+	}
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	queue "github.com/radius-project/radius/pkg/ucp/queue/client"
+)
+
+var _ ctrl.Controller = (*PurgeDeadLetteredOperation)(nil)
+
+// PurgeDeadLetteredOperation is the controller implementation to delete an async operation in the dead-letter queue.
+type PurgeDeadLetteredOperation struct {
+	ctrl.BaseController
+}
+
+// NewPurgeDeadLetteredOperation creates a new PurgeDeadLetteredOperation controller.
+func NewPurgeDeadLetteredOperation(opts ctrl.Options) (ctrl.Controller, error) {
+	return &PurgeDeadLetteredOperation{ctrl.NewBaseController(opts)}, nil
+}
+
+// Run deletes the dead-lettered operation. It returns a NoContent response if the operation is deleted or not found.
+// The operation status is kept so that the result of the operation can still be queried.
+func (e *PurgeDeadLetteredOperation) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	dlq, ok := deadLetterClient(&e.BaseController)
+	if !ok {
+		return newDeadLetterUnsupportedResponse(), nil
+	}
+
+	msg, err := dlq.GetDeadLetter(ctx, serviceCtx.ResourceID.Name())
+	if errors.Is(err, queue.ErrDeadLetterNotFound) {
+		return rest.NewNoContentResponse(), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if !inNamespace(decodeRequest(msg), serviceCtx.ResourceID.ProviderNamespace()) {
+		return rest.NewNoContentResponse(), nil
+	}
+
+	err = dlq.PurgeDeadLetter(ctx, msg.ID)
+	if err != nil && !errors.Is(err, queue.ErrDeadLetterNotFound) {
+		return nil, err
+	}
+
+	return rest.NewNoContentResponse(), nil
+}
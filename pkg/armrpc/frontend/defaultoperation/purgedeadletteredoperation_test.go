@@ -0,0 +1,65 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	queue "github.com/radius-project/radius/pkg/ucp/queue/client"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPurgeDeadLetteredOperationRun(t *testing.T) {
+	q, msg := newDeadLetterTestQueue(t, testDeadLetterResourceID)
+
+	ctl, err := NewPurgeDeadLetteredOperation(ctrl.Options{RequestQueue: q})
+	require.NoError(t, err)
+
+	purgeTests := []struct {
+		desc    string
+		id      string
+		deleted bool
+	}{
+		{"purge operation of the other namespace", "/planes/radius/local/providers/Applications.Dapr/locations/global/operationdeadletters/" + msg.ID, false},
+		{"purge existing operation", testDeadLetterCollectionID + "/" + msg.ID, true},
+		{"purge non-existing operation", testDeadLetterCollectionID + "/" + msg.ID, true},
+	}
+
+	for _, tt := range purgeTests {
+		t.Run(tt.desc, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodDelete, tt.id, nil)
+			ctx := newDeadLetterTestContext(tt.id)
+
+			resp, err := ctl.Run(ctx, w, req)
+			require.NoError(t, err)
+			_ = resp.Apply(ctx, w, req)
+			require.Equal(t, http.StatusNoContent, w.Result().StatusCode)
+
+			_, err = q.GetDeadLetter(context.Background(), msg.ID)
+			if tt.deleted {
+				require.ErrorIs(t, err, queue.ErrDeadLetterNotFound)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	queue "github.com/radius-project/radius/pkg/ucp/queue/client"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+const (
+	// OperationReplay is the custom action to replay the dead-lettered operation.
+	OperationReplay v1.OperationMethod = "REPLAY"
+)
+
+var _ ctrl.Controller = (*ReplayDeadLetteredOperation)(nil)
+
+// ReplayDeadLetteredOperation is the controller implementation to move an async operation in the dead-letter queue back
+// to the request queue.
+type ReplayDeadLetteredOperation struct {
+	ctrl.BaseController
+}
+
+// NewReplayDeadLetteredOperation creates a new ReplayDeadLetteredOperation controller.
+func NewReplayDeadLetteredOperation(opts ctrl.Options) (ctrl.Controller, error) {
+	return &ReplayDeadLetteredOperation{ctrl.NewBaseController(opts)}, nil
+}
+
+// Run resets the operation status and the provisioning state of the resource to Accepted, and requeues the dead-lettered
+// operation so that the worker processes it again. It returns a NotFound response if the operation is not in the
+// dead-letter queue.
+func (e *ReplayDeadLetteredOperation) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	dlq, ok := deadLetterClient(&e.BaseController)
+	if !ok {
+		return newDeadLetterUnsupportedResponse(), nil
+	}
+
+	msg, err := dlq.GetDeadLetter(ctx, serviceCtx.ResourceID.Name())
+	if errors.Is(err, queue.ErrDeadLetterNotFound) {
+		return rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	asyncReq := decodeRequest(msg)
+	if !inNamespace(asyncReq, serviceCtx.ResourceID.ProviderNamespace()) {
+		return rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
+	}
+
+	// The worker skips the operations in the terminal state, so the operation status must be reset before the
+	// message is replayed. The provisioning state of the resource is reset together with it, like the worker does,
+	// so that the resource doesn't stay Failed while the operation is processed again.
+	if asyncReq != nil {
+		id, err := resources.ParseResource(asyncReq.ResourceID)
+		if err != nil {
+			return rest.NewBadRequestResponse(err.Error()), nil
+		}
+
+		resourceClient, err := e.DataProvider().GetStorageClient(ctx, id.Type())
+		if err != nil {
+			return nil, err
+		}
+
+		resource, err := acceptedResource(ctx, resourceClient, id)
+		if err != nil && !errors.Is(err, &store.ErrNotFound{}) {
+			return nil, err
+		}
+
+		err = e.StatusManager().ResetWithResource(ctx, resourceClient, resource, id, asyncReq.OperationID)
+		if err != nil && !errors.Is(err, &store.ErrNotFound{}) {
+			return nil, err
+		}
+	}
+
+	err = dlq.ReplayDeadLetter(ctx, msg.ID)
+	if errors.Is(err, queue.ErrDeadLetterNotFound) {
+		return rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return rest.NewNoContentResponse(), nil
+}
+
+// acceptedResource gets the resource and sets its provisioning state to Accepted without saving it. nil is returned
+// if the resource is already in the Accepted state.
+func acceptedResource(ctx context.Context, resourceClient store.StorageClient, id resources.ID) (*store.Object, error) {
+	obj, err := resourceClient.Get(ctx, id.String())
+	if err != nil {
+		return nil, err
+	}
+
+	data, ok := obj.Data.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("resource %q has unexpected data of type %T", id.String(), obj.Data)
+	}
+
+	if state, ok := data["provisioningState"].(string); ok && strings.EqualFold(state, string(v1.ProvisioningStateAccepted)) {
+		return nil, nil
+	}
+
+	data["provisioningState"] = string(v1.ProvisioningStateAccepted)
+	return obj, nil
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	manager "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	queue "github.com/radius-project/radius/pkg/ucp/queue/client"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplayDeadLetteredOperationRun(t *testing.T) {
+	replayTests := []struct {
+		desc        string
+		resource    *store.Object
+		resourceErr error
+		resetErr    error
+		code        int
+	}{
+		{
+			desc:     "replay operation",
+			resource: &store.Object{Data: map[string]any{"provisioningState": string(v1.ProvisioningStateFailed)}},
+			code:     http.StatusNoContent,
+		},
+		{
+			desc:        "replay operation without resource",
+			resourceErr: &store.ErrNotFound{ID: testDeadLetterResourceID},
+			code:        http.StatusNoContent,
+		},
+		{
+			desc:     "replay operation without status",
+			resource: &store.Object{Data: map[string]any{"provisioningState": string(v1.ProvisioningStateFailed)}},
+			resetErr: &store.ErrNotFound{ID: "status"},
+			code:     http.StatusNoContent,
+		},
+	}
+
+	for _, tt := range replayTests {
+		t.Run(tt.desc, func(t *testing.T) {
+			mctrl := gomock.NewController(t)
+			defer mctrl.Finish()
+
+			q, msg := newDeadLetterTestQueue(t, testDeadLetterResourceID)
+			asyncReq := decodeRequest(msg)
+
+			resourceClient := store.NewMockStorageClient(mctrl)
+			resourceClient.EXPECT().
+				Get(gomock.Any(), testDeadLetterResourceID).
+				Return(tt.resource, tt.resourceErr).
+				Times(1)
+			dp := dataprovider.NewMockDataStorageProvider(mctrl)
+			dp.EXPECT().
+				GetStorageClient(gomock.Any(), "Applications.Core/containers").
+				Return(resourceClient, nil).
+				Times(1)
+
+			// The provisioning state of the resource is reset together with the operation status.
+			sm := manager.NewMockStatusManager(mctrl)
+			sm.EXPECT().
+				ResetWithResource(gomock.Any(), resourceClient, tt.resource, resources.MustParse(testDeadLetterResourceID), asyncReq.OperationID).
+				DoAndReturn(func(ctx context.Context, resourceClient store.StorageClient, resource *store.Object, id resources.ID, operationID uuid.UUID) error {
+					if resource != nil {
+						require.Equal(t, string(v1.ProvisioningStateAccepted), resource.Data.(map[string]any)["provisioningState"])
+					}
+					return tt.resetErr
+				}).
+				Times(1)
+
+			ctl, err := NewReplayDeadLetteredOperation(ctrl.Options{DataProvider: dp, RequestQueue: q, StatusManager: sm})
+			require.NoError(t, err)
+
+			id := testDeadLetterCollectionID + "/" + msg.ID
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, id+"/replay", nil)
+			ctx := newDeadLetterTestContext(id)
+
+			resp, err := ctl.Run(ctx, w, req)
+			require.NoError(t, err)
+			_ = resp.Apply(ctx, w, req)
+			require.Equal(t, tt.code, w.Result().StatusCode)
+
+			// The operation is moved back to the request queue.
+			_, err = q.GetDeadLetter(context.Background(), msg.ID)
+			require.ErrorIs(t, err, queue.ErrDeadLetterNotFound)
+
+			replayed, err := q.Dequeue(context.Background(), queue.QueueClientConfig{})
+			require.NoError(t, err)
+			require.Equal(t, msg.ID, replayed.ID)
+			require.Equal(t, 1, replayed.DequeueCount)
+		})
+	}
+
+	t.Run("replay non-existing operation", func(t *testing.T) {
+		q, _ := newDeadLetterTestQueue(t, testDeadLetterResourceID)
+
+		ctl, err := NewReplayDeadLetteredOperation(ctrl.Options{RequestQueue: q})
+		require.NoError(t, err)
+
+		id := testDeadLetterCollectionID + "/unknown"
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, id+"/replay", nil)
+		ctx := newDeadLetterTestContext(id)
+
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+	})
+}
@@ -190,7 +190,7 @@ func withOtelLabelsForRequest(req *http.Request) {
 }
 
 // ConfigureDefaultHandlers registers handlers for the default operations such as getting operationStatuses and
-// operationResults, and updating a subscription lifecycle. It returns an error if any of the handler registrations fail.
+// operationResults, managing the dead-lettered operations, and updating a subscription lifecycle. It returns an error if any of the handler registrations fail.
 func ConfigureDefaultHandlers(
 	ctx context.Context,
 	rootRouter chi.Router,
@@ -241,6 +241,17 @@ func ConfigureDefaultHandlers(
 		return err
 	}
 
+	err = RegisterHandler(ctx, HandlerOptions{
+		ParentRouter:      rootRouter,
+		Path:              opStatus + "/cancel",
+		ResourceType:      statusRT,
+		Method:            defaultoperation.OperationCancel,
+		ControllerFactory: defaultoperation.NewCancelOperationStatus,
+	}, ctrlOpts)
+	if err != nil {
+		return err
+	}
+
 	opResult := fmt.Sprintf("%s/providers/%s/locations/{location}/operationresults/{operationId}", rootScopePath, providerNamespace)
 	err = RegisterHandler(ctx, HandlerOptions{
 		ParentRouter:      rootRouter,
@@ -253,6 +264,22 @@ func ConfigureDefaultHandlers(
 		return err
 	}
 
+	deadLetterRT := providerNamespace + "/" + defaultoperation.DeadLetteredOperationsType
+	deadLetters := fmt.Sprintf("%s/providers/%s/locations/{location}/%s", rootScopePath, providerNamespace, defaultoperation.DeadLetteredOperationsType)
+	deadLetter := deadLetters + "/{messageId}"
+	for _, h := range []HandlerOptions{
+		{Path: deadLetters, Method: v1.OperationList, ControllerFactory: defaultoperation.NewListDeadLetteredOperations},
+		{Path: deadLetter, Method: v1.OperationGet, ControllerFactory: defaultoperation.NewGetDeadLetteredOperation},
+		{Path: deadLetter, Method: v1.OperationDelete, ControllerFactory: defaultoperation.NewPurgeDeadLetteredOperation},
+		{Path: deadLetter + "/replay", Method: defaultoperation.OperationReplay, ControllerFactory: defaultoperation.NewReplayDeadLetteredOperation},
+	} {
+		h.ParentRouter = rootRouter
+		h.ResourceType = deadLetterRT
+		if err := RegisterHandler(ctx, h, ctrlOpts); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
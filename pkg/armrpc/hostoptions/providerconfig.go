@@ -69,6 +69,10 @@ type WorkerServerOptions struct {
 	MaxOperationConcurrency *int `yaml:"maxOperationConcurrency,omitempty"`
 	// MaxOperationRetryCount is the maximum retry count to process async request operation.
 	MaxOperationRetryCount *int `yaml:"maxOperationRetryCount,omitempty"`
+	// MaxPartitionConcurrency is the maximum concurrency to process async request operations of the same partition.
+	MaxPartitionConcurrency *int `yaml:"maxPartitionConcurrency,omitempty"`
+	// PartitionConcurrency overrides MaxPartitionConcurrency for the specific partitions by partition key.
+	PartitionConcurrency map[string]int `yaml:"partitionConcurrency,omitempty"`
 }
 
 // BicepOptions includes options required for bicep execution.
@@ -83,4 +87,16 @@ type BicepOptions struct {
 type TerraformOptions struct {
 	// Path is the path to the directory mounted to the container where terraform can be installed and executed.
 	Path string `yaml:"path,omitempty"`
+	// Cache includes the options of the provider plugin and module caches shared by the terraform executions.
+	Cache TerraformCacheOptions `yaml:"cache,omitempty"`
+}
+
+// TerraformCacheOptions includes the options of the terraform provider plugin and module caches.
+type TerraformCacheOptions struct {
+	// Enabled enables the caches, which are stored in the "cache" subdirectory of the terraform path.
+	Enabled bool `yaml:"enabled,omitempty"`
+	// PluginCacheMaxSizeMB is the maximum size of the provider plugin cache in megabytes. The size is not limited if zero.
+	PluginCacheMaxSizeMB int64 `yaml:"pluginCacheMaxSizeMB,omitempty"`
+	// ModuleCacheMaxSizeMB is the maximum size of the module cache in megabytes. The size is not limited if zero.
+	ModuleCacheMaxSizeMB int64 `yaml:"moduleCacheMaxSizeMB,omitempty"`
 }
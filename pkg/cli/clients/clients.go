@@ -21,6 +21,7 @@ import (
 	"io"
 	"os"
 
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
 	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
 	ucp_v20231001preview "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
@@ -170,6 +171,25 @@ type ApplicationsManagementClient interface {
 
 	// ShowRecipe shows recipe details including list of all parameters for a given recipe registered to an environment
 	ShowRecipe(ctx context.Context, environmentName string, recipe corerp.RecipeGetMetadata) (corerp.RecipeGetMetadataResponse, error)
+
+	// ListDeadLetteredOperations lists the async operations in the dead-letter queue.
+	ListDeadLetteredOperations(ctx context.Context) ([]v1.DeadLetteredOperation, error)
+
+	// ShowDeadLetteredOperation shows the async operation in the dead-letter queue.
+	ShowDeadLetteredOperation(ctx context.Context, name string) (v1.DeadLetteredOperation, error)
+
+	// ReplayDeadLetteredOperation moves the async operation in the dead-letter queue back to the request queue.
+	ReplayDeadLetteredOperation(ctx context.Context, name string) error
+
+	// PurgeDeadLetteredOperation deletes the async operation in the dead-letter queue.
+	PurgeDeadLetteredOperation(ctx context.Context, name string) error
+
+	// CancelOperation requests the cancellation of the in-flight async operation of the resource provider namespace.
+	CancelOperation(ctx context.Context, namespace string, operationID string, reason string) (v1.AsyncOperationStatus, error)
+
+	// PlanResource previews the changes the recipe of a portable resource makes when the resource is deployed with the
+	// given definition, without deploying it.
+	PlanResource(ctx context.Context, resourceType string, resourceName string, resource map[string]any) (corerp.RecipePlanResult, error)
 }
 
 // ShallowCopy creates a shallow copy of the DeploymentParameters object by iterating through the original object and
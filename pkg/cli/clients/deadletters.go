@@ -0,0 +1,145 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	armruntime "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm/runtime"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/azure/clientv2"
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	// deadLetteredOperationsAPIVersion is the api-version of the dead-lettered operations API.
+	deadLetteredOperationsAPIVersion = "2023-10-01-preview"
+
+	// deadLetteredOperationsNamespace is the resource provider namespace which processes the async operations of
+	// Radius resources.
+	deadLetteredOperationsNamespace = "Applications.Core"
+)
+
+// deadLetteredOperationList is the response of listing dead-lettered operations.
+type deadLetteredOperationList struct {
+	Value []v1.DeadLetteredOperation `json:"value"`
+}
+
+// ListDeadLetteredOperations lists the async operations in the dead-letter queue.
+func (amc *UCPApplicationsManagementClient) ListDeadLetteredOperations(ctx context.Context) ([]v1.DeadLetteredOperation, error) {
+	resp, err := amc.doDeadLetterRequest(ctx, http.MethodGet, "", http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	result := deadLetteredOperationList{}
+	if err := runtime.UnmarshalAsJSON(resp, &result); err != nil {
+		return nil, err
+	}
+
+	return result.Value, nil
+}
+
+// ShowDeadLetteredOperation shows the async operation in the dead-letter queue.
+func (amc *UCPApplicationsManagementClient) ShowDeadLetteredOperation(ctx context.Context, name string) (v1.DeadLetteredOperation, error) {
+	resp, err := amc.doDeadLetterRequest(ctx, http.MethodGet, "/"+url.PathEscape(name), http.StatusOK)
+	if err != nil {
+		return v1.DeadLetteredOperation{}, err
+	}
+
+	result := v1.DeadLetteredOperation{}
+	if err := runtime.UnmarshalAsJSON(resp, &result); err != nil {
+		return v1.DeadLetteredOperation{}, err
+	}
+
+	return result, nil
+}
+
+// ReplayDeadLetteredOperation moves the async operation in the dead-letter queue back to the request queue.
+func (amc *UCPApplicationsManagementClient) ReplayDeadLetteredOperation(ctx context.Context, name string) error {
+	_, err := amc.doDeadLetterRequest(ctx, http.MethodPost, "/"+url.PathEscape(name)+"/replay", http.StatusOK, http.StatusNoContent)
+	return err
+}
+
+// PurgeDeadLetteredOperation deletes the async operation in the dead-letter queue.
+func (amc *UCPApplicationsManagementClient) PurgeDeadLetteredOperation(ctx context.Context, name string) error {
+	_, err := amc.doDeadLetterRequest(ctx, http.MethodDelete, "/"+url.PathEscape(name), http.StatusOK, http.StatusNoContent)
+	return err
+}
+
+// doDeadLetterRequest sends the request to the dead-lettered operations API. path is relative to the collection of
+// the dead-lettered operations.
+func (amc *UCPApplicationsManagementClient) doDeadLetterRequest(ctx context.Context, method string, path string, statusCodes ...int) (*http.Response, error) {
+	return amc.doLocationRequest(ctx, method, deadLetteredOperationsNamespace, "/operationdeadletters"+path, nil, statusCodes...)
+}
+
+// doLocationRequest sends the request to the location scoped API of the resource provider namespace, such as the
+// operation statuses. path is relative to the global location of the namespace. body is sent as JSON unless nil.
+func (amc *UCPApplicationsManagementClient) doLocationRequest(ctx context.Context, method string, namespace string, path string, body any, statusCodes ...int) (*http.Response, error) {
+	options := amc.ClientOptions
+	if options == nil {
+		options = &arm.ClientOptions{}
+	}
+
+	host := cloud.AzurePublic.Services[cloud.ResourceManager].Endpoint
+	if c, ok := options.Cloud.Services[cloud.ResourceManager]; ok {
+		host = c.Endpoint
+	}
+
+	scope, err := resources.ParseScope(amc.RootScope)
+	if err != nil {
+		return nil, err
+	}
+
+	pipeline, err := armruntime.NewPipeline(clientv2.ModuleName, clientv2.ModuleVersion, &aztoken.AnonymousCredential{}, runtime.PipelineOptions{}, options)
+	if err != nil {
+		return nil, err
+	}
+
+	urlPath := scope.PlaneScope() + "/providers/" + namespace + "/locations/" + v1.LocationGlobal + path
+	req, err := runtime.NewRequest(ctx, method, runtime.JoinPaths(host, urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", deadLetteredOperationsAPIVersion)
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+
+	if body != nil {
+		if err := runtime.MarshalAsJSON(req, body); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := pipeline.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if !runtime.HasStatusCode(resp, statusCodes...) {
+		return nil, runtime.NewResponseError(resp)
+	}
+
+	return resp, nil
+}
@@ -59,3 +59,13 @@ func Is404Error(err error) bool {
 
 	return false
 }
+
+// Is409Error returns true if the error is a ResponseError with an ErrorCode of "Conflict" or a StatusCode of 409.
+func Is409Error(err error) bool {
+	responseError := &azcore.ResponseError{}
+	if !errors.As(err, &responseError) {
+		return false
+	}
+
+	return responseError.ErrorCode == v1.CodeConflict || responseError.StatusCode == http.StatusConflict
+}
@@ -63,3 +63,25 @@ func TestIs404Error(t *testing.T) {
 		t.Errorf("Expected Is404Error to return false for nil error, but it returned true")
 	}
 }
+
+func TestIs409Error(t *testing.T) {
+	if !Is409Error(&azcore.ResponseError{ErrorCode: v1.CodeConflict}) {
+		t.Errorf("Expected Is409Error to return true for ResponseError with ErrorCode of 'Conflict', but it returned false")
+	}
+
+	if !Is409Error(&azcore.ResponseError{StatusCode: http.StatusConflict}) {
+		t.Errorf("Expected Is409Error to return true for ResponseError with StatusCode of 409, but it returned false")
+	}
+
+	if Is409Error(&azcore.ResponseError{StatusCode: http.StatusNotFound}) {
+		t.Errorf("Expected Is409Error to return false for ResponseError with StatusCode of 404, but it returned true")
+	}
+
+	if Is409Error(errors.New("Some other error")) {
+		t.Errorf("Expected Is409Error to return false for error of type *errors.errorString, but it returned true")
+	}
+
+	if Is409Error(nil) {
+		t.Errorf("Expected Is409Error to return false for nil error, but it returned true")
+	}
+}
@@ -18,6 +18,7 @@ package clients
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"strings"
 
@@ -72,6 +73,12 @@ var (
 // ListAllResourcesByType retrieves a list of all resources of a given type from the root
 // scope, and returns them in a slice of GenericResource objects, or an error if one occurs.
 func (amc *UCPApplicationsManagementClient) ListAllResourcesByType(ctx context.Context, resourceType string) ([]generated.GenericResource, error) {
+	return amc.listAllResourcesByType(ctx, resourceType, nil)
+}
+
+// listAllResourcesByType lists the resources of a particular type. If filter is set, the server only returns the
+// resources matching the filter expression.
+func (amc *UCPApplicationsManagementClient) listAllResourcesByType(ctx context.Context, resourceType string, filter *string) ([]generated.GenericResource, error) {
 	results := []generated.GenericResource{}
 
 	client, err := generated.NewGenericResourcesClient(amc.RootScope, resourceType, &aztoken.AnonymousCredential{}, amc.ClientOptions)
@@ -79,7 +86,7 @@ func (amc *UCPApplicationsManagementClient) ListAllResourcesByType(ctx context.C
 		return results, err
 	}
 
-	pager := client.NewListByRootScopePager(&generated.GenericResourcesClientListByRootScopeOptions{})
+	pager := client.NewListByRootScopePager(&generated.GenericResourcesClientListByRootScopeOptions{Filter: filter})
 	for pager.More() {
 		nextPage, err := pager.NextPage(ctx)
 		if err != nil {
@@ -100,11 +107,22 @@ func (amc *UCPApplicationsManagementClient) ListAllResourcesByType(ctx context.C
 // ListAllResourcesOfTypeInApplication takes in a context, an application name and a
 // resource type and returns a slice of GenericResources and an error if one occurs.
 func (amc *UCPApplicationsManagementClient) ListAllResourcesOfTypeInApplication(ctx context.Context, applicationName string, resourceType string) ([]generated.GenericResource, error) {
+	filter, err := amc.applicationFilter(ctx, applicationName)
+	if err != nil {
+		return nil, err
+	}
+
+	return amc.listAllResourcesOfTypeInApplication(ctx, applicationName, resourceType, filter)
+}
+
+func (amc *UCPApplicationsManagementClient) listAllResourcesOfTypeInApplication(ctx context.Context, applicationName string, resourceType string, filter *string) ([]generated.GenericResource, error) {
 	results := []generated.GenericResource{}
-	resourceList, err := amc.ListAllResourcesByType(ctx, resourceType)
+	resourceList, err := amc.listAllResourcesByType(ctx, resourceType, filter)
 	if err != nil {
 		return nil, err
 	}
+
+	// The resources are still matched here because servers that don't support filtering return all the resources.
 	for _, resource := range resourceList {
 		isResourceWithApplication := isResourceInApplication(ctx, resource, applicationName)
 		if isResourceWithApplication {
@@ -120,9 +138,14 @@ func (amc *UCPApplicationsManagementClient) ListAllResourcesOfTypeInApplication(
 // ListAllResourcesByApplication takes in a context and an application name and returns
 // a slice of GenericResources and an error if one occurs.
 func (amc *UCPApplicationsManagementClient) ListAllResourcesByApplication(ctx context.Context, applicationName string) ([]generated.GenericResource, error) {
+	filter, err := amc.applicationFilter(ctx, applicationName)
+	if err != nil {
+		return nil, err
+	}
+
 	results := []generated.GenericResource{}
 	for _, resourceType := range ResourceTypesList {
-		resourceList, err := amc.ListAllResourcesOfTypeInApplication(ctx, applicationName, resourceType)
+		resourceList, err := amc.listAllResourcesOfTypeInApplication(ctx, applicationName, resourceType, filter)
 		if err != nil {
 			return nil, err
 		}
@@ -138,9 +161,14 @@ func (amc *UCPApplicationsManagementClient) ListAllResourcesByApplication(ctx co
 // ListAllResourcesByEnvironment iterates through a list of resource types and calls ListAllResourcesOfTypeInEnvironment
 // for each one, appending the results to a slice of GenericResources and returning it. If an error is encountered, it is returned.
 func (amc *UCPApplicationsManagementClient) ListAllResourcesByEnvironment(ctx context.Context, environmentName string) ([]generated.GenericResource, error) {
+	filter, err := amc.environmentFilter(ctx, environmentName)
+	if err != nil {
+		return nil, err
+	}
+
 	results := []generated.GenericResource{}
 	for _, resourceType := range ResourceTypesList {
-		resourceList, err := amc.ListAllResourcesOfTypeInEnvironment(ctx, environmentName, resourceType)
+		resourceList, err := amc.listAllResourcesOfTypeInEnvironment(ctx, environmentName, resourceType, filter)
 		if err != nil {
 			return nil, err
 		}
@@ -156,11 +184,22 @@ func (amc *UCPApplicationsManagementClient) ListAllResourcesByEnvironment(ctx co
 // ListAllResourcesOfTypeInEnvironment takes in a context, an environment name and a
 // resource type and returns a slice of GenericResources and an error if one occurs.
 func (amc *UCPApplicationsManagementClient) ListAllResourcesOfTypeInEnvironment(ctx context.Context, environmentName string, resourceType string) ([]generated.GenericResource, error) {
+	filter, err := amc.environmentFilter(ctx, environmentName)
+	if err != nil {
+		return nil, err
+	}
+
+	return amc.listAllResourcesOfTypeInEnvironment(ctx, environmentName, resourceType, filter)
+}
+
+func (amc *UCPApplicationsManagementClient) listAllResourcesOfTypeInEnvironment(ctx context.Context, environmentName string, resourceType string, filter *string) ([]generated.GenericResource, error) {
 	results := []generated.GenericResource{}
-	resourceList, err := amc.ListAllResourcesByType(ctx, resourceType)
+	resourceList, err := amc.listAllResourcesByType(ctx, resourceType, filter)
 	if err != nil {
 		return nil, err
 	}
+
+	// The resources are still matched here because servers that don't support filtering return all the resources.
 	for _, resource := range resourceList {
 		isResourceWithApplication := isResourceInEnvironment(ctx, resource, environmentName)
 		if isResourceWithApplication {
@@ -170,6 +209,43 @@ func (amc *UCPApplicationsManagementClient) ListAllResourcesOfTypeInEnvironment(
 	return results, nil
 }
 
+// applicationFilter returns the filter expression matching the resources of the application, or nil if the application
+// does not exist.
+func (amc *UCPApplicationsManagementClient) applicationFilter(ctx context.Context, applicationName string) (*string, error) {
+	application, err := amc.ShowApplication(ctx, applicationName)
+	if clientv2.Is404Error(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return resourceFilter("properties.application", application.ID), nil
+}
+
+// environmentFilter returns the filter expression matching the resources of the environment, or nil if the environment
+// does not exist.
+func (amc *UCPApplicationsManagementClient) environmentFilter(ctx context.Context, environmentName string) (*string, error) {
+	environment, err := amc.GetEnvDetails(ctx, environmentName)
+	if clientv2.Is404Error(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return resourceFilter("properties.environment", environment.ID), nil
+}
+
+// resourceFilter returns the filter expression matching the resources whose field is the given ID. The stores compare
+// the values case-insensitively, so resources referencing the ID with another casing are matched too.
+func resourceFilter(field string, id *string) *string {
+	if id == nil {
+		return nil
+	}
+
+	filter := fmt.Sprintf("%s eq '%s'", field, strings.ReplaceAll(*id, "'", "''"))
+	return &filter
+}
+
 // ShowResource creates a new client for a given resource type and attempts to retrieve the resource with the given name,
 // returning the resource or an error if one occurs.
 func (amc *UCPApplicationsManagementClient) ShowResource(ctx context.Context, resourceType string, resourceName string) (generated.GenericResource, error) {
@@ -9,6 +9,7 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	generated "github.com/radius-project/radius/pkg/cli/clients_new/generated"
 	v20231001preview "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
 	v20231001preview0 "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
@@ -37,6 +38,21 @@ func (m *MockApplicationsManagementClient) EXPECT() *MockApplicationsManagementC
 	return m.recorder
 }
 
+// CancelOperation mocks base method.
+func (m *MockApplicationsManagementClient) CancelOperation(arg0 context.Context, arg1, arg2, arg3 string) (v1.AsyncOperationStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelOperation", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(v1.AsyncOperationStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelOperation indicates an expected call of CancelOperation.
+func (mr *MockApplicationsManagementClientMockRecorder) CancelOperation(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelOperation", reflect.TypeOf((*MockApplicationsManagementClient)(nil).CancelOperation), arg0, arg1, arg2, arg3)
+}
+
 // CreateApplicationIfNotFound mocks base method.
 func (m *MockApplicationsManagementClient) CreateApplicationIfNotFound(arg0 context.Context, arg1 string, arg2 v20231001preview.ApplicationResource) error {
 	m.ctrl.T.Helper()
@@ -258,6 +274,21 @@ func (mr *MockApplicationsManagementClientMockRecorder) ListApplications(arg0 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListApplications", reflect.TypeOf((*MockApplicationsManagementClient)(nil).ListApplications), arg0)
 }
 
+// ListDeadLetteredOperations mocks base method.
+func (m *MockApplicationsManagementClient) ListDeadLetteredOperations(arg0 context.Context) ([]v1.DeadLetteredOperation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeadLetteredOperations", arg0)
+	ret0, _ := ret[0].([]v1.DeadLetteredOperation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeadLetteredOperations indicates an expected call of ListDeadLetteredOperations.
+func (mr *MockApplicationsManagementClientMockRecorder) ListDeadLetteredOperations(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeadLetteredOperations", reflect.TypeOf((*MockApplicationsManagementClient)(nil).ListDeadLetteredOperations), arg0)
+}
+
 // ListEnvironmentsAll mocks base method.
 func (m *MockApplicationsManagementClient) ListEnvironmentsAll(arg0 context.Context) ([]v20231001preview.EnvironmentResource, error) {
 	m.ctrl.T.Helper()
@@ -303,6 +334,49 @@ func (mr *MockApplicationsManagementClientMockRecorder) ListUCPGroup(arg0, arg1,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUCPGroup", reflect.TypeOf((*MockApplicationsManagementClient)(nil).ListUCPGroup), arg0, arg1, arg2)
 }
 
+// PlanResource mocks base method.
+func (m *MockApplicationsManagementClient) PlanResource(arg0 context.Context, arg1, arg2 string, arg3 map[string]interface{}) (v20231001preview.RecipePlanResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlanResource", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(v20231001preview.RecipePlanResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlanResource indicates an expected call of PlanResource.
+func (mr *MockApplicationsManagementClientMockRecorder) PlanResource(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlanResource", reflect.TypeOf((*MockApplicationsManagementClient)(nil).PlanResource), arg0, arg1, arg2, arg3)
+}
+
+// PurgeDeadLetteredOperation mocks base method.
+func (m *MockApplicationsManagementClient) PurgeDeadLetteredOperation(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeadLetteredOperation", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeDeadLetteredOperation indicates an expected call of PurgeDeadLetteredOperation.
+func (mr *MockApplicationsManagementClientMockRecorder) PurgeDeadLetteredOperation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeadLetteredOperation", reflect.TypeOf((*MockApplicationsManagementClient)(nil).PurgeDeadLetteredOperation), arg0, arg1)
+}
+
+// ReplayDeadLetteredOperation mocks base method.
+func (m *MockApplicationsManagementClient) ReplayDeadLetteredOperation(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplayDeadLetteredOperation", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplayDeadLetteredOperation indicates an expected call of ReplayDeadLetteredOperation.
+func (mr *MockApplicationsManagementClientMockRecorder) ReplayDeadLetteredOperation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplayDeadLetteredOperation", reflect.TypeOf((*MockApplicationsManagementClient)(nil).ReplayDeadLetteredOperation), arg0, arg1)
+}
+
 // ShowApplication mocks base method.
 func (m *MockApplicationsManagementClient) ShowApplication(arg0 context.Context, arg1 string) (v20231001preview.ApplicationResource, error) {
 	m.ctrl.T.Helper()
@@ -318,6 +392,21 @@ func (mr *MockApplicationsManagementClientMockRecorder) ShowApplication(arg0, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowApplication", reflect.TypeOf((*MockApplicationsManagementClient)(nil).ShowApplication), arg0, arg1)
 }
 
+// ShowDeadLetteredOperation mocks base method.
+func (m *MockApplicationsManagementClient) ShowDeadLetteredOperation(arg0 context.Context, arg1 string) (v1.DeadLetteredOperation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShowDeadLetteredOperation", arg0, arg1)
+	ret0, _ := ret[0].(v1.DeadLetteredOperation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShowDeadLetteredOperation indicates an expected call of ShowDeadLetteredOperation.
+func (mr *MockApplicationsManagementClientMockRecorder) ShowDeadLetteredOperation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowDeadLetteredOperation", reflect.TypeOf((*MockApplicationsManagementClient)(nil).ShowDeadLetteredOperation), arg0, arg1)
+}
+
 // ShowRecipe mocks base method.
 func (m *MockApplicationsManagementClient) ShowRecipe(arg0 context.Context, arg1 string, arg2 v20231001preview.RecipeGetMetadata) (v20231001preview.RecipeGetMetadataResponse, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,44 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+)
+
+// CancelOperation requests the cancellation of the in-flight async operation of the resource provider namespace.
+// The operation is canceled by the worker processing it, so the returned status may not be Canceled yet.
+func (amc *UCPApplicationsManagementClient) CancelOperation(ctx context.Context, namespace string, operationID string, reason string) (v1.AsyncOperationStatus, error) {
+	body := v1.CancelAsyncOperationRequest{Reason: reason}
+	resp, err := amc.doLocationRequest(ctx, http.MethodPost, namespace, "/operationstatuses/"+url.PathEscape(operationID)+"/cancel", body, http.StatusOK, http.StatusAccepted)
+	if err != nil {
+		return v1.AsyncOperationStatus{}, err
+	}
+
+	result := v1.AsyncOperationStatus{}
+	if err := runtime.UnmarshalAsJSON(resp, &result); err != nil {
+		return v1.AsyncOperationStatus{}, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	corerpv20231001 "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	ext_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/extenders"
+	daprv20231001 "github.com/radius-project/radius/pkg/daprrp/api/v20231001preview"
+	dapr_ctrl "github.com/radius-project/radius/pkg/daprrp/frontend/controller"
+	dsv20231001 "github.com/radius-project/radius/pkg/datastoresrp/api/v20231001preview"
+	ds_ctrl "github.com/radius-project/radius/pkg/datastoresrp/frontend/controller"
+	msgv20231001 "github.com/radius-project/radius/pkg/messagingrp/api/v20231001preview"
+	msg_ctrl "github.com/radius-project/radius/pkg/messagingrp/frontend/controller"
+)
+
+var (
+	// PlanResourceTypesList is the list of the resource types whose recipe changes can be previewed with PlanResource.
+	PlanResourceTypesList = []string{
+		dapr_ctrl.DaprPubSubBrokersResourceType,
+		dapr_ctrl.DaprSecretStoresResourceType,
+		dapr_ctrl.DaprStateStoresResourceType,
+		ds_ctrl.MongoDatabasesResourceType,
+		msg_ctrl.RabbitMQQueuesResourceType,
+		ds_ctrl.RedisCachesResourceType,
+		ds_ctrl.SqlDatabasesResourceType,
+		ext_ctrl.ResourceTypeName,
+	}
+)
+
+// PlanResource previews the changes the recipe of a portable resource makes when the resource is deployed with the given
+// definition, without deploying it. resource is the JSON representation of the resource.
+func (amc *UCPApplicationsManagementClient) PlanResource(ctx context.Context, resourceType string, resourceName string, resource map[string]any) (corerpv20231001.RecipePlanResult, error) {
+	var result any
+	switch strings.ToLower(resourceType) {
+	case strings.ToLower(dapr_ctrl.DaprPubSubBrokersResourceType):
+		client, err := daprv20231001.NewPubSubBrokersClient(amc.RootScope, &aztoken.AnonymousCredential{}, amc.ClientOptions)
+		if err != nil {
+			return corerpv20231001.RecipePlanResult{}, err
+		}
+		body := daprv20231001.DaprPubSubBrokerResource{}
+		if err := convertResource(resource, &body); err != nil {
+			return corerpv20231001.RecipePlanResult{}, err
+		}
+		resp, err := client.Plan(ctx, resourceName, body, nil)
+		if err != nil {
+			return corerpv20231001.RecipePlanResult{}, err
+		}
+		result = resp.RecipePlanResult
+	case strings.ToLower(dapr_ctrl.DaprSecretStoresResourceType):
+		client, err := daprv20231001.NewSecretStoresClient(amc.RootScope, &aztoken.AnonymousCredential{}, amc.ClientOptions)
+		if err != nil {
+			return corerpv20231001.RecipePlanResult{}, err
+		}
+		body := daprv20231001.DaprSecretStoreResource{}
+		if err := convertResource(resource, &body); err != nil {
+			return corerpv20231001.RecipePlanResult{}, err
+		}
+		resp, err := client.Plan(ctx, resourceName, body, nil)
+		if err != nil {
+			return corerpv20231001.RecipePlanResult{}, err
+		}
+		result = resp.RecipePlanResult
+	case strings.ToLower(dapr_ctrl.DaprStateStoresResourceType):
+		client, err := daprv20231001.NewStateStoresClient(amc.RootScope, &aztoken.AnonymousCredential{}, amc.ClientOptions)
+		if err != nil {
+			return corerpv20231001.RecipePlanResult{}, err
+		}
+		body := daprv20231001.DaprStateStoreResource{}
+		if err := convertResource(resource, &body); err != nil {
+			return corerpv20231001.RecipePlanResult{}, err
+		}
+		resp, err := client.Plan(ctx, resourceName, body, nil)
+		if err != nil {
+			return corerpv20231001.RecipePlanResult{}, err
+		}
+		result = resp.RecipePlanResult
+	case strings.ToLower(ds_ctrl.MongoDatabasesResourceType):
+		client, err := dsv20231001.NewMongoDatabasesClient(amc.RootScope, &aztoken.AnonymousCredential{}, amc.ClientOptions)
+		if err != nil {
+			return corerpv20231001.RecipePlanResult{}, err
+		}
+		body := dsv20231001.MongoDatabaseResource{}
+		if err := convertResource(resource, &body); err != nil {
+			return corerpv20231001.RecipePlanResult{}, err
+		}
+		resp, err := client.Plan(ctx, resourceName, body, nil)
+		if err != nil {
+			return corerpv20231001.RecipePlanResult{}, err
+		}
+		result = resp.RecipePlanResult
+	case strings.ToLower(msg_ctrl.RabbitMQQueuesResourceType):
+		client, err := msgv20231001.NewRabbitMqQueuesClient(amc.RootScope, &aztoken.AnonymousCredential{}, amc.ClientOptions)
+		if err != nil {
+			return corerpv20231001.RecipePlanResult{}, err
+		}
+		body := msgv20231001.RabbitMQQueueResource{}
+		if err := convertResource(resource, &body); err != nil {
+			return corerpv20231001.RecipePlanResult{}, err
+		}
+		resp, err := client.Plan(ctx, resourceName, body, nil)
+		if err != nil {
+			return corerpv20231001.RecipePlanResult{}, err
+		}
+		result = resp.RecipePlanResult
+	case strings.ToLower(ds_ctrl.RedisCachesResourceType):
+		client, err := dsv20231001.NewRedisCachesClient(amc.RootScope, &aztoken.AnonymousCredential{}, amc.ClientOptions)
+		if err != nil {
+			return corerpv20231001.RecipePlanResult{}, err
+		}
+		body := dsv20231001.RedisCacheResource{}
+		if err := convertResource(resource, &body); err != nil {
+			return corerpv20231001.RecipePlanResult{}, err
+		}
+		resp, err := client.Plan(ctx, resourceName, body, nil)
+		if err != nil {
+			return corerpv20231001.RecipePlanResult{}, err
+		}
+		result = resp.RecipePlanResult
+	case strings.ToLower(ds_ctrl.SqlDatabasesResourceType):
+		client, err := dsv20231001.NewSQLDatabasesClient(amc.RootScope, &aztoken.AnonymousCredential{}, amc.ClientOptions)
+		if err != nil {
+			return corerpv20231001.RecipePlanResult{}, err
+		}
+		body := dsv20231001.SQLDatabaseResource{}
+		if err := convertResource(resource, &body); err != nil {
+			return corerpv20231001.RecipePlanResult{}, err
+		}
+		resp, err := client.Plan(ctx, resourceName, body, nil)
+		if err != nil {
+			return corerpv20231001.RecipePlanResult{}, err
+		}
+		result = resp.RecipePlanResult
+	case strings.ToLower(ext_ctrl.ResourceTypeName):
+		client, err := corerpv20231001.NewExtendersClient(amc.RootScope, &aztoken.AnonymousCredential{}, amc.ClientOptions)
+		if err != nil {
+			return corerpv20231001.RecipePlanResult{}, err
+		}
+		body := corerpv20231001.ExtenderResource{}
+		if err := convertResource(resource, &body); err != nil {
+			return corerpv20231001.RecipePlanResult{}, err
+		}
+		resp, err := client.Plan(ctx, resourceName, body, nil)
+		if err != nil {
+			return corerpv20231001.RecipePlanResult{}, err
+		}
+		result = resp.RecipePlanResult
+	default:
+		return corerpv20231001.RecipePlanResult{}, fmt.Errorf("the recipe changes of resources of type %q can't be previewed", resourceType)
+	}
+
+	// The plan models of the resource providers share the same schema, so they are converted to the Applications.Core one.
+	plan := corerpv20231001.RecipePlanResult{}
+	if err := convertResource(result, &plan); err != nil {
+		return corerpv20231001.RecipePlanResult{}, err
+	}
+
+	return plan, nil
+}
+
+// convertResource converts between the models of a resource using their JSON representation.
+func convertResource(in any, out any) error {
+	b, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, out)
+}
@@ -89,7 +89,9 @@ type GenericResourcesClientGetOptions struct {
 // GenericResourcesClientListByRootScopeOptions contains the optional parameters for the GenericResourcesClient.ListByRootScope
 // method.
 type GenericResourcesClientListByRootScopeOptions struct {
-	// placeholder for future optional parameters
+	// Restricts the resources returned to those matching the filter expression. For example: properties.application eq '<application
+	// id>'
+	Filter *string
 }
 
 // GenericResourcesClientListSecretsOptions contains the optional parameters for the GenericResourcesClient.ListSecrets method.
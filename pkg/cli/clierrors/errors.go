@@ -33,3 +33,8 @@ func Message(message string, args ...any) *ErrorMessage {
 func MessageWithCause(cause error, message string, args ...any) *ErrorMessage {
 	return &ErrorMessage{Cause: cause, Message: fmt.Sprintf(message, args...)}
 }
+
+// Exit returns a new ExitError with the given exit code.
+func Exit(code int) *ExitError {
+	return &ExitError{Code: code}
+}
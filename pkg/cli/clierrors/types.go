@@ -16,6 +16,8 @@ limitations under the License.
 
 package clierrors
 
+import "fmt"
+
 // FriendlyError defines an interface for errors that should be gracefully handled by the CLI and
 // display a friendly error message to the user.
 type FriendlyError interface {
@@ -54,3 +56,18 @@ func (*ErrorMessage) IsFriendlyError() bool {
 func (e *ErrorMessage) Unwrap() error {
 	return e.Cause
 }
+
+var _ error = &ExitError{}
+
+// ExitError represents the outcome of a command which has already been reported to the user, and which makes the CLI
+// exit with a specific non-zero exit code. For example a command comparing states can use it to report differences to
+// scripts. No message is displayed for it.
+type ExitError struct {
+	// Code is the exit code of the CLI.
+	Code int
+}
+
+// Error returns the error message for the error.
+func (e *ExitError) Error() string {
+	return fmt.Sprintf("exit code %d", e.Code)
+}
@@ -0,0 +1,239 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package appimport
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/bicep"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/app/export"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/deploy"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad app import` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+	cmd := &cobra.Command{
+		Use:   "import [file]",
+		Short: "Imports an application exported by 'rad app export'.",
+		Long: `Imports an application exported by 'rad app export'.
+
+The import command deploys an application exported to a Bicep or JSON file by 'rad app export' into your default
+environment (unless otherwise specified). The application can be imported into another environment or resource group
+than the one it was exported from, and can be renamed with the --application flag. If the application already exists,
+its resources are updated.
+
+The secrets of the exported application are declared as parameters without default value, which must be set with the
+--parameters flag.`,
+		Args: cobra.ExactArgs(1),
+		Example: `
+# Import an application into the current environment
+rad app import my-application.bicep
+
+# Import an application into another environment and resource group
+rad app import my-application.json --environment production --group production
+
+# Import an application with another name
+rad app import my-application.bicep --application my-application-copy
+
+# Import an application, setting the parameters declared for its secrets
+rad app import my-application.bicep --parameters mySecretsPassword=$PASSWORD --parameters @secrets.json`,
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddEnvironmentNameFlag(cmd)
+	commonflags.AddApplicationNameFlag(cmd)
+	commonflags.AddParameterFlag(cmd)
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad app import` command.
+type Runner struct {
+	Bicep             bicep.Interface
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Deploy            deploy.Interface
+	Output            output.Interface
+
+	ApplicationName string
+	EnvironmentName string
+	FilePath        string
+	Parameters      clients.DeploymentParameters
+	Providers       *clients.Providers
+	Workspace       *workspaces.Workspace
+}
+
+// NewRunner creates a new instance of the `rad app import` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		Bicep:             factory.GetBicep(),
+		ConfigHolder:      factory.GetConfigHolder(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		Deploy:            factory.GetDeploy(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad app import` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	r.Workspace.Scope, err = cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	r.EnvironmentName, err = cli.RequireEnvironmentName(cmd, args, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	// The default application of the workspace is ignored, the name of the application defaults to the name of the
+	// exported application.
+	r.ApplicationName, err = cmd.Flags().GetString("application")
+	if err != nil {
+		return err
+	}
+
+	// Unlike `rad deploy`, the environment must exist since the application is deployed to it.
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(cmd.Context(), *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	env, err := client.GetEnvDetails(cmd.Context(), r.EnvironmentName)
+	if clients.Is404Error(err) {
+		return clierrors.Message("The environment %q does not exist in scope %q. Run `rad env create` first.", r.EnvironmentName, r.Workspace.Scope)
+	} else if err != nil {
+		return err
+	}
+
+	r.Providers = &clients.Providers{}
+	r.Providers.Radius = &clients.RadiusProvider{}
+	r.Providers.Radius.EnvironmentID = r.Workspace.Scope + "/providers/applications.core/environments/" + r.EnvironmentName
+	r.Workspace.Environment = r.Providers.Radius.EnvironmentID
+
+	if env.Properties != nil && env.Properties.Providers != nil {
+		if env.Properties.Providers.Aws != nil {
+			r.Providers.AWS = &clients.AWSProvider{
+				Scope: *env.Properties.Providers.Aws.Scope,
+			}
+		}
+		if env.Properties.Providers.Azure != nil {
+			r.Providers.Azure = &clients.AzureProvider{
+				Scope: *env.Properties.Providers.Azure.Scope,
+			}
+		}
+	}
+
+	r.FilePath = args[0]
+
+	parameterArgs, err := cmd.Flags().GetStringArray("parameters")
+	if err != nil {
+		return err
+	}
+
+	parser := bicep.ParameterParser{FileSystem: bicep.OSFileSystem{}}
+	r.Parameters, err = parser.Parse(parameterArgs...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Run runs the `rad app import` command.
+func (r *Runner) Run(ctx context.Context) error {
+	template, err := r.Bicep.PrepareTemplate(r.FilePath)
+	if err != nil {
+		return err
+	}
+
+	parameters, _ := template["parameters"].(map[string]any)
+	applicationNameParameter, ok := parameters[export.ApplicationNameParameter].(map[string]any)
+	if !ok {
+		return clierrors.Message("The file %q is not an application exported by `rad app export`.", r.FilePath)
+	}
+
+	if r.ApplicationName == "" {
+		r.ApplicationName, _ = applicationNameParameter["defaultValue"].(string)
+	}
+	if r.ApplicationName == "" {
+		return clierrors.Message("The file %q doesn't declare the name of the application. Specify it with the --application flag.", r.FilePath)
+	}
+
+	// The parameters without default value, such as the secrets of the application, are checked before deploying so
+	// that the resources aren't partially imported.
+	missing := []string{}
+	for name, parameter := range parameters {
+		declaration, _ := parameter.(map[string]any)
+		if _, ok := declaration["defaultValue"]; ok || name == export.EnvironmentParameter {
+			continue
+		}
+
+		if _, ok := r.Parameters[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return clierrors.Message("The file %q declares parameters without default value: %s. Set them with the --parameters flag.", r.FilePath, strings.Join(missing, ", "))
+	}
+
+	deploymentParameters := clients.ShallowCopy(r.Parameters)
+	deploymentParameters[export.ApplicationNameParameter] = map[string]any{"value": r.ApplicationName}
+
+	r.Providers.Radius.ApplicationID = r.Workspace.Scope + "/providers/applications.core/applications/" + r.ApplicationName
+
+	progressText := fmt.Sprintf(
+		"Importing application '%v' from '%v' into environment '%v' from workspace '%v'...\n\n"+
+			"Deployment In Progress...", r.ApplicationName, r.FilePath, r.EnvironmentName, r.Workspace.Name)
+
+	_, err = r.Deploy.DeployWithProgress(ctx, deploy.Options{
+		ConnectionFactory: r.ConnectionFactory,
+		Workspace:         *r.Workspace,
+		Template:          template,
+		Parameters:        deploymentParameters,
+		ProgressText:      progressText,
+		CompletionText:    "Import Complete",
+		Providers:         r.Providers,
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
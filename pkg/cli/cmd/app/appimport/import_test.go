@@ -0,0 +1,264 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package appimport
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/radius-project/radius/pkg/cli/bicep"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/deploy"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspaceAndApplication(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Import command valid",
+			Input:         []string{"app.bicep"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ConfigureMocks: func(mocks radcli.ValidateMocks) {
+				mocks.ApplicationManagementClient.EXPECT().
+					GetEnvDetails(gomock.Any(), radcli.TestEnvironmentName).
+					Return(v20231001preview.EnvironmentResource{}, nil).
+					Times(1)
+			},
+			ValidateCallback: func(t *testing.T, r framework.Runner) {
+				runner := r.(*Runner)
+				require.Equal(t, "app.bicep", runner.FilePath)
+				require.Equal(t, radcli.TestEnvironmentName, runner.EnvironmentName)
+
+				// The default application of the workspace is ignored.
+				require.Equal(t, "", runner.ApplicationName)
+			},
+		},
+		{
+			Name:          "Import command with application and cloud providers",
+			Input:         []string{"app.json", "-a", "copy", "-e", "prod"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ConfigureMocks: func(mocks radcli.ValidateMocks) {
+				mocks.ApplicationManagementClient.EXPECT().
+					GetEnvDetails(gomock.Any(), "prod").
+					Return(v20231001preview.EnvironmentResource{
+						Properties: &v20231001preview.EnvironmentProperties{
+							Providers: &v20231001preview.Providers{
+								Azure: &v20231001preview.ProvidersAzure{
+									Scope: to.Ptr("/subscriptions/test-subscription/resourceGroups/test-group"),
+								},
+							},
+						},
+					}, nil).
+					Times(1)
+			},
+			ValidateCallback: func(t *testing.T, r framework.Runner) {
+				runner := r.(*Runner)
+				require.Equal(t, "copy", runner.ApplicationName)
+				require.Equal(t, "prod", runner.EnvironmentName)
+				require.Equal(t, "/subscriptions/test-subscription/resourceGroups/test-group", runner.Providers.Azure.Scope)
+				require.Equal(t, "/planes/radius/local/resourceGroups/test-resource-group/providers/applications.core/environments/prod", runner.Providers.Radius.EnvironmentID)
+			},
+		},
+		{
+			Name:          "Import command with parameters",
+			Input:         []string{"app.bicep", "--parameters", "mySecretsPassword=secret"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ConfigureMocks: func(mocks radcli.ValidateMocks) {
+				mocks.ApplicationManagementClient.EXPECT().
+					GetEnvDetails(gomock.Any(), radcli.TestEnvironmentName).
+					Return(v20231001preview.EnvironmentResource{}, nil).
+					Times(1)
+			},
+			ValidateCallback: func(t *testing.T, r framework.Runner) {
+				runner := r.(*Runner)
+				require.Equal(t, clients.DeploymentParameters{"mySecretsPassword": {"value": "secret"}}, runner.Parameters)
+			},
+		},
+		{
+			Name:          "Import command environment does not exist",
+			Input:         []string{"app.bicep"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ConfigureMocks: func(mocks radcli.ValidateMocks) {
+				mocks.ApplicationManagementClient.EXPECT().
+					GetEnvDetails(gomock.Any(), radcli.TestEnvironmentName).
+					Return(v20231001preview.EnvironmentResource{}, radcli.Create404Error()).
+					Times(1)
+			},
+		},
+		{
+			Name:          "Import command without file",
+			Input:         []string{},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	environmentID := "/planes/radius/local/resourceGroups/test-group/providers/applications.core/environments/test-env"
+	template := map[string]any{
+		"parameters": map[string]any{
+			"environment":     map[string]any{"type": "string"},
+			"applicationName": map[string]any{"type": "string", "defaultValue": "test-app"},
+		},
+		"resources": map[string]any{},
+	}
+
+	setup := func(t *testing.T, applicationName string, template map[string]any) (*Runner, *deploy.MockInterface) {
+		ctrl := gomock.NewController(t)
+
+		bicepMock := bicep.NewMockInterface(ctrl)
+		bicepMock.EXPECT().
+			PrepareTemplate("app.bicep").
+			Return(template, nil).
+			Times(1)
+
+		runner := &Runner{
+			Bicep:             bicepMock,
+			ConnectionFactory: &connections.MockFactory{},
+			Deploy:            deploy.NewMockInterface(ctrl),
+			Output:            &output.MockOutput{},
+
+			// Populated by Validate()
+			ApplicationName: applicationName,
+			EnvironmentName: "test-env",
+			FilePath:        "app.bicep",
+			Providers: &clients.Providers{
+				Radius: &clients.RadiusProvider{EnvironmentID: environmentID},
+			},
+			Workspace: &workspaces.Workspace{
+				Name:        "test-workspace",
+				Scope:       "/planes/radius/local/resourceGroups/test-group",
+				Environment: environmentID,
+			},
+		}
+
+		return runner, runner.Deploy.(*deploy.MockInterface)
+	}
+
+	for _, tc := range []struct {
+		name                string
+		applicationName     string
+		expectedApplication string
+	}{
+		{name: "Default application name", applicationName: "", expectedApplication: "test-app"},
+		{name: "Renamed application", applicationName: "copy", expectedApplication: "copy"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			runner, deployMock := setup(t, tc.applicationName, template)
+
+			deployMock.EXPECT().
+				DeployWithProgress(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, o deploy.Options) (clients.DeploymentResult, error) {
+					require.Equal(t, template, o.Template)
+					require.Equal(t, clients.DeploymentParameters{"applicationName": {"value": tc.expectedApplication}}, o.Parameters)
+					require.Equal(t, "/planes/radius/local/resourceGroups/test-group/providers/applications.core/applications/"+tc.expectedApplication, o.Providers.Radius.ApplicationID)
+					require.Equal(t, environmentID, o.Providers.Radius.EnvironmentID)
+					require.Equal(t, "Import Complete", o.CompletionText)
+					return clients.DeploymentResult{}, nil
+				}).
+				Times(1)
+
+			err := runner.Run(context.Background())
+			require.NoError(t, err)
+		})
+	}
+
+	secretTemplate := map[string]any{
+		"parameters": map[string]any{
+			"environment":       map[string]any{"type": "string"},
+			"applicationName":   map[string]any{"type": "string", "defaultValue": "test-app"},
+			"mySecretsPassword": map[string]any{"type": "securestring"},
+			"mySecretsUsername": map[string]any{"type": "securestring"},
+		},
+		"resources": map[string]any{},
+	}
+
+	t.Run("Secret parameters", func(t *testing.T) {
+		runner, deployMock := setup(t, "", secretTemplate)
+		runner.Parameters = clients.DeploymentParameters{
+			"mySecretsPassword": {"value": "password"},
+			"mySecretsUsername": {"value": "username"},
+		}
+
+		deployMock.EXPECT().
+			DeployWithProgress(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, o deploy.Options) (clients.DeploymentResult, error) {
+				expected := clients.DeploymentParameters{
+					"applicationName":   {"value": "test-app"},
+					"mySecretsPassword": {"value": "password"},
+					"mySecretsUsername": {"value": "username"},
+				}
+				require.Equal(t, expected, o.Parameters)
+				return clients.DeploymentResult{}, nil
+			}).
+			Times(1)
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+	})
+
+	t.Run("Missing secret parameters", func(t *testing.T) {
+		runner, _ := setup(t, "", secretTemplate)
+		runner.Parameters = clients.DeploymentParameters{"mySecretsUsername": {"value": "username"}}
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("The file %q declares parameters without default value: %s. Set them with the --parameters flag.", "app.bicep", "mySecretsPassword"), err)
+	})
+
+	t.Run("Not an exported application", func(t *testing.T) {
+		runner, _ := setup(t, "", map[string]any{"parameters": map[string]any{"environment": map[string]any{"type": "string"}}})
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("The file %q is not an application exported by `rad app export`.", "app.bicep"), err)
+	})
+}
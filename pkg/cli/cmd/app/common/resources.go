@@ -0,0 +1,53 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"encoding/json"
+
+	"github.com/go-openapi/jsonpointer"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+)
+
+// GetValue returns the value at the given JSON pointer path of the resource, or nil if it doesn't exist.
+// It is used to access the properties of a resource in a weakly-typed way since the data type is a property bag.
+func GetValue(resource generated.GenericResource, path string) any {
+	p, err := jsonpointer.New(path)
+	if err != nil {
+		// This should never fail since we're hard-coding the paths.
+		panic("parsing JSON pointer should not fail: " + err.Error())
+	}
+
+	value, _, err := p.Get(&resource)
+	if err != nil {
+		// Not found, this is fine.
+		return nil
+	}
+
+	return value
+}
+
+// ToStronglyTypedData uses JSON marshalling and unmarshalling to convert a weakly-typed
+// representation to a strongly-typed one.
+func ToStronglyTypedData(data any, result any) error {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, result)
+}
@@ -17,11 +17,10 @@ limitations under the License.
 package connections
 
 import (
-	"encoding/json"
 	"sort"
 
-	"github.com/go-openapi/jsonpointer"
 	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/cli/cmd/app/common"
 	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/resourcemodel"
 	"github.com/radius-project/radius/pkg/ucp/resources"
@@ -229,21 +228,9 @@ func outputResourcesFromAPIData(resource generated.GenericResource) []outputReso
 	// working with is a property bag.
 	//
 	// Any Radius resource type that supports output resources uses the following property path to return them.
-	p, err := jsonpointer.New("/properties/status/outputResources")
-	if err != nil {
-		// This should never fail since we're hard-coding the path.
-		panic("parsing JSON pointer should not fail: " + err.Error())
-	}
-
-	raw, _, err := p.Get(&resource)
-	if err != nil {
-		// Not found, this is fine.
-		return []outputResourceEntry{}
-	}
-
-	ors, ok := raw.([]any)
+	ors, ok := common.GetValue(resource, "/properties/status/outputResources").([]any)
 	if !ok {
-		// Not an array, this is fine.
+		// Not found or not an array, this is fine.
 		return []outputResourceEntry{}
 	}
 
@@ -259,7 +246,7 @@ func outputResourcesFromAPIData(resource generated.GenericResource) []outputReso
 		}
 
 		data := outputResourceWireFormat{}
-		err = toStronglyTypedData(or, &data)
+		err := common.ToStronglyTypedData(or, &data)
 		if err != nil {
 			entries = append(entries, outputResourceEntry{node: node{Error: err.Error()}})
 			continue
@@ -301,21 +288,9 @@ func connectionsFromAPIData(resource generated.GenericResource) []connectionEntr
 	// working with is a property bag.
 	//
 	// Any Radius resource type that supports connections uses the following property path to return them.
-	p, err := jsonpointer.New("/properties/connections")
-	if err != nil {
-		// This should never fail since we're hard-coding the path.
-		panic("parsing JSON pointer should not fail: " + err.Error())
-	}
-
-	raw, _, err := p.Get(&resource)
-	if err != nil {
-		// Not found, this is fine.
-		return []connectionEntry{}
-	}
-
-	connections, ok := raw.(map[string]any)
+	connections, ok := common.GetValue(resource, "/properties/connections").(map[string]any)
 	if !ok {
-		// Not a map of objects, this is fine.
+		// Not found or not a map of objects, this is fine.
 		return []connectionEntry{}
 	}
 
@@ -327,7 +302,7 @@ func connectionsFromAPIData(resource generated.GenericResource) []connectionEntr
 	for name, connection := range connections {
 
 		data := v20231001preview.ConnectionProperties{}
-		err := toStronglyTypedData(connection, &data)
+		err := common.ToStronglyTypedData(connection, &data)
 		if err != nil {
 			entries = append(entries, connectionEntry{
 				Name: name,
@@ -352,19 +327,3 @@ func connectionsFromAPIData(resource generated.GenericResource) []connectionEntr
 
 	return entries
 }
-
-// toStronglyTypedData uses JSON marshalling and unmarshalling to convert a weakly-typed
-// representation to a strongly-typed one.
-func toStronglyTypedData(data any, result any) error {
-	b, err := json.Marshal(data)
-	if err != nil {
-		return err
-	}
-
-	err = json.Unmarshal(b, result)
-	if err != nil {
-		return err
-	}
-
-	return nil
-}
@@ -24,11 +24,10 @@ import (
 func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
 	runner := NewRunner(factory)
 	cmd := &cobra.Command{
-		Use:     "connections",
-		Aliases: []string{"graph"},
-		Short:   "Shows the connections for an application.",
-		Long:    `Shows the connections for an application`,
-		Args:    cobra.MaximumNArgs(1),
+		Use:   "connections",
+		Short: "Shows the connections for an application.",
+		Long:  `Shows the connections for an application`,
+		Args:  cobra.MaximumNArgs(1),
 		Example: `
 # Show connections for current application
 rad app connections
@@ -124,9 +123,7 @@ func (r *Runner) Run(ctx context.Context) error {
 		return err
 	}
 
-	graph := compute(r.ApplicationName, applicationResources, environmentResources)
-	display := display(graph)
-	r.Output.LogInfo(display)
+	r.Output.LogInfo(Render(r.ApplicationName, applicationResources, environmentResources))
 
 	return nil
 }
@@ -20,8 +20,17 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
 )
 
+// Render computes the connections of the application from the given application and environment resources and
+// builds the formatted output as text. It is also used by `rad app graph`, which used to be an alias of
+// `rad app connections`, to keep this output available.
+func Render(applicationName string, applicationResources []generated.GenericResource, environmentResources []generated.GenericResource) string {
+	return display(compute(applicationName, applicationResources, environmentResources))
+}
+
 // display builds the formatted output for the application graph as text.
 func display(graph *applicationGraph) string {
 	applicationResources := []resourceEntry{}
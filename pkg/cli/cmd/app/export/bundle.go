@@ -0,0 +1,506 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package export
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	dependencygraph "github.com/radius-project/radius/pkg/algorithm/graph"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_radius "github.com/radius-project/radius/pkg/ucp/resources/radius"
+)
+
+const (
+	// EnvironmentParameter is the name of the bundle parameter containing the ID of the environment the application is
+	// deployed to. It is set automatically by `rad deploy` and `rad app import`.
+	EnvironmentParameter = "environment"
+
+	// ApplicationNameParameter is the name of the bundle parameter containing the name of the application. Its default
+	// value is the name of the exported application.
+	ApplicationNameParameter = "applicationName"
+
+	// APIVersion is the API version of the resources declared by the bundle.
+	APIVersion = "2023-10-01-preview"
+
+	// applicationType is the resource type of Radius Applications.
+	applicationType = "Applications.Core/applications"
+
+	// applicationSymbolicName is the symbolic name of the application in the bundle.
+	applicationSymbolicName = "app"
+
+	// secretStoreType is the resource type of Radius secret stores, in lower case.
+	secretStoreType = "applications.core/secretstores"
+)
+
+// readOnlyProperties are the read-only properties of Radius resources, which are never exported.
+var readOnlyProperties = map[string]bool{
+	"provisioningState": true,
+	"status":            true,
+}
+
+// recipeProperties are the properties of portable resources provisioned by a recipe which are exported. The other
+// properties of these resources are set by their recipe.
+var recipeProperties = map[string]bool{
+	"application":          true,
+	"environment":          true,
+	"recipe":               true,
+	"resourceProvisioning": true,
+}
+
+// secretsTypes are the resource types, in lower case, of the portable resources whose secrets are set when they are
+// provisioned manually.
+var secretsTypes = map[string]bool{
+	"applications.core/extenders":            true,
+	"applications.datastores/mongodatabases": true,
+	"applications.datastores/rediscaches":    true,
+	"applications.datastores/sqldatabases":   true,
+	"applications.messaging/rabbitmqqueues":  true,
+}
+
+// reservedNames are the names which can't be used as symbolic names in the bundle.
+var reservedNames = map[string]bool{
+	EnvironmentParameter:     true,
+	ApplicationNameParameter: true,
+	"existing":               true,
+	"false":                  true,
+	"for":                    true,
+	"if":                     true,
+	"import":                 true,
+	"in":                     true,
+	"module":                 true,
+	"null":                   true,
+	"output":                 true,
+	"param":                  true,
+	"radius":                 true,
+	"resource":               true,
+	"targetScope":            true,
+	"true":                   true,
+	"var":                    true,
+}
+
+var invalidSymbolicNameCharacters = regexp.MustCompile("[^a-zA-Z0-9_]+")
+
+// bundle is a self-contained snapshot of an application and its resources, which can be rendered as Bicep or as an ARM
+// JSON template.
+type bundle struct {
+	// ApplicationName is the name of the exported application, and the default value of the applicationName parameter.
+	ApplicationName string
+
+	// Resources are the application and its resources, in dependency order.
+	Resources []bundleResource
+
+	// Parameters are the parameters of the bundle other than the environment and applicationName parameters, sorted
+	// by name.
+	Parameters []*bundleParameter
+}
+
+// bundleParameter is a parameter of the bundle other than the environment and applicationName parameters.
+type bundleParameter struct {
+	// Name is the name of the parameter. It is assigned once all the resources are rewritten, so that it doesn't
+	// depend on the order in which the parameters are found.
+	Name string
+
+	// Type is the Bicep type of the parameter: string or object.
+	Type string
+
+	// Secure is true if the value of the parameter is a secret.
+	Secure bool
+
+	// Description is the description of the parameter.
+	Description string
+
+	// DefaultValue is the default value of the parameter, or nil if the parameter is required.
+	DefaultValue any
+
+	// baseName is the name the parameter name is derived from. It is made unique among the symbolic names.
+	baseName string
+}
+
+// bundleResource is a resource declared by a bundle.
+type bundleResource struct {
+	// SymbolicName is the symbolic name of the resource in the bundle.
+	SymbolicName string
+
+	// Type is the resource type, without the API version.
+	Type string
+
+	// Name is the name of the resource: a string, or an expression for the application.
+	Name any
+
+	// Body contains the location, tags and properties of the resource. The values referencing the environment or the
+	// resources of the bundle are expressions.
+	Body map[string]any
+
+	// DependsOn are the symbolic names of the resources of the bundle referenced by the resource, sorted.
+	DependsOn []string
+}
+
+var _ dependencygraph.DependencyItem = bundleResource{}
+
+// Key returns the symbolic name of the resource.
+func (r bundleResource) Key() string {
+	return r.SymbolicName
+}
+
+// GetDependencies returns the symbolic names of the resources of the bundle referenced by the resource.
+func (r bundleResource) GetDependencies() ([]string, error) {
+	return r.DependsOn, nil
+}
+
+// expression is a value of the bundle which is only known during the deployment.
+type expression struct {
+	// ARM is the expression in the ARM template language, without brackets.
+	ARM string
+
+	// Bicep is the expression in the Bicep language.
+	Bicep string
+}
+
+// parameterExpression returns the expression referencing a parameter of the bundle.
+func parameterExpression(name string) expression {
+	return expression{ARM: fmt.Sprintf("parameters('%s')", name), Bicep: name}
+}
+
+// idExpression returns the expression referencing the ID of a resource of the bundle.
+func idExpression(symbolicName string) expression {
+	return expression{ARM: fmt.Sprintf("reference('%s').id", symbolicName), Bicep: symbolicName + ".id"}
+}
+
+// newBundle creates the bundle of an application from the application resource and the resources of the application.
+//
+// System and status properties are removed. The ID of the environment is replaced by the environment parameter, the
+// name of the application by the applicationName parameter, and the IDs of the resources of the bundle by references,
+// so that the bundle can be deployed to another environment or resource group. The IDs of the other Radius resources,
+// such as the resources shared by the applications of the environment, are replaced by parameters defaulting to the
+// IDs. The secrets, which are never returned by Radius, are replaced by secure parameters. An error is returned if the resources reference each other in a cycle,
+// because references can't form cycles.
+func newBundle(application generated.GenericResource, applicationResources []generated.GenericResource) (*bundle, error) {
+	applicationName := to.String(application.Name)
+	environmentID, _ := application.Properties["environment"].(string)
+
+	// Assign symbolic names first, so that references can be resolved regardless of the order of the resources.
+	symbolicNames := map[string]bool{applicationSymbolicName: true}
+	symbolicNamesByID := map[string]string{}
+	symbolicNamesByID[strings.ToLower(to.String(application.ID))] = applicationSymbolicName
+
+	sorted := append([]generated.GenericResource{}, applicationResources...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if !strings.EqualFold(to.String(sorted[i].Type), to.String(sorted[j].Type)) {
+			return strings.ToLower(to.String(sorted[i].Type)) < strings.ToLower(to.String(sorted[j].Type))
+		}
+		return to.String(sorted[i].Name) < to.String(sorted[j].Name)
+	})
+
+	for _, resource := range sorted {
+		symbolicName := newSymbolicName(to.String(resource.Name), symbolicNames)
+		symbolicNames[symbolicName] = true
+		symbolicNamesByID[strings.ToLower(to.String(resource.ID))] = symbolicName
+	}
+
+	b := &bundle{ApplicationName: applicationName}
+	parameters := map[string]*bundleParameter{}
+	bundleResources := []bundleResource{}
+	for _, resource := range append([]generated.GenericResource{application}, sorted...) {
+		r := &rewriter{
+			environmentID:     environmentID,
+			symbolicName:      symbolicNamesByID[strings.ToLower(to.String(resource.ID))],
+			symbolicNamesByID: symbolicNamesByID,
+			dependencies:      map[string]bool{},
+			parameters:        parameters,
+		}
+		bundleResource := bundleResource{
+			SymbolicName: r.symbolicName,
+			Type:         to.String(resource.Type),
+			Name:         to.String(resource.Name),
+			Body:         r.body(resource),
+		}
+		if bundleResource.SymbolicName == applicationSymbolicName {
+			bundleResource.Name = parameterExpression(ApplicationNameParameter)
+		}
+
+		delete(r.dependencies, bundleResource.SymbolicName)
+		for dependency := range r.dependencies {
+			bundleResource.DependsOn = append(bundleResource.DependsOn, dependency)
+		}
+		sort.Strings(bundleResource.DependsOn)
+
+		bundleResources = append(bundleResources, bundleResource)
+	}
+
+	// The parameters share the namespace of the symbolic names.
+	keys := make([]string, 0, len(parameters))
+	for key := range parameters {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		parameter := parameters[key]
+		parameter.Name = newSymbolicName(parameter.baseName, symbolicNames)
+		symbolicNames[parameter.Name] = true
+		b.Parameters = append(b.Parameters, parameter)
+	}
+	sort.Slice(b.Parameters, func(i, j int) bool {
+		return b.Parameters[i].Name < b.Parameters[j].Name
+	})
+
+	items := []dependencygraph.DependencyItem{}
+	for _, bundleResource := range bundleResources {
+		bundleResource.Body = resolveParameters(bundleResource.Body).(map[string]any)
+		items = append(items, bundleResource)
+	}
+
+	dg, err := dependencygraph.ComputeDependencyGraph(items)
+	if err != nil {
+		return nil, err
+	}
+
+	ordered, err := dg.Order()
+	if err != nil {
+		return nil, fmt.Errorf("the resources of application %q reference each other in a cycle", applicationName)
+	}
+
+	// The application is always declared first since it doesn't reference the other resources.
+	for _, item := range ordered {
+		if item.Key() == applicationSymbolicName {
+			b.Resources = append([]bundleResource{item.(bundleResource)}, b.Resources...)
+		} else {
+			b.Resources = append(b.Resources, item.(bundleResource))
+		}
+	}
+
+	return b, nil
+}
+
+// resolveParameters returns a copy of a value where the parameters of the bundle are replaced by expressions.
+func resolveParameters(value any) any {
+	switch v := value.(type) {
+	case *bundleParameter:
+		return parameterExpression(v.Name)
+	case map[string]any:
+		result := map[string]any{}
+		for key, item := range v {
+			result[key] = resolveParameters(item)
+		}
+		return result
+	case []any:
+		result := []any{}
+		for _, item := range v {
+			result = append(result, resolveParameters(item))
+		}
+		return result
+	}
+
+	return value
+}
+
+// rewriter removes the system and status properties of a resource and replaces the IDs of the environment and of the
+// resources of the bundle by expressions, and the IDs of the other Radius resources and the secrets of the resource by
+// parameters.
+type rewriter struct {
+	environmentID     string
+	symbolicNamesByID map[string]string
+
+	// symbolicName is the symbolic name of the resource being rewritten.
+	symbolicName string
+
+	// dependencies are the symbolic names of the resources referenced by the resource being rewritten.
+	dependencies map[string]bool
+
+	// parameters are the parameters of the bundle found so far, by unique key. They are shared by the rewriters of
+	// all the resources of the bundle.
+	parameters map[string]*bundleParameter
+}
+
+// body returns the body of a resource in the bundle.
+func (r *rewriter) body(resource generated.GenericResource) map[string]any {
+	body := map[string]any{}
+	if resource.Location != nil {
+		body["location"] = *resource.Location
+	}
+
+	if len(resource.Tags) > 0 {
+		tags := map[string]any{}
+		for key, value := range resource.Tags {
+			if value != nil {
+				tags[key] = *value
+			}
+		}
+		body["tags"] = tags
+	}
+
+	provisionedByRecipe := isProvisionedByRecipe(resource.Properties)
+	properties := map[string]any{}
+	for key, value := range resource.Properties {
+		if readOnlyProperties[key] || (provisionedByRecipe && !recipeProperties[key]) || value == nil {
+			continue
+		}
+
+		properties[key] = r.value(value)
+	}
+	r.secrets(resource, properties)
+	body["properties"] = properties
+
+	return body
+}
+
+// secrets replaces the secrets of a resource, which are never returned by Radius, by secure parameters: the values
+// of secret stores, and the secrets of the portable resources provisioned manually.
+func (r *rewriter) secrets(resource generated.GenericResource, properties map[string]any) {
+	resourceType := strings.ToLower(to.String(resource.Type))
+	switch {
+	case resourceType == secretStoreType:
+		data, _ := properties["data"].(map[string]any)
+		keys := make([]string, 0, len(data))
+		for key := range data {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		valueFrom := false
+		for _, key := range keys {
+			entry, _ := data[key].(map[string]any)
+			if entry == nil {
+				entry = map[string]any{}
+				data[key] = entry
+			}
+
+			// The values referenced from an external secret store are deployed as-is.
+			if entry["valueFrom"] != nil {
+				valueFrom = true
+				continue
+			}
+
+			entry["value"] = r.parameter("secret/"+r.symbolicName+"/"+key, &bundleParameter{
+				Type:        "string",
+				Secure:      true,
+				Description: fmt.Sprintf("The value of the %q secret of secret store %q.", key, to.String(resource.Name)),
+				baseName:    r.symbolicName + " " + key,
+			})
+		}
+
+		// The values are written to a new Kubernetes secret, since the secret of the exported resource belongs to the
+		// environment it was exported from. The secret is kept when values reference it, since they would reference
+		// nothing otherwise.
+		if len(keys) > 0 && !valueFrom {
+			delete(properties, "resource")
+		}
+	case secretsTypes[resourceType] && !isProvisionedByRecipe(resource.Properties):
+		properties["secrets"] = r.parameter("secret/"+r.symbolicName, &bundleParameter{
+			Type:        "object",
+			Secure:      true,
+			Description: fmt.Sprintf("The secrets of %s resource %q.", to.String(resource.Type), to.String(resource.Name)),
+			baseName:    r.symbolicName + " secrets",
+		})
+	}
+}
+
+// parameter returns the parameter of the bundle with the given key, adding it to the parameters of the bundle if it
+// doesn't exist yet.
+func (r *rewriter) parameter(key string, parameter *bundleParameter) *bundleParameter {
+	if existing, ok := r.parameters[key]; ok {
+		return existing
+	}
+
+	r.parameters[key] = parameter
+	return parameter
+}
+
+// value returns a copy of a value where the IDs of the environment and of the resources of the bundle are replaced by
+// expressions, and the IDs of the other Radius resources by parameters.
+func (r *rewriter) value(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		result := map[string]any{}
+		for key, item := range v {
+			if item != nil {
+				result[key] = r.value(item)
+			}
+		}
+		return result
+	case []any:
+		result := []any{}
+		for _, item := range v {
+			result = append(result, r.value(item))
+		}
+		return result
+	case string:
+		if r.environmentID != "" && strings.EqualFold(v, r.environmentID) {
+			return parameterExpression(EnvironmentParameter)
+		}
+
+		if symbolicName, ok := r.symbolicNamesByID[strings.ToLower(v)]; ok {
+			r.dependencies[symbolicName] = true
+			return idExpression(symbolicName)
+		}
+
+		// The resources outside of the bundle may not exist where the bundle is deployed, so their IDs can be set
+		// when deploying it. A parameter is declared for each resource, regardless of how many times it's referenced.
+		id, err := resources.ParseResource(v)
+		if err == nil && id.IsUCPQualfied() && strings.EqualFold(id.ScopeSegments()[0].Type, resources_radius.PlaneTypeRadius) {
+			return r.parameter("external/"+strings.ToLower(v), &bundleParameter{
+				Type:         "string",
+				Description:  fmt.Sprintf("The ID of the %s resource %q, which isn't part of the application.", id.Type(), id.Name()),
+				DefaultValue: v,
+				baseName:     id.Name() + " id",
+			})
+		}
+
+		return v
+	}
+
+	return value
+}
+
+// newSymbolicName returns a valid and unique symbolic name for a resource with the given name.
+func newSymbolicName(name string, existing map[string]bool) string {
+	parts := invalidSymbolicNameCharacters.Split(name, -1)
+	symbolicName := ""
+	for _, part := range parts {
+		if part == "" {
+			continue
+		}
+
+		if symbolicName == "" {
+			symbolicName = strings.ToLower(part[:1]) + part[1:]
+		} else {
+			symbolicName += strings.ToUpper(part[:1]) + part[1:]
+		}
+	}
+
+	// Symbolic names can't start with a digit.
+	if symbolicName == "" || (symbolicName[0] >= '0' && symbolicName[0] <= '9') {
+		symbolicName = "resource" + symbolicName
+	}
+
+	candidate := symbolicName
+	for i := 2; reservedNames[candidate] || existing[candidate]; i++ {
+		candidate = fmt.Sprintf("%s%d", symbolicName, i)
+	}
+
+	return candidate
+}
+
+// isProvisionedByRecipe returns true if the properties are the properties of a portable resource provisioned by a recipe.
+func isProvisionedByRecipe(properties map[string]any) bool {
+	provisioning, ok := properties["resourceProvisioning"].(string)
+	return ok && !strings.EqualFold(provisioning, "manual")
+}
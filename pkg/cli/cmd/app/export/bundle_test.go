@@ -0,0 +1,344 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package export
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_newBundle(t *testing.T) {
+	b, err := newBundle(testApplication(), testApplicationResources())
+	require.NoError(t, err)
+
+	expected := &bundle{
+		ApplicationName: "test-app",
+		Resources: []bundleResource{
+			{
+				SymbolicName: "app",
+				Type:         "Applications.Core/applications",
+				Name:         parameterExpression(ApplicationNameParameter),
+				Body: map[string]any{
+					"location": "global",
+					"properties": map[string]any{
+						"environment": parameterExpression(EnvironmentParameter),
+					},
+				},
+			},
+			{
+				SymbolicName: "backend",
+				Type:         "Applications.Core/containers",
+				Name:         "backend",
+				Body: map[string]any{
+					"location": "global",
+					"properties": map[string]any{
+						"application": idExpression("app"),
+						"container": map[string]any{
+							"image": "backend:latest",
+							"ports": map[string]any{"web": map[string]any{"containerPort": float64(8080)}},
+						},
+					},
+				},
+				DependsOn: []string{"app"},
+			},
+			{
+				SymbolicName: "redis",
+				Type:         "Applications.Datastores/redisCaches",
+				Name:         "redis",
+				Body: map[string]any{
+					"location": "global",
+					"properties": map[string]any{
+						"application":          idExpression("app"),
+						"environment":          parameterExpression(EnvironmentParameter),
+						"resourceProvisioning": "recipe",
+						"recipe":               map[string]any{"name": "default"},
+					},
+				},
+				DependsOn: []string{"app"},
+			},
+			{
+				SymbolicName: "frontend",
+				Type:         "Applications.Core/containers",
+				Name:         "frontend",
+				Body: map[string]any{
+					"location": "global",
+					"tags":     map[string]any{"team": "web"},
+					"properties": map[string]any{
+						"application": idExpression("app"),
+						"container": map[string]any{
+							"image": "frontend:latest",
+							"env":   map[string]any{"GREETING": "Hello ${name}"},
+						},
+						"connections": map[string]any{
+							"backend": map[string]any{"source": idExpression("backend")},
+							"redis":   map[string]any{"source": idExpression("redis")},
+						},
+					},
+				},
+				DependsOn: []string{"app", "backend", "redis"},
+			},
+		},
+	}
+	require.Equal(t, expected, b)
+}
+
+func Test_newBundle_Cycle(t *testing.T) {
+	resources := testApplicationResources()
+	resources[2].Properties["connections"] = map[string]any{
+		"frontend": map[string]any{"source": frontendResourceID},
+	}
+
+	_, err := newBundle(testApplication(), resources)
+	require.EqualError(t, err, `the resources of application "test-app" reference each other in a cycle`)
+}
+
+func Test_newBundle_Secrets(t *testing.T) {
+	resources := []generated.GenericResource{
+		{
+			ID:   to.Ptr(scope + "/providers/Applications.Core/secretStores/my-secrets"),
+			Name: to.Ptr("my-secrets"),
+			Type: to.Ptr("Applications.Core/secretStores"),
+			Properties: map[string]any{
+				"application": applicationResourceID,
+				"type":        "generic",
+				"resource":    "test-namespace/my-secrets",
+				"data": map[string]any{
+					"password": map[string]any{},
+					"username": map[string]any{"encoding": "raw"},
+				},
+			},
+		},
+		{
+			ID:   to.Ptr(scope + "/providers/Applications.Core/secretStores/tls"),
+			Name: to.Ptr("tls"),
+			Type: to.Ptr("Applications.Core/secretStores"),
+			Properties: map[string]any{
+				"application": applicationResourceID,
+				"type":        "certificate",
+				"resource":    "test-namespace/tls",
+				"data": map[string]any{
+					"tls.crt": map[string]any{"valueFrom": map[string]any{"name": "tls-crt"}},
+				},
+			},
+		},
+		{
+			ID:   to.Ptr(scope + "/providers/Applications.Core/secretStores/mixed"),
+			Name: to.Ptr("mixed"),
+			Type: to.Ptr("Applications.Core/secretStores"),
+			Properties: map[string]any{
+				"application": applicationResourceID,
+				"type":        "generic",
+				"resource":    "test-namespace/mixed",
+				"data": map[string]any{
+					"password": map[string]any{},
+					"username": map[string]any{"valueFrom": map[string]any{"name": "username"}},
+				},
+			},
+		},
+		{
+			ID:   to.Ptr(redisResourceID),
+			Name: to.Ptr("redis"),
+			Type: to.Ptr("Applications.Datastores/redisCaches"),
+			Properties: map[string]any{
+				"application":          applicationResourceID,
+				"environment":          environmentResourceID,
+				"resourceProvisioning": "manual",
+				"host":                 "redis.example.com",
+				"port":                 float64(6380),
+			},
+		},
+	}
+
+	b, err := newBundle(testApplication(), resources)
+	require.NoError(t, err)
+
+	expectedParameters := []*bundleParameter{
+		{
+			Name:        "mixedPassword",
+			Type:        "string",
+			Secure:      true,
+			Description: `The value of the "password" secret of secret store "mixed".`,
+			baseName:    "mixed password",
+		},
+		{
+			Name:        "mySecretsPassword",
+			Type:        "string",
+			Secure:      true,
+			Description: `The value of the "password" secret of secret store "my-secrets".`,
+			baseName:    "mySecrets password",
+		},
+		{
+			Name:        "mySecretsUsername",
+			Type:        "string",
+			Secure:      true,
+			Description: `The value of the "username" secret of secret store "my-secrets".`,
+			baseName:    "mySecrets username",
+		},
+		{
+			Name:        "redisSecrets",
+			Type:        "object",
+			Secure:      true,
+			Description: `The secrets of Applications.Datastores/redisCaches resource "redis".`,
+			baseName:    "redis secrets",
+		},
+	}
+	require.Equal(t, expectedParameters, b.Parameters)
+
+	bodies := map[string]map[string]any{}
+	for _, resource := range b.Resources {
+		bodies[resource.SymbolicName] = resource.Body["properties"].(map[string]any)
+	}
+
+	// The secret store is recreated in the namespace of the environment it is imported into.
+	require.Equal(t, map[string]any{
+		"application": idExpression("app"),
+		"type":        "generic",
+		"data": map[string]any{
+			"password": map[string]any{"value": parameterExpression("mySecretsPassword")},
+			"username": map[string]any{"encoding": "raw", "value": parameterExpression("mySecretsUsername")},
+		},
+	}, bodies["mySecrets"])
+
+	// The values referenced from an external secret store don't need parameters.
+	require.Equal(t, map[string]any{
+		"application": idExpression("app"),
+		"type":        "certificate",
+		"resource":    "test-namespace/tls",
+		"data": map[string]any{
+			"tls.crt": map[string]any{"valueFrom": map[string]any{"name": "tls-crt"}},
+		},
+	}, bodies["tls"])
+
+	// The secret store keeps the secret referenced by its values when it mixes them with other values.
+	require.Equal(t, map[string]any{
+		"application": idExpression("app"),
+		"type":        "generic",
+		"resource":    "test-namespace/mixed",
+		"data": map[string]any{
+			"password": map[string]any{"value": parameterExpression("mixedPassword")},
+			"username": map[string]any{"valueFrom": map[string]any{"name": "username"}},
+		},
+	}, bodies["mixed"])
+
+	require.Equal(t, parameterExpression("redisSecrets"), bodies["redis"]["secrets"])
+}
+
+func Test_newBundle_ExternalResources(t *testing.T) {
+	sharedRedisID := "/planes/radius/local/resourceGroups/shared/providers/Applications.Datastores/redisCaches/redis"
+
+	resources := testApplicationResources()
+	resources[1].Properties["connections"] = map[string]any{
+		"backend":     map[string]any{"source": backendResourceID},
+		"redis":       map[string]any{"source": redisResourceID},
+		"sharedRedis": map[string]any{"source": strings.ToLower(sharedRedisID)},
+	}
+	resources[2].Properties["connections"] = map[string]any{
+		"sharedRedis": map[string]any{"source": sharedRedisID},
+	}
+
+	b, err := newBundle(testApplication(), resources)
+	require.NoError(t, err)
+
+	// The parameter doesn't collide with the symbolic name of the redis resource of the application, and its default
+	// value is the ID as first referenced, by the backend container.
+	expectedParameters := []*bundleParameter{
+		{
+			Name:         "redisId",
+			Type:         "string",
+			Description:  `The ID of the Applications.Datastores/redisCaches resource "redis", which isn't part of the application.`,
+			DefaultValue: sharedRedisID,
+			baseName:     "redis id",
+		},
+	}
+	require.Equal(t, expectedParameters, b.Parameters)
+
+	for _, resource := range b.Resources {
+		if resource.SymbolicName == "frontend" || resource.SymbolicName == "backend" {
+			connections := resource.Body["properties"].(map[string]any)["connections"].(map[string]any)
+			require.Equal(t, map[string]any{"source": parameterExpression("redisId")}, connections["sharedRedis"])
+		}
+	}
+}
+
+func Test_newSymbolicName(t *testing.T) {
+	existing := map[string]bool{"app": true, "frontend": true}
+
+	testcases := []struct {
+		name     string
+		expected string
+	}{
+		{name: "backend", expected: "backend"},
+		{name: "my-redis_cache.v2", expected: "myRedis_cacheV2"},
+		{name: "Frontend", expected: "frontend2"},
+		{name: "app", expected: "app2"},
+		{name: "environment", expected: "environment2"},
+		{name: "1st-container", expected: "resource1stContainer"},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, newSymbolicName(tc.name, existing))
+		})
+	}
+}
+
+func Test_rewriter_value(t *testing.T) {
+	r := &rewriter{
+		environmentID:     environmentResourceID,
+		symbolicNamesByID: map[string]string{"/planes/radius/local/resourcegroups/test-group/providers/applications.core/containers/frontend": "frontend"},
+		dependencies:      map[string]bool{},
+		parameters:        map[string]*bundleParameter{},
+	}
+
+	value := r.value(map[string]any{
+		"environment": "/planes/radius/local/resourcegroups/test-group/providers/applications.core/environments/TEST-ENV",
+		"sources":     []any{frontendResourceID, "frontend", float64(1)},
+		"other":       scope + "/providers/Applications.Core/containers/other",
+		"otherAgain":  "/planes/radius/local/resourcegroups/test-group/providers/applications.core/containers/OTHER",
+		"azure":       "/subscriptions/test-subscription/resourceGroups/test-group/providers/Microsoft.Cache/redis/cache",
+		"path":        "/var/run/secrets",
+	})
+
+	other := &bundleParameter{
+		Type:         "string",
+		Description:  `The ID of the Applications.Core/containers resource "other", which isn't part of the application.`,
+		DefaultValue: scope + "/providers/Applications.Core/containers/other",
+		baseName:     "other id",
+	}
+	expected := map[string]any{
+		"environment": parameterExpression(EnvironmentParameter),
+		"sources":     []any{idExpression("frontend"), "frontend", float64(1)},
+		"other":       other,
+		"otherAgain":  other,
+		"azure":       "/subscriptions/test-subscription/resourceGroups/test-group/providers/Microsoft.Cache/redis/cache",
+		"path":        "/var/run/secrets",
+	}
+	require.Equal(t, expected, value)
+	require.Equal(t, map[string]bool{"frontend": true}, r.dependencies)
+	require.Equal(t, map[string]*bundleParameter{"external/" + strings.ToLower(scope) + "/providers/applications.core/containers/other": other}, r.parameters)
+}
+
+func Test_rewriter_body_NoProperties(t *testing.T) {
+	r := &rewriter{symbolicNamesByID: map[string]string{}, dependencies: map[string]bool{}}
+
+	body := r.body(generated.GenericResource{Name: to.Ptr("test")})
+	require.Equal(t, map[string]any{"properties": map[string]any{}}, body)
+}
@@ -0,0 +1,223 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/spf13/cobra"
+)
+
+const (
+	formatBicep = "bicep"
+	formatJSON  = "json"
+)
+
+var supportedFormats = []string{formatBicep, formatJSON}
+
+// NewCommand creates an instance of the command and runner for the `rad app export` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Exports an application to a Bicep or JSON file.",
+		Long: `Exports an application to a Bicep or JSON file.
+
+The exported file is a self-contained snapshot of the application and its resources, which can be deployed to another
+environment or resource group with 'rad app import' or 'rad deploy'. It declares two parameters:
+
+- 'environment': the ID of the environment to deploy the application to, set automatically when deploying the file.
+- 'applicationName': the name of the application, which defaults to the name of the exported application.
+
+System and status properties are not exported, and neither are the properties set by the recipes of portable resources.
+The references to the environment and to the resources of the application are replaced by the parameters and by
+references to the resources declared in the file. The references to the other Radius resources, such as the resources
+shared by the applications of the environment, are replaced by parameters defaulting to their current ID, so that they
+can be set to the resources of the environment the application is deployed to.
+
+Secrets are never returned by Radius, so the values of secret stores and the secrets of the portable resources
+provisioned manually are replaced by secure parameters without default value. They must be set when deploying the
+file, for example with 'rad app import --parameters'.
+
+The format of the file is inferred from the extension of the file, and defaults to Bicep. The file is written to the
+standard output unless a file is specified.`,
+		Args: cobra.MaximumNArgs(1),
+		Example: `
+# Export the current application to the standard output as Bicep
+rad app export
+
+# Export the specified application to a Bicep file
+rad app export my-application --file my-application.bicep
+
+# Export the specified application to an ARM JSON template
+rad app export my-application --file my-application.json
+
+# Copy the current application to another environment
+rad app export --file app.bicep && rad app import app.bicep --environment production
+
+# Copy an application with a secret store to another environment, setting the value of its secret
+rad app export --file app.bicep && rad app import app.bicep --environment production --parameters mySecretsPassword=$PASSWORD`,
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddApplicationNameFlag(cmd)
+	cmd.Flags().String("file", "", "path of the exported file (defaults to the standard output)")
+	cmd.Flags().String("format", "", "format of the exported file (supported formats are "+strings.Join(supportedFormats, ", ")+")")
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad app export` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+
+	ApplicationName string
+	FilePath        string
+	Format          string
+	Workspace       *workspaces.Workspace
+}
+
+// NewRunner creates a new instance of the `rad app export` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:      factory.GetConfigHolder(),
+		Output:            factory.GetOutput(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+	}
+}
+
+// Validate runs validation for the `rad app export` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	r.Workspace.Scope, err = cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	r.ApplicationName, err = cli.RequireApplicationArgs(cmd, args, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	r.FilePath, err = cmd.Flags().GetString("file")
+	if err != nil {
+		return err
+	}
+
+	r.Format, err = cmd.Flags().GetString("format")
+	if err != nil {
+		return err
+	}
+
+	r.Format = strings.ToLower(r.Format)
+	if r.Format == "" && strings.EqualFold(filepath.Ext(r.FilePath), ".json") {
+		r.Format = formatJSON
+	} else if r.Format == "" {
+		r.Format = formatBicep
+	}
+
+	if r.Format != formatBicep && r.Format != formatJSON {
+		return clierrors.Message("Unsupported format %q. Supported formats are %s.", r.Format, strings.Join(supportedFormats, ", "))
+	}
+
+	return nil
+}
+
+// Run runs the `rad app export` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	application, err := client.ShowResource(ctx, applicationType, r.ApplicationName)
+	if clients.Is404Error(err) {
+		return clierrors.Message("Application %q does not exist or has been deleted.", r.ApplicationName)
+	} else if err != nil {
+		return err
+	}
+
+	applicationResources, err := client.ListAllResourcesByApplication(ctx, r.ApplicationName)
+	if err != nil {
+		return err
+	}
+
+	b, err := newBundle(application, applicationResources)
+	if err != nil {
+		return clierrors.MessageWithCause(err, "Failed to export application %q.", r.ApplicationName)
+	}
+
+	if r.FilePath == "" {
+		if r.Format == formatJSON {
+			return r.Output.WriteFormatted(output.FormatJson, renderJSON(b), output.FormatterOptions{})
+		}
+
+		r.Output.LogInfo("%s", strings.TrimSuffix(renderBicep(b), "\n"))
+		return nil
+	}
+
+	var content []byte
+	if r.Format == formatJSON {
+		content, err = json.MarshalIndent(renderJSON(b), "", "  ")
+		if err != nil {
+			return err
+		}
+		content = append(content, '\n')
+	} else {
+		content = []byte(renderBicep(b))
+	}
+
+	err = os.WriteFile(r.FilePath, content, 0644)
+	if err != nil {
+		return clierrors.MessageWithCause(err, "Failed to write file %q.", r.FilePath)
+	}
+
+	r.Output.LogInfo("Exported application %q with %d resource(s) to %q.", r.ApplicationName, len(b.Resources)-1, r.FilePath)
+
+	required := []string{}
+	for _, parameter := range b.Parameters {
+		if parameter.DefaultValue == nil {
+			required = append(required, parameter.Name)
+		}
+	}
+	if len(required) > 0 {
+		r.Output.LogInfo("Set the following parameters when deploying the file: %s.", strings.Join(required, ", "))
+	}
+
+	return nil
+}
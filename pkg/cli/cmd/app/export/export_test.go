@@ -0,0 +1,288 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package export
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Export command application (positional)",
+			Input:         []string{"test-app"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, r framework.Runner) {
+				runner := r.(*Runner)
+				require.Equal(t, "test-app", runner.ApplicationName)
+				require.Equal(t, "", runner.FilePath)
+				require.Equal(t, formatBicep, runner.Format)
+			},
+		},
+		{
+			Name:          "Export command format inferred from the file",
+			Input:         []string{"-a", "test-app", "--file", "app.JSON"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, r framework.Runner) {
+				runner := r.(*Runner)
+				require.Equal(t, "app.JSON", runner.FilePath)
+				require.Equal(t, formatJSON, runner.Format)
+			},
+		},
+		{
+			Name:          "Export command explicit format",
+			Input:         []string{"test-app", "--file", "app.txt", "--format", "JSON"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, r framework.Runner) {
+				require.Equal(t, formatJSON, r.(*Runner).Format)
+			},
+		},
+		{
+			Name:          "Export command unsupported format",
+			Input:         []string{"test-app", "--format", "yaml"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Export command without application",
+			Input:         []string{},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Export command too many args",
+			Input:         []string{"test-app", "other-app"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	setup := func(t *testing.T, filePath string, format string) (*Runner, *output.MockOutput) {
+		ctrl := gomock.NewController(t)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			ShowResource(gomock.Any(), "Applications.Core/applications", "test-app").
+			Return(testApplication(), nil).
+			Times(1)
+		appManagementClient.EXPECT().
+			ListAllResourcesByApplication(gomock.Any(), "test-app").
+			Return(testApplicationResources(), nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:         &workspaces.Workspace{Name: "test-workspace", Scope: scope},
+			Output:            outputSink,
+
+			// Populated by Validate()
+			ApplicationName: "test-app",
+			FilePath:        filePath,
+			Format:          format,
+		}
+
+		return runner, outputSink
+	}
+
+	b, err := newBundle(testApplication(), testApplicationResources())
+	require.NoError(t, err)
+
+	t.Run("Bicep to the standard output", func(t *testing.T) {
+		runner, outputSink := setup(t, "", formatBicep)
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "%s",
+				Params: []any{strings.TrimSuffix(renderBicep(b), "\n")},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("JSON to the standard output", func(t *testing.T) {
+		runner, outputSink := setup(t, "", formatJSON)
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format:  "json",
+				Obj:     renderJSON(b),
+				Options: output.FormatterOptions{},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Bicep to a file", func(t *testing.T) {
+		filePath := filepath.Join(t.TempDir(), "app.bicep")
+		runner, outputSink := setup(t, filePath, formatBicep)
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		content, err := os.ReadFile(filePath)
+		require.NoError(t, err)
+		require.Equal(t, renderBicep(b), string(content))
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Exported application %q with %d resource(s) to %q.",
+				Params: []any{"test-app", 3, filePath},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("JSON to a file", func(t *testing.T) {
+		filePath := filepath.Join(t.TempDir(), "app.json")
+		runner, _ := setup(t, filePath, formatJSON)
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		content, err := os.ReadFile(filePath)
+		require.NoError(t, err)
+		require.Contains(t, string(content), `"application": "[reference('app').id]"`)
+	})
+}
+
+func Test_Run_Secrets(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	resources := append(testApplicationResources(), generated.GenericResource{
+		ID:   to.Ptr(scope + "/providers/Applications.Core/secretStores/my-secrets"),
+		Name: to.Ptr("my-secrets"),
+		Type: to.Ptr("Applications.Core/secretStores"),
+		Properties: map[string]any{
+			"application": applicationResourceID,
+			"data":        map[string]any{"password": map[string]any{}},
+		},
+	})
+
+	appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+	appManagementClient.EXPECT().
+		ShowResource(gomock.Any(), "Applications.Core/applications", "test-app").
+		Return(testApplication(), nil).
+		Times(1)
+	appManagementClient.EXPECT().
+		ListAllResourcesByApplication(gomock.Any(), "test-app").
+		Return(resources, nil).
+		Times(1)
+
+	filePath := filepath.Join(t.TempDir(), "app.bicep")
+	outputSink := &output.MockOutput{}
+	runner := &Runner{
+		ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+		Workspace:         &workspaces.Workspace{Name: "test-workspace", Scope: scope},
+		Output:            outputSink,
+		ApplicationName:   "test-app",
+		FilePath:          filePath,
+		Format:            formatBicep,
+	}
+
+	err := runner.Run(context.Background())
+	require.NoError(t, err)
+
+	content, err := os.ReadFile(filePath)
+	require.NoError(t, err)
+	require.Contains(t, string(content), "@secure()\n@description('The value of the \"password\" secret of secret store \"my-secrets\".')\nparam mySecretsPassword string\n")
+
+	expected := []any{
+		output.LogOutput{
+			Format: "Exported application %q with %d resource(s) to %q.",
+			Params: []any{"test-app", 4, filePath},
+		},
+		output.LogOutput{
+			Format: "Set the following parameters when deploying the file: %s.",
+			Params: []any{"mySecretsPassword"},
+		},
+	}
+	require.Equal(t, expected, outputSink.Writes)
+}
+
+func Test_Run_ApplicationNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+	appManagementClient.EXPECT().
+		ShowResource(gomock.Any(), "Applications.Core/applications", "test-app").
+		Return(generated.GenericResource{}, radcli.Create404Error()).
+		Times(1)
+
+	runner := &Runner{
+		ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+		Workspace:         &workspaces.Workspace{Name: "test-workspace", Scope: scope},
+		Output:            &output.MockOutput{},
+		ApplicationName:   "test-app",
+		Format:            formatBicep,
+	}
+
+	err := runner.Run(context.Background())
+	require.Equal(t, clierrors.Message("Application %q does not exist or has been deleted.", "test-app"), err)
+}
@@ -0,0 +1,242 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	environmentParameterDescription     = "The ID of the environment to deploy the application to. It is set automatically by rad deploy and rad app import."
+	applicationNameParameterDescription = "The name of the application."
+)
+
+var bicepIdentifier = regexp.MustCompile("^[a-zA-Z_][a-zA-Z0-9_]*$")
+
+// renderJSON renders the bundle as an ARM JSON template using symbolic names.
+func renderJSON(b *bundle) map[string]any {
+	resources := map[string]any{}
+	for _, resource := range b.Resources {
+		properties := map[string]any{"name": jsonValue(resource.Name)}
+		for key, value := range resource.Body {
+			properties[key] = jsonValue(value)
+		}
+
+		declaration := map[string]any{
+			"import":     "radius",
+			"type":       resource.Type + "@" + APIVersion,
+			"properties": properties,
+		}
+		if len(resource.DependsOn) > 0 {
+			dependsOn := []any{}
+			for _, dependency := range resource.DependsOn {
+				dependsOn = append(dependsOn, dependency)
+			}
+			declaration["dependsOn"] = dependsOn
+		}
+
+		resources[resource.SymbolicName] = declaration
+	}
+
+	parameters := map[string]any{
+		EnvironmentParameter: map[string]any{
+			"type":     "string",
+			"metadata": map[string]any{"description": environmentParameterDescription},
+		},
+		ApplicationNameParameter: map[string]any{
+			"type":         "string",
+			"defaultValue": b.ApplicationName,
+			"metadata":     map[string]any{"description": applicationNameParameterDescription},
+		},
+	}
+	for _, parameter := range b.Parameters {
+		declaration := map[string]any{
+			"type":     parameterType(parameter),
+			"metadata": map[string]any{"description": parameter.Description},
+		}
+		if parameter.DefaultValue != nil {
+			declaration["defaultValue"] = jsonValue(parameter.DefaultValue)
+		}
+
+		parameters[parameter.Name] = declaration
+	}
+
+	return map[string]any{
+		"$schema":         "https://schema.management.azure.com/schemas/2019-04-01/deploymentTemplate.json#",
+		"languageVersion": "2.0",
+		"contentVersion":  "1.0.0.0",
+		"imports": map[string]any{
+			"radius": map[string]any{
+				"provider": "Radius",
+				"version":  "latest",
+			},
+		},
+		"parameters": parameters,
+		"resources":  resources,
+	}
+}
+
+// parameterType returns the type of a parameter in an ARM JSON template.
+func parameterType(parameter *bundleParameter) string {
+	if parameter.Secure && parameter.Type == "object" {
+		return "secureObject"
+	} else if parameter.Secure {
+		return "securestring"
+	}
+
+	return parameter.Type
+}
+
+// jsonValue returns a copy of a bundle value where expressions are replaced by ARM expressions.
+func jsonValue(value any) any {
+	switch v := value.(type) {
+	case expression:
+		return "[" + v.ARM + "]"
+	case map[string]any:
+		result := map[string]any{}
+		for key, item := range v {
+			result[key] = jsonValue(item)
+		}
+		return result
+	case []any:
+		result := []any{}
+		for _, item := range v {
+			result = append(result, jsonValue(item))
+		}
+		return result
+	case string:
+		// Strings starting with a bracket are escaped so that they aren't evaluated as expressions.
+		if strings.HasPrefix(v, "[") && strings.HasSuffix(v, "]") {
+			return "[" + v
+		}
+		return v
+	}
+
+	return value
+}
+
+// renderBicep renders the bundle as a Bicep file.
+func renderBicep(b *bundle) string {
+	sb := &strings.Builder{}
+	sb.WriteString("import radius as radius\n\n")
+	fmt.Fprintf(sb, "@description(%s)\n", bicepString(environmentParameterDescription))
+	fmt.Fprintf(sb, "param %s string\n\n", EnvironmentParameter)
+	fmt.Fprintf(sb, "@description(%s)\n", bicepString(applicationNameParameterDescription))
+	fmt.Fprintf(sb, "param %s string = %s\n", ApplicationNameParameter, bicepString(b.ApplicationName))
+
+	for _, parameter := range b.Parameters {
+		sb.WriteString("\n")
+		if parameter.Secure {
+			sb.WriteString("@secure()\n")
+		}
+		fmt.Fprintf(sb, "@description(%s)\n", bicepString(parameter.Description))
+		if parameter.DefaultValue != nil {
+			fmt.Fprintf(sb, "param %s %s = %s\n", parameter.Name, parameter.Type, bicepValue(parameter.DefaultValue, 0))
+		} else {
+			fmt.Fprintf(sb, "param %s %s\n", parameter.Name, parameter.Type)
+		}
+	}
+
+	for _, resource := range b.Resources {
+		fmt.Fprintf(sb, "\nresource %s '%s@%s' = {\n", resource.SymbolicName, resource.Type, APIVersion)
+		fmt.Fprintf(sb, "  name: %s\n", bicepValue(resource.Name, 1))
+
+		// Bicep files conventionally declare the location and tags before the properties.
+		for _, key := range []string{"location", "tags", "properties"} {
+			if value, ok := resource.Body[key]; ok {
+				fmt.Fprintf(sb, "  %s: %s\n", key, bicepValue(value, 1))
+			}
+		}
+
+		sb.WriteString("}\n")
+	}
+
+	return sb.String()
+}
+
+// bicepValue renders a bundle value as a Bicep expression, indented at the given level.
+func bicepValue(value any, level int) string {
+	indent := strings.Repeat("  ", level)
+	switch v := value.(type) {
+	case expression:
+		return v.Bicep
+	case map[string]any:
+		if len(v) == 0 {
+			return "{}"
+		}
+
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		sb := &strings.Builder{}
+		sb.WriteString("{\n")
+		for _, key := range keys {
+			name := key
+			if !bicepIdentifier.MatchString(key) {
+				name = bicepString(key)
+			}
+			fmt.Fprintf(sb, "%s  %s: %s\n", indent, name, bicepValue(v[key], level+1))
+		}
+		sb.WriteString(indent + "}")
+		return sb.String()
+	case []any:
+		if len(v) == 0 {
+			return "[]"
+		}
+
+		sb := &strings.Builder{}
+		sb.WriteString("[\n")
+		for _, item := range v {
+			fmt.Fprintf(sb, "%s  %s\n", indent, bicepValue(item, level+1))
+		}
+		sb.WriteString(indent + "]")
+		return sb.String()
+	case string:
+		return bicepString(v)
+	case nil:
+		return "null"
+	}
+
+	// Numbers and booleans have the same representation in JSON and Bicep.
+	b, err := json.Marshal(value)
+	if err != nil {
+		return bicepString(fmt.Sprintf("%v", value))
+	}
+
+	return string(b)
+}
+
+// bicepString renders a string as a Bicep string literal.
+func bicepString(s string) string {
+	replacer := strings.NewReplacer(
+		`\`, `\\`,
+		`'`, `\'`,
+		"\n", `\n`,
+		"\r", `\r`,
+		"\t", `\t`,
+		"${", `\${`,
+	)
+
+	return "'" + replacer.Replace(s) + "'"
+}
@@ -0,0 +1,214 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package export
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/recipes/armtemplate"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_renderBicep(t *testing.T) {
+	b, err := newBundle(testApplication(), testApplicationResources())
+	require.NoError(t, err)
+
+	expected := `import radius as radius
+
+@description('The ID of the environment to deploy the application to. It is set automatically by rad deploy and rad app import.')
+param environment string
+
+@description('The name of the application.')
+param applicationName string = 'test-app'
+
+resource app 'Applications.Core/applications@2023-10-01-preview' = {
+  name: applicationName
+  location: 'global'
+  properties: {
+    environment: environment
+  }
+}
+
+resource backend 'Applications.Core/containers@2023-10-01-preview' = {
+  name: 'backend'
+  location: 'global'
+  properties: {
+    application: app.id
+    container: {
+      image: 'backend:latest'
+      ports: {
+        web: {
+          containerPort: 8080
+        }
+      }
+    }
+  }
+}
+
+resource redis 'Applications.Datastores/redisCaches@2023-10-01-preview' = {
+  name: 'redis'
+  location: 'global'
+  properties: {
+    application: app.id
+    environment: environment
+    recipe: {
+      name: 'default'
+    }
+    resourceProvisioning: 'recipe'
+  }
+}
+
+resource frontend 'Applications.Core/containers@2023-10-01-preview' = {
+  name: 'frontend'
+  location: 'global'
+  tags: {
+    team: 'web'
+  }
+  properties: {
+    application: app.id
+    connections: {
+      backend: {
+        source: backend.id
+      }
+      redis: {
+        source: redis.id
+      }
+    }
+    container: {
+      env: {
+        GREETING: 'Hello \${name}'
+      }
+      image: 'frontend:latest'
+    }
+  }
+}
+`
+	require.Equal(t, expected, renderBicep(b))
+}
+
+func Test_renderJSON(t *testing.T) {
+	b, err := newBundle(testApplication(), testApplicationResources())
+	require.NoError(t, err)
+
+	template := renderJSON(b)
+	require.Equal(t, "2.0", template["languageVersion"])
+
+	resources := template["resources"].(map[string]any)
+	require.Equal(t, map[string]any{
+		"import": "radius",
+		"type":   "Applications.Core/applications@2023-10-01-preview",
+		"properties": map[string]any{
+			"name":     "[parameters('applicationName')]",
+			"location": "global",
+			"properties": map[string]any{
+				"environment": "[parameters('environment')]",
+			},
+		},
+	}, resources["app"])
+	require.Equal(t, []any{"app", "backend", "redis"}, resources["frontend"].(map[string]any)["dependsOn"])
+
+	// The template can be evaluated like the templates compiled from Bicep.
+	evaluator, err := armtemplate.NewEvaluator(template, map[string]any{
+		EnvironmentParameter: map[string]any{"value": environmentResourceID},
+	})
+	require.NoError(t, err)
+	evaluator.Scope = "/planes/radius/local/resourceGroups/other-group"
+
+	evaluated, err := evaluator.Resources()
+	require.NoError(t, err)
+
+	bodies := map[string]map[string]any{}
+	for _, resource := range evaluated {
+		bodies[resource.SymbolicName] = resource.Body
+	}
+	require.Equal(t, "test-app", bodies["app"]["name"])
+	require.Equal(t, environmentResourceID, bodies["app"]["properties"].(map[string]any)["environment"])
+	require.Equal(t, map[string]any{
+		"backend": map[string]any{"source": "/planes/radius/local/resourceGroups/other-group/providers/Applications.Core/containers/backend"},
+		"redis":   map[string]any{"source": "/planes/radius/local/resourceGroups/other-group/providers/Applications.Datastores/redisCaches/redis"},
+	}, bodies["frontend"]["properties"].(map[string]any)["connections"])
+}
+
+func Test_render_Parameters(t *testing.T) {
+	b := &bundle{
+		ApplicationName: "test-app",
+		Parameters: []*bundleParameter{
+			{Name: "mySecretsPassword", Type: "string", Secure: true, Description: "The password."},
+			{Name: "redisSecrets", Type: "object", Secure: true, Description: "The secrets of redis."},
+			{Name: "sharedId", Type: "string", Description: "The ID of shared.", DefaultValue: "/planes/radius/local/resourceGroups/shared/providers/Applications.Core/containers/shared"},
+		},
+	}
+
+	expected := `import radius as radius
+
+@description('The ID of the environment to deploy the application to. It is set automatically by rad deploy and rad app import.')
+param environment string
+
+@description('The name of the application.')
+param applicationName string = 'test-app'
+
+@secure()
+@description('The password.')
+param mySecretsPassword string
+
+@secure()
+@description('The secrets of redis.')
+param redisSecrets object
+
+@description('The ID of shared.')
+param sharedId string = '/planes/radius/local/resourceGroups/shared/providers/Applications.Core/containers/shared'
+`
+	require.Equal(t, expected, renderBicep(b))
+
+	parameters := renderJSON(b)["parameters"].(map[string]any)
+	require.Equal(t, map[string]any{
+		"type":     "securestring",
+		"metadata": map[string]any{"description": "The password."},
+	}, parameters["mySecretsPassword"])
+	require.Equal(t, map[string]any{
+		"type":     "secureObject",
+		"metadata": map[string]any{"description": "The secrets of redis."},
+	}, parameters["redisSecrets"])
+	require.Equal(t, map[string]any{
+		"type":         "string",
+		"defaultValue": "/planes/radius/local/resourceGroups/shared/providers/Applications.Core/containers/shared",
+		"metadata":     map[string]any{"description": "The ID of shared."},
+	}, parameters["sharedId"])
+}
+
+func Test_jsonValue(t *testing.T) {
+	require.Equal(t, "[parameters('environment')]", jsonValue(parameterExpression(EnvironmentParameter)))
+	require.Equal(t, "[[not an expression]", jsonValue("[not an expression]"))
+	require.Equal(t, "[not an expression", jsonValue("[not an expression"))
+	require.Equal(t, []any{float64(1), true, nil}, jsonValue([]any{float64(1), true, nil}))
+}
+
+func Test_bicepValue(t *testing.T) {
+	require.Equal(t, "{}", bicepValue(map[string]any{}, 0))
+	require.Equal(t, "[]", bicepValue([]any{}, 0))
+	require.Equal(t, "null", bicepValue(nil, 0))
+	require.Equal(t, "true", bicepValue(true, 0))
+	require.Equal(t, "1.5", bicepValue(float64(1.5), 0))
+	require.Equal(t, "{\n  'dapr.io/enabled': 'true'\n  key: [\n    1\n    'a'\n  ]\n}", bicepValue(map[string]any{
+		"dapr.io/enabled": "true",
+		"key":             []any{float64(1), "a"},
+	}, 0))
+}
+
+func Test_bicepString(t *testing.T) {
+	require.Equal(t, `'it\'s a \\ \${test}\n'`, bicepString("it's a \\ ${test}\n"))
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package export
+
+import (
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/to"
+)
+
+const (
+	scope                 = "/planes/radius/local/resourceGroups/test-group"
+	environmentResourceID = scope + "/providers/Applications.Core/environments/test-env"
+	applicationResourceID = scope + "/providers/Applications.Core/applications/test-app"
+	frontendResourceID    = scope + "/providers/Applications.Core/containers/frontend"
+	backendResourceID     = scope + "/providers/Applications.Core/containers/backend"
+	redisResourceID       = scope + "/providers/Applications.Datastores/redisCaches/redis"
+)
+
+func testApplication() generated.GenericResource {
+	return generated.GenericResource{
+		ID:       to.Ptr(applicationResourceID),
+		Name:     to.Ptr("test-app"),
+		Type:     to.Ptr("Applications.Core/applications"),
+		Location: to.Ptr("global"),
+		SystemData: &generated.SystemData{
+			CreatedBy: to.Ptr("someone"),
+		},
+		Properties: map[string]any{
+			"environment":       environmentResourceID,
+			"provisioningState": "Succeeded",
+			"status": map[string]any{
+				"compute": map[string]any{"kind": "kubernetes"},
+			},
+		},
+	}
+}
+
+func testApplicationResources() []generated.GenericResource {
+	return []generated.GenericResource{
+		{
+			ID:       to.Ptr(redisResourceID),
+			Name:     to.Ptr("redis"),
+			Type:     to.Ptr("Applications.Datastores/redisCaches"),
+			Location: to.Ptr("global"),
+			Properties: map[string]any{
+				"application":          applicationResourceID,
+				"environment":          environmentResourceID,
+				"resourceProvisioning": "recipe",
+				"recipe":               map[string]any{"name": "default"},
+				"host":                 "redis.svc.cluster.local",
+				"port":                 float64(6379),
+				"provisioningState":    "Succeeded",
+			},
+		},
+		{
+			ID:       to.Ptr(frontendResourceID),
+			Name:     to.Ptr("frontend"),
+			Type:     to.Ptr("Applications.Core/containers"),
+			Location: to.Ptr("global"),
+			Tags:     map[string]*string{"team": to.Ptr("web")},
+			Properties: map[string]any{
+				"application": applicationResourceID,
+				"container": map[string]any{
+					"image": "frontend:latest",
+					"env":   map[string]any{"GREETING": "Hello ${name}", "EMPTY": nil},
+				},
+				"connections": map[string]any{
+					"backend": map[string]any{"source": backendResourceID},
+					"redis":   map[string]any{"source": "/PLANES/radius/local/resourceGroups/test-group/providers/Applications.Datastores/redisCaches/redis"},
+				},
+				"provisioningState": "Succeeded",
+				"status":            map[string]any{"outputResources": []any{}},
+			},
+		},
+		{
+			ID:       to.Ptr(backendResourceID),
+			Name:     to.Ptr("backend"),
+			Type:     to.Ptr("Applications.Core/containers"),
+			Location: to.Ptr("global"),
+			Properties: map[string]any{
+				"application": applicationResourceID,
+				"container": map[string]any{
+					"image": "backend:latest",
+					"ports": map[string]any{"web": map[string]any{"containerPort": float64(8080)}},
+				},
+				"provisioningState": "Succeeded",
+			},
+		},
+	}
+}
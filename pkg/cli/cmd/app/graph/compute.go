@@ -0,0 +1,345 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package graph
+
+import (
+	"net/url"
+	"sort"
+	"strings"
+
+	dependencygraph "github.com/radius-project/radius/pkg/algorithm/graph"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/cli/cmd/app/common"
+	"github.com/radius-project/radius/pkg/resourcemodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const containerType = "Applications.Core/containers"
+
+// dependency is an edge of the application graph along with the node it points to.
+type dependency struct {
+	edge graphEdge
+	node graphNode
+}
+
+// compute constructs the dependency graph of an application from the given application and environment resources.
+//
+// Like `rad app connections`, the graph contains the application-scoped resources, and the environment-scoped
+// and external resources they reference (recursively). This function does not return errors and will skip
+// missing or corrupted data, so that partial results can be displayed.
+func compute(applicationName string, applicationResources []generated.GenericResource, environmentResources []generated.GenericResource) *applicationGraph {
+	// Resource IDs are case-insensitive, so references are resolved using the lowercase ID of the resources.
+	resourcesByID := map[string]generated.GenericResource{}
+	for _, resource := range append(append([]generated.GenericResource{}, applicationResources...), environmentResources...) {
+		if resource.ID == nil {
+			continue
+		}
+
+		key := strings.ToLower(*resource.ID)
+		if _, found := resourcesByID[key]; !found {
+			resourcesByID[key] = resource
+		}
+	}
+
+	// Containers can be referenced by URL (eg: 'http://frontend:3000') in connections and routes, so we also index
+	// them by name. Application-scoped containers take precedence since they were added first.
+	containersByName := map[string]string{}
+	for _, resource := range append(append([]generated.GenericResource{}, applicationResources...), environmentResources...) {
+		if resource.ID == nil {
+			continue
+		}
+
+		parsed, err := resources.ParseResource(*resource.ID)
+		if err != nil || !strings.EqualFold(parsed.Type(), containerType) {
+			continue
+		}
+
+		name := strings.ToLower(parsed.Name())
+		if _, found := containersByName[name]; !found {
+			containersByName[name] = *resource.ID
+		}
+	}
+
+	// Explore the graph breadth-first, starting with the application-scoped resources. Environment-scoped resources
+	// become part of the graph when an application resource depends on them.
+	queue := []string{}
+	for _, resource := range applicationResources {
+		if resource.ID != nil {
+			queue = append(queue, strings.ToLower(*resource.ID))
+		}
+	}
+
+	nodesByID := map[string]graphNode{}
+	edges := []graphEdge{}
+	for len(queue) > 0 {
+		key := queue[0]
+		queue = queue[1:]
+		if _, visited := nodesByID[key]; visited {
+			continue
+		}
+
+		resource := resourcesByID[key]
+		nodesByID[key] = resourceNode(*resource.ID)
+
+		for _, d := range dependenciesFromAPIData(resource, resourcesByID, containersByName) {
+			edges = append(edges, d.edge)
+
+			destination := strings.ToLower(d.edge.To)
+			if _, found := resourcesByID[destination]; found {
+				queue = append(queue, destination)
+			} else if _, found := nodesByID[destination]; !found {
+				nodesByID[destination] = d.node
+			}
+		}
+	}
+
+	// Make sure that the edges use the same casing as the nodes they point to.
+	for i := range edges {
+		edges[i].To = nodesByID[strings.ToLower(edges[i].To)].ID
+	}
+
+	graph := &applicationGraph{ApplicationName: applicationName, Nodes: []graphNode{}, Edges: edges}
+	for _, node := range nodesByID {
+		graph.Nodes = append(graph.Nodes, node)
+	}
+
+	sortNodes(graph.Nodes)
+	sortEdges(graph.Edges)
+	graph.HasCycle = hasCycle(graph)
+
+	return graph
+}
+
+// dependenciesFromAPIData resolves the outbound dependencies of a resource from the generic resource representation:
+// its connections, its routes (for gateways) and its output resources.
+func dependenciesFromAPIData(resource generated.GenericResource, resourcesByID map[string]generated.GenericResource, containersByName map[string]string) []dependency {
+	dependencies := []dependency{}
+
+	// Any Radius resource type that supports connections uses the '/properties/connections' property path.
+	if connections, ok := common.GetValue(resource, "/properties/connections").(map[string]any); ok {
+		for name, connection := range connections {
+			data := struct {
+				Source string `json:"source"`
+			}{}
+			if common.ToStronglyTypedData(connection, &data) != nil {
+				continue
+			}
+
+			if node, ok := resolveDestination(data.Source, resourcesByID, containersByName); ok {
+				edge := graphEdge{From: *resource.ID, To: node.ID, Kind: edgeKindConnection, Name: name}
+				dependencies = append(dependencies, dependency{edge: edge, node: node})
+			}
+		}
+	}
+
+	// Gateways route to containers by URL, or to HTTP routes by resource ID.
+	if routes, ok := common.GetValue(resource, "/properties/routes").([]any); ok {
+		for _, route := range routes {
+			data := struct {
+				Destination string `json:"destination"`
+				Path        string `json:"path"`
+			}{}
+			if common.ToStronglyTypedData(route, &data) != nil {
+				continue
+			}
+
+			if node, ok := resolveDestination(data.Destination, resourcesByID, containersByName); ok {
+				edge := graphEdge{From: *resource.ID, To: node.ID, Kind: edgeKindRoute, Name: data.Path}
+				dependencies = append(dependencies, dependency{edge: edge, node: node})
+			}
+		}
+	}
+
+	// Any Radius resource type that supports output resources uses the '/properties/status/outputResources' property path.
+	if outputResources, ok := common.GetValue(resource, "/properties/status/outputResources").([]any); ok {
+		kind := edgeKindOutput
+		if isProvisionedByRecipe(resource) {
+			kind = edgeKindRecipe
+		}
+
+		for _, outputResource := range outputResources {
+			data := struct {
+				ID resources.ID `json:"id"`
+			}{}
+			if common.ToStronglyTypedData(outputResource, &data) != nil || data.ID.String() == "" {
+				continue
+			}
+
+			node := outputResourceNode(data.ID)
+			edge := graphEdge{From: *resource.ID, To: node.ID, Kind: kind}
+			dependencies = append(dependencies, dependency{edge: edge, node: node})
+		}
+	}
+
+	return dependencies
+}
+
+// resolveDestination resolves the destination of a connection or a route, which is either a resource ID or a URL. URLs
+// whose host is the name of a container are resolved to the container. It returns false if the destination is invalid.
+func resolveDestination(destination string, resourcesByID map[string]generated.GenericResource, containersByName map[string]string) (graphNode, bool) {
+	if parsed, err := resources.ParseResource(destination); err == nil {
+		if resource, found := resourcesByID[strings.ToLower(destination)]; found {
+			return resourceNode(*resource.ID), true
+		}
+
+		node := graphNode{ID: destination, Name: parsed.Name(), Type: parsed.Type(), Kind: nodeKindExternal, Provider: providerFromID(parsed)}
+		return node, true
+	}
+
+	u, err := url.Parse(destination)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return graphNode{}, false
+	}
+
+	if id, found := containersByName[strings.ToLower(u.Hostname())]; found {
+		return resourceNode(id), true
+	}
+
+	return graphNode{ID: destination, Name: u.Host, Kind: nodeKindExternal}, true
+}
+
+// isProvisionedByRecipe returns true if the resource is a portable resource provisioned by a recipe, meaning that its
+// output resources were created by the recipe.
+func isProvisionedByRecipe(resource generated.GenericResource) bool {
+	if provisioning, ok := common.GetValue(resource, "/properties/resourceProvisioning").(string); ok && strings.EqualFold(provisioning, "manual") {
+		return false
+	}
+
+	return common.GetValue(resource, "/properties/recipe") != nil
+}
+
+// resourceNode creates a node for a Radius resource from its ID.
+func resourceNode(id string) graphNode {
+	node := graphNode{ID: id, Kind: nodeKindResource}
+	if parsed, err := resources.ParseResource(id); err == nil {
+		node.Name = parsed.Name()
+		node.Type = parsed.Type()
+	}
+
+	return node
+}
+
+// outputResourceNode creates a node for an output resource from its ID.
+func outputResourceNode(id resources.ID) graphNode {
+	return graphNode{
+		ID:       id.String(),
+		Name:     id.Name(),
+		Type:     id.Type(),
+		Kind:     nodeKindOutputResource,
+		Provider: providerFromID(id),
+	}
+}
+
+// providerFromID returns the provider of a resource from the scope of its ID.
+func providerFromID(id resources.ID) string {
+	if len(id.ScopeSegments()) > 0 && id.IsUCPQualfied() {
+		return id.ScopeSegments()[0].Type
+	} else if len(id.ScopeSegments()) > 0 {
+		// Relative Resource ID (ARM)
+		return resourcemodel.ProviderAzure
+	}
+
+	return ""
+}
+
+// hasCycle returns true if the edges of the graph form a cycle.
+func hasCycle(graph *applicationGraph) bool {
+	dependenciesByID := map[string][]string{}
+	for _, edge := range graph.Edges {
+		dependenciesByID[edge.From] = append(dependenciesByID[edge.From], edge.To)
+	}
+
+	items := []dependencygraph.DependencyItem{}
+	for _, node := range graph.Nodes {
+		items = append(items, dependencyItem{id: node.ID, dependencies: dependenciesByID[node.ID]})
+	}
+
+	dg, err := dependencygraph.ComputeDependencyGraph(items)
+	if err != nil {
+		// This should never happen since every edge points to a node of the graph.
+		return false
+	}
+
+	_, err = dg.Order()
+	return err != nil
+}
+
+var _ dependencygraph.DependencyItem = dependencyItem{}
+
+// dependencyItem adapts a node of the application graph for cycle detection.
+type dependencyItem struct {
+	id           string
+	dependencies []string
+}
+
+// Key returns the ID of the node.
+func (i dependencyItem) Key() string {
+	return i.id
+}
+
+// GetDependencies returns the IDs of the nodes the node depends on.
+func (i dependencyItem) GetDependencies() ([]string, error) {
+	return i.dependencies, nil
+}
+
+// sortNodes sorts the nodes by kind (resources first), type, name and id to produce a stable output. Containers are
+// sorted before the other resources.
+func sortNodes(nodes []graphNode) {
+	rank := func(node graphNode) int {
+		switch {
+		case node.Kind == nodeKindResource && strings.EqualFold(node.Type, containerType):
+			return 0
+		case node.Kind == nodeKindResource:
+			return 1
+		case node.Kind == nodeKindExternal:
+			return 2
+		default:
+			return 3
+		}
+	}
+
+	sort.Slice(nodes, func(i, j int) bool {
+		if rank(nodes[i]) != rank(nodes[j]) {
+			return rank(nodes[i]) < rank(nodes[j])
+		}
+		if nodes[i].Type != nodes[j].Type {
+			return nodes[i].Type < nodes[j].Type
+		}
+		if nodes[i].Name != nodes[j].Name {
+			return nodes[i].Name < nodes[j].Name
+		}
+
+		return nodes[i].ID < nodes[j].ID
+	})
+}
+
+// sortEdges sorts the edges by source, destination, kind and name to produce a stable output.
+func sortEdges(edges []graphEdge) {
+	sort.Slice(edges, func(i, j int) bool {
+		if edges[i].From != edges[j].From {
+			return edges[i].From < edges[j].From
+		}
+		if edges[i].To != edges[j].To {
+			return edges[i].To < edges[j].To
+		}
+		if edges[i].Kind != edges[j].Kind {
+			return edges[i].Kind < edges[j].Kind
+		}
+
+		return edges[i].Name < edges[j].Name
+	})
+}
@@ -0,0 +1,189 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package graph
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_compute(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		actual := compute("test-app", []generated.GenericResource{}, []generated.GenericResource{})
+
+		expected := &applicationGraph{ApplicationName: "test-app", Nodes: []graphNode{}, Edges: []graphEdge{}}
+		require.Equal(t, expected, actual)
+	})
+
+	t.Run("environment resources without references are ignored", func(t *testing.T) {
+		environmentResources := []generated.GenericResource{
+			{
+				ID:         to.Ptr(redisResourceID),
+				Properties: makeResourceProperties(nil, []any{redisAWSOutputResource}),
+			},
+		}
+
+		actual := compute("test-app", []generated.GenericResource{}, environmentResources)
+
+		expected := &applicationGraph{ApplicationName: "test-app", Nodes: []graphNode{}, Edges: []graphEdge{}}
+		require.Equal(t, expected, actual)
+	})
+
+	t.Run("connections, routes and recipes", func(t *testing.T) {
+		redisProperties := makeResourceProperties(nil, []any{redisAWSOutputResource})
+		redisProperties["recipe"] = map[string]any{"name": "default"}
+
+		applicationResources := []generated.GenericResource{
+			{
+				ID:         to.Ptr(gatewayResourceID),
+				Properties: makeGatewayProperties(map[string]string{"/": "http://frontend:3000"}),
+			},
+			{
+				ID:         to.Ptr(frontendResourceID),
+				Properties: makeResourceProperties(map[string]string{"backend": "http://backend:3000"}, nil),
+			},
+			{
+				ID: to.Ptr(backendResourceID),
+				Properties: makeResourceProperties(map[string]string{
+					"redis":   redisResourceID,
+					"storage": azureStorageResourceID,
+				}, []any{backendDeploymentOutputResource}),
+			},
+		}
+		environmentResources := []generated.GenericResource{
+			{
+				ID:         to.Ptr(redisResourceID),
+				Properties: redisProperties,
+			},
+		}
+
+		actual := compute("test-app", applicationResources, environmentResources)
+
+		expected := &applicationGraph{
+			ApplicationName: "test-app",
+			Nodes: []graphNode{
+				{ID: backendResourceID, Name: "backend", Type: "Applications.Core/containers", Kind: nodeKindResource},
+				{ID: frontendResourceID, Name: "frontend", Type: "Applications.Core/containers", Kind: nodeKindResource},
+				{ID: gatewayResourceID, Name: "gateway", Type: "Applications.Core/gateways", Kind: nodeKindResource},
+				{ID: redisResourceID, Name: "redis", Type: "Applications.Datastores/redisCaches", Kind: nodeKindResource},
+				{ID: azureStorageResourceID, Name: "storage", Type: "Microsoft.Storage/storageAccounts", Kind: nodeKindExternal, Provider: "azure"},
+				{ID: awsMemoryDBResourceID, Name: "redis-aqbjixghynqgg", Type: "AWS.MemoryDB/Cluster", Kind: nodeKindOutputResource, Provider: "aws"},
+				{ID: backendDeploymentResourceID, Name: "backend", Type: "apps/Deployment", Kind: nodeKindOutputResource, Provider: "kubernetes"},
+			},
+			Edges: []graphEdge{
+				{From: backendResourceID, To: backendDeploymentResourceID, Kind: edgeKindOutput},
+				{From: backendResourceID, To: redisResourceID, Kind: edgeKindConnection, Name: "redis"},
+				{From: backendResourceID, To: azureStorageResourceID, Kind: edgeKindConnection, Name: "storage"},
+				{From: frontendResourceID, To: backendResourceID, Kind: edgeKindConnection, Name: "backend"},
+				{From: gatewayResourceID, To: frontendResourceID, Kind: edgeKindRoute, Name: "/"},
+				{From: redisResourceID, To: awsMemoryDBResourceID, Kind: edgeKindRecipe},
+			},
+		}
+		require.Equal(t, expected, actual)
+	})
+
+	t.Run("manually provisioned resources", func(t *testing.T) {
+		redisProperties := makeResourceProperties(nil, []any{redisAWSOutputResource})
+		redisProperties["recipe"] = map[string]any{"name": "default"}
+		redisProperties["resourceProvisioning"] = "manual"
+
+		applicationResources := []generated.GenericResource{
+			{
+				ID:         to.Ptr(redisResourceID),
+				Properties: redisProperties,
+			},
+		}
+
+		actual := compute("test-app", applicationResources, []generated.GenericResource{})
+
+		expected := []graphEdge{
+			{From: redisResourceID, To: awsMemoryDBResourceID, Kind: edgeKindOutput},
+		}
+		require.Equal(t, expected, actual.Edges)
+	})
+
+	t.Run("unknown and invalid destinations", func(t *testing.T) {
+		applicationResources := []generated.GenericResource{
+			{
+				ID: to.Ptr(frontendResourceID),
+				Properties: makeResourceProperties(map[string]string{
+					"api":     "https://api.example.com",
+					"invalid": "not a destination",
+				}, nil),
+			},
+		}
+
+		actual := compute("test-app", applicationResources, []generated.GenericResource{})
+
+		expected := &applicationGraph{
+			ApplicationName: "test-app",
+			Nodes: []graphNode{
+				{ID: frontendResourceID, Name: "frontend", Type: "Applications.Core/containers", Kind: nodeKindResource},
+				{ID: "https://api.example.com", Name: "api.example.com", Kind: nodeKindExternal},
+			},
+			Edges: []graphEdge{
+				{From: frontendResourceID, To: "https://api.example.com", Kind: edgeKindConnection, Name: "api"},
+			},
+		}
+		require.Equal(t, expected, actual)
+	})
+
+	t.Run("cycle", func(t *testing.T) {
+		applicationResources := []generated.GenericResource{
+			{
+				ID:         to.Ptr(frontendResourceID),
+				Properties: makeResourceProperties(map[string]string{"backend": "http://backend:3000"}, nil),
+			},
+			{
+				ID:         to.Ptr(backendResourceID),
+				Properties: makeResourceProperties(map[string]string{"frontend": frontendResourceID}, nil),
+			},
+		}
+
+		actual := compute("test-app", applicationResources, []generated.GenericResource{})
+		require.True(t, actual.HasCycle)
+		require.Len(t, actual.Nodes, 2)
+		require.Len(t, actual.Edges, 2)
+	})
+
+	t.Run("resource IDs are case-insensitive", func(t *testing.T) {
+		applicationResources := []generated.GenericResource{
+			{
+				ID:         to.Ptr(frontendResourceID),
+				Properties: makeResourceProperties(map[string]string{"redis": "/planes/radius/local/resourcegroups/test-group/providers/applications.datastores/rediscaches/redis"}, nil),
+			},
+		}
+		environmentResources := []generated.GenericResource{
+			{
+				ID:         to.Ptr(redisResourceID),
+				Properties: makeResourceProperties(nil, nil),
+			},
+		}
+
+		actual := compute("test-app", applicationResources, environmentResources)
+
+		expected := []graphEdge{
+			{From: frontendResourceID, To: redisResourceID, Kind: edgeKindConnection, Name: "redis"},
+		}
+		require.Equal(t, expected, actual.Edges)
+		require.Len(t, actual.Nodes, 2)
+		require.False(t, actual.HasCycle)
+	})
+}
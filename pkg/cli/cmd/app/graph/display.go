@@ -0,0 +1,143 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package graph
+
+import (
+	"fmt"
+	"strings"
+)
+
+// displayDOT builds the output for the application graph in the Graphviz DOT language.
+func displayDOT(graph *applicationGraph) string {
+	output := &strings.Builder{}
+	output.WriteString(fmt.Sprintf("digraph %s {\n", quoteDOT(graph.ApplicationName)))
+	if graph.HasCycle {
+		output.WriteString("  // The connections and routes of the application form a cycle.\n")
+	}
+	output.WriteString("  rankdir=LR;\n")
+
+	for _, node := range graph.Nodes {
+		attributes := "shape=box"
+		switch node.Kind {
+		case nodeKindOutputResource:
+			attributes = "shape=box, style=dashed"
+		case nodeKindExternal:
+			attributes = "shape=ellipse"
+		}
+
+		output.WriteString(fmt.Sprintf("  %s [label=%s, %s];\n", quoteDOT(node.ID), quoteDOT(nodeLabel(node, "\n")), attributes))
+	}
+
+	for _, edge := range graph.Edges {
+		attributes := []string{}
+		if label := edgeLabel(edge); label != "" {
+			attributes = append(attributes, "label="+quoteDOT(label))
+		}
+		if edge.Kind == edgeKindRecipe || edge.Kind == edgeKindOutput {
+			attributes = append(attributes, "style=dashed")
+		}
+
+		if len(attributes) == 0 {
+			output.WriteString(fmt.Sprintf("  %s -> %s;\n", quoteDOT(edge.From), quoteDOT(edge.To)))
+		} else {
+			output.WriteString(fmt.Sprintf("  %s -> %s [%s];\n", quoteDOT(edge.From), quoteDOT(edge.To), strings.Join(attributes, ", ")))
+		}
+	}
+
+	output.WriteString("}\n")
+	return output.String()
+}
+
+// displayMermaid builds the output for the application graph as a Mermaid flowchart.
+func displayMermaid(graph *applicationGraph) string {
+	// Mermaid node IDs can't contain most of the characters of resource IDs, so we number the nodes instead.
+	idsByResourceID := map[string]string{}
+	for i, node := range graph.Nodes {
+		idsByResourceID[node.ID] = fmt.Sprintf("n%d", i)
+	}
+
+	output := &strings.Builder{}
+	output.WriteString("flowchart LR\n")
+	if graph.HasCycle {
+		output.WriteString("  %% The connections and routes of the application form a cycle.\n")
+	}
+
+	for _, node := range graph.Nodes {
+		label := quoteMermaid(nodeLabel(node, "<br/>"))
+		switch node.Kind {
+		case nodeKindOutputResource:
+			output.WriteString(fmt.Sprintf("  %s([%s])\n", idsByResourceID[node.ID], label))
+		case nodeKindExternal:
+			output.WriteString(fmt.Sprintf("  %s{{%s}}\n", idsByResourceID[node.ID], label))
+		default:
+			output.WriteString(fmt.Sprintf("  %s[%s]\n", idsByResourceID[node.ID], label))
+		}
+	}
+
+	for _, edge := range graph.Edges {
+		arrow := "-->"
+		switch edge.Kind {
+		case edgeKindRoute:
+			arrow = "==>"
+		case edgeKindRecipe, edgeKindOutput:
+			arrow = "-.->"
+		}
+
+		if label := edgeLabel(edge); label != "" {
+			output.WriteString(fmt.Sprintf("  %s %s|%s| %s\n", idsByResourceID[edge.From], arrow, quoteMermaid(label), idsByResourceID[edge.To]))
+		} else {
+			output.WriteString(fmt.Sprintf("  %s %s %s\n", idsByResourceID[edge.From], arrow, idsByResourceID[edge.To]))
+		}
+	}
+
+	return output.String()
+}
+
+// nodeLabel returns the label of a node, made of its name and type separated by the given line break.
+func nodeLabel(node graphNode, lineBreak string) string {
+	switch {
+	case node.Type == "":
+		return node.Name
+	case node.Kind == nodeKindOutputResource:
+		return fmt.Sprintf("%s%s%s: %s", node.Name, lineBreak, node.Provider, node.Type)
+	default:
+		return fmt.Sprintf("%s%s%s", node.Name, lineBreak, node.Type)
+	}
+}
+
+// edgeLabel returns the label of an edge: the name of connections, the path of routes, and 'recipe' for the output
+// resources created by recipes.
+func edgeLabel(edge graphEdge) string {
+	if edge.Kind == edgeKindRecipe {
+		return edgeKindRecipe
+	}
+
+	return edge.Name
+}
+
+// quoteDOT quotes a string as a DOT identifier.
+func quoteDOT(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	s = strings.ReplaceAll(s, "\n", `\n`)
+	return `"` + s + `"`
+}
+
+// quoteMermaid quotes a string as a Mermaid label.
+func quoteMermaid(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, "#quot;") + `"`
+}
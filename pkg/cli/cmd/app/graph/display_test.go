@@ -0,0 +1,96 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var testGraph = &applicationGraph{
+	ApplicationName: "test-app",
+	Nodes: []graphNode{
+		{ID: frontendResourceID, Name: "frontend", Type: "Applications.Core/containers", Kind: nodeKindResource},
+		{ID: gatewayResourceID, Name: "gateway", Type: "Applications.Core/gateways", Kind: nodeKindResource},
+		{ID: redisResourceID, Name: "redis", Type: "Applications.Datastores/redisCaches", Kind: nodeKindResource},
+		{ID: "https://api.example.com", Name: "api.example.com", Kind: nodeKindExternal},
+		{ID: awsMemoryDBResourceID, Name: "redis-aqbjixghynqgg", Type: "AWS.MemoryDB/Cluster", Kind: nodeKindOutputResource, Provider: "aws"},
+	},
+	Edges: []graphEdge{
+		{From: frontendResourceID, To: redisResourceID, Kind: edgeKindConnection, Name: "redis"},
+		{From: frontendResourceID, To: "https://api.example.com", Kind: edgeKindConnection, Name: "api"},
+		{From: gatewayResourceID, To: frontendResourceID, Kind: edgeKindRoute, Name: "/"},
+		{From: redisResourceID, To: awsMemoryDBResourceID, Kind: edgeKindRecipe},
+	},
+}
+
+func Test_displayDOT(t *testing.T) {
+	t.Run("graph", func(t *testing.T) {
+		expected := `digraph "test-app" {
+  rankdir=LR;
+  "` + frontendResourceID + `" [label="frontend\nApplications.Core/containers", shape=box];
+  "` + gatewayResourceID + `" [label="gateway\nApplications.Core/gateways", shape=box];
+  "` + redisResourceID + `" [label="redis\nApplications.Datastores/redisCaches", shape=box];
+  "https://api.example.com" [label="api.example.com", shape=ellipse];
+  "` + awsMemoryDBResourceID + `" [label="redis-aqbjixghynqgg\naws: AWS.MemoryDB/Cluster", shape=box, style=dashed];
+  "` + frontendResourceID + `" -> "` + redisResourceID + `" [label="redis"];
+  "` + frontendResourceID + `" -> "https://api.example.com" [label="api"];
+  "` + gatewayResourceID + `" -> "` + frontendResourceID + `" [label="/"];
+  "` + redisResourceID + `" -> "` + awsMemoryDBResourceID + `" [label="recipe", style=dashed];
+}
+`
+		require.Equal(t, expected, displayDOT(testGraph))
+	})
+
+	t.Run("cycle", func(t *testing.T) {
+		graph := &applicationGraph{ApplicationName: `my "app"`, HasCycle: true}
+
+		expected := `digraph "my \"app\"" {
+  // The connections and routes of the application form a cycle.
+  rankdir=LR;
+}
+`
+		require.Equal(t, expected, displayDOT(graph))
+	})
+}
+
+func Test_displayMermaid(t *testing.T) {
+	t.Run("graph", func(t *testing.T) {
+		expected := `flowchart LR
+  n0["frontend<br/>Applications.Core/containers"]
+  n1["gateway<br/>Applications.Core/gateways"]
+  n2["redis<br/>Applications.Datastores/redisCaches"]
+  n3{{"api.example.com"}}
+  n4(["redis-aqbjixghynqgg<br/>aws: AWS.MemoryDB/Cluster"])
+  n0 -->|"redis"| n2
+  n0 -->|"api"| n3
+  n1 ==>|"/"| n0
+  n2 -.->|"recipe"| n4
+`
+		require.Equal(t, expected, displayMermaid(testGraph))
+	})
+
+	t.Run("cycle", func(t *testing.T) {
+		graph := &applicationGraph{ApplicationName: "test-app", HasCycle: true}
+
+		expected := `flowchart LR
+  %% The connections and routes of the application form a cycle.
+`
+		require.Equal(t, expected, displayMermaid(graph))
+	})
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package graph
+
+import (
+	"context"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	app_connections "github.com/radius-project/radius/pkg/cli/cmd/app/connections"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/spf13/cobra"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	formatDOT     = "dot"
+	formatMermaid = "mermaid"
+)
+
+// supportedFormats are the formats of the graph. The default format of the output flag renders the connections of the
+// application as text, like `rad app connections`.
+var supportedFormats = []string{output.DefaultFormat, formatDOT, formatMermaid, output.FormatJson, output.FormatYaml}
+
+// NewCommand creates an instance of the command and runner for the `rad app graph` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+	cmd := &cobra.Command{
+		Use:   "graph",
+		Short: "Renders the dependency graph of an application.",
+		Long: `Renders the dependency graph of an application.
+
+The graph contains the containers, gateways and portable resources of the application, and their output resources.
+The edges of the graph are the connections, the gateway routes, and the output resources created by recipes.
+
+By default, the connections of the application are rendered as text, the same way as 'rad app connections'. The
+graph can also be rendered in the Graphviz DOT language with '--output dot', as a Mermaid flowchart (which can be
+embedded in Markdown documents) with '--output mermaid', or as JSON or YAML.`,
+		Args: cobra.MaximumNArgs(1),
+		Example: `
+# Show the connections of the current application
+rad app graph
+
+# Render the graph of the specified application as a Mermaid flowchart
+rad app graph my-application --output mermaid
+
+# Render the graph of the current application as an image using Graphviz
+rad app graph --output dot | dot -Tsvg > graph.svg`,
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddEnvironmentNameFlag(cmd)
+	commonflags.AddApplicationNameFlag(cmd)
+	commonflags.AddOutputFlag(cmd)
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad app graph` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+
+	ApplicationName string
+	EnvironmentName string
+	Format          string
+	Workspace       *workspaces.Workspace
+}
+
+// NewRunner creates a new instance of the `rad app graph` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:      factory.GetConfigHolder(),
+		Output:            factory.GetOutput(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+	}
+}
+
+// Validate runs validation for the `rad app graph` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	r.Workspace.Scope, err = cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	r.ApplicationName, err = cli.RequireApplicationArgs(cmd, args, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	r.Format, err = cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+
+	r.Format = strings.ToLower(r.Format)
+	if !slices.Contains(supportedFormats, r.Format) {
+		return clierrors.Message("Unsupported format %q. Supported formats are %s.", r.Format, strings.Join(supportedFormats, ", "))
+	}
+
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(cmd.Context(), *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	// Validate that the application exists
+	app, err := client.ShowApplication(cmd.Context(), r.ApplicationName)
+	if clients.Is404Error(err) {
+		return clierrors.Message("Application %q does not exist or has been deleted.", r.ApplicationName)
+	} else if err != nil {
+		return err
+	}
+
+	parsed, err := resources.ParseResource(*app.Properties.Environment)
+	if err != nil {
+		return err
+	}
+
+	r.EnvironmentName = parsed.Name()
+
+	return nil
+}
+
+// Run runs the `rad app graph` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	applicationResources, err := client.ListAllResourcesByApplication(ctx, r.ApplicationName)
+	if err != nil {
+		return err
+	}
+
+	environmentResources, err := client.ListAllResourcesByEnvironment(ctx, r.EnvironmentName)
+	if err != nil {
+		return err
+	}
+
+	graph := compute(r.ApplicationName, applicationResources, environmentResources)
+	switch r.Format {
+	case output.FormatJson, output.FormatYaml:
+		return r.Output.WriteFormatted(r.Format, graph, output.FormatterOptions{})
+	case formatDOT:
+		r.Output.LogInfo(displayDOT(graph))
+	case formatMermaid:
+		r.Output.LogInfo(displayMermaid(graph))
+	default:
+		r.Output.LogInfo(app_connections.Render(r.ApplicationName, applicationResources, environmentResources))
+	}
+
+	return nil
+}
@@ -0,0 +1,252 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package graph
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/golang/mock/gomock"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	app_connections "github.com/radius-project/radius/pkg/cli/cmd/app/connections"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	application := v20231001preview.ApplicationResource{
+		Name: to.Ptr("test-app"),
+		ID:   to.Ptr(applicationResourceID),
+		Type: to.Ptr("Applications.Core/applications"),
+		Properties: &v20231001preview.ApplicationProperties{
+			Environment: to.Ptr(environmentResourceID),
+		},
+	}
+
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Graph command application (positional)",
+			Input:         []string{"test-app"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ConfigureMocks: func(mocks radcli.ValidateMocks) {
+				mocks.ApplicationManagementClient.EXPECT().
+					ShowApplication(gomock.Any(), "test-app").
+					Return(application, nil).
+					Times(1)
+			},
+			ValidateCallback: func(t *testing.T, r framework.Runner) {
+				runner := r.(*Runner)
+				// These values are used by Run()
+				require.Equal(t, "test-app", runner.ApplicationName)
+				require.Equal(t, "test-env", runner.EnvironmentName)
+				// The connections are rendered as text by default, like `rad app connections`.
+				require.Equal(t, output.DefaultFormat, runner.Format)
+			},
+		},
+		{
+			Name:          "Graph command application (flag) with format",
+			Input:         []string{"-a", "test-app", "--output", "Mermaid"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ConfigureMocks: func(mocks radcli.ValidateMocks) {
+				mocks.ApplicationManagementClient.EXPECT().
+					ShowApplication(gomock.Any(), "test-app").
+					Return(application, nil).
+					Times(1)
+			},
+			ValidateCallback: func(t *testing.T, r framework.Runner) {
+				runner := r.(*Runner)
+				require.Equal(t, "test-app", runner.ApplicationName)
+				require.Equal(t, formatMermaid, runner.Format)
+			},
+		},
+		{
+			Name:          "Graph command unsupported format",
+			Input:         []string{"test-app", "-o", "svg"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Graph command missing application",
+			Input:         []string{"-a", "test-app"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ConfigureMocks: func(mocks radcli.ValidateMocks) {
+				mocks.ApplicationManagementClient.EXPECT().
+					ShowApplication(gomock.Any(), "test-app").
+					Return(v20231001preview.ApplicationResource{}, &azcore.ResponseError{ErrorCode: v1.CodeNotFound}).
+					Times(1)
+			},
+		},
+		{
+			Name:          "Graph command with incorrect args",
+			Input:         []string{"foo", "bar"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	applicationResources := []generated.GenericResource{
+		{
+			ID:         to.Ptr(backendResourceID),
+			Properties: makeResourceProperties(map[string]string{"redis": redisResourceID}, nil),
+		},
+	}
+	environmentResources := []generated.GenericResource{
+		{
+			ID:         to.Ptr(redisResourceID),
+			Properties: makeResourceProperties(nil, nil),
+		},
+	}
+
+	setup := func(t *testing.T, format string) (*Runner, *output.MockOutput) {
+		ctrl := gomock.NewController(t)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			ListAllResourcesByApplication(gomock.Any(), "test-app").
+			Return(applicationResources, nil).
+			Times(1)
+		appManagementClient.EXPECT().
+			ListAllResourcesByEnvironment(gomock.Any(), "test-env").
+			Return(environmentResources, nil).
+			Times(1)
+
+		workspace := &workspaces.Workspace{
+			Connection: map[string]any{
+				"kind":    "kubernetes",
+				"context": "kind-kind",
+			},
+			Name:  "kind-kind",
+			Scope: "/planes/radius/local/resourceGroups/test-group",
+		}
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:         workspace,
+			Output:            outputSink,
+
+			// Populated by Validate()
+			ApplicationName: "test-app",
+			EnvironmentName: "test-env",
+			Format:          format,
+		}
+
+		return runner, outputSink
+	}
+
+	expectedGraph := &applicationGraph{
+		ApplicationName: "test-app",
+		Nodes: []graphNode{
+			{ID: backendResourceID, Name: "backend", Type: "Applications.Core/containers", Kind: nodeKindResource},
+			{ID: redisResourceID, Name: "redis", Type: "Applications.Datastores/redisCaches", Kind: nodeKindResource},
+		},
+		Edges: []graphEdge{
+			{From: backendResourceID, To: redisResourceID, Kind: edgeKindConnection, Name: "redis"},
+		},
+	}
+
+	t.Run("dot", func(t *testing.T) {
+		runner, outputSink := setup(t, formatDOT)
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: displayDOT(expectedGraph),
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("mermaid", func(t *testing.T) {
+		runner, outputSink := setup(t, formatMermaid)
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: displayMermaid(expectedGraph),
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("json", func(t *testing.T) {
+		runner, outputSink := setup(t, output.FormatJson)
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format: output.FormatJson,
+				Obj:    expectedGraph,
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("default", func(t *testing.T) {
+		runner, outputSink := setup(t, output.DefaultFormat)
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: app_connections.Render("test-app", applicationResources, environmentResources),
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package graph
+
+import (
+	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
+)
+
+// This file contains shared variables and functions used in tests.
+
+var environmentResourceID = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/test-env"
+var applicationResourceID = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/test-app"
+var frontendResourceID = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/frontend"
+var backendResourceID = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/backend"
+var gatewayResourceID = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/gateways/gateway"
+var redisResourceID = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Datastores/redisCaches/redis"
+
+var awsMemoryDBResourceID = "/planes/aws/aws/accounts/00000000/regions/us-west-2/providers/AWS.MemoryDB/Cluster/redis-aqbjixghynqgg"
+var azureStorageResourceID = "/subscriptions/00000000/resourceGroups/azure-group/providers/Microsoft.Storage/storageAccounts/storage"
+
+var backendDeploymentResourceID = resources_kubernetes.IDFromParts(resources_kubernetes.PlaneNameTODO, "apps", "Deployment", "default-test-app", "backend").String()
+var backendDeploymentOutputResource any = map[string]any{"id": backendDeploymentResourceID}
+var redisAWSOutputResource any = map[string]any{"id": awsMemoryDBResourceID}
+
+// makeResourceProperties creates a map of resource properties for a resource.
+//
+// connections should contain a map of name -> resource ID or URL.
+// outputResources should contain the list of output resources.
+func makeResourceProperties(connections map[string]string, outputResources []any) map[string]any {
+	properties := map[string]any{}
+
+	if connections != nil {
+		c := map[string]any{}
+		for name, source := range connections {
+			c[name] = map[string]any{
+				"source": source,
+			}
+		}
+		properties["connections"] = c
+	}
+
+	if len(outputResources) > 0 {
+		properties["status"] = map[string]any{
+			"outputResources": outputResources,
+		}
+	}
+
+	return properties
+}
+
+// makeGatewayProperties creates a map of resource properties for a gateway.
+//
+// routes should contain a map of path -> destination.
+func makeGatewayProperties(routes map[string]string) map[string]any {
+	r := []any{}
+	for path, destination := range routes {
+		r = append(r, map[string]any{
+			"path":        path,
+			"destination": destination,
+		})
+	}
+
+	return map[string]any{"routes": r}
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package graph
+
+const (
+	// nodeKindResource is the kind of the nodes representing Radius resources, such as containers, gateways and
+	// portable resources.
+	nodeKindResource = "resource"
+
+	// nodeKindOutputResource is the kind of the nodes representing the output resources of Radius resources, such as
+	// a Kubernetes Deployment or the cloud resources created by a recipe.
+	nodeKindOutputResource = "outputResource"
+
+	// nodeKindExternal is the kind of the nodes representing resources and endpoints referenced by Radius resources,
+	// but not managed by Radius.
+	nodeKindExternal = "external"
+
+	// edgeKindConnection is the kind of the edges representing connections.
+	edgeKindConnection = "connection"
+
+	// edgeKindRoute is the kind of the edges representing gateway routes.
+	edgeKindRoute = "route"
+
+	// edgeKindRecipe is the kind of the edges from portable resources to the output resources created by their recipe.
+	edgeKindRecipe = "recipe"
+
+	// edgeKindOutput is the kind of the edges from resources to the output resources they own, when they're not created
+	// by a recipe.
+	edgeKindOutput = "output"
+)
+
+// applicationGraph represents the dependency graph of a Radius Application as a list of nodes and directed edges.
+//
+// The application graph supports serialization to JSON.
+type applicationGraph struct {
+	// ApplicationName is the name of the application.
+	ApplicationName string `json:"applicationName"`
+
+	// Nodes is the set of resources in the graph, sorted by kind, type, name and id.
+	Nodes []graphNode `json:"nodes"`
+
+	// Edges is the set of dependencies between the nodes, sorted by source, destination, kind and name.
+	Edges []graphEdge `json:"edges"`
+
+	// HasCycle is true if the connections and routes of the application form a cycle.
+	HasCycle bool `json:"hasCycle"`
+}
+
+// graphNode represents a resource in the application graph.
+type graphNode struct {
+	// ID is the resource id, or the URL of an external endpoint.
+	ID string `json:"id"`
+
+	// Name is the name of the resource.
+	Name string `json:"name"`
+
+	// Type is the resource type. The type is empty for external endpoints.
+	Type string `json:"type"`
+
+	// Kind is the kind of node: resource, outputResource or external.
+	Kind string `json:"kind"`
+
+	// Provider is the provider of an output resource (eg: kubernetes, azure, aws).
+	Provider string `json:"provider,omitempty"`
+}
+
+// graphEdge represents a dependency between two resources in the application graph.
+type graphEdge struct {
+	// From is the id of the dependent resource (eg: an 'Applications.Core/containers').
+	From string `json:"from"`
+
+	// To is the id of the resource depended on (eg: an 'Applications.Datastores/redisCaches').
+	To string `json:"to"`
+
+	// Kind is the kind of edge: connection, route, recipe or output.
+	Kind string `json:"kind"`
+
+	// Name is the name of the connection, or the path of the route. This field is empty for other kinds of edges.
+	Name string `json:"name,omitempty"`
+}
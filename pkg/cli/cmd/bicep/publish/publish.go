@@ -21,6 +21,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"os"
 	"strings"
 
 	credentials "github.com/oras-project/oras-credentials-go"
@@ -29,6 +31,7 @@ import (
 	"github.com/radius-project/radius/pkg/cli/connections"
 	"github.com/radius-project/radius/pkg/cli/framework"
 	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/rp/util"
 
 	"github.com/opencontainers/go-digest"
 	specs "github.com/opencontainers/image-spec/specs-go"
@@ -67,11 +70,22 @@ func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
 		Long: `Publish a Bicep file to an OCI registry.
 This command compiles and publishes a local Bicep file to a remote Open Container Initiative (OCI) registry, such as Azure Container Registry, Docker Hub, or GitHub Container Registry, to later be used as a Bicep registry or for Radius Recipes.
 Before publishing, it is expected the user runs docker login (or similar command) and has the proper permission to push to the target OCI registry.
+Alternatively, the registry credentials can be provided as a username and password, a bearer token, or a docker config JSON file.
+The password and the bearer token are read from standard input so that they don't appear in the shell history or the process list.
 For more information on Bicep modules visit https://learn.microsoft.com/azure/azure-resource-manager/bicep/modules
 		`,
 		Example: `
 # Publish a Bicep file to a container registry
 rad bicep publish --file ./redis-test.bicep --target br:ghcr.io/myregistry/redis-test:v1
+
+# Publish a Bicep file to a private container registry using a username and a password read from standard input
+echo $PASSWORD | rad bicep publish --file ./redis-test.bicep --target br:myregistry.azurecr.io/redis-test:v1 --username myuser --password-stdin
+
+# Publish a Bicep file to a private container registry using a bearer token read from standard input
+echo $TOKEN | rad bicep publish --file ./redis-test.bicep --target br:myregistry.azurecr.io/redis-test:v1 --token-stdin
+
+# Publish a Bicep file to a private container registry using the credentials in a docker config JSON file
+rad bicep publish --file ./redis-test.bicep --target br:myregistry.azurecr.io/redis-test:v1 --registry-config ./config.json
 		`,
 		Args: cobra.ExactArgs(0),
 		RunE: framework.RunCommand(runner),
@@ -81,6 +95,12 @@ rad bicep publish --file ./redis-test.bicep --target br:ghcr.io/myregistry/redis
 	_ = cmd.MarkFlagRequired("file")
 	cmd.Flags().String("target", "", "remote OCI registry path, in the format 'br:HOST/PATH:TAG'.")
 	_ = cmd.MarkFlagRequired("target")
+	cmd.Flags().String("username", "", "The username used to authenticate to the OCI registry.")
+	cmd.Flags().Bool("password-stdin", false, "Read the password used to authenticate to the OCI registry from standard input.")
+	cmd.Flags().Bool("token-stdin", false, "Read the bearer token used to authenticate to the OCI registry from standard input.")
+	cmd.Flags().String("registry-config", "", "path to a docker config JSON file containing the credentials of the OCI registry.")
+	cmd.MarkFlagsRequiredTogether("username", "password-stdin")
+	cmd.MarkFlagsMutuallyExclusive("username", "token-stdin", "registry-config")
 
 	return cmd, runner
 }
@@ -92,11 +112,15 @@ type Runner struct {
 	ConnectionFactory connections.Factory
 	Output            output.Interface
 
-	File          string
-	Target        string
-	Destination   *destination
-	Template      map[string]any
-	TemplateBytes []byte
+	File           string
+	Target         string
+	Username       string
+	Password       string
+	Token          string
+	RegistryConfig string
+	Destination    *destination
+	Template       map[string]any
+	TemplateBytes  []byte
 }
 
 // NewRunner creates a new instance of the `rad bicep publish` runner.
@@ -131,9 +155,53 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 
 	r.Target = strings.TrimPrefix(target, "br:")
 
+	r.Username, err = cmd.Flags().GetString("username")
+	if err != nil {
+		return err
+	}
+	passwordStdin, err := cmd.Flags().GetBool("password-stdin")
+	if err != nil {
+		return err
+	}
+	if passwordStdin {
+		r.Password, err = readSecret(cmd.InOrStdin(), "password")
+		if err != nil {
+			return err
+		}
+	}
+	tokenStdin, err := cmd.Flags().GetBool("token-stdin")
+	if err != nil {
+		return err
+	}
+	if tokenStdin {
+		r.Token, err = readSecret(cmd.InOrStdin(), "token")
+		if err != nil {
+			return err
+		}
+	}
+	r.RegistryConfig, err = cmd.Flags().GetString("registry-config")
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
+// readSecret reads a secret from the given reader, ignoring the trailing newline.
+func readSecret(reader io.Reader, name string) (string, error) {
+	b, err := io.ReadAll(reader)
+	if err != nil {
+		return "", clierrors.MessageWithCause(err, "Failed to read the %s from standard input.", name)
+	}
+
+	secret := strings.TrimSuffix(strings.TrimSuffix(string(b), "\n"), "\r")
+	if secret == "" {
+		return "", clierrors.Message("The %s read from standard input is empty.", name)
+	}
+
+	return secret, nil
+}
+
 // Run runs the `rad bicep publish` command.
 //
 
@@ -227,10 +295,7 @@ func (r *Runner) prepareSource(ctx context.Context) (*memory.Store, error) {
 }
 
 func (r *Runner) prepareDestination(ctx context.Context) (*remote.Repository, error) {
-	// Create a new credential store from Docker to get local credentials
-	ds, err := credentials.NewStoreFromDocker(credentials.StoreOptions{
-		AllowPlaintextPut: true,
-	})
+	credential, err := r.credential()
 	if err != nil {
 		return nil, err
 	}
@@ -243,12 +308,49 @@ func (r *Runner) prepareDestination(ctx context.Context) (*remote.Repository, er
 	dst.Client = &auth.Client{
 		Client:     retry.DefaultClient,
 		Cache:      auth.DefaultCache,
-		Credential: ds.Get,
+		Credential: credential,
 	}
 
 	return dst, nil
 }
 
+// credential returns the function providing the credentials of the target registry. The credentials given on the
+// command line take precedence over the local credentials from Docker.
+func (r *Runner) credential() (func(context.Context, string) (auth.Credential, error), error) {
+	switch {
+	case r.Username != "":
+		return auth.StaticCredential(r.Destination.host, auth.Credential{Username: r.Username, Password: r.Password}), nil
+	case r.Token != "":
+		return auth.StaticCredential(r.Destination.host, auth.Credential{AccessToken: r.Token}), nil
+	case r.RegistryConfig != "":
+		config, err := os.ReadFile(r.RegistryConfig)
+		if err != nil {
+			return nil, err
+		}
+
+		host := r.Destination.host
+		if host == "index.docker.io" {
+			host = "docker.io"
+		}
+		credential, err := util.DockerConfigCredential(config, host)
+		if err != nil {
+			return nil, err
+		}
+
+		return auth.StaticCredential(r.Destination.host, credential), nil
+	}
+
+	// Create a new credential store from Docker to get local credentials
+	ds, err := credentials.NewStoreFromDocker(credentials.StoreOptions{
+		AllowPlaintextPut: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ds.Get, nil
+}
+
 // extractDestination extracts the host, repo, and tag from the target
 func (r *Runner) extractDestination() (*destination, error) {
 	ref, err := registry.ParseReference(r.Target)
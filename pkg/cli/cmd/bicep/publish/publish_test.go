@@ -18,20 +18,30 @@ package bicep
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
 	"reflect"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/opencontainers/go-digest"
 	specs "github.com/opencontainers/image-spec/specs-go"
 	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
 	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
 	"github.com/radius-project/radius/test/radcli"
 	"github.com/stretchr/testify/require"
 	"oras.land/oras-go/v2"
 	"oras.land/oras-go/v2/content/memory"
 	"oras.land/oras-go/v2/registry"
 	"oras.land/oras-go/v2/registry/remote"
+	"oras.land/oras-go/v2/registry/remote/auth"
 )
 
 func TestRunner_extractDestination(t *testing.T) {
@@ -241,6 +251,113 @@ func TestRunner_prepareDestination(t *testing.T) {
 	}
 }
 
+// newTestRegistry creates a stand-in OCI registry serving the manifest "repo:tag" to the requests with the given
+// Authorization header and challenging the others.
+func newTestRegistry(t *testing.T, challenge string, authorization string) *httptest.Server {
+	manifest := []byte(`{"schemaVersion":2}`)
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != authorization {
+			w.Header().Set("WWW-Authenticate", challenge)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.URL.Path != "/v2/repo/manifests/tag" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", ocispec.MediaTypeImageManifest)
+		w.Header().Set("Docker-Content-Digest", digest.FromBytes(manifest).String())
+		w.Header().Set("Content-Length", strconv.Itoa(len(manifest)))
+		_, _ = w.Write(manifest)
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestRunner_prepareDestination_Credentials(t *testing.T) {
+	basic := "Basic " + base64.StdEncoding.EncodeToString([]byte("user:secret"))
+	basicChallenge := `Basic realm="test"`
+
+	configFile := filepath.Join(t.TempDir(), "config.json")
+
+	tests := []struct {
+		name           string
+		challenge      string
+		authorization  string
+		runner         Runner
+		registryConfig func(host string) string
+		wantErr        string
+	}{
+		{
+			name:          "username and password",
+			challenge:     basicChallenge,
+			authorization: basic,
+			runner:        Runner{Username: "user", Password: "secret"},
+		},
+		{
+			name:          "wrong password",
+			challenge:     basicChallenge,
+			authorization: basic,
+			runner:        Runner{Username: "user", Password: "wrong"},
+			wantErr:       "401",
+		},
+		{
+			name:          "bearer token",
+			challenge:     `Bearer realm="https://auth.example.com/token",service="test"`,
+			authorization: "Bearer token",
+			runner:        Runner{Token: "token"},
+		},
+		{
+			name:          "registry config",
+			challenge:     basicChallenge,
+			authorization: basic,
+			runner:        Runner{RegistryConfig: configFile},
+			registryConfig: func(host string) string {
+				return `{"auths":{"` + host + `":{"auth":"` + base64.StdEncoding.EncodeToString([]byte("user:secret")) + `"}}}`
+			},
+		},
+		{
+			name:          "registry config without the registry",
+			challenge:     basicChallenge,
+			authorization: basic,
+			runner:        Runner{RegistryConfig: configFile},
+			registryConfig: func(host string) string {
+				return `{"auths":{"ghcr.io":{"auth":"` + base64.StdEncoding.EncodeToString([]byte("user:secret")) + `"}}}`
+			},
+			wantErr: "docker config doesn't contain credentials for registry",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newTestRegistry(t, tt.challenge, tt.authorization)
+			serverURL, err := url.Parse(server.URL)
+			require.NoError(t, err)
+
+			if tt.registryConfig != nil {
+				err = os.WriteFile(configFile, []byte(tt.registryConfig(serverURL.Host)), 0600)
+				require.NoError(t, err)
+			}
+
+			r := tt.runner
+			r.Destination = &destination{host: serverURL.Host, repo: "repo", tag: "tag"}
+
+			dst, err := r.prepareDestination(context.Background())
+			if err == nil {
+				dst.Client.(*auth.Client).Client = server.Client()
+				_, err = dst.Resolve(context.Background(), "tag")
+			}
+
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestRunner_Validate(t *testing.T) {
 	tests := []radcli.ValidateInput{
 		{
@@ -291,3 +408,58 @@ func TestRunner_Validate(t *testing.T) {
 	}
 	radcli.SharedValidateValidation(t, NewCommand, tests)
 }
+
+func TestRunner_Validate_CredentialsFromStdin(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []string
+		stdin    string
+		expected Runner
+		err      string
+	}{
+		{
+			name:     "password",
+			input:    []string{"--username", "user", "--password-stdin"},
+			stdin:    "secret\n",
+			expected: Runner{Username: "user", Password: "secret"},
+		},
+		{
+			name:     "token",
+			input:    []string{"--token-stdin"},
+			stdin:    "token\r\n",
+			expected: Runner{Token: "token"},
+		},
+		{
+			name:  "empty password",
+			input: []string{"--username", "user", "--password-stdin"},
+			stdin: "\n",
+			err:   "The password read from standard input is empty.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			factory := &framework.Impl{
+				ConfigHolder: &framework.ConfigHolder{},
+				Output:       &output.MockOutput{},
+			}
+			cmd, runner := NewCommand(factory)
+			cmd.SetIn(strings.NewReader(tt.stdin))
+
+			input := append([]string{"--file", "redis.recipe.bicep", "--target", "br:ghcr.io/test-registry/test/repo:tag"}, tt.input...)
+			require.NoError(t, cmd.ParseFlags(input))
+
+			err := runner.Validate(cmd, cmd.Flags().Args())
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			r := runner.(*Runner)
+			require.Equal(t, tt.expected.Username, r.Username)
+			require.Equal(t, tt.expected.Password, r.Password)
+			require.Equal(t, tt.expected.Token, r.Token)
+		})
+	}
+}
@@ -129,11 +129,8 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 		return clierrors.Message("Secret Access Key %q cannot be empty.", r.SecretAccessKey)
 	}
 
-	kubeContext, ok := r.Workspace.KubernetesContext()
-	if !ok {
-		return clierrors.Message("A Kubernetes connection is required.")
-	}
-	r.KubeContext = kubeContext
+	// Credentials are stored through the Radius API, so workspaces without a Kubernetes connection are supported.
+	r.KubeContext, _ = r.Workspace.KubernetesContext()
 	return nil
 }
 
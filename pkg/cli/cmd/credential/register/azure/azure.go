@@ -22,7 +22,6 @@ import (
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/cli"
-	"github.com/radius-project/radius/pkg/cli/clierrors"
 	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
 	"github.com/radius-project/radius/pkg/cli/cmd/credential/common"
 	"github.com/radius-project/radius/pkg/cli/connections"
@@ -141,11 +140,8 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 	r.ClientSecret = clientSecret
 	r.TenantID = tenantID
 
-	kubeContext, ok := r.Workspace.KubernetesContext()
-	if !ok {
-		return clierrors.Message("A Kubernetes connection is required.")
-	}
-	r.KubeContext = kubeContext
+	// Credentials are stored through the Radius API, so workspaces without a Kubernetes connection are supported.
+	r.KubeContext, _ = r.Workspace.KubernetesContext()
 
 	return nil
 }
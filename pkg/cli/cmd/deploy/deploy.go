@@ -19,6 +19,7 @@ package deploy
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/cli"
@@ -29,9 +30,11 @@ import (
 	"github.com/radius-project/radius/pkg/cli/connections"
 	"github.com/radius-project/radius/pkg/cli/deploy"
 	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/objectformats"
 	"github.com/radius-project/radius/pkg/cli/output"
 	"github.com/radius-project/radius/pkg/cli/workspaces"
 	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/recipes/armtemplate"
 	"github.com/radius-project/radius/pkg/to"
 	"github.com/spf13/cobra"
 )
@@ -67,6 +70,10 @@ func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
 	
 	You can specify parameters using multiple sources. Parameters can be overridden based on the 
 	order the are provided. Parameters appearing later in the argument list will override those defined earlier.
+
+	You can preview the changes the recipes of the portable resources declared by the template make using the '--preview'
+	flag. The template is not deployed when previewing. Template expressions which can't be evaluated before the
+	deployment are listed, and the previews using them are reported as partial.
 	`,
 		Example: `
 # deploy a Bicep template
@@ -98,6 +105,9 @@ rad deploy myapp.bicep --parameters @myfile.json
 
 # specify parameters from multiple sources
 rad deploy myapp.bicep --parameters @myfile.json --parameters version=latest
+
+# preview the changes made by the recipes of the portable resources without deploying the template
+rad deploy myapp.bicep --preview
 `,
 		Args: cobra.ExactArgs(1),
 		RunE: framework.RunCommand(runner),
@@ -108,6 +118,7 @@ rad deploy myapp.bicep --parameters @myfile.json --parameters version=latest
 	commonflags.AddEnvironmentNameFlag(cmd)
 	commonflags.AddApplicationNameFlag(cmd)
 	commonflags.AddParameterFlag(cmd)
+	cmd.Flags().Bool("preview", false, "Preview the changes made by the recipes of the portable resources declared by the template, without deploying it")
 
 	return cmd, runner
 }
@@ -124,6 +135,7 @@ type Runner struct {
 	EnvironmentName string
 	FilePath        string
 	Parameters      map[string]map[string]any
+	Preview         bool
 	Workspace       *workspaces.Workspace
 	Providers       *clients.Providers
 }
@@ -232,6 +244,14 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	// The runner is shared with `rad run`, which doesn't support previewing.
+	if cmd.Flags().Lookup("preview") != nil {
+		r.Preview, err = cmd.Flags().GetBool("preview")
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -246,6 +266,10 @@ func (r *Runner) Run(ctx context.Context) error {
 		return err
 	}
 
+	if r.Preview {
+		return r.preview(ctx, template)
+	}
+
 	// Create application if specified. This supports the case where the application resource
 	// is not specified in Bicep. Creating the application automatically helps us "bootstrap" in a new environment.
 	if r.ApplicationName != "" {
@@ -303,3 +327,126 @@ func (r *Runner) Run(ctx context.Context) error {
 
 	return nil
 }
+
+// preview displays the changes the recipes of the portable resources declared by the template make when the template is
+// deployed, without deploying it. The resources are evaluated from the template and parameters, so the changes of
+// resources whose name is only known during the deployment can't be previewed.
+func (r *Runner) preview(ctx context.Context, template map[string]any) error {
+	templateResources, err := r.EvaluateTemplateResources(template)
+	if err != nil {
+		return err
+	}
+
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	r.Output.LogInfo("Previewing the recipe changes of template '%v' in environment '%v' from workspace '%v'...", r.FilePath, r.EnvironmentName, r.Workspace.Name)
+
+	previewed := 0
+	for _, resource := range templateResources {
+		if !strings.EqualFold(resource.Import, armtemplate.ImportRadius) || resource.Existing || !supportsPreview(resource.Type) {
+			continue
+		}
+
+		previewed++
+		r.Output.LogInfo("")
+		if !resource.NameResolved {
+			r.Output.LogInfo("%s %s: the name of the resource is only known during the deployment, its changes can't be previewed.", resource.SymbolicName, resource.Type)
+			r.logUnresolved(resource.Unresolved)
+			continue
+		}
+
+		plan, err := client.PlanResource(ctx, resource.Type, resource.Name, resource.Body)
+		if err != nil {
+			return err
+		}
+
+		r.Output.LogInfo("%s %s:", resource.Name, resource.Type)
+		if len(resource.Unresolved) > 0 {
+			r.Output.LogInfo("The preview is partial, these expressions of the resource can't be evaluated before the deployment:")
+			r.logUnresolved(resource.Unresolved)
+		}
+
+		if len(plan.Changes) == 0 {
+			r.Output.LogInfo("No changes")
+			continue
+		}
+
+		err = r.Output.WriteFormatted(output.FormatTable, plan.Changes, objectformats.GetRecipePlanTableFormat())
+		if err != nil {
+			return err
+		}
+
+		// Changes of recipe resources whose template expressions can't be evaluated are only partially known.
+		for _, change := range plan.Changes {
+			if len(change.Unresolved) == 0 {
+				continue
+			}
+
+			r.Output.LogInfo("The change of %s is partial, these expressions of the recipe can't be evaluated before the deployment:", to.String(change.Resource))
+			for _, unresolved := range change.Unresolved {
+				r.Output.LogInfo("  - %s", to.String(unresolved))
+			}
+		}
+	}
+
+	if previewed == 0 {
+		r.Output.LogInfo("")
+		r.Output.LogInfo("The template doesn't declare portable resources whose recipe changes can be previewed.")
+	}
+
+	return nil
+}
+
+// logUnresolved displays the template expressions which can't be evaluated before the deployment.
+func (r *Runner) logUnresolved(unresolved []armtemplate.UnresolvedExpression) {
+	for _, expression := range unresolved {
+		r.Output.LogInfo("  - %s", expression.String())
+	}
+}
+
+// EvaluateTemplateResources returns the resources declared by the template, evaluated with the parameters of the
+// deployment and the IDs of the environment and application. The names and bodies of the resources are only resolved
+// when they don't depend on values known during the deployment.
+func (r *Runner) EvaluateTemplateResources(template map[string]any) ([]armtemplate.Resource, error) {
+	err := bicep.InjectEnvironmentParam(template, r.Parameters, r.Providers.Radius.EnvironmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = bicep.InjectApplicationParam(template, r.Parameters, r.Providers.Radius.ApplicationID)
+	if err != nil {
+		return nil, err
+	}
+
+	parameters := map[string]any{}
+	for name, parameter := range r.Parameters {
+		parameters[name] = parameter
+	}
+
+	evaluator, err := armtemplate.NewEvaluator(template, parameters)
+	if err != nil {
+		return nil, err
+	}
+	evaluator.Scope = r.Workspace.Scope
+
+	templateResources, err := evaluator.Resources()
+	if err != nil {
+		return nil, clierrors.MessageWithCause(err, "Failed to read the resources of template '%s'.", r.FilePath)
+	}
+
+	return templateResources, nil
+}
+
+// supportsPreview returns true if the recipe changes of resources of the given type can be previewed.
+func supportsPreview(resourceType string) bool {
+	for _, t := range clients.PlanResourceTypesList {
+		if strings.EqualFold(t, resourceType) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/radius-project/radius/pkg/cli/connections"
 	"github.com/radius-project/radius/pkg/cli/deploy"
 	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/objectformats"
 	"github.com/radius-project/radius/pkg/cli/output"
 	"github.com/radius-project/radius/pkg/cli/workspaces"
 	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
@@ -58,6 +59,21 @@ func Test_Validate(t *testing.T) {
 					Times(1)
 			},
 		},
+		{
+			Name:          "rad deploy - valid with preview",
+			Input:         []string{"app.bicep", "--preview"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ConfigureMocks: func(mocks radcli.ValidateMocks) {
+				mocks.ApplicationManagementClient.EXPECT().
+					GetEnvDetails(gomock.Any(), radcli.TestEnvironmentName).
+					Return(v20231001preview.EnvironmentResource{}, nil).
+					Times(1)
+			},
+		},
 		{
 			Name:          "rad deploy - valid with parameters",
 			Input:         []string{"app.bicep", "-p", "foo=bar", "--parameters", "a=b"},
@@ -491,4 +507,149 @@ func Test_Run(t *testing.T) {
 		// is always empty.
 		require.Empty(t, outputSink.Writes)
 	})
+	t.Run("Preview", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		template := map[string]any{
+			"parameters": map[string]any{
+				"environment": map[string]any{"type": "string"},
+			},
+			"resources": map[string]any{
+				"redis": map[string]any{
+					"import": "Radius",
+					"type":   "Applications.Datastores/redisCaches@2023-10-01-preview",
+					"properties": map[string]any{
+						"name": "[format('{0}-redis', 'app')]",
+						"properties": map[string]any{
+							"environment": "[parameters('environment')]",
+							"host":        "[reference('queue').properties.host]",
+						},
+					},
+				},
+				"container": map[string]any{
+					"import": "Radius",
+					"type":   "Applications.Core/containers@2023-10-01-preview",
+					"properties": map[string]any{
+						"name": "frontend",
+					},
+				},
+				"queue": map[string]any{
+					"import": "Radius",
+					"type":   "Applications.Messaging/rabbitMQQueues@2023-10-01-preview",
+					"properties": map[string]any{
+						"name": "[uniqueString(resourceGroup().id)]",
+					},
+				},
+			},
+		}
+
+		bicep := bicep.NewMockInterface(ctrl)
+		bicep.EXPECT().
+			PrepareTemplate("app.bicep").
+			Return(template, nil).
+			Times(1)
+
+		environmentID := "/planes/radius/local/resourceGroups/test-group/providers/applications.core/environments/test-env"
+		changes := []*v20231001preview.RecipeResourceChange{
+			{
+				Resource:     to.Ptr("/planes/kubernetes/local/namespaces/default/providers/apps/Deployment/redis"),
+				ResourceType: to.Ptr("apps/Deployment"),
+				ChangeType:   to.Ptr(v20231001preview.RecipeChangeTypeCreate),
+			},
+			{
+				Resource:     to.Ptr("service"),
+				ResourceType: to.Ptr("core/Service"),
+				ChangeType:   to.Ptr(v20231001preview.RecipeChangeTypeUnknown),
+				Unresolved:   []*string{to.Ptr(`[uniqueString(parameters('context').resource.id)] (template function isn't supported: "uniqueString")`)},
+			},
+		}
+
+		appManagmentMock := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagmentMock.EXPECT().
+			PlanResource(gomock.Any(), "Applications.Datastores/redisCaches", "app-redis", map[string]any{
+				"name": "app-redis",
+				"properties": map[string]any{
+					"environment": environmentID,
+					"host":        "[reference('queue').properties.host]",
+				},
+			}).
+			Return(v20231001preview.RecipePlanResult{Changes: changes}, nil).
+			Times(1)
+
+		// The template is not deployed when previewing.
+		deployMock := deploy.NewMockInterface(ctrl)
+
+		workspace := &workspaces.Workspace{
+			Connection: map[string]any{
+				"kind":    "kubernetes",
+				"context": "kind-kind",
+			},
+			Name:  "kind-kind",
+			Scope: "/planes/radius/local/resourceGroups/test-group",
+		}
+		outputSink := &output.MockOutput{}
+
+		runner := &Runner{
+			Bicep:             bicep,
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagmentMock},
+			Deploy:            deployMock,
+			Output:            outputSink,
+			Providers: &clients.Providers{
+				Radius: &clients.RadiusProvider{
+					EnvironmentID: environmentID,
+				},
+			},
+			FilePath:        "app.bicep",
+			EnvironmentName: "test-env",
+			Parameters:      map[string]map[string]any{},
+			Preview:         true,
+			Workspace:       workspace,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Previewing the recipe changes of template '%v' in environment '%v' from workspace '%v'...",
+				Params: []any{"app.bicep", "test-env", "kind-kind"},
+			},
+			output.LogOutput{Format: ""},
+			output.LogOutput{
+				Format: "%s %s: the name of the resource is only known during the deployment, its changes can't be previewed.",
+				Params: []any{"queue", "Applications.Messaging/rabbitMQQueues"},
+			},
+			output.LogOutput{
+				Format: "  - %s",
+				Params: []any{`[uniqueString(resourceGroup().id)] (template function isn't supported: "resourceGroup")`},
+			},
+			output.LogOutput{Format: ""},
+			output.LogOutput{
+				Format: "%s %s:",
+				Params: []any{"app-redis", "Applications.Datastores/redisCaches"},
+			},
+			output.LogOutput{
+				Format: "The preview is partial, these expressions of the resource can't be evaluated before the deployment:",
+			},
+			output.LogOutput{
+				Format: "  - %s",
+				Params: []any{`[reference('queue').properties.host] (expression can't be resolved before deployment: property "properties" of resource "queue")`},
+			},
+			output.FormattedOutput{
+				Format:  "table",
+				Obj:     changes,
+				Options: objectformats.GetRecipePlanTableFormat(),
+			},
+			output.LogOutput{
+				Format: "The change of %s is partial, these expressions of the recipe can't be evaluated before the deployment:",
+				Params: []any{"service"},
+			},
+			output.LogOutput{
+				Format: "  - %s",
+				Params: []any{`[uniqueString(parameters('context').resource.id)] (template function isn't supported: "uniqueString")`},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
 }
@@ -0,0 +1,300 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diff
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/recipes/armtemplate"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	// StatusCreate is the status of a resource declared by the template which isn't deployed.
+	StatusCreate = "create"
+
+	// StatusUpdate is the status of a deployed resource whose properties differ from the template.
+	StatusUpdate = "update"
+
+	// StatusUnchanged is the status of a deployed resource whose properties match the template.
+	StatusUnchanged = "unchanged"
+
+	// StatusUnknown is the status of a resource whose name is only known during the deployment.
+	StatusUnknown = "unknown"
+
+	// ChangeAdded is the kind of change of a property declared by the template which isn't deployed.
+	ChangeAdded = "added"
+
+	// ChangeRemoved is the kind of change of a deployed property which isn't declared by the template.
+	ChangeRemoved = "removed"
+
+	// ChangeChanged is the kind of change of a property whose deployed value differs from the template.
+	ChangeChanged = "changed"
+)
+
+// readOnlyProperties are the read-only properties of Radius resources, which are never compared.
+var readOnlyProperties = map[string]bool{
+	"provisioningState": true,
+	"status":            true,
+}
+
+// ResourceDiff is the difference between a resource declared by a template and the deployed resource.
+type ResourceDiff struct {
+	// Name is the name of the resource. It is the symbolic name of the resource when the status is unknown.
+	Name string `json:"name"`
+
+	// Type is the resource type.
+	Type string `json:"type"`
+
+	// Status is the status of the resource: create, update, unchanged or unknown.
+	Status string `json:"status"`
+
+	// Changes are the changes of the properties of the resource, sorted by path.
+	Changes []PropertyChange `json:"changes"`
+
+	// Unresolved are the template expressions of the resource which can't be evaluated before the deployment, with the
+	// reason they can't be. The properties using them aren't compared, so the comparison is partial when there are any.
+	Unresolved []string `json:"unresolved,omitempty"`
+}
+
+// PropertyChange is the difference between a property declared by a template and the deployed property.
+type PropertyChange struct {
+	// Path is the path of the property, such as 'properties.container.image'.
+	Path string `json:"path"`
+
+	// Change is the kind of change: added, removed or changed.
+	Change string `json:"change"`
+
+	// Deployed is the deployed value of the property. It is nil for added properties.
+	Deployed any `json:"deployed,omitempty"`
+
+	// Template is the value of the property declared by the template. It is nil for removed properties.
+	Template any `json:"template,omitempty"`
+}
+
+// HasDrift returns true if deploying the template would create or update the resource.
+func (d ResourceDiff) HasDrift() bool {
+	return d.Status == StatusCreate || d.Status == StatusUpdate
+}
+
+// compare computes the difference between a resource declared by a template and the deployed resource, which is nil
+// if the resource isn't deployed.
+//
+// Read-only properties are ignored, as well as template values which are only known during the deployment. Deployed
+// properties which aren't declared by the template are reported as removed, unless they have an empty value, or are set
+// by the recipe of a portable resource.
+func compare(templateResource armtemplate.Resource, deployed *generated.GenericResource) ResourceDiff {
+	diff := ResourceDiff{Name: templateResource.Name, Type: templateResource.Type, Changes: []PropertyChange{}}
+	for _, unresolved := range templateResource.Unresolved {
+		diff.Unresolved = append(diff.Unresolved, unresolved.String())
+	}
+
+	if !templateResource.NameResolved {
+		if templateResource.SymbolicName != "" {
+			diff.Name = templateResource.SymbolicName
+		}
+		diff.Status = StatusUnknown
+		return diff
+	}
+
+	template := templateResource.Body
+	if deployed == nil {
+		diff.Status = StatusCreate
+		for _, key := range sortedKeys(template) {
+			if key == "name" {
+				continue
+			}
+
+			diff.Changes = append(diff.Changes, PropertyChange{Path: key, Change: ChangeAdded, Template: template[key]})
+		}
+
+		return diff
+	}
+
+	c := comparer{changes: []PropertyChange{}}
+	if location, ok := template["location"]; ok {
+		c.compareValues("location", location, toWeaklyTypedData(deployed.Location))
+	}
+	if tags, ok := template["tags"]; ok {
+		c.compareValues("tags", tags, toWeaklyTypedData(deployed.Tags))
+	}
+
+	templateProperties, _ := template["properties"].(map[string]any)
+	deployedProperties := map[string]any{}
+	for key, value := range deployed.Properties {
+		if !readOnlyProperties[key] {
+			deployedProperties[key] = value
+		}
+	}
+
+	// The properties of portable resources which aren't declared by the template are set by their recipe.
+	c.ignoreRemoved = isProvisionedByRecipe(deployed.Properties)
+	c.compareMaps("properties", templateProperties, deployedProperties)
+	c.ignoreRemoved = false
+
+	diff.Changes = c.changes
+	sort.SliceStable(diff.Changes, func(i, j int) bool {
+		return diff.Changes[i].Path < diff.Changes[j].Path
+	})
+
+	diff.Status = StatusUnchanged
+	if len(diff.Changes) > 0 {
+		diff.Status = StatusUpdate
+	}
+
+	return diff
+}
+
+// comparer accumulates the changes found while comparing values.
+type comparer struct {
+	changes []PropertyChange
+
+	// ignoreRemoved is true if the deployed properties which aren't declared by the template of the map being compared
+	// must be ignored.
+	ignoreRemoved bool
+}
+
+// compareValues compares a template value with a deployed value.
+func (c *comparer) compareValues(path string, template any, deployed any) {
+	if isUnresolved(template) {
+		return
+	}
+
+	templateMap, templateIsMap := template.(map[string]any)
+	deployedMap, deployedIsMap := deployed.(map[string]any)
+	if templateIsMap && deployedIsMap {
+		c.compareMaps(path, templateMap, deployedMap)
+		return
+	}
+
+	if isEmpty(deployed) && !isEmpty(template) {
+		c.changes = append(c.changes, PropertyChange{Path: path, Change: ChangeAdded, Template: template})
+		return
+	}
+
+	if !equal(template, deployed) {
+		c.changes = append(c.changes, PropertyChange{Path: path, Change: ChangeChanged, Deployed: deployed, Template: template})
+	}
+}
+
+// compareMaps compares the properties of a template object with the properties of a deployed object.
+func (c *comparer) compareMaps(path string, template map[string]any, deployed map[string]any) {
+	ignoreRemoved := c.ignoreRemoved
+	c.ignoreRemoved = false
+	defer func() { c.ignoreRemoved = ignoreRemoved }()
+
+	for _, key := range sortedKeys(template) {
+		c.compareValues(path+"."+key, template[key], deployed[key])
+	}
+
+	if ignoreRemoved {
+		return
+	}
+
+	for _, key := range sortedKeys(deployed) {
+		if _, ok := template[key]; ok || isEmpty(deployed[key]) {
+			continue
+		}
+
+		c.changes = append(c.changes, PropertyChange{Path: path + "." + key, Change: ChangeRemoved, Deployed: deployed[key]})
+	}
+}
+
+// isProvisionedByRecipe returns true if the properties are the properties of a portable resource provisioned by a recipe.
+func isProvisionedByRecipe(properties map[string]any) bool {
+	provisioning, ok := properties["resourceProvisioning"].(string)
+	return ok && !strings.EqualFold(provisioning, "manual")
+}
+
+// isUnresolved returns true if the template value contains expressions which are only known during the deployment.
+func isUnresolved(value any) bool {
+	switch v := value.(type) {
+	case string:
+		return strings.HasPrefix(v, "[") && strings.HasSuffix(v, "]") && !strings.HasPrefix(v, "[[")
+	case []any:
+		for _, item := range v {
+			if isUnresolved(item) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// isEmpty returns true for values which are equivalent to an unset property.
+func isEmpty(value any) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case bool:
+		return !v
+	case float64:
+		return v == 0
+	case map[string]any:
+		return len(v) == 0
+	case []any:
+		return len(v) == 0
+	}
+
+	return false
+}
+
+// equal compares a template value with a deployed value. Resource IDs are compared case-insensitively.
+func equal(template any, deployed any) bool {
+	templateString, templateIsString := template.(string)
+	deployedString, deployedIsString := deployed.(string)
+	if templateIsString && deployedIsString && strings.EqualFold(templateString, deployedString) {
+		_, err := resources.Parse(templateString)
+		return templateString == deployedString || err == nil
+	}
+
+	return reflect.DeepEqual(toWeaklyTypedData(template), toWeaklyTypedData(deployed))
+}
+
+// toWeaklyTypedData uses JSON marshalling and unmarshalling to convert a value to its JSON representation, so that
+// values of different Go types can be compared.
+func toWeaklyTypedData(value any) any {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return value
+	}
+
+	var result any
+	if err := json.Unmarshal(b, &result); err != nil {
+		return value
+	}
+
+	return result
+}
+
+// sortedKeys returns the keys of the map in sorted order.
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,180 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diff
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/recipes/armtemplate"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_compare(t *testing.T) {
+	containerID := "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/frontend"
+
+	resource := func(body map[string]any) armtemplate.Resource {
+		return armtemplate.Resource{
+			Name:         "frontend",
+			NameResolved: true,
+			Type:         "Applications.Core/containers",
+			Body:         body,
+		}
+	}
+
+	testcases := []struct {
+		name     string
+		template armtemplate.Resource
+		deployed *generated.GenericResource
+		expected ResourceDiff
+	}{
+		{
+			name: "unresolved name",
+			template: armtemplate.Resource{
+				SymbolicName: "queue",
+				Name:         "[uniqueString(resourceGroup().id)]",
+				Type:         "Applications.Messaging/rabbitMQQueues",
+				Body:         map[string]any{"name": "[uniqueString(resourceGroup().id)]"},
+			},
+			expected: ResourceDiff{Name: "queue", Type: "Applications.Messaging/rabbitMQQueues", Status: StatusUnknown, Changes: []PropertyChange{}},
+		},
+		{
+			name:     "not deployed",
+			template: resource(map[string]any{"name": "frontend", "properties": map[string]any{"application": "app"}}),
+			expected: ResourceDiff{
+				Name:   "frontend",
+				Type:   "Applications.Core/containers",
+				Status: StatusCreate,
+				Changes: []PropertyChange{
+					{Path: "properties", Change: ChangeAdded, Template: map[string]any{"application": "app"}},
+				},
+			},
+		},
+		{
+			name: "unchanged",
+			template: armtemplate.Resource{
+				Name:         "frontend",
+				NameResolved: true,
+				Type:         "Applications.Core/containers",
+				Body: map[string]any{
+					"name": "frontend",
+					"properties": map[string]any{
+						"container": map[string]any{"image": "nginx", "ports": map[string]any{"web": map[string]any{"containerPort": 80}}},
+						"source":    "[reference('db').id]",
+						"resource":  "/planes/radius/local/resourcegroups/test-group/providers/applications.core/containers/frontend",
+					},
+				},
+				Unresolved: []armtemplate.UnresolvedExpression{
+					{Expression: "[reference('db').id]", Reason: `expression can't be resolved before deployment: ID of resource "db"`},
+				},
+			},
+			deployed: &generated.GenericResource{
+				Properties: map[string]any{
+					"container":         map[string]any{"image": "nginx", "ports": map[string]any{"web": map[string]any{"containerPort": float64(80)}}},
+					"resource":          containerID,
+					"provisioningState": "Succeeded",
+					"status":            map[string]any{"outputResources": []any{}},
+					"connections":       map[string]any{},
+				},
+			},
+			expected: ResourceDiff{
+				Name:       "frontend",
+				Type:       "Applications.Core/containers",
+				Status:     StatusUnchanged,
+				Changes:    []PropertyChange{},
+				Unresolved: []string{`[reference('db').id] (expression can't be resolved before deployment: ID of resource "db")`},
+			},
+		},
+		{
+			name: "updated",
+			template: resource(map[string]any{
+				"name": "frontend",
+				"tags": map[string]any{"team": "web"},
+				"properties": map[string]any{
+					"container": map[string]any{"image": "nginx:latest", "env": map[string]any{"PORT": "80"}},
+				},
+			}),
+			deployed: &generated.GenericResource{
+				Properties: map[string]any{
+					"container": map[string]any{"image": "nginx", "command": []any{"run"}},
+				},
+			},
+			expected: ResourceDiff{
+				Name:   "frontend",
+				Type:   "Applications.Core/containers",
+				Status: StatusUpdate,
+				Changes: []PropertyChange{
+					{Path: "properties.container.command", Change: ChangeRemoved, Deployed: []any{"run"}},
+					{Path: "properties.container.env", Change: ChangeAdded, Template: map[string]any{"PORT": "80"}},
+					{Path: "properties.container.image", Change: ChangeChanged, Deployed: "nginx", Template: "nginx:latest"},
+					{Path: "tags", Change: ChangeAdded, Template: map[string]any{"team": "web"}},
+				},
+			},
+		},
+		{
+			name: "provisioned by recipe",
+			template: armtemplate.Resource{
+				Name:         "redis",
+				NameResolved: true,
+				Type:         "Applications.Datastores/redisCaches",
+				Body:         map[string]any{"name": "redis", "properties": map[string]any{"environment": "env"}},
+			},
+			deployed: &generated.GenericResource{
+				Location: to.Ptr("global"),
+				Properties: map[string]any{
+					"environment":          "env",
+					"resourceProvisioning": "recipe",
+					"host":                 "redis.svc",
+					"port":                 float64(6379),
+				},
+			},
+			expected: ResourceDiff{Name: "redis", Type: "Applications.Datastores/redisCaches", Status: StatusUnchanged, Changes: []PropertyChange{}},
+		},
+		{
+			name: "provisioned manually",
+			template: armtemplate.Resource{
+				Name:         "redis",
+				NameResolved: true,
+				Type:         "Applications.Datastores/redisCaches",
+				Body:         map[string]any{"name": "redis", "properties": map[string]any{"resourceProvisioning": "manual"}},
+			},
+			deployed: &generated.GenericResource{
+				Properties: map[string]any{
+					"resourceProvisioning": "manual",
+					"host":                 "redis.svc",
+				},
+			},
+			expected: ResourceDiff{
+				Name:   "redis",
+				Type:   "Applications.Datastores/redisCaches",
+				Status: StatusUpdate,
+				Changes: []PropertyChange{
+					{Path: "properties.host", Change: ChangeRemoved, Deployed: "redis.svc"},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			diff := compare(tc.template, tc.deployed)
+			require.Equal(t, tc.expected, diff)
+			require.Equal(t, tc.expected.Status == StatusCreate || tc.expected.Status == StatusUpdate, diff.HasDrift())
+		})
+	}
+}
@@ -0,0 +1,268 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diff
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	deploycmd "github.com/radius-project/radius/pkg/cli/cmd/deploy"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/objectformats"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/recipes/armtemplate"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// DriftExitCode is the exit code of the command when deploying the template would change resources.
+	DriftExitCode = 2
+
+	// maxValueLength is the maximum length of the values displayed in the table output.
+	maxValueLength = 50
+)
+
+// NewCommand creates an instance of the command and runner for the `rad diff` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "diff [file]",
+		Short: "Compare a template with the deployed resources",
+		Long: `Compare a Bicep or ARM template with the deployed resources
+
+The diff command compiles a Bicep or ARM template, and compares the Radius resources it declares with the resources
+deployed in your default environment (unless otherwise specified). It displays the resources that deploying the template
+would create, and the properties it would add, remove or change in the deployed resources.
+
+Read-only properties such as the status of the resources are ignored, as well as the values which are only known during
+the deployment. The properties set by the recipes of portable resources aren't reported as removed. The template
+expressions which can't be evaluated before the deployment are listed, and the comparisons using them are reported as
+partial.
+
+The diff command accepts the same parameters as the 'rad deploy' command. See the 'rad deploy' help for more information.
+
+The command exits with code 2 when deploying the template would change resources, so that scripts can detect drift.
+	`,
+		Example: `
+# compare a Bicep template with the deployed resources
+rad diff myapp.bicep
+
+# compare using a specific environment and parameters
+rad diff myapp.bicep --environment production --parameters version=latest
+
+# compare and output the differences as JSON
+rad diff myapp.bicep --output json
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddEnvironmentNameFlag(cmd)
+	commonflags.AddApplicationNameFlag(cmd)
+	commonflags.AddParameterFlag(cmd)
+	commonflags.AddOutputFlag(cmd)
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad diff` command.
+type Runner struct {
+	deploycmd.Runner
+
+	Format string
+}
+
+// NewRunner creates a new instance of the `rad diff` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		Runner: *deploycmd.NewRunner(factory),
+	}
+}
+
+// Validate runs validation for the `rad diff` command. It validates the workspace, environment, application and
+// parameters in the same way as `rad deploy`, and reads the output format.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	err := r.Runner.Validate(cmd, args)
+	if err != nil {
+		return err
+	}
+
+	r.Format, err = cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Run runs the `rad diff` command. It compiles the template, compares its Radius resources with the deployed resources
+// and displays the differences. It returns an ExitError with DriftExitCode if deploying the template would change resources.
+func (r *Runner) Run(ctx context.Context) error {
+	template, err := r.Bicep.PrepareTemplate(r.FilePath)
+	if err != nil {
+		return err
+	}
+
+	templateResources, err := r.EvaluateTemplateResources(template)
+	if err != nil {
+		return err
+	}
+
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	diffs := []ResourceDiff{}
+	for _, resource := range templateResources {
+		if !strings.EqualFold(resource.Import, armtemplate.ImportRadius) || resource.Existing {
+			continue
+		}
+
+		var deployed *generated.GenericResource
+		if resource.NameResolved {
+			result, err := client.ShowResource(ctx, resource.Type, resource.Name)
+			if err != nil && !clients.Is404Error(err) {
+				return err
+			} else if err == nil {
+				deployed = &result
+			}
+		}
+
+		diffs = append(diffs, compare(resource, deployed))
+	}
+
+	if strings.EqualFold(r.Format, output.FormatTable) {
+		err = r.displayTable(diffs)
+	} else {
+		err = r.Output.WriteFormatted(r.Format, diffs, output.FormatterOptions{})
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, diff := range diffs {
+		if diff.HasDrift() {
+			return clierrors.Exit(DriftExitCode)
+		}
+	}
+
+	return nil
+}
+
+// diffRow is a row of the table output.
+type diffRow struct {
+	Type     string
+	Name     string
+	Change   string
+	Property string
+	Deployed string
+	Template string
+}
+
+// displayTable displays the differences as a table with a row per changed property, followed by a summary.
+func (r *Runner) displayTable(diffs []ResourceDiff) error {
+	r.Output.LogInfo("Comparing template '%v' with environment '%v' from workspace '%v'...", r.FilePath, r.EnvironmentName, r.Workspace.Name)
+	r.Output.LogInfo("")
+
+	if len(diffs) == 0 {
+		r.Output.LogInfo("The template doesn't declare Radius resources.")
+		return nil
+	}
+
+	rows := []diffRow{}
+	counts := map[string]int{}
+	for _, diff := range diffs {
+		counts[diff.Status]++
+		if diff.Status != StatusUpdate {
+			rows = append(rows, diffRow{Type: diff.Type, Name: diff.Name, Change: diff.Status})
+			continue
+		}
+
+		for _, change := range diff.Changes {
+			rows = append(rows, diffRow{
+				Type:     diff.Type,
+				Name:     diff.Name,
+				Change:   change.Change,
+				Property: change.Path,
+				Deployed: formatValue(change.Deployed),
+				Template: formatValue(change.Template),
+			})
+		}
+	}
+
+	err := r.Output.WriteFormatted(output.FormatTable, rows, objectformats.GetResourceDiffTableFormat())
+	if err != nil {
+		return err
+	}
+
+	r.Output.LogInfo("")
+	if counts[StatusCreate] == 0 && counts[StatusUpdate] == 0 {
+		r.Output.LogInfo("No differences.")
+	} else {
+		r.Output.LogInfo("Deploying the template would create %d and update %d resource(s).", counts[StatusCreate], counts[StatusUpdate])
+	}
+	if counts[StatusUnknown] > 0 {
+		r.Output.LogInfo("%d resource(s) couldn't be compared because their name is only known during the deployment.", counts[StatusUnknown])
+	}
+
+	for _, diff := range diffs {
+		if len(diff.Unresolved) == 0 {
+			continue
+		}
+
+		r.Output.LogInfo("The comparison of %s %s is partial, these expressions of the template can't be evaluated before the deployment:", diff.Name, diff.Type)
+		for _, unresolved := range diff.Unresolved {
+			r.Output.LogInfo("  - %s", unresolved)
+		}
+	}
+
+	return nil
+}
+
+// formatValue formats a property value for the table output as compact JSON, without quotes for strings.
+func formatValue(value any) string {
+	if value == nil {
+		return ""
+	}
+
+	s, ok := value.(string)
+	if !ok {
+		b, err := json.Marshal(value)
+		if err != nil {
+			s = fmt.Sprintf("%v", value)
+		} else {
+			s = string(b)
+		}
+	}
+
+	if len(s) > maxValueLength {
+		s = s[:maxValueLength-3] + "..."
+	}
+
+	return s
+}
@@ -0,0 +1,303 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diff
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/radius-project/radius/pkg/cli/bicep"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/objectformats"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "rad diff - valid",
+			Input:         []string{"app.bicep"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ConfigureMocks: func(mocks radcli.ValidateMocks) {
+				mocks.ApplicationManagementClient.EXPECT().
+					GetEnvDetails(gomock.Any(), radcli.TestEnvironmentName).
+					Return(v20231001preview.EnvironmentResource{}, nil).
+					Times(1)
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, "app.bicep", r.FilePath)
+				require.Equal(t, "table", r.Format)
+			},
+		},
+		{
+			Name:          "rad diff - valid with parameters and output",
+			Input:         []string{"app.bicep", "-p", "foo=bar", "--output", "json"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ConfigureMocks: func(mocks radcli.ValidateMocks) {
+				mocks.ApplicationManagementClient.EXPECT().
+					GetEnvDetails(gomock.Any(), radcli.TestEnvironmentName).
+					Return(v20231001preview.EnvironmentResource{}, nil).
+					Times(1)
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, map[string]map[string]any{"foo": {"value": "bar"}}, r.Parameters)
+				require.Equal(t, "json", r.Format)
+			},
+		},
+		{
+			Name:          "rad diff - environment does not exist",
+			Input:         []string{"app.bicep", "-e", "prod"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ConfigureMocks: func(mocks radcli.ValidateMocks) {
+				mocks.ApplicationManagementClient.EXPECT().
+					GetEnvDetails(gomock.Any(), "prod").
+					Return(v20231001preview.EnvironmentResource{}, radcli.Create404Error()).
+					Times(1)
+			},
+		},
+		{
+			Name:          "rad diff - too many args",
+			Input:         []string{"app.bicep", "other.bicep"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	environmentID := "/planes/radius/local/resourceGroups/test-group/providers/applications.core/environments/test-env"
+	template := map[string]any{
+		"parameters": map[string]any{
+			"environment": map[string]any{"type": "string"},
+		},
+		"resources": map[string]any{
+			"container": map[string]any{
+				"import": "Radius",
+				"type":   "Applications.Core/containers@2023-10-01-preview",
+				"properties": map[string]any{
+					"name": "frontend",
+					"properties": map[string]any{
+						"environment": "[parameters('environment')]",
+						"container":   map[string]any{"image": "nginx:latest"},
+					},
+				},
+			},
+			"redis": map[string]any{
+				"import": "Radius",
+				"type":   "Applications.Datastores/redisCaches@2023-10-01-preview",
+				"properties": map[string]any{
+					"name": "redis",
+					"properties": map[string]any{
+						"environment": "[parameters('environment')]",
+					},
+				},
+			},
+			"queue": map[string]any{
+				"import": "Radius",
+				"type":   "Applications.Messaging/rabbitMQQueues@2023-10-01-preview",
+				"properties": map[string]any{
+					"name": "[uniqueString(resourceGroup().id)]",
+				},
+			},
+		},
+	}
+
+	deployedContainer := generated.GenericResource{
+		Properties: map[string]any{
+			"environment":       environmentID,
+			"container":         map[string]any{"image": "nginx"},
+			"provisioningState": "Succeeded",
+		},
+	}
+
+	newRunner := func(t *testing.T, format string, deployed map[string]*generated.GenericResource) (*Runner, *output.MockOutput) {
+		ctrl := gomock.NewController(t)
+
+		bicepMock := bicep.NewMockInterface(ctrl)
+		bicepMock.EXPECT().
+			PrepareTemplate("app.bicep").
+			Return(template, nil).
+			Times(1)
+
+		appManagementMock := clients.NewMockApplicationsManagementClient(ctrl)
+		for _, resourceType := range []string{"Applications.Core/containers", "Applications.Datastores/redisCaches"} {
+			resourceType := resourceType
+			appManagementMock.EXPECT().
+				ShowResource(gomock.Any(), resourceType, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, resourceType string, name string) (generated.GenericResource, error) {
+					resource, ok := deployed[name]
+					if !ok {
+						return generated.GenericResource{}, radcli.Create404Error()
+					}
+					return *resource, nil
+				}).
+				Times(1)
+		}
+
+		outputSink := &output.MockOutput{}
+		runner := NewRunner(&framework.Impl{})
+		runner.Bicep = bicepMock
+		runner.ConnectionFactory = &connections.MockFactory{ApplicationsManagementClient: appManagementMock}
+		runner.Output = outputSink
+		runner.Providers = &clients.Providers{Radius: &clients.RadiusProvider{EnvironmentID: environmentID}}
+		runner.FilePath = "app.bicep"
+		runner.EnvironmentName = "test-env"
+		runner.Parameters = map[string]map[string]any{}
+		runner.Workspace = &workspaces.Workspace{
+			Name:  "test-workspace",
+			Scope: "/planes/radius/local/resourceGroups/test-group",
+		}
+		runner.Format = format
+
+		return runner, outputSink
+	}
+
+	t.Run("Drift as table", func(t *testing.T) {
+		runner, outputSink := newRunner(t, "table", map[string]*generated.GenericResource{"frontend": &deployedContainer})
+
+		err := runner.Run(context.Background())
+		exitErr := &clierrors.ExitError{}
+		require.True(t, errors.As(err, &exitErr))
+		require.Equal(t, DriftExitCode, exitErr.Code)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Comparing template '%v' with environment '%v' from workspace '%v'...",
+				Params: []any{"app.bicep", "test-env", "test-workspace"},
+			},
+			output.LogOutput{Format: ""},
+			output.FormattedOutput{
+				Format: "table",
+				Obj: []diffRow{
+					{
+						Type:     "Applications.Core/containers",
+						Name:     "frontend",
+						Change:   ChangeChanged,
+						Property: "properties.container.image",
+						Deployed: "nginx",
+						Template: "nginx:latest",
+					},
+					{Type: "Applications.Messaging/rabbitMQQueues", Name: "queue", Change: StatusUnknown},
+					{Type: "Applications.Datastores/redisCaches", Name: "redis", Change: StatusCreate},
+				},
+				Options: objectformats.GetResourceDiffTableFormat(),
+			},
+			output.LogOutput{Format: ""},
+			output.LogOutput{
+				Format: "Deploying the template would create %d and update %d resource(s).",
+				Params: []any{1, 1},
+			},
+			output.LogOutput{
+				Format: "%d resource(s) couldn't be compared because their name is only known during the deployment.",
+				Params: []any{1},
+			},
+			output.LogOutput{
+				Format: "The comparison of %s %s is partial, these expressions of the template can't be evaluated before the deployment:",
+				Params: []any{"queue", "Applications.Messaging/rabbitMQQueues"},
+			},
+			output.LogOutput{
+				Format: "  - %s",
+				Params: []any{`[uniqueString(resourceGroup().id)] (template function isn't supported: "resourceGroup")`},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("No drift as JSON", func(t *testing.T) {
+		deployedRedis := generated.GenericResource{
+			Properties: map[string]any{
+				"environment":          environmentID,
+				"resourceProvisioning": "recipe",
+				"host":                 "redis.svc",
+			},
+		}
+		deployedContainer := generated.GenericResource{
+			Properties: map[string]any{
+				"environment": environmentID,
+				"container":   map[string]any{"image": "nginx:latest"},
+			},
+		}
+		runner, outputSink := newRunner(t, "json", map[string]*generated.GenericResource{
+			"frontend": &deployedContainer,
+			"redis":    &deployedRedis,
+		})
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format: "json",
+				Obj: []ResourceDiff{
+					{Name: "frontend", Type: "Applications.Core/containers", Status: StatusUnchanged, Changes: []PropertyChange{}},
+					{
+						Name:       "queue",
+						Type:       "Applications.Messaging/rabbitMQQueues",
+						Status:     StatusUnknown,
+						Changes:    []PropertyChange{},
+						Unresolved: []string{`[uniqueString(resourceGroup().id)] (template function isn't supported: "resourceGroup")`},
+					},
+					{Name: "redis", Type: "Applications.Datastores/redisCaches", Status: StatusUnchanged, Changes: []PropertyChange{}},
+				},
+				Options: output.FormatterOptions{},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+}
+
+func Test_formatValue(t *testing.T) {
+	require.Equal(t, "", formatValue(nil))
+	require.Equal(t, "nginx", formatValue("nginx"))
+	require.Equal(t, `{"web":{"containerPort":80}}`, formatValue(map[string]any{"web": map[string]any{"containerPort": 80}}))
+	require.Equal(t, "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa...", formatValue(strings.Repeat("a", 60)))
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cancel
+
+import (
+	"context"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// defaultProviderNamespace is the resource provider namespace of the operation when --provider is not specified.
+	defaultProviderNamespace = "Applications.Core"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad operation cancel` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "cancel [operation id]",
+		Short: "Cancel an in-flight async operation",
+		Long: `Cancel an in-flight async operation
+
+The cancellation is requested to the worker processing the operation. The operation completes with the Canceled
+status once the worker observes the request. Operations which have already completed cannot be canceled.`,
+		Example: `
+# Cancel the operation of Applications.Core resources
+rad operation cancel 8a6c0b8e-8d69-4e8a-a8b4-0c2bb2bbd3a1
+
+# Cancel the operation of Applications.Datastores resources with the reason
+rad operation cancel 8a6c0b8e-8d69-4e8a-a8b4-0c2bb2bbd3a1 --provider Applications.Datastores --reason "wrong parameters"`,
+		Args: cobra.ExactArgs(1),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	cmd.Flags().String("provider", defaultProviderNamespace, "The resource provider namespace which processes the operation")
+	cmd.Flags().String("reason", "", "The reason why the operation is canceled")
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad operation cancel` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+	OperationID       string
+	Namespace         string
+	Reason            string
+}
+
+// NewRunner creates a new instance of the `rad operation cancel` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConnectionFactory: factory.GetConnectionFactory(),
+		ConfigHolder:      factory.GetConfigHolder(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad operation cancel` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+	r.OperationID = args[0]
+
+	r.Namespace, err = cmd.Flags().GetString("provider")
+	if err != nil {
+		return err
+	}
+
+	r.Reason, err = cmd.Flags().GetString("reason")
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Run runs the `rad operation cancel` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.CancelOperation(ctx, r.Namespace, r.OperationID, r.Reason)
+	if clients.Is404Error(err) {
+		return clierrors.Message("The operation %q was not found.", r.OperationID)
+	} else if clients.Is409Error(err) {
+		return clierrors.Message("The operation %q has already completed and cannot be canceled.", r.OperationID)
+	} else if err != nil {
+		return err
+	}
+
+	r.Output.LogInfo("Cancellation of operation %q requested.", r.OperationID)
+	return nil
+}
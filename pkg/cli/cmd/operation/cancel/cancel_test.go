@@ -0,0 +1,149 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cancel
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/golang/mock/gomock"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+)
+
+const testOperationID = "8a6c0b8e-8d69-4e8a-a8b4-0c2bb2bbd3a1"
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Cancel Command with operation id",
+			Input:         []string{testOperationID},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, testOperationID, r.OperationID)
+				require.Equal(t, "Applications.Core", r.Namespace)
+				require.Empty(t, r.Reason)
+			},
+		},
+		{
+			Name:          "Cancel Command with provider and reason",
+			Input:         []string{testOperationID, "--provider", "Applications.Datastores", "--reason", "wrong parameters"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, "Applications.Datastores", r.Namespace)
+				require.Equal(t, "wrong parameters", r.Reason)
+			},
+		},
+		{
+			Name:          "Cancel Command without operation id",
+			Input:         []string{},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	runTests := []struct {
+		desc   string
+		err    error
+		want   error
+		writes []any
+	}{
+		{
+			desc: "Success",
+			writes: []any{
+				output.LogOutput{
+					Format: "Cancellation of operation %q requested.",
+					Params: []any{testOperationID},
+				},
+			},
+		},
+		{
+			desc: "Not found",
+			err:  &azcore.ResponseError{StatusCode: http.StatusNotFound},
+			want: clierrors.Message("The operation %q was not found.", testOperationID),
+		},
+		{
+			desc: "Completed",
+			err:  &azcore.ResponseError{StatusCode: http.StatusConflict},
+			want: clierrors.Message("The operation %q has already completed and cannot be canceled.", testOperationID),
+		},
+	}
+
+	for _, tt := range runTests {
+		t.Run(tt.desc, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+			appManagementClient.EXPECT().
+				CancelOperation(gomock.Any(), "Applications.Core", testOperationID, "wrong parameters").
+				Return(v1.AsyncOperationStatus{}, tt.err).
+				Times(1)
+
+			outputSink := &output.MockOutput{}
+			runner := &Runner{
+				ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+				Output:            outputSink,
+				Workspace:         &workspaces.Workspace{},
+				OperationID:       testOperationID,
+				Namespace:         "Applications.Core",
+				Reason:            "wrong parameters",
+			}
+
+			err := runner.Run(context.Background())
+			if tt.want != nil {
+				require.Equal(t, tt.want, err)
+			} else {
+				require.NoError(t, err)
+			}
+			if tt.writes == nil {
+				require.Empty(t, outputSink.Writes)
+			} else {
+				require.Equal(t, tt.writes, outputSink.Writes)
+			}
+		})
+	}
+}
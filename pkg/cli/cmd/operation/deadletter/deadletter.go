@@ -0,0 +1,68 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deadletter
+
+import (
+	deadletter_list "github.com/radius-project/radius/pkg/cli/cmd/operation/deadletter/list"
+	deadletter_purge "github.com/radius-project/radius/pkg/cli/cmd/operation/deadletter/purge"
+	deadletter_replay "github.com/radius-project/radius/pkg/cli/cmd/operation/deadletter/replay"
+	deadletter_show "github.com/radius-project/radius/pkg/cli/cmd/operation/deadletter/show"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates a new cobra command for managing dead-lettered operations, with subcommands for listing, showing,
+// replaying and purging dead-lettered operations.
+func NewCommand(factory framework.Factory) *cobra.Command {
+	// This command is not runnable, and thus has no runner.
+	cmd := &cobra.Command{
+		Use:   "deadletter",
+		Short: "Manage dead-lettered operations",
+		Long: `Manage dead-lettered operations
+
+An async operation is moved to the dead-letter queue when it cannot be processed, for example when it exceeded the
+maximum number of retries or its request could not be decoded. Dead-lettered operations can be inspected, replayed
+or purged.`,
+		Example: `
+# List dead-lettered operations in default workspace
+rad operation deadletter list
+
+# Show details of a dead-lettered operation
+rad operation deadletter show applications.core.8a6c0b8e
+
+# Move a dead-lettered operation back to the request queue
+rad operation deadletter replay applications.core.8a6c0b8e
+
+# Delete a dead-lettered operation
+rad operation deadletter purge applications.core.8a6c0b8e
+`,
+	}
+
+	list, _ := deadletter_list.NewCommand(factory)
+	cmd.AddCommand(list)
+
+	show, _ := deadletter_show.NewCommand(factory)
+	cmd.AddCommand(show)
+
+	replay, _ := deadletter_replay.NewCommand(factory)
+	cmd.AddCommand(replay)
+
+	purge, _ := deadletter_purge.NewCommand(factory)
+	cmd.AddCommand(purge)
+
+	return cmd
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package purge
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/prompt"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/spf13/cobra"
+)
+
+const (
+	purgeConfirmationFmt = "Are you sure you want to purge the dead-lettered operation '%v'? The operation cannot be replayed after it is purged"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad operation deadletter purge` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "purge [name]",
+		Short: "Purge a dead-lettered operation",
+		Long:  "Delete a dead-lettered operation from the dead-letter queue without processing it",
+		Example: `
+# Purge a dead-lettered operation
+rad operation deadletter purge applications.core.8a6c0b8e
+
+# Purge a dead-lettered operation without confirmation
+rad operation deadletter purge applications.core.8a6c0b8e --yes
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddConfirmationFlag(cmd)
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad operation deadletter purge` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	InputPrompter     prompt.Interface
+	Workspace         *workspaces.Workspace
+	Name              string
+	Confirmation      bool
+}
+
+// NewRunner creates a new instance of the `rad operation deadletter purge` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConnectionFactory: factory.GetConnectionFactory(),
+		ConfigHolder:      factory.GetConfigHolder(),
+		Output:            factory.GetOutput(),
+		InputPrompter:     factory.GetPrompter(),
+	}
+}
+
+// Validate runs validation for the `rad operation deadletter purge` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+	r.Name = args[0]
+
+	yes, err := cmd.Flags().GetBool("yes")
+	if err != nil {
+		return err
+	}
+	r.Confirmation = yes
+
+	return nil
+}
+
+// Run runs the `rad operation deadletter purge` command.
+func (r *Runner) Run(ctx context.Context) error {
+	// Prompt user to confirm purging
+	if !r.Confirmation {
+		confirmed, err := prompt.YesOrNoPrompt(fmt.Sprintf(purgeConfirmationFmt, r.Name), prompt.ConfirmNo, r.InputPrompter)
+		if err != nil {
+			return err
+		}
+
+		if !confirmed {
+			r.Output.LogInfo("Dead-lettered operation %q NOT purged.", r.Name)
+			return nil
+		}
+	}
+
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	err = client.PurgeDeadLetteredOperation(ctx, r.Name)
+	if err != nil {
+		return err
+	}
+
+	r.Output.LogInfo("Dead-lettered operation %q purged.", r.Name)
+	return nil
+}
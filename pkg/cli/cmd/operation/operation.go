@@ -0,0 +1,50 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operation
+
+import (
+	operation_cancel "github.com/radius-project/radius/pkg/cli/cmd/operation/cancel"
+	"github.com/radius-project/radius/pkg/cli/cmd/operation/deadletter"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates a new cobra command for managing the async operations of Radius resources, with subcommands for
+// cancelling operations and managing the dead-lettered operations.
+func NewCommand(factory framework.Factory) *cobra.Command {
+	// This command is not runnable, and thus has no runner.
+	cmd := &cobra.Command{
+		Use:   "operation",
+		Short: "Manage async operations",
+		Long: `Manage async operations
+
+Radius processes long-running requests such as deploying or deleting resources as async operations.`,
+		Example: `
+# Cancel an in-flight operation
+rad operation cancel 8a6c0b8e-8d69-4e8a-a8b4-0c2bb2bbd3a1
+
+# List dead-lettered operations in default workspace
+rad operation deadletter list
+`,
+	}
+
+	cancelCmd, _ := operation_cancel.NewCommand(factory)
+	cmd.AddCommand(cancelCmd)
+	cmd.AddCommand(deadletter.NewCommand(factory))
+
+	return cmd
+}
@@ -59,6 +59,12 @@ rad recipe register cosmosdb -e env_name -w workspace --template-kind bicep --te
 		
 # specify multiple parameters using a JSON parameter file
 rad recipe register cosmosdb -e env_name -w workspace --template-kind bicep --template-path template_path --resource-type Applications.Datastores/mongoDatabases --parameters @myfile.json
+
+# Add a Helm chart recipe from an OCI registry to an environment
+rad recipe register redis -e env_name -w workspace --template-kind helm --template-path oci://myregistry.azurecr.io/charts/redis --template-version 1.0.0 --resource-type Applications.Datastores/redisCaches
+
+# Add a Kubernetes manifest recipe from a path in a git repository to an environment
+rad recipe register redis -e env_name -w workspace --template-kind manifest --template-path "git::https://github.com/myorg/recipes.git//kubernetes/redis?ref=v1.0.0" --resource-type Applications.Datastores/redisCaches
 		`,
 		Args: cobra.ExactArgs(1),
 		RunE: framework.RunCommand(runner),
@@ -70,7 +76,7 @@ rad recipe register cosmosdb -e env_name -w workspace --template-kind bicep --te
 	commonflags.AddEnvironmentNameFlag(cmd)
 	cmd.Flags().String("template-kind", "", "specify the kind for the template provided by the recipe.")
 	_ = cmd.MarkFlagRequired("template-kind")
-	cmd.Flags().String("template-version", "", "specify the version for the terraform module.")
+	cmd.Flags().String("template-version", "", "specify the version for the terraform module or the helm chart.")
 	cmd.Flags().String("template-path", "", "specify the path to the template provided by the recipe.")
 	_ = cmd.MarkFlagRequired("template-path")
 	cmd.Flags().String("resource-type", "", "specify the type of the portable resource this recipe can be consumed by")
@@ -191,6 +197,19 @@ func (r *Runner) Run(ctx context.Context) error {
 			TemplatePath: &r.TemplatePath,
 			Parameters:   bicep.ConvertToMapStringInterface(r.Parameters),
 		}
+	case recipes.TemplateKindHelm:
+		properties = &corerp.HelmRecipeProperties{
+			TemplateKind:    &r.TemplateKind,
+			TemplatePath:    &r.TemplatePath,
+			TemplateVersion: &r.TemplateVersion,
+			Parameters:      bicep.ConvertToMapStringInterface(r.Parameters),
+		}
+	case recipes.TemplateKindManifest:
+		properties = &corerp.ManifestRecipeProperties{
+			TemplateKind: &r.TemplateKind,
+			TemplatePath: &r.TemplatePath,
+			Parameters:   bicep.ConvertToMapStringInterface(r.Parameters),
+		}
 	}
 	if val, ok := envRecipes[r.ResourceType]; ok {
 		val[r.RecipeName] = properties
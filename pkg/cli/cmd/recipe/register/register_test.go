@@ -63,6 +63,24 @@ func Test_Validate(t *testing.T) {
 				Config:         configWithWorkspace,
 			},
 		},
+		{
+			Name:          "Valid Register Command for helm recipe",
+			Input:         []string{"test_recipe", "--template-kind", recipes.TemplateKindHelm, "--template-path", "oci://ghcr.io/testpublicrecipe/charts/redis", "--resource-type", ds_ctrl.RedisCachesResourceType, "--template-version", "1.0.0"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Valid Register Command for manifest recipe",
+			Input:         []string{"test_recipe", "--template-kind", recipes.TemplateKindManifest, "--template-path", "git::https://github.com/testpublicrecipe/recipes.git//redis?ref=v1.0.0", "--resource-type", ds_ctrl.RedisCachesResourceType},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
 		{
 			Name:          "Valid Register Command with parameters passed as file",
 			Input:         []string{"test_recipe", "--template-kind", recipes.TemplateKindBicep, "--template-path", "test_template", "--resource-type", ds_ctrl.MongoDatabasesResourceType, "--parameters", "@testdata/recipeparam.json"},
@@ -194,6 +212,53 @@ func Test_Run(t *testing.T) {
 		require.Equal(t, expectedOutput, outputSink.Writes)
 	})
 
+	t.Run("Register helm recipe Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		envResource := v20231001preview.EnvironmentResource{
+			ID:       to.Ptr("/planes/radius/local/resourcegroups/kind-kind/providers/applications.core/environments/kind-kind"),
+			Name:     to.Ptr("kind-kind"),
+			Type:     to.Ptr("applications.core/environments"),
+			Location: to.Ptr(v1.LocationGlobal),
+			Properties: &v20231001preview.EnvironmentProperties{
+				Compute: &v20231001preview.KubernetesCompute{
+					Namespace: to.Ptr("default"),
+				},
+			},
+		}
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetEnvDetails(gomock.Any(), gomock.Any()).
+			Return(envResource, nil).Times(1)
+		appManagementClient.EXPECT().
+			CreateEnvironment(context.Background(), "kind-kind", v1.LocationGlobal, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, _ string, properties *v20231001preview.EnvironmentProperties) error {
+				recipe, ok := properties.Recipes[ds_ctrl.RedisCachesResourceType]["redis"].(*v20231001preview.HelmRecipeProperties)
+				require.True(t, ok)
+				require.Equal(t, recipes.TemplateKindHelm, *recipe.TemplateKind)
+				require.Equal(t, "oci://ghcr.io/testpublicrecipe/charts/redis", *recipe.TemplatePath)
+				require.Equal(t, "1.0.0", *recipe.TemplateVersion)
+				return nil
+			}).Times(1)
+
+		outputSink := &output.MockOutput{}
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            outputSink,
+			Workspace:         &workspaces.Workspace{Environment: "kind-kind"},
+			TemplateKind:      recipes.TemplateKindHelm,
+			TemplatePath:      "oci://ghcr.io/testpublicrecipe/charts/redis",
+			TemplateVersion:   "1.0.0",
+			ResourceType:      ds_ctrl.RedisCachesResourceType,
+			RecipeName:        "redis",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+	})
+
 	t.Run("Register recipe Failure", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 
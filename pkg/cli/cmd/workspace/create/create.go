@@ -47,7 +47,11 @@ func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
 		Short: "Create a workspace",
 		Long: `Create a workspace.
 		
-Available workspaceTypes: kubernetes
+Available workspaceTypes: kubernetes, direct
+
+A 'kubernetes' workspace connects to Radius through the Kubernetes API server of a cluster in your kubeconfig.
+A 'direct' workspace connects to a Radius endpoint URL without Kubernetes, for example when Radius is exposed
+through an ingress. Requests can be authenticated with a bearer token or with tokens acquired from an OIDC issuer.
 
 Workspaces allow you to manage multiple Radius platforms and environments using a local configuration file. 
 
@@ -57,7 +61,11 @@ You can easily define and switch between workspaces to deploy and manage applica
 # Create a workspace with name 'myworkspace' and kubernetes context 'aks'
 rad workspace create kubernetes myworkspace --context aks
 # Create a workspace with name of current kubernetes context in current kubernetes context
-rad workspace create kubernetes`,
+rad workspace create kubernetes
+# Create a workspace with name 'ci' connecting to an endpoint with a bearer token read from the RADIUS_TOKEN environment variable
+rad workspace create direct ci --endpoint https://radius.example.com --ca-file ./ca.pem --token-env RADIUS_TOKEN
+# Create a workspace with name 'ci' connecting to an endpoint with tokens acquired from an OIDC issuer
+rad workspace create direct ci --endpoint https://radius.example.com --oidc-issuer https://login.example.com --oidc-client-id radius-ci --oidc-client-secret-env RADIUS_CLIENT_SECRET`,
 		RunE: framework.RunCommand(runner),
 	}
 
@@ -66,6 +74,14 @@ rad workspace create kubernetes`,
 	commonflags.AddEnvironmentNameFlag(cmd)
 	cmd.Flags().BoolP("force", "f", false, "Overwrite existing workspace if present")
 	cmd.Flags().StringP("context", "c", "", "the Kubernetes context to use, will use the default if unset")
+	cmd.Flags().String("endpoint", "", "the Radius endpoint URL of a direct workspace")
+	cmd.Flags().String("ca-file", "", "the PEM bundle of certificate authorities trusted for the endpoint of a direct workspace")
+	cmd.Flags().String("token-env", "", "the environment variable containing the bearer token of a direct workspace")
+	cmd.Flags().String("token-file", "", "the file containing the bearer token of a direct workspace")
+	cmd.Flags().String("oidc-issuer", "", "the OIDC issuer URL used to acquire the tokens of a direct workspace")
+	cmd.Flags().String("oidc-client-id", "", "the OIDC client ID used to acquire the tokens of a direct workspace")
+	cmd.Flags().String("oidc-client-secret-env", "", "the environment variable containing the OIDC client secret of a direct workspace")
+	cmd.Flags().StringSlice("oidc-scope", []string{}, "the OIDC scopes requested for the tokens of a direct workspace")
 
 	return cmd, runner
 }
@@ -97,8 +113,8 @@ func NewRunner(factory framework.Factory) *Runner {
 // Validate runs validation for the `rad workspace create` command.
 //
 
-// Validate checks if the given workspace name is valid, if the given Kubernetes context is valid, if the Radius
-// control plane is installed on the target platform, if the workspace already exists, if the user has specified the
+// Validate checks if the given workspace name is valid, if the given Kubernetes context is valid and the Radius
+// control plane is installed on the target platform (or if the direct connection is valid), if the workspace already exists, if the user has specified the
 // --force flag, if the given resource group and environment exist, and returns an error if any of these checks fail.
 func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 	config := r.ConfigHolder.Config
@@ -108,27 +124,37 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	kubeContextList, err := r.KubernetesInterface.GetKubeContext()
-	if err != nil {
-		return clierrors.Message("Failed to read Kubernetes configuration. Ensure you have a valid Kubeconfig file and try again.")
-	}
-	context, err := cli.RequireKubeContext(cmd, kubeContextList.CurrentContext)
-	if err != nil {
-		return err
-	}
+	var connection map[string]any
+	switch args[0] {
+	case workspaces.KindDirect:
+		if workspaceName == "" {
+			return clierrors.Message("A workspace name is required for a direct workspace. Specify the name as an argument or with the `--workspace` flag.")
+		}
 
-	_, ok := kubeContextList.Contexts[context]
-	if !ok {
-		return fmt.Errorf("the kubeconfig does not contain a context called %q", context)
-	}
+		connection, err = r.validateDirectConnection(cmd)
+		if err != nil {
+			return err
+		}
+	default:
+		var context string
+		context, err = r.validateKubernetesConnection(cmd)
+		if err != nil {
+			return err
+		}
 
-	if workspaceName == "" {
-		workspaceName = context
-	}
+		if workspaceName == "" {
+			workspaceName = context
+		}
 
-	state, err := r.HelmInterface.CheckRadiusInstall(context)
-	if !state.Installed || err != nil {
-		return fmt.Errorf("unable to create workspace %q. Radius control plane not installed on target platform. Run 'rad install' and try again", workspaceName)
+		state, err := r.HelmInterface.CheckRadiusInstall(context)
+		if !state.Installed || err != nil {
+			return fmt.Errorf("unable to create workspace %q. Radius control plane not installed on target platform. Run 'rad install' and try again", workspaceName)
+		}
+
+		connection = map[string]any{
+			"context": context,
+			"kind":    workspaces.KindKubernetes,
+		}
 	}
 
 	workspaceExists, err := cli.HasWorkspace(config, workspaceName)
@@ -155,9 +181,7 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 		r.Workspace = &workspaces.Workspace{}
 		r.Workspace.Name = workspaceName
 	}
-	r.Workspace.Connection = map[string]any{}
-	r.Workspace.Connection["context"] = context
-	r.Workspace.Connection["kind"] = args[0]
+	r.Workspace.Connection = connection
 
 	group, err := cmd.Flags().GetString("group")
 	if err != nil {
@@ -202,6 +226,114 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// validateKubernetesConnection returns the Kubernetes context specified with the --context flag, or the current context,
+// and returns an error if the kubeconfig doesn't contain the context.
+func (r *Runner) validateKubernetesConnection(cmd *cobra.Command) (string, error) {
+	kubeContextList, err := r.KubernetesInterface.GetKubeContext()
+	if err != nil {
+		return "", clierrors.Message("Failed to read Kubernetes configuration. Ensure you have a valid Kubeconfig file and try again.")
+	}
+	context, err := cli.RequireKubeContext(cmd, kubeContextList.CurrentContext)
+	if err != nil {
+		return "", err
+	}
+
+	_, ok := kubeContextList.Contexts[context]
+	if !ok {
+		return "", fmt.Errorf("the kubeconfig does not contain a context called %q", context)
+	}
+
+	return context, nil
+}
+
+// validateDirectConnection builds the connection of a direct workspace from the flags, and returns an error if the
+// connection can't be created with it. The endpoint isn't contacted, unless a resource group is specified.
+func (r *Runner) validateDirectConnection(cmd *cobra.Command) (map[string]any, error) {
+	endpoint, err := cmd.Flags().GetString("endpoint")
+	if err != nil {
+		return nil, err
+	}
+
+	if endpoint == "" {
+		return nil, clierrors.Message("The `--endpoint` flag is required for a direct workspace.")
+	}
+
+	connection := map[string]any{
+		"kind":     workspaces.KindDirect,
+		"endpoint": endpoint,
+	}
+
+	caFile, err := cmd.Flags().GetString("ca-file")
+	if err != nil {
+		return nil, err
+	}
+	if caFile != "" {
+		connection["caFile"] = caFile
+	}
+
+	auth, err := directConnectionAuth(cmd)
+	if err != nil {
+		return nil, err
+	}
+	if auth != nil {
+		connection["auth"] = auth
+	}
+
+	_, err = (workspaces.Workspace{Connection: connection}).Connect()
+	if err != nil {
+		return nil, clierrors.MessageWithCause(err, "The direct connection is invalid.")
+	}
+
+	return connection, nil
+}
+
+// directConnectionAuth builds the authentication of a direct connection from the flags. It returns nil if no
+// authentication is specified, and an error if flags of several kinds of authentication are specified.
+func directConnectionAuth(cmd *cobra.Command) (map[string]any, error) {
+	flags := map[string]string{}
+	for _, name := range []string{"token-env", "token-file", "oidc-issuer", "oidc-client-id", "oidc-client-secret-env"} {
+		value, err := cmd.Flags().GetString(name)
+		if err != nil {
+			return nil, err
+		}
+		flags[name] = value
+	}
+
+	scopes, err := cmd.Flags().GetStringSlice("oidc-scope")
+	if err != nil {
+		return nil, err
+	}
+
+	bearer := flags["token-env"] != "" || flags["token-file"] != ""
+	oidc := flags["oidc-issuer"] != "" || flags["oidc-client-id"] != "" || flags["oidc-client-secret-env"] != "" || len(scopes) > 0
+	switch {
+	case bearer && oidc:
+		return nil, clierrors.Message("The bearer token flags and the OIDC flags cannot be used together.")
+	case bearer:
+		auth := map[string]any{"kind": workspaces.DirectAuthKindBearer}
+		if flags["token-env"] != "" {
+			auth["tokenEnv"] = flags["token-env"]
+		}
+		if flags["token-file"] != "" {
+			auth["tokenFile"] = flags["token-file"]
+		}
+		return auth, nil
+	case oidc:
+		auth := map[string]any{
+			"kind":            workspaces.DirectAuthKindOIDC,
+			"issuer":          flags["oidc-issuer"],
+			"clientId":        flags["oidc-client-id"],
+			"clientSecretEnv": flags["oidc-client-secret-env"],
+		}
+		if len(scopes) > 0 {
+			auth["scopes"] = scopes
+		}
+		return auth, nil
+	default:
+		return nil, nil
+	}
+}
+
 // Run runs the `rad workspace create` command.
 //
 
@@ -126,6 +126,82 @@ func Test_Validate(t *testing.T) {
 				mocks.ApplicationManagementClient.EXPECT().GetEnvDetails(gomock.Any(), "env1").Return(corerp.EnvironmentResource{}, nil).Times(1)
 			},
 		},
+		{
+			Name:          "valid direct create command with bearer token",
+			Input:         []string{"direct", "ci", "--endpoint", "https://radius.example.com", "--token-env", "RADIUS_TOKEN"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				expected := map[string]any{
+					"kind":     workspaces.KindDirect,
+					"endpoint": "https://radius.example.com",
+					"auth": map[string]any{
+						"kind":     workspaces.DirectAuthKindBearer,
+						"tokenEnv": "RADIUS_TOKEN",
+					},
+				}
+				require.Equal(t, "ci", runner.(*Runner).Workspace.Name)
+				require.Equal(t, expected, runner.(*Runner).Workspace.Connection)
+			},
+		},
+		{
+			Name:          "valid direct create command with oidc",
+			Input:         []string{"direct", "ci", "--endpoint", "https://radius.example.com", "--oidc-issuer", "https://login.example.com", "--oidc-client-id", "radius-ci", "--oidc-client-secret-env", "RADIUS_CLIENT_SECRET", "--oidc-scope", "radius"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				expected := map[string]any{
+					"kind":            workspaces.DirectAuthKindOIDC,
+					"issuer":          "https://login.example.com",
+					"clientId":        "radius-ci",
+					"clientSecretEnv": "RADIUS_CLIENT_SECRET",
+					"scopes":          []string{"radius"},
+				}
+				require.Equal(t, expected, runner.(*Runner).Workspace.Connection["auth"])
+			},
+		},
+		{
+			Name:          "direct create command without workspace name",
+			Input:         []string{"direct", "--endpoint", "https://radius.example.com"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "direct create command without endpoint",
+			Input:         []string{"direct", "ci"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "direct create command with bearer token and oidc",
+			Input:         []string{"direct", "ci", "--endpoint", "https://radius.example.com", "--token-env", "RADIUS_TOKEN", "--oidc-issuer", "https://login.example.com"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "direct create command with bearer token over http",
+			Input:         []string{"direct", "ci", "--endpoint", "http://radius.example.com", "--token-file", "token"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
 	}
 
 	radcli.SharedValidateValidation(t, NewCommand, testcases)
@@ -101,7 +101,8 @@ func (i *impl) CreateDeploymentClient(ctx context.Context, workspace workspaces.
 }
 
 // CreateDiagnosticsClient creates a DiagnosticsClient by connecting to a workspace, testing the connection, and creating
-// clients for applications, containers, environments, and gateways. If an error occurs, it is returned.
+// clients for applications, containers, environments, and gateways. The Kubernetes clients are only created for Kubernetes
+// connections. If an error occurs, it is returned.
 func (i *impl) CreateDiagnosticsClient(ctx context.Context, workspace workspaces.Workspace) (clients.DiagnosticsClient, error) {
 	connection, err := workspace.Connect()
 	if err != nil {
@@ -120,6 +121,34 @@ func (i *impl) CreateDiagnosticsClient(ctx context.Context, workspace workspaces
 		return nil, err
 	}
 
+	clientOpts := sdk.NewClientOptions(connection)
+	appClient, err := generated.NewGenericResourcesClient(workspace.Scope, "Applications.Core/applications", &aztoken.AnonymousCredential{}, clientOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	cntrClient, err := generated.NewGenericResourcesClient(workspace.Scope, "Applications.Core/containers", &aztoken.AnonymousCredential{}, clientOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	envClient, err := generated.NewGenericResourcesClient(workspace.Scope, "Applications.Core/environments", &aztoken.AnonymousCredential{}, clientOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	gwClient, err := generated.NewGenericResourcesClient(workspace.Scope, "Applications.Core/gateways", &aztoken.AnonymousCredential{}, clientOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	diagnosticsClient := &deployment.ARMDiagnosticsClient{
+		ApplicationClient: *appClient,
+		ContainerClient:   *cntrClient,
+		EnvironmentClient: *envClient,
+		GatewayClient:     *gwClient,
+	}
+
 	switch c := connectionConfig.(type) {
 	case *workspaces.KubernetesConnectionConfig:
 		k8sClient, config, err := kubernetes.NewClientset(c.Context)
@@ -131,36 +160,14 @@ func (i *impl) CreateDiagnosticsClient(ctx context.Context, workspace workspaces
 			return nil, err
 		}
 
-		clientOpts := sdk.NewClientOptions(connection)
-		appClient, err := generated.NewGenericResourcesClient(workspace.Scope, "Applications.Core/applications", &aztoken.AnonymousCredential{}, clientOpts)
-		if err != nil {
-			return nil, err
-		}
-
-		cntrClient, err := generated.NewGenericResourcesClient(workspace.Scope, "Applications.Core/containers", &aztoken.AnonymousCredential{}, clientOpts)
-		if err != nil {
-			return nil, err
-		}
-
-		envClient, err := generated.NewGenericResourcesClient(workspace.Scope, "Applications.Core/environments", &aztoken.AnonymousCredential{}, clientOpts)
-		if err != nil {
-			return nil, err
-		}
-
-		gwClient, err := generated.NewGenericResourcesClient(workspace.Scope, "Applications.Core/gateways", &aztoken.AnonymousCredential{}, clientOpts)
-		if err != nil {
-			return nil, err
-		}
-
-		return &deployment.ARMDiagnosticsClient{
-			K8sTypedClient:    k8sClient,
-			RestConfig:        config,
-			K8sRuntimeClient:  client,
-			ApplicationClient: *appClient,
-			ContainerClient:   *cntrClient,
-			EnvironmentClient: *envClient,
-			GatewayClient:     *gwClient,
-		}, nil
+		diagnosticsClient.K8sTypedClient = k8sClient
+		diagnosticsClient.RestConfig = config
+		diagnosticsClient.K8sRuntimeClient = client
+		return diagnosticsClient, nil
+	case *workspaces.DirectConnectionConfig:
+		// The Kubernetes cluster hosting the application isn't reachable, so only the diagnostics based on
+		// the Radius API (such as public endpoints) are available.
+		return diagnosticsClient, nil
 	default:
 		return nil, fmt.Errorf("unsupported connection type: %+v", connection)
 	}
@@ -23,6 +23,7 @@ import (
 
 	"github.com/radius-project/radius/pkg/cli/clients"
 	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
 	k8slabels "github.com/radius-project/radius/pkg/kubernetes"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 
@@ -79,8 +80,14 @@ func (dc *ARMDiagnosticsClient) GetPublicEndpoint(ctx context.Context, options c
 }
 
 // Expose function finds a running replica of the container, prints the replica name, sets up a signal notification,
-// creates channels for errors, readiness and stopping, and runs a portforwarding process.
+// creates channels for errors, readiness and stopping, and runs a portforwarding process. It returns an error if the
+// workspace doesn't use a Kubernetes connection.
 func (dc *ARMDiagnosticsClient) Expose(ctx context.Context, options clients.ExposeOptions) (failed chan error, stop chan struct{}, signals chan os.Signal, err error) {
+	if dc.K8sTypedClient == nil {
+		err = workspaces.ErrKubernetesConnectionRequired
+		return
+	}
+
 	namespace, err := dc.findNamespaceOfContainer(ctx, options.Resource)
 	if err != nil {
 		return
@@ -114,8 +121,13 @@ func (dc *ARMDiagnosticsClient) Expose(ctx context.Context, options clients.Expo
 }
 
 // Logs() retrieves the running replicas of the container, and creates log streams for the replicas. If an error occurs,
-// it will close all the created streams before returning the error.
+// it will close all the created streams before returning the error. It returns an error if the workspace doesn't use a
+// Kubernetes connection.
 func (dc *ARMDiagnosticsClient) Logs(ctx context.Context, options clients.LogsOptions) ([]clients.LogStream, error) {
+	if dc.K8sTypedClient == nil {
+		return nil, workspaces.ErrKubernetesConnectionRequired
+	}
+
 	namespace, err := dc.findNamespaceOfContainer(ctx, options.Resource)
 	if err != nil {
 		return nil, nil
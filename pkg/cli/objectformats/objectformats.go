@@ -292,3 +292,86 @@ func GetRecipeParamsTableFormat() output.FormatterOptions {
 		},
 	}
 }
+
+// GetDeadLetteredOperationTableFormat returns a FormatterOptions struct containing the column headings and JSONPaths for the
+// dead-lettered operations table.
+func GetDeadLetteredOperationTableFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "NAME",
+				JSONPath: "{ .Name }",
+			},
+			{
+				Heading:  "OPERATION",
+				JSONPath: "{ .Properties.OperationType }",
+			},
+			{
+				Heading:  "RESOURCE",
+				JSONPath: "{ .Properties.ResourceID }",
+			},
+			{
+				Heading:  "DEQUEUE COUNT",
+				JSONPath: "{ .Properties.DequeueCount }",
+			},
+			{
+				Heading:  "REASON",
+				JSONPath: "{ .Properties.Reason }",
+			},
+		},
+	}
+}
+
+// GetRecipePlanTableFormat returns a FormatterOptions struct containing the column headings and JSONPaths for the table of
+// the changes made by a recipe.
+func GetRecipePlanTableFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "CHANGE",
+				JSONPath: "{ .ChangeType }",
+			},
+			{
+				Heading:  "TYPE",
+				JSONPath: "{ .ResourceType }",
+			},
+			{
+				Heading:  "RESOURCE",
+				JSONPath: "{ .Resource }",
+			},
+		},
+	}
+}
+
+// GetResourceDiffTableFormat returns a FormatterOptions struct containing the column headings and JSONPaths for the table
+// of the differences between the resources of a template and the deployed resources.
+func GetResourceDiffTableFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "TYPE",
+				JSONPath: "{ .Type }",
+			},
+			{
+				Heading:  "NAME",
+				JSONPath: "{ .Name }",
+			},
+			{
+				Heading:  "CHANGE",
+				JSONPath: "{ .Change }",
+			},
+			{
+				Heading:  "PROPERTY",
+				JSONPath: "{ .Property }",
+			},
+			{
+				Heading:  "DEPLOYED",
+				JSONPath: "{ .Deployed }",
+			},
+			{
+				Heading:  "TEMPLATE",
+				JSONPath: "{ .Template }",
+			},
+		},
+	}
+}
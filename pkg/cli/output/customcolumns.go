@@ -0,0 +1,81 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+type CustomColumnsFormatter struct {
+	// Columns is the comma-separated list of the columns of the table. A column is either the heading of a column defined
+	// by the command, or a heading and a JSONPath expression separated by a colon, e.g. "NAME,ID:.id".
+	Columns string
+}
+
+// Format writes a table with the selected columns to the writer. It returns an error if a heading doesn't match a column
+// defined by the command.
+func (f *CustomColumnsFormatter) Format(obj any, writer io.Writer, options FormatterOptions) error {
+	columns, err := f.parseColumns(options.Columns)
+	if err != nil {
+		return err
+	}
+
+	return (&TableFormatter{}).Format(obj, writer, FormatterOptions{Columns: columns})
+}
+
+func (f *CustomColumnsFormatter) parseColumns(defined []Column) ([]Column, error) {
+	columns := []Column{}
+	for _, spec := range strings.Split(f.Columns, ",") {
+		spec = strings.TrimSpace(spec)
+		if spec == "" {
+			return nil, fmt.Errorf("invalid custom-columns %q, the columns can't be empty", f.Columns)
+		}
+
+		if heading, path, ok := strings.Cut(spec, ":"); ok {
+			if strings.TrimSpace(heading) == "" || strings.TrimSpace(path) == "" {
+				return nil, fmt.Errorf("invalid custom column %q, expected <heading>:<jsonpath>", spec)
+			}
+			columns = append(columns, Column{Heading: strings.TrimSpace(heading), JSONPath: relaxedJSONPath(path)})
+			continue
+		}
+
+		found := false
+		for _, column := range defined {
+			if strings.EqualFold(column.Heading, spec) {
+				columns = append(columns, column)
+				found = true
+				break
+			}
+		}
+		if !found {
+			headings := []string{}
+			for _, column := range defined {
+				headings = append(headings, column.Heading)
+			}
+			if len(headings) == 0 {
+				return nil, fmt.Errorf("unknown column %q, this command doesn't define columns, use <heading>:<jsonpath> to define them", spec)
+			}
+			return nil, fmt.Errorf("unknown column %q, the available columns are %s", spec, strings.Join(headings, ", "))
+		}
+	}
+
+	return columns, nil
+}
+
+var _ Formatter = (*CustomColumnsFormatter)(nil)
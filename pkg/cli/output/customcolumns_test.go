@@ -0,0 +1,124 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type customColumnsInput struct {
+	Name string `json:"name"`
+	Kind string `json:"kind"`
+	Size int    `json:"size"`
+}
+
+var customColumnsInputOptions = FormatterOptions{
+	Columns: []Column{
+		{
+			Heading:  "NAME",
+			JSONPath: "{ .Name }",
+		},
+		{
+			Heading:  "KIND",
+			JSONPath: "{ .Kind }",
+		},
+	},
+}
+
+func Test_CustomColumns(t *testing.T) {
+	obj := []customColumnsInput{
+		{Name: "cache", Kind: "redis", Size: 3},
+		{Name: "db", Kind: "mongo", Size: 1},
+	}
+
+	tests := []struct {
+		desc     string
+		columns  string
+		options  FormatterOptions
+		expected string
+		err      string
+	}{
+		{
+			desc:    "defined columns",
+			columns: "kind,Name",
+			options: customColumnsInputOptions,
+			expected: `KIND      NAME
+redis     cache
+mongo     db
+`,
+		},
+		{
+			desc:    "jsonpath columns",
+			columns: "NAME,SIZE:.size",
+			options: customColumnsInputOptions,
+			expected: `NAME      SIZE
+cache     3
+db        1
+`,
+		},
+		{
+			desc:    "no defined columns",
+			columns: "NAME:{.name}",
+			expected: `NAME
+cache
+db
+`,
+		},
+		{
+			desc:    "unknown column",
+			columns: "NAME,SIZE",
+			options: customColumnsInputOptions,
+			err:     `unknown column "SIZE", the available columns are NAME, KIND`,
+		},
+		{
+			desc:    "unknown column without defined columns",
+			columns: "NAME",
+			err:     `unknown column "NAME", this command doesn't define columns, use <heading>:<jsonpath> to define them`,
+		},
+		{
+			desc:    "empty column",
+			columns: "NAME,,KIND",
+			options: customColumnsInputOptions,
+			err:     `invalid custom-columns "NAME,,KIND", the columns can't be empty`,
+		},
+		{
+			desc:    "missing jsonpath",
+			columns: "SIZE:",
+			options: customColumnsInputOptions,
+			err:     `invalid custom column "SIZE:", expected <heading>:<jsonpath>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			formatter := &CustomColumnsFormatter{Columns: tt.columns}
+
+			buffer := &bytes.Buffer{}
+			err := formatter.Format(obj, buffer, tt.options)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, buffer.String())
+		})
+	}
+}
@@ -17,15 +17,26 @@ limitations under the License.
 package output
 
 const (
-	FormatJson    = "json"
-	FormatTable   = "table"
-	DefaultFormat = FormatTable
+	FormatJson          = "json"
+	FormatTable         = "table"
+	FormatYaml          = "yaml"
+	FormatName          = "name"
+	FormatJSONPath      = "jsonpath"
+	FormatGoTemplate    = "go-template"
+	FormatCustomColumns = "custom-columns"
+	DefaultFormat       = FormatTable
 )
 
-// SupportedFormats returns a slice of strings containing the supported formats for a request.
+// SupportedFormats returns a slice of strings containing the supported formats for a request. The formats taking an
+// argument are followed by a placeholder for the argument.
 func SupportedFormats() []string {
 	return []string{
 		FormatJson,
 		FormatTable,
+		FormatYaml,
+		FormatName,
+		FormatJSONPath + "=<expression>",
+		FormatGoTemplate + "=<template>",
+		FormatCustomColumns + "=<columns>",
 	}
 }
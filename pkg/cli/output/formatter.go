@@ -17,6 +17,7 @@ limitations under the License.
 package output
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 	"reflect"
@@ -38,19 +39,73 @@ type Formatter interface {
 	Format(obj any, writer io.Writer, options FormatterOptions) error
 }
 
-// NewFormatter takes in a string and returns a Formatter interface and an error if the format is not supported.
+// NewFormatter takes in a string and returns a Formatter interface and an error if the format is not supported. The
+// jsonpath, go-template and custom-columns formats take their argument after an equal sign, e.g. "jsonpath={.name}".
 func NewFormatter(format string) (Formatter, error) {
-	normalized := strings.ToLower(strings.TrimSpace(format))
+	name, arg, hasArg := strings.Cut(strings.TrimSpace(format), "=")
+	normalized := strings.ToLower(name)
+
+	switch normalized {
+	case FormatJSONPath, FormatGoTemplate, FormatCustomColumns:
+		if strings.TrimSpace(arg) == "" {
+			return nil, fmt.Errorf("format %s requires an argument, e.g. %s=<argument>", normalized, normalized)
+		}
+	default:
+		if hasArg {
+			return nil, fmt.Errorf("unsupported format %s", format)
+		}
+	}
+
 	switch normalized {
 	case FormatJson:
 		return &JSONFormatter{}, nil
 	case FormatTable:
 		return &TableFormatter{}, nil
+	case FormatYaml:
+		return &YAMLFormatter{}, nil
+	case FormatName:
+		return &NameFormatter{}, nil
+	case FormatJSONPath:
+		return &JSONPathFormatter{Expression: arg}, nil
+	case FormatGoTemplate:
+		return &GoTemplateFormatter{Template: arg}, nil
+	case FormatCustomColumns:
+		return &CustomColumnsFormatter{Columns: arg}, nil
 	default:
 		return nil, fmt.Errorf("unsupported format %s", format)
 	}
 }
 
+// convertToJSONObject converts the object to the maps, slices and scalars of its JSON representation, so that the
+// formats evaluating expressions use the same field names as the JSON format.
+func convertToJSONObject(obj any) (any, error) {
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	var result any
+	if err := json.Unmarshal(b, &result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// relaxedJSONPath returns the JSONPath expression with the braces and the leading dot added if they're missing,
+// so that ".name" and "name" can be used for "{.name}".
+func relaxedJSONPath(expression string) string {
+	expression = strings.TrimSpace(expression)
+	if strings.HasPrefix(expression, "{") {
+		return expression
+	}
+	if !strings.HasPrefix(expression, ".") {
+		expression = "." + expression
+	}
+
+	return "{" + expression + "}"
+}
+
 func convertToSlice(obj any) ([]any, error) {
 	// We use reflection here because we're building a table and thus need to handle both scalars (structs)
 	// and slices/arrays of structs.
@@ -0,0 +1,63 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewFormatter(t *testing.T) {
+	tests := []struct {
+		format   string
+		expected Formatter
+		err      string
+	}{
+		{format: "json", expected: &JSONFormatter{}},
+		{format: " Table ", expected: &TableFormatter{}},
+		{format: "yaml", expected: &YAMLFormatter{}},
+		{format: "name", expected: &NameFormatter{}},
+		{format: "jsonpath={.Name}", expected: &JSONPathFormatter{Expression: "{.Name}"}},
+		{format: "go-template={{.name}}={{.id}}", expected: &GoTemplateFormatter{Template: "{{.name}}={{.id}}"}},
+		{format: "custom-columns=NAME,ID:.id", expected: &CustomColumnsFormatter{Columns: "NAME,ID:.id"}},
+		{format: "jsonpath", err: "format jsonpath requires an argument, e.g. jsonpath=<argument>"},
+		{format: "custom-columns=", err: "format custom-columns requires an argument, e.g. custom-columns=<argument>"},
+		{format: "json=true", err: "unsupported format json=true"},
+		{format: "xml", err: "unsupported format xml"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			formatter, err := NewFormatter(tt.format)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, formatter)
+		})
+	}
+}
+
+func Test_relaxedJSONPath(t *testing.T) {
+	require.Equal(t, "{.name}", relaxedJSONPath("{.name}"))
+	require.Equal(t, "{.name}", relaxedJSONPath(".name"))
+	require.Equal(t, "{.name}", relaxedJSONPath("name"))
+	require.Equal(t, "{[*].name}", relaxedJSONPath("{[*].name}"))
+}
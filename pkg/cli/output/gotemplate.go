@@ -0,0 +1,45 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"fmt"
+	"io"
+	"text/template"
+)
+
+type GoTemplateFormatter struct {
+	// Template is the Go template executed with the object, e.g. "{{.name}}" or "{{range .}}{{.name}} {{end}}" for a slice.
+	Template string
+}
+
+// Format executes the Go template with the JSON representation of the object and writes the result to the writer.
+func (f *GoTemplateFormatter) Format(obj any, writer io.Writer, options FormatterOptions) error {
+	tmpl, err := template.New("output").Parse(f.Template)
+	if err != nil {
+		return fmt.Errorf("invalid go-template: %w", err)
+	}
+
+	converted, err := convertToJSONObject(obj)
+	if err != nil {
+		return err
+	}
+
+	return tmpl.Execute(writer, converted)
+}
+
+var _ Formatter = (*GoTemplateFormatter)(nil)
@@ -0,0 +1,59 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type goTemplateInput struct {
+	Name string `json:"name"`
+	ID   string `json:"id"`
+}
+
+func Test_GoTemplate_Scalar(t *testing.T) {
+	obj := goTemplateInput{Name: "cache", ID: "/planes/radius/local/resourceGroups/test/providers/Applications.Datastores/redisCaches/cache"}
+
+	formatter := &GoTemplateFormatter{Template: "{{.name}}: {{.id}}"}
+
+	buffer := &bytes.Buffer{}
+	err := formatter.Format(obj, buffer, FormatterOptions{})
+	require.NoError(t, err)
+	require.Equal(t, "cache: /planes/radius/local/resourceGroups/test/providers/Applications.Datastores/redisCaches/cache", buffer.String())
+}
+
+func Test_GoTemplate_Slice(t *testing.T) {
+	obj := []goTemplateInput{{Name: "cache"}, {Name: "db"}}
+
+	formatter := &GoTemplateFormatter{Template: "{{range .}}{{.name}}\n{{end}}"}
+
+	buffer := &bytes.Buffer{}
+	err := formatter.Format(obj, buffer, FormatterOptions{})
+	require.NoError(t, err)
+	require.Equal(t, "cache\ndb\n", buffer.String())
+}
+
+func Test_GoTemplate_Invalid(t *testing.T) {
+	formatter := &GoTemplateFormatter{Template: "{{.name"}
+
+	buffer := &bytes.Buffer{}
+	err := formatter.Format(goTemplateInput{}, buffer, FormatterOptions{})
+	require.ErrorContains(t, err, "invalid go-template")
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"fmt"
+	"io"
+
+	"k8s.io/client-go/util/jsonpath"
+)
+
+type JSONPathFormatter struct {
+	// Expression is the JSONPath expression evaluated on the object, e.g. "{.name}" or "{[*].name}" for a slice.
+	Expression string
+}
+
+// Format evaluates the JSONPath expression on the JSON representation of the object and writes the result to the writer.
+func (f *JSONPathFormatter) Format(obj any, writer io.Writer, options FormatterOptions) error {
+	p := jsonpath.New("output").AllowMissingKeys(true)
+	if err := p.Parse(relaxedJSONPath(f.Expression)); err != nil {
+		return fmt.Errorf("invalid jsonpath expression %q: %w", f.Expression, err)
+	}
+
+	converted, err := convertToJSONObject(obj)
+	if err != nil {
+		return err
+	}
+
+	if err := p.Execute(writer, converted); err != nil {
+		return err
+	}
+
+	_, err = writer.Write([]byte("\n"))
+	return err
+}
+
+var _ Formatter = (*JSONPathFormatter)(nil)
@@ -0,0 +1,92 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type jsonPathInput struct {
+	Name       string            `json:"name"`
+	Properties map[string]string `json:"properties"`
+}
+
+func Test_JSONPath(t *testing.T) {
+	tests := []struct {
+		desc       string
+		obj        any
+		expression string
+		expected   string
+		err        string
+	}{
+		{
+			desc:       "scalar",
+			obj:        jsonPathInput{Name: "cache", Properties: map[string]string{"host": "localhost"}},
+			expression: "{.properties.host}",
+			expected:   "localhost\n",
+		},
+		{
+			desc:       "relaxed expression",
+			obj:        jsonPathInput{Name: "cache"},
+			expression: ".name",
+			expected:   "cache\n",
+		},
+		{
+			desc:       "slice",
+			obj:        []jsonPathInput{{Name: "cache"}, {Name: "db"}},
+			expression: "{[*].name}",
+			expected:   "cache db\n",
+		},
+		{
+			desc:       "range",
+			obj:        []jsonPathInput{{Name: "cache"}, {Name: "db"}},
+			expression: `{range [*]}{.name}{"\n"}{end}`,
+			expected:   "cache\ndb\n\n",
+		},
+		{
+			desc:       "missing key",
+			obj:        jsonPathInput{Name: "cache"},
+			expression: "{.properties.port}",
+			expected:   "\n",
+		},
+		{
+			desc:       "invalid expression",
+			obj:        jsonPathInput{Name: "cache"},
+			expression: "{.name",
+			err:        `invalid jsonpath expression "{.name": unclosed action`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			formatter := &JSONPathFormatter{Expression: tt.expression}
+
+			buffer := &bytes.Buffer{}
+			err := formatter.Format(tt.obj, buffer, FormatterOptions{})
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, buffer.String())
+		})
+	}
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+type NameFormatter struct {
+}
+
+// Format writes the name of the object, or the name of each item if the object is a slice, on separate lines. The name
+// is prefixed by the type of the object if it has one, e.g. "Applications.Core/containers/frontend".
+func (f *NameFormatter) Format(obj any, writer io.Writer, options FormatterOptions) error {
+	converted, err := convertToJSONObject(obj)
+	if err != nil {
+		return err
+	}
+
+	items, ok := converted.([]any)
+	if !ok {
+		items = []any{converted}
+	}
+
+	for _, item := range items {
+		fields, _ := item.(map[string]any)
+		name, _ := fields["name"].(string)
+		if name == "" {
+			return errors.New("the output doesn't have names, name format is not supported for this command")
+		}
+
+		if resourceType, _ := fields["type"].(string); resourceType != "" {
+			name = resourceType + "/" + name
+		}
+
+		if _, err := fmt.Fprintln(writer, name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+var _ Formatter = (*NameFormatter)(nil)
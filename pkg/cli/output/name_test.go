@@ -0,0 +1,70 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type nameInput struct {
+	Name string `json:"name,omitempty"`
+	Type string `json:"type,omitempty"`
+}
+
+func Test_Name(t *testing.T) {
+	tests := []struct {
+		desc     string
+		obj      any
+		expected string
+		err      string
+	}{
+		{
+			desc:     "scalar",
+			obj:      nameInput{Name: "frontend", Type: "Applications.Core/containers"},
+			expected: "Applications.Core/containers/frontend\n",
+		},
+		{
+			desc:     "slice",
+			obj:      []nameInput{{Name: "default"}, {Name: "dev"}},
+			expected: "default\ndev\n",
+		},
+		{
+			desc: "no name",
+			obj:  nameInput{Type: "Applications.Core/containers"},
+			err:  "the output doesn't have names, name format is not supported for this command",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			formatter := &NameFormatter{}
+
+			buffer := &bytes.Buffer{}
+			err := formatter.Format(tt.obj, buffer, FormatterOptions{})
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, buffer.String())
+		})
+	}
+}
@@ -0,0 +1,45 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"encoding/json"
+	"io"
+
+	"sigs.k8s.io/yaml"
+)
+
+type YAMLFormatter struct {
+}
+
+// Format marshals the object into YAML and writes it to the writer. The fields are named as in the JSON format.
+func (f *YAMLFormatter) Format(obj any, writer io.Writer, options FormatterOptions) error {
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+
+	b, err = yaml.JSONToYAML(b)
+	if err != nil {
+		return err
+	}
+
+	_, err = writer.Write(b)
+	return err
+}
+
+var _ Formatter = (*YAMLFormatter)(nil)
@@ -0,0 +1,71 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type yamlInput struct {
+	Name   string            `json:"name"`
+	Size   int               `json:"size"`
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+func Test_YAML_Scalar(t *testing.T) {
+	obj := yamlInput{
+		Name:   "cache",
+		Size:   3,
+		Labels: map[string]string{"tier": "backend"},
+	}
+
+	formatter := &YAMLFormatter{}
+
+	buffer := &bytes.Buffer{}
+	err := formatter.Format(obj, buffer, FormatterOptions{})
+	require.NoError(t, err)
+
+	expected := `labels:
+  tier: backend
+name: cache
+size: 3
+`
+	require.Equal(t, expected, buffer.String())
+}
+
+func Test_YAML_Slice(t *testing.T) {
+	obj := []yamlInput{
+		{Name: "cache", Size: 3},
+		{Name: "db", Size: 1},
+	}
+
+	formatter := &YAMLFormatter{}
+
+	buffer := &bytes.Buffer{}
+	err := formatter.Format(obj, buffer, FormatterOptions{})
+	require.NoError(t, err)
+
+	expected := `- name: cache
+  size: 3
+- name: db
+  size: 1
+`
+	require.Equal(t, expected, buffer.String())
+}
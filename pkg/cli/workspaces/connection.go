@@ -73,23 +73,27 @@ func (ws Workspace) ConnectionConfig() (ConnectionConfig, error) {
 		return nil, fmt.Errorf("workspace field '$.connection.kind' must be a string")
 	}
 
+	var config ConnectionConfig
 	switch kind {
 	case KindKubernetes:
-		config := &KubernetesConnectionConfig{}
-		decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{ErrorUnused: true, Result: config})
-		if err != nil {
-			return nil, err
-		}
-
-		err = decoder.Decode(ws.Connection)
-		if err != nil {
-			return nil, err
-		}
-
-		return config, nil
+		config = &KubernetesConnectionConfig{}
+	case KindDirect:
+		config = &DirectConnectionConfig{}
 	default:
 		return nil, fmt.Errorf("unsupported connection kind '%s'", kind)
 	}
+
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{ErrorUnused: true, Result: config})
+	if err != nil {
+		return nil, err
+	}
+
+	err = decoder.Decode(ws.Connection)
+	if err != nil {
+		return nil, err
+	}
+
+	return config, nil
 }
 
 // Connect attempts to create a connection to the workspace using the connection configuration and returns the
@@ -103,8 +107,8 @@ func (ws Workspace) Connect() (sdk.Connection, error) {
 	return connectionConfig.Connect()
 }
 
-// ConnectionConfigEquals() checks if the given ConnectionConfig is of type Kubernetes and if the Kubernetes
-// context is the same as the one stored in the Workspace, and returns a boolean value accordingly.
+// ConnectionConfigEquals() checks if the given ConnectionConfig has the same kind as the one stored in the Workspace
+// and targets the same Kubernetes context or direct endpoint, and returns a boolean value accordingly.
 func (ws Workspace) ConnectionConfigEquals(other ConnectionConfig) bool {
 	switch other.GetKind() {
 	case KindKubernetes:
@@ -114,6 +118,13 @@ func (ws Workspace) ConnectionConfigEquals(other ConnectionConfig) bool {
 		}
 
 		return ws.Connection["kind"] == KindKubernetes && ws.IsSameKubernetesContext(kc.Context)
+	case KindDirect:
+		dc, ok := other.(*DirectConnectionConfig)
+		if !ok {
+			return false
+		}
+
+		return ws.Connection["kind"] == KindDirect && ws.Connection["endpoint"] == dc.Endpoint
 	default:
 		return false
 	}
@@ -0,0 +1,274 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workspaces
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/radius-project/radius/pkg/sdk"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+const (
+	// KindDirect is the kind of a connection to a UCP endpoint reachable without Kubernetes, such as UCP exposed
+	// through an ingress.
+	KindDirect string = "direct"
+
+	// DirectAuthKindBearer is the kind of authentication using a static bearer token read from an environment
+	// variable or a file.
+	DirectAuthKindBearer string = "bearer"
+
+	// DirectAuthKindOIDC is the kind of authentication using bearer tokens acquired from an OpenID Connect
+	// issuer with the client credentials flow.
+	DirectAuthKindOIDC string = "oidc"
+)
+
+var _ ConnectionConfig = (*DirectConnectionConfig)(nil)
+
+type DirectConnectionConfig struct {
+	// Kind specifies the kind of connection. For DirectConnectionConfig this is always 'direct'.
+	Kind string `json:"kind" mapstructure:"kind" yaml:"kind"`
+
+	// Endpoint is the URL of UCP, for example 'https://radius.example.com'.
+	Endpoint string `json:"endpoint" mapstructure:"endpoint" yaml:"endpoint"`
+
+	// CAFile is the path of a PEM bundle of certificate authorities trusted for the endpoint, in addition
+	// to the certificate authorities of the system. This field is optional.
+	CAFile string `json:"caFile,omitempty" mapstructure:"caFile" yaml:"caFile,omitempty"`
+
+	// Auth describes how the requests are authenticated. This field is optional.
+	Auth *DirectConnectionAuth `json:"auth,omitempty" mapstructure:"auth" yaml:"auth,omitempty"`
+}
+
+type DirectConnectionAuth struct {
+	// Kind specifies the kind of authentication, either 'bearer' or 'oidc'.
+	Kind string `json:"kind" mapstructure:"kind" yaml:"kind"`
+
+	// TokenEnv is the name of the environment variable containing the bearer token. Used with the 'bearer' kind.
+	TokenEnv string `json:"tokenEnv,omitempty" mapstructure:"tokenEnv" yaml:"tokenEnv,omitempty"`
+
+	// TokenFile is the path of the file containing the bearer token. The file is read for every request so that
+	// the token can be rotated. Used with the 'bearer' kind.
+	TokenFile string `json:"tokenFile,omitempty" mapstructure:"tokenFile" yaml:"tokenFile,omitempty"`
+
+	// Issuer is the URL of the OpenID Connect issuer. Used with the 'oidc' kind.
+	Issuer string `json:"issuer,omitempty" mapstructure:"issuer" yaml:"issuer,omitempty"`
+
+	// ClientID is the client ID registered with the issuer. Used with the 'oidc' kind.
+	ClientID string `json:"clientId,omitempty" mapstructure:"clientId" yaml:"clientId,omitempty"`
+
+	// ClientSecretEnv is the name of the environment variable containing the client secret. Used with the 'oidc' kind.
+	ClientSecretEnv string `json:"clientSecretEnv,omitempty" mapstructure:"clientSecretEnv" yaml:"clientSecretEnv,omitempty"`
+
+	// Scopes are the scopes requested from the issuer. Used with the 'oidc' kind. This field is optional.
+	Scopes []string `json:"scopes,omitempty" mapstructure:"scopes" yaml:"scopes,omitempty"`
+}
+
+// String() returns a string that describes the direct connection configuration.
+func (c *DirectConnectionConfig) String() string {
+	return fmt.Sprintf("Direct (endpoint=%s)", c.Endpoint)
+}
+
+// GetKind() returns the string "KindDirect" for a DirectConnectionConfig object.
+func (c *DirectConnectionConfig) GetKind() string {
+	return KindDirect
+}
+
+// Connect() validates the configuration, reads the CA bundle and creates a direct connection to the UCP endpoint. Tokens
+// are only acquired when requests are sent, so Connect() doesn't access the network.
+func (c *DirectConnectionConfig) Connect() (sdk.Connection, error) {
+	strURL := strings.TrimSuffix(c.Endpoint, "/")
+	if strURL == "" {
+		return nil, errors.New("the direct connection is missing required field '$.connection.endpoint'")
+	}
+
+	strURL = strURL + "/apis/api.ucp.dev/v1alpha3"
+	_, err := url.ParseRequestURI(strURL)
+	if err != nil {
+		return nil, err
+	}
+
+	options := sdk.DirectConnectionOptions{}
+	if c.CAFile != "" {
+		options.CACertificates, err = os.ReadFile(c.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the CA bundle: %w", err)
+		}
+	}
+
+	if c.Auth != nil {
+		options.TokenSource, err = c.Auth.tokenSource()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return sdk.NewDirectConnectionWithOptions(strURL, options)
+}
+
+// tokenSource validates the authentication configuration and returns the matching token source.
+func (a *DirectConnectionAuth) tokenSource() (sdk.TokenSource, error) {
+	switch a.Kind {
+	case DirectAuthKindBearer:
+		if (a.TokenEnv == "") == (a.TokenFile == "") {
+			return nil, errors.New("the bearer authentication requires exactly one of '$.connection.auth.tokenEnv' or '$.connection.auth.tokenFile'")
+		}
+
+		return &bearerTokenSource{env: a.TokenEnv, file: a.TokenFile}, nil
+	case DirectAuthKindOIDC:
+		if a.Issuer == "" || a.ClientID == "" || a.ClientSecretEnv == "" {
+			return nil, errors.New("the oidc authentication requires '$.connection.auth.issuer', '$.connection.auth.clientId' and '$.connection.auth.clientSecretEnv'")
+		}
+
+		return &oidcTokenSource{issuer: a.Issuer, clientID: a.ClientID, clientSecretEnv: a.ClientSecretEnv, scopes: a.Scopes}, nil
+	default:
+		return nil, fmt.Errorf("unsupported authentication kind '%s'", a.Kind)
+	}
+}
+
+var _ sdk.TokenSource = (*bearerTokenSource)(nil)
+
+// bearerTokenSource reads a static bearer token from an environment variable or a file.
+type bearerTokenSource struct {
+	env  string
+	file string
+}
+
+// Token returns the bearer token, or an error if it's missing.
+func (s *bearerTokenSource) Token(ctx context.Context) (string, error) {
+	if s.env != "" {
+		token := strings.TrimSpace(os.Getenv(s.env))
+		if token == "" {
+			return "", fmt.Errorf("the environment variable %q containing the bearer token is not set", s.env)
+		}
+
+		return token, nil
+	}
+
+	b, err := os.ReadFile(s.file)
+	if err != nil {
+		return "", fmt.Errorf("failed to read the bearer token: %w", err)
+	}
+
+	token := strings.TrimSpace(string(b))
+	if token == "" {
+		return "", fmt.Errorf("the file %q containing the bearer token is empty", s.file)
+	}
+
+	return token, nil
+}
+
+var _ sdk.TokenSource = (*oidcTokenSource)(nil)
+
+// oidcTokenSource acquires tokens from an OpenID Connect issuer with the client credentials flow. The token endpoint is
+// discovered on first use and the tokens are cached until they expire.
+type oidcTokenSource struct {
+	issuer          string
+	clientID        string
+	clientSecretEnv string
+	scopes          []string
+
+	mu     sync.Mutex
+	source oauth2.TokenSource
+}
+
+// Token returns an access token for the client, acquiring a new one when the cached token has expired.
+func (s *oidcTokenSource) Token(ctx context.Context) (string, error) {
+	source, err := s.tokenSource(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	token, err := source.Token()
+	if err != nil {
+		return "", fmt.Errorf("failed to acquire a token from issuer %q: %w", s.issuer, err)
+	}
+
+	return token.AccessToken, nil
+}
+
+func (s *oidcTokenSource) tokenSource(ctx context.Context) (oauth2.TokenSource, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.source != nil {
+		return s.source, nil
+	}
+
+	secret := os.Getenv(s.clientSecretEnv)
+	if secret == "" {
+		return nil, fmt.Errorf("the environment variable %q containing the client secret is not set", s.clientSecretEnv)
+	}
+
+	tokenURL, err := discoverTokenEndpoint(ctx, s.issuer)
+	if err != nil {
+		return nil, err
+	}
+
+	config := &clientcredentials.Config{
+		ClientID:     s.clientID,
+		ClientSecret: secret,
+		TokenURL:     tokenURL,
+		Scopes:       s.scopes,
+	}
+
+	// The token source outlives the request that created it, so it must not be bound to the request context.
+	s.source = oauth2.ReuseTokenSource(nil, config.TokenSource(context.Background()))
+	return s.source, nil
+}
+
+// discoverTokenEndpoint reads the token endpoint from the OpenID Connect discovery document of the issuer.
+func discoverTokenEndpoint(ctx context.Context, issuer string) (string, error) {
+	discoveryURL := strings.TrimSuffix(issuer, "/") + "/.well-known/openid-configuration"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, discoveryURL, nil)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to discover the token endpoint of issuer %q: %w", issuer, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to discover the token endpoint of issuer %q: unexpected status code %d", issuer, resp.StatusCode)
+	}
+
+	document := struct {
+		TokenEndpoint string `json:"token_endpoint"`
+	}{}
+	err = json.NewDecoder(resp.Body).Decode(&document)
+	if err != nil {
+		return "", fmt.Errorf("failed to discover the token endpoint of issuer %q: %w", issuer, err)
+	}
+
+	if document.TokenEndpoint == "" {
+		return "", fmt.Errorf("the discovery document of issuer %q doesn't contain a token endpoint", issuer)
+	}
+
+	return document.TokenEndpoint, nil
+}
@@ -0,0 +1,236 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workspaces
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ConnectionConfig_Direct(t *testing.T) {
+	ws := Workspace{
+		Connection: map[string]any{
+			"kind":     KindDirect,
+			"endpoint": "https://radius.example.com",
+			"caFile":   "ca.pem",
+			"auth": map[string]any{
+				"kind":     DirectAuthKindBearer,
+				"tokenEnv": "RADIUS_TOKEN",
+			},
+		},
+	}
+
+	config, err := ws.ConnectionConfig()
+	require.NoError(t, err)
+
+	expected := &DirectConnectionConfig{
+		Kind:     KindDirect,
+		Endpoint: "https://radius.example.com",
+		CAFile:   "ca.pem",
+		Auth:     &DirectConnectionAuth{Kind: DirectAuthKindBearer, TokenEnv: "RADIUS_TOKEN"},
+	}
+	require.Equal(t, expected, config)
+	require.Equal(t, "Direct (endpoint=https://radius.example.com)", ws.FmtConnection())
+	require.True(t, ws.ConnectionConfigEquals(expected))
+	require.False(t, ws.ConnectionConfigEquals(&DirectConnectionConfig{Kind: KindDirect, Endpoint: "https://other.example.com"}))
+
+	_, ok := ws.KubernetesContext()
+	require.False(t, ok)
+}
+
+func Test_ConnectionConfig_Direct_UnknownField(t *testing.T) {
+	ws := Workspace{
+		Connection: map[string]any{
+			"kind":     KindDirect,
+			"endpoint": "https://radius.example.com",
+			"context":  "kind-kind",
+		},
+	}
+
+	_, err := ws.ConnectionConfig()
+	require.Error(t, err)
+}
+
+func Test_DirectConnectionConfig_Connect(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		config := &DirectConnectionConfig{
+			Kind:     KindDirect,
+			Endpoint: "https://radius.example.com/",
+			Auth:     &DirectConnectionAuth{Kind: DirectAuthKindBearer, TokenFile: "token"},
+		}
+
+		connection, err := config.Connect()
+		require.NoError(t, err)
+		require.Equal(t, "https://radius.example.com/apis/api.ucp.dev/v1alpha3", connection.Endpoint())
+	})
+
+	testcases := []struct {
+		name   string
+		config DirectConnectionConfig
+		err    string
+	}{
+		{
+			name:   "missing endpoint",
+			config: DirectConnectionConfig{Kind: KindDirect},
+			err:    "the direct connection is missing required field '$.connection.endpoint'",
+		},
+		{
+			name:   "missing CA file",
+			config: DirectConnectionConfig{Kind: KindDirect, Endpoint: "https://radius.example.com", CAFile: filepath.Join(t.TempDir(), "ca.pem")},
+			err:    "failed to read the CA bundle",
+		},
+		{
+			name: "bearer without token",
+			config: DirectConnectionConfig{
+				Kind:     KindDirect,
+				Endpoint: "https://radius.example.com",
+				Auth:     &DirectConnectionAuth{Kind: DirectAuthKindBearer},
+			},
+			err: "the bearer authentication requires exactly one of",
+		},
+		{
+			name: "bearer with both tokens",
+			config: DirectConnectionConfig{
+				Kind:     KindDirect,
+				Endpoint: "https://radius.example.com",
+				Auth:     &DirectConnectionAuth{Kind: DirectAuthKindBearer, TokenEnv: "RADIUS_TOKEN", TokenFile: "token"},
+			},
+			err: "the bearer authentication requires exactly one of",
+		},
+		{
+			name: "oidc without client ID",
+			config: DirectConnectionConfig{
+				Kind:     KindDirect,
+				Endpoint: "https://radius.example.com",
+				Auth:     &DirectConnectionAuth{Kind: DirectAuthKindOIDC, Issuer: "https://login.example.com", ClientSecretEnv: "SECRET"},
+			},
+			err: "the oidc authentication requires",
+		},
+		{
+			name: "unsupported authentication",
+			config: DirectConnectionConfig{
+				Kind:     KindDirect,
+				Endpoint: "https://radius.example.com",
+				Auth:     &DirectConnectionAuth{Kind: "basic"},
+			},
+			err: "unsupported authentication kind 'basic'",
+		},
+		{
+			name: "token over http",
+			config: DirectConnectionConfig{
+				Kind:     KindDirect,
+				Endpoint: "http://radius.example.com",
+				Auth:     &DirectConnectionAuth{Kind: DirectAuthKindBearer, TokenEnv: "RADIUS_TOKEN"},
+			},
+			err: "the endpoint must use the https scheme to send bearer tokens",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := tc.config.Connect()
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tc.err)
+		})
+	}
+}
+
+func Test_BearerTokenSource(t *testing.T) {
+	t.Run("environment variable", func(t *testing.T) {
+		t.Setenv("RADIUS_TEST_TOKEN", "abcd\n")
+
+		token, err := (&bearerTokenSource{env: "RADIUS_TEST_TOKEN"}).Token(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, "abcd", token)
+	})
+
+	t.Run("missing environment variable", func(t *testing.T) {
+		t.Setenv("RADIUS_TEST_TOKEN", "")
+
+		_, err := (&bearerTokenSource{env: "RADIUS_TEST_TOKEN"}).Token(context.Background())
+		require.Error(t, err)
+	})
+
+	t.Run("file is read for every token", func(t *testing.T) {
+		file := filepath.Join(t.TempDir(), "token")
+		source := &bearerTokenSource{file: file}
+
+		require.NoError(t, os.WriteFile(file, []byte("abcd\n"), 0600))
+		token, err := source.Token(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, "abcd", token)
+
+		require.NoError(t, os.WriteFile(file, []byte("efgh"), 0600))
+		token, err = source.Token(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, "efgh", token)
+	})
+}
+
+func Test_OIDCTokenSource(t *testing.T) {
+	tokenRequests := 0
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{"token_endpoint": server.URL + "/token"})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		tokenRequests++
+
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "client_credentials", r.Form.Get("grant_type"))
+		require.Equal(t, "radius", r.Form.Get("scope"))
+
+		clientID, clientSecret, ok := r.BasicAuth()
+		require.True(t, ok)
+		require.Equal(t, "radius-ci", clientID)
+		require.Equal(t, "secret", clientSecret)
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"access_token": "abcd", "token_type": "Bearer", "expires_in": 3600})
+	})
+
+	t.Setenv("RADIUS_TEST_CLIENT_SECRET", "secret")
+	source := &oidcTokenSource{issuer: server.URL + "/", clientID: "radius-ci", clientSecretEnv: "RADIUS_TEST_CLIENT_SECRET", scopes: []string{"radius"}}
+
+	for i := 0; i < 2; i++ {
+		token, err := source.Token(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, "abcd", token)
+	}
+
+	// The token is cached until it expires.
+	require.Equal(t, 1, tokenRequests)
+}
+
+func Test_OIDCTokenSource_MissingClientSecret(t *testing.T) {
+	t.Setenv("RADIUS_TEST_CLIENT_SECRET", "")
+	source := &oidcTokenSource{issuer: "https://login.example.com", clientID: "radius-ci", clientSecretEnv: "RADIUS_TEST_CLIENT_SECRET"}
+
+	_, err := source.Token(context.Background())
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "the environment variable \"RADIUS_TEST_CLIENT_SECRET\" containing the client secret is not set")
+}
@@ -43,3 +43,18 @@ type EditableWorkspaceRequiredError struct {
 func (*EditableWorkspaceRequiredError) Error() string {
 	return "This operation requires a workspace. Use `rad init` to scaffold a workspace in the local directory, or specify a named workspace using the `--workspace` command line flag."
 }
+
+var _ error = (*KubernetesConnectionRequiredError)(nil)
+
+// ErrKubernetesConnectionRequired is a value of KubernetesConnectionRequiredError.
+var ErrKubernetesConnectionRequired error = &KubernetesConnectionRequiredError{}
+
+// KubernetesConnectionRequiredError is an error used when an operation needs to access the Kubernetes cluster hosting
+// the application, but the workspace doesn't use a Kubernetes connection.
+type KubernetesConnectionRequiredError struct {
+}
+
+// Error() returns a message describing KubernetesConnectionRequiredError.
+func (*KubernetesConnectionRequiredError) Error() string {
+	return "This operation requires a workspace with a Kubernetes connection. Use `rad workspace create kubernetes` to create one, or specify one using the `--workspace` command line flag."
+}
@@ -103,6 +103,13 @@ func (src *EnvironmentResource) ConvertTo() (v1.DataModelInterface, error) {
 		}
 	}
 
+	if src.Properties.RecipeConfig != nil {
+		converted.Properties.RecipeConfig, err = toRecipeConfigDatamodel(src.Properties.RecipeConfig)
+		if err != nil {
+			return &datamodel.Environment{}, err
+		}
+	}
+
 	if src.Properties.Simulated != nil && *src.Properties.Simulated {
 		converted.Properties.Simulated = true
 	}
@@ -165,6 +172,8 @@ func (dst *EnvironmentResource) ConvertFrom(src v1.DataModelInterface) error {
 		}
 	}
 
+	dst.Properties.RecipeConfig = fromRecipeConfigDatamodel(env.Properties.RecipeConfig)
+
 	if env.Properties.Simulated {
 		dst.Properties.Simulated = to.Ptr(env.Properties.Simulated)
 	}
@@ -316,6 +325,19 @@ func toEnvironmentRecipeProperties(e RecipePropertiesClassification) (datamodel.
 			TemplatePath: to.String(c.TemplatePath),
 			Parameters:   c.Parameters,
 		}, nil
+	case *HelmRecipeProperties:
+		return datamodel.EnvironmentRecipeProperties{
+			TemplateKind:    types.TemplateKindHelm,
+			TemplateVersion: to.String(c.TemplateVersion),
+			TemplatePath:    to.String(c.TemplatePath),
+			Parameters:      c.Parameters,
+		}, nil
+	case *ManifestRecipeProperties:
+		return datamodel.EnvironmentRecipeProperties{
+			TemplateKind: types.TemplateKindManifest,
+			TemplatePath: to.String(c.TemplatePath),
+			Parameters:   c.Parameters,
+		}, nil
 	}
 	return datamodel.EnvironmentRecipeProperties{}, nil
 }
@@ -335,6 +357,329 @@ func fromRecipePropertiesClassificationDatamodel(e datamodel.EnvironmentRecipePr
 			TemplatePath: to.Ptr(e.TemplatePath),
 			Parameters:   e.Parameters,
 		}
+	case types.TemplateKindHelm:
+		return &HelmRecipeProperties{
+			TemplateKind:    to.Ptr(e.TemplateKind),
+			TemplateVersion: to.Ptr(e.TemplateVersion),
+			TemplatePath:    to.Ptr(e.TemplatePath),
+			Parameters:      e.Parameters,
+		}
+	case types.TemplateKindManifest:
+		return &ManifestRecipeProperties{
+			TemplateKind: to.Ptr(e.TemplateKind),
+			TemplatePath: to.Ptr(e.TemplatePath),
+			Parameters:   e.Parameters,
+		}
 	}
 	return nil
 }
+
+func toRecipeConfigDatamodel(config *RecipeConfigProperties) (datamodel.RecipeConfigProperties, error) {
+	recipeConfig := datamodel.RecipeConfigProperties{}
+	if config.Bicep != nil {
+		authentication, err := toRegistryAuthDatamodel(config.Bicep.Authentication)
+		if err != nil {
+			return datamodel.RecipeConfigProperties{}, err
+		}
+		recipeConfig.Bicep.Authentication = authentication
+	}
+
+	if config.Terraform == nil {
+		return recipeConfig, nil
+	}
+
+	if config.Terraform.Backend != nil {
+		backend, err := toTerraformBackendDatamodel(config.Terraform.Backend)
+		if err != nil {
+			return datamodel.RecipeConfigProperties{}, err
+		}
+		recipeConfig.Terraform.Backend = backend
+	}
+
+	if config.Terraform.Providers != nil {
+		providers, err := toProvidersConfigDatamodel(config.Terraform.Providers)
+		if err != nil {
+			return datamodel.RecipeConfigProperties{}, err
+		}
+		recipeConfig.Terraform.Providers = providers
+	}
+
+	if config.Terraform.Authentication != nil {
+		authentication, err := toAuthConfigDatamodel(config.Terraform.Authentication)
+		if err != nil {
+			return datamodel.RecipeConfigProperties{}, err
+		}
+		recipeConfig.Terraform.Authentication = authentication
+	}
+
+	return recipeConfig, nil
+}
+
+func fromRecipeConfigDatamodel(config datamodel.RecipeConfigProperties) *RecipeConfigProperties {
+	var terraform *TerraformConfigProperties
+	if config.Terraform.Backend != nil || config.Terraform.Providers != nil || config.Terraform.Authentication != nil {
+		terraform = &TerraformConfigProperties{
+			Providers: fromProvidersConfigDatamodel(config.Terraform.Providers),
+		}
+		if config.Terraform.Backend != nil {
+			terraform.Backend = fromTerraformBackendDatamodel(config.Terraform.Backend)
+		}
+		if config.Terraform.Authentication != nil {
+			terraform.Authentication = fromAuthConfigDatamodel(config.Terraform.Authentication)
+		}
+	}
+
+	var bicep *BicepConfigProperties
+	if config.Bicep.Authentication != nil {
+		bicep = &BicepConfigProperties{
+			Authentication: fromRegistryAuthDatamodel(config.Bicep.Authentication),
+		}
+	}
+
+	if terraform == nil && bicep == nil {
+		return nil
+	}
+
+	return &RecipeConfigProperties{
+		Terraform: terraform,
+		Bicep:     bicep,
+	}
+}
+
+func toProvidersConfigDatamodel(providers map[string]*ProviderConfigProperties) (map[string]datamodel.ProviderConfigProperties, error) {
+	converted := map[string]datamodel.ProviderConfigProperties{}
+	for name, provider := range providers {
+		if provider == nil {
+			continue
+		}
+
+		config := datamodel.ProviderConfigProperties{
+			AdditionalProperties: provider.AdditionalProperties,
+		}
+		if provider.Secrets != nil {
+			config.Secrets = map[string]datamodel.SecretReference{}
+			for key, ref := range provider.Secrets {
+				if ref == nil || to.String(ref.Source) == "" || to.String(ref.Key) == "" {
+					return nil, &v1.ErrModelConversion{PropertyName: fmt.Sprintf("$.properties.recipeConfig.terraform.providers.%s.secrets.%s", name, key), ValidValue: "secret reference with source and key"}
+				}
+				config.Secrets[key] = datamodel.SecretReference{
+					Source: *ref.Source,
+					Key:    *ref.Key,
+				}
+			}
+		}
+		converted[name] = config
+	}
+
+	return converted, nil
+}
+
+func fromProvidersConfigDatamodel(providers map[string]datamodel.ProviderConfigProperties) map[string]*ProviderConfigProperties {
+	if providers == nil {
+		return nil
+	}
+
+	converted := map[string]*ProviderConfigProperties{}
+	for name, provider := range providers {
+		config := &ProviderConfigProperties{
+			AdditionalProperties: provider.AdditionalProperties,
+		}
+		if provider.Secrets != nil {
+			config.Secrets = map[string]*SecretReference{}
+			for key, ref := range provider.Secrets {
+				config.Secrets[key] = &SecretReference{
+					Source: to.Ptr(ref.Source),
+					Key:    to.Ptr(ref.Key),
+				}
+			}
+		}
+		converted[name] = config
+	}
+
+	return converted
+}
+
+func toTerraformBackendDatamodel(backend *TerraformBackendProperties) (*datamodel.TerraformBackendProperties, error) {
+	if backend.Kind == nil {
+		return nil, &v1.ErrModelConversion{PropertyName: "$.properties.recipeConfig.terraform.backend.kind", ValidValue: "[kubernetes s3 postgresql local]"}
+	}
+
+	converted := &datamodel.TerraformBackendProperties{}
+	switch *backend.Kind {
+	case TerraformBackendKindKubernetes:
+		converted.Kind = datamodel.TerraformBackendKubernetes
+	case TerraformBackendKindS3:
+		if backend.S3 == nil || to.String(backend.S3.Bucket) == "" {
+			return nil, &v1.ErrModelConversion{PropertyName: "$.properties.recipeConfig.terraform.backend.s3.bucket", ValidValue: "specified for the s3 backend"}
+		}
+		converted.Kind = datamodel.TerraformBackendS3
+		converted.S3 = &datamodel.S3BackendProperties{
+			Bucket:        to.String(backend.S3.Bucket),
+			Region:        to.String(backend.S3.Region),
+			Endpoint:      to.String(backend.S3.Endpoint),
+			KeyPrefix:     to.String(backend.S3.KeyPrefix),
+			DynamoDBTable: to.String(backend.S3.DynamodbTable),
+		}
+	case TerraformBackendKindPostgresql:
+		if backend.Postgresql == nil || to.String(backend.Postgresql.ConnectionString) == "" {
+			return nil, &v1.ErrModelConversion{PropertyName: "$.properties.recipeConfig.terraform.backend.postgresql.connectionString", ValidValue: "specified for the postgresql backend"}
+		}
+		converted.Kind = datamodel.TerraformBackendPostgreSQL
+		converted.PostgreSQL = &datamodel.PostgreSQLBackendProperties{
+			ConnectionString: to.String(backend.Postgresql.ConnectionString),
+			SecretName:       to.String(backend.Postgresql.SecretName),
+		}
+	case TerraformBackendKindLocal:
+		if backend.Local == nil || to.String(backend.Local.Path) == "" {
+			return nil, &v1.ErrModelConversion{PropertyName: "$.properties.recipeConfig.terraform.backend.local.path", ValidValue: "specified for the local backend"}
+		}
+		converted.Kind = datamodel.TerraformBackendLocal
+		converted.Local = &datamodel.LocalBackendProperties{
+			Path: to.String(backend.Local.Path),
+		}
+	default:
+		return nil, &v1.ErrModelConversion{PropertyName: "$.properties.recipeConfig.terraform.backend.kind", ValidValue: "[kubernetes s3 postgresql local]"}
+	}
+
+	return converted, nil
+}
+
+func fromTerraformBackendDatamodel(backend *datamodel.TerraformBackendProperties) *TerraformBackendProperties {
+	converted := &TerraformBackendProperties{
+		Kind: to.Ptr(TerraformBackendKind(backend.Kind)),
+	}
+
+	if backend.S3 != nil {
+		converted.S3 = &S3BackendProperties{
+			Bucket:        to.Ptr(backend.S3.Bucket),
+			Region:        toStringPtr(backend.S3.Region),
+			Endpoint:      toStringPtr(backend.S3.Endpoint),
+			KeyPrefix:     toStringPtr(backend.S3.KeyPrefix),
+			DynamodbTable: toStringPtr(backend.S3.DynamoDBTable),
+		}
+	}
+
+	if backend.PostgreSQL != nil {
+		converted.Postgresql = &PostgreSQLBackendProperties{
+			ConnectionString: to.Ptr(backend.PostgreSQL.ConnectionString),
+			SecretName:       toStringPtr(backend.PostgreSQL.SecretName),
+		}
+	}
+
+	if backend.Local != nil {
+		converted.Local = &LocalBackendProperties{
+			Path: to.Ptr(backend.Local.Path),
+		}
+	}
+
+	return converted
+}
+
+func toAuthConfigDatamodel(auth *AuthConfig) (*datamodel.AuthConfig, error) {
+	converted := &datamodel.AuthConfig{}
+	var err error
+	if auth.Git != nil {
+		if converted.Git.PAT, err = toSecretConfigsDatamodel(auth.Git.Pat, "$.properties.recipeConfig.terraform.authentication.git.pat"); err != nil {
+			return nil, err
+		}
+		if converted.Git.SSH, err = toSecretConfigsDatamodel(auth.Git.SSH, "$.properties.recipeConfig.terraform.authentication.git.ssh"); err != nil {
+			return nil, err
+		}
+	}
+	if converted.Registries, err = toSecretConfigsDatamodel(auth.Registries, "$.properties.recipeConfig.terraform.authentication.registries"); err != nil {
+		return nil, err
+	}
+
+	return converted, nil
+}
+
+func toSecretConfigsDatamodel(secrets map[string]*SecretConfig, propertyName string) (map[string]datamodel.SecretConfig, error) {
+	if secrets == nil {
+		return nil, nil
+	}
+
+	converted := map[string]datamodel.SecretConfig{}
+	for host, secret := range secrets {
+		if secret == nil || to.String(secret.Secret) == "" {
+			return nil, &v1.ErrModelConversion{PropertyName: fmt.Sprintf("%s.%s.secret", propertyName, host), ValidValue: "resource ID of a secret store"}
+		}
+		converted[host] = datamodel.SecretConfig{Secret: *secret.Secret}
+	}
+
+	return converted, nil
+}
+
+func fromAuthConfigDatamodel(auth *datamodel.AuthConfig) *AuthConfig {
+	converted := &AuthConfig{
+		Registries: fromSecretConfigsDatamodel(auth.Registries),
+	}
+	if auth.Git.PAT != nil || auth.Git.SSH != nil {
+		converted.Git = &GitAuthConfig{
+			Pat: fromSecretConfigsDatamodel(auth.Git.PAT),
+			SSH: fromSecretConfigsDatamodel(auth.Git.SSH),
+		}
+	}
+
+	return converted
+}
+
+func fromSecretConfigsDatamodel(secrets map[string]datamodel.SecretConfig) map[string]*SecretConfig {
+	if secrets == nil {
+		return nil
+	}
+
+	converted := map[string]*SecretConfig{}
+	for host, secret := range secrets {
+		converted[host] = &SecretConfig{Secret: to.Ptr(secret.Secret)}
+	}
+
+	return converted
+}
+
+func toRegistryAuthDatamodel(authentication map[string]*RegistryAuthConfig) (map[string]datamodel.RegistryAuthConfig, error) {
+	if authentication == nil {
+		return nil, nil
+	}
+
+	converted := map[string]datamodel.RegistryAuthConfig{}
+	for host, auth := range authentication {
+		if auth == nil || auth.Kind == nil {
+			return nil, &v1.ErrModelConversion{PropertyName: fmt.Sprintf("$.properties.recipeConfig.bicep.authentication.%s.kind", host), ValidValue: "[basic bearer dockerConfig]"}
+		}
+
+		var kind string
+		switch *auth.Kind {
+		case RegistryAuthKindBasic:
+			kind = datamodel.RegistryAuthBasic
+		case RegistryAuthKindBearer:
+			kind = datamodel.RegistryAuthBearer
+		case RegistryAuthKindDockerConfig:
+			kind = datamodel.RegistryAuthDockerConfig
+		default:
+			return nil, &v1.ErrModelConversion{PropertyName: fmt.Sprintf("$.properties.recipeConfig.bicep.authentication.%s.kind", host), ValidValue: "[basic bearer dockerConfig]"}
+		}
+
+		if to.String(auth.Secret) == "" {
+			return nil, &v1.ErrModelConversion{PropertyName: fmt.Sprintf("$.properties.recipeConfig.bicep.authentication.%s.secret", host), ValidValue: "resource ID of a secret store"}
+		}
+
+		converted[host] = datamodel.RegistryAuthConfig{
+			Kind:   kind,
+			Secret: *auth.Secret,
+		}
+	}
+
+	return converted, nil
+}
+
+func fromRegistryAuthDatamodel(authentication map[string]datamodel.RegistryAuthConfig) map[string]*RegistryAuthConfig {
+	converted := map[string]*RegistryAuthConfig{}
+	for host, auth := range authentication {
+		converted[host] = &RegistryAuthConfig{
+			Kind:   to.Ptr(RegistryAuthKind(auth.Kind)),
+			Secret: to.Ptr(auth.Secret),
+		}
+	}
+
+	return converted
+}
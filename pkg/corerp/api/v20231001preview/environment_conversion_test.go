@@ -141,6 +141,15 @@ func TestConvertVersionedToDataModel(t *testing.T) {
 								TemplateKind: recipes.TemplateKindBicep,
 								TemplatePath: "br:ghcr.io/sampleregistry/radius/recipes/rediscaches",
 							},
+							"redis-helm-recipe": datamodel.EnvironmentRecipeProperties{
+								TemplateKind:    recipes.TemplateKindHelm,
+								TemplatePath:    "oci://ghcr.io/sampleregistry/charts/redis",
+								TemplateVersion: "1.0.0",
+							},
+							"redis-manifest-recipe": datamodel.EnvironmentRecipeProperties{
+								TemplateKind: recipes.TemplateKindManifest,
+								TemplatePath: "oci://ghcr.io/sampleregistry/manifests/redis:1.0.0",
+							},
 						},
 						dapr_ctrl.DaprStateStoresResourceType: {
 							"statestore-recipe": datamodel.EnvironmentRecipeProperties{
@@ -268,6 +277,181 @@ func TestConvertVersionedToDataModel(t *testing.T) {
 			},
 			err: nil,
 		},
+		{
+			filename: "environmentresource-with-terraform-backend.json",
+			expected: &datamodel.Environment{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/environments/env0",
+						Name: "env0",
+						Type: "Applications.Core/environments",
+						Tags: map[string]string{},
+					},
+					InternalMetadata: v1.InternalMetadata{
+						CreatedAPIVersion:      "2023-10-01-preview",
+						UpdatedAPIVersion:      "2023-10-01-preview",
+						AsyncProvisioningState: v1.ProvisioningStateAccepted,
+					},
+				},
+				Properties: datamodel.EnvironmentProperties{
+					Compute: rpv1.EnvironmentCompute{
+						Kind: "kubernetes",
+						KubernetesCompute: rpv1.KubernetesComputeProperties{
+							Namespace: "default",
+						},
+					},
+					RecipeConfig: datamodel.RecipeConfigProperties{
+						Terraform: datamodel.TerraformConfigProperties{
+							Backend: &datamodel.TerraformBackendProperties{
+								Kind: datamodel.TerraformBackendS3,
+								S3: &datamodel.S3BackendProperties{
+									Bucket:        "tfstate",
+									Endpoint:      "http://minio.minio-system:9000",
+									DynamoDBTable: "tflock",
+								},
+							},
+						},
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			filename: "environmentresource-with-terraform-providers.json",
+			expected: &datamodel.Environment{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/environments/env0",
+						Name: "env0",
+						Type: "Applications.Core/environments",
+						Tags: map[string]string{},
+					},
+					InternalMetadata: v1.InternalMetadata{
+						CreatedAPIVersion:      "2023-10-01-preview",
+						UpdatedAPIVersion:      "2023-10-01-preview",
+						AsyncProvisioningState: v1.ProvisioningStateAccepted,
+					},
+				},
+				Properties: datamodel.EnvironmentProperties{
+					Compute: rpv1.EnvironmentCompute{
+						Kind: "kubernetes",
+						KubernetesCompute: rpv1.KubernetesComputeProperties{
+							Namespace: "default",
+						},
+					},
+					RecipeConfig: datamodel.RecipeConfigProperties{
+						Terraform: datamodel.TerraformConfigProperties{
+							Providers: map[string]datamodel.ProviderConfigProperties{
+								"vault": {
+									AdditionalProperties: map[string]any{
+										"address":         "https://vault.example.com:8200",
+										"skip_tls_verify": true,
+									},
+									Secrets: map[string]datamodel.SecretReference{
+										"token": {
+											Source: "/planes/radius/local/resourceGroups/radius-test-rg/providers/Applications.Core/secretStores/vault",
+											Key:    "token",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			filename: "environmentresource-with-terraform-authentication.json",
+			expected: &datamodel.Environment{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/environments/env0",
+						Name: "env0",
+						Type: "Applications.Core/environments",
+						Tags: map[string]string{},
+					},
+					InternalMetadata: v1.InternalMetadata{
+						CreatedAPIVersion:      "2023-10-01-preview",
+						UpdatedAPIVersion:      "2023-10-01-preview",
+						AsyncProvisioningState: v1.ProvisioningStateAccepted,
+					},
+				},
+				Properties: datamodel.EnvironmentProperties{
+					Compute: rpv1.EnvironmentCompute{
+						Kind: "kubernetes",
+						KubernetesCompute: rpv1.KubernetesComputeProperties{
+							Namespace: "default",
+						},
+					},
+					RecipeConfig: datamodel.RecipeConfigProperties{
+						Terraform: datamodel.TerraformConfigProperties{
+							Authentication: &datamodel.AuthConfig{
+								Git: datamodel.GitAuthConfig{
+									PAT: map[string]datamodel.SecretConfig{
+										"dev.azure.com": {
+											Secret: "/planes/radius/local/resourceGroups/radius-test-rg/providers/Applications.Core/secretStores/ado-pat",
+										},
+									},
+									SSH: map[string]datamodel.SecretConfig{
+										"github.com": {
+											Secret: "/planes/radius/local/resourceGroups/radius-test-rg/providers/Applications.Core/secretStores/github-ssh",
+										},
+									},
+								},
+								Registries: map[string]datamodel.SecretConfig{
+									"registry.example.com": {
+										Secret: "/planes/radius/local/resourceGroups/radius-test-rg/providers/Applications.Core/secretStores/registry-token",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			filename: "environmentresource-with-bicep-registry-auth.json",
+			expected: &datamodel.Environment{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/environments/env0",
+						Name: "env0",
+						Type: "Applications.Core/environments",
+						Tags: map[string]string{},
+					},
+					InternalMetadata: v1.InternalMetadata{
+						CreatedAPIVersion:      "2023-10-01-preview",
+						UpdatedAPIVersion:      "2023-10-01-preview",
+						AsyncProvisioningState: v1.ProvisioningStateAccepted,
+					},
+				},
+				Properties: datamodel.EnvironmentProperties{
+					Compute: rpv1.EnvironmentCompute{
+						Kind: "kubernetes",
+						KubernetesCompute: rpv1.KubernetesComputeProperties{
+							Namespace: "default",
+						},
+					},
+					RecipeConfig: datamodel.RecipeConfigProperties{
+						Bicep: datamodel.BicepConfigProperties{
+							Authentication: map[string]datamodel.RegistryAuthConfig{
+								"myregistry.azurecr.io": {
+									Kind:   datamodel.RegistryAuthBasic,
+									Secret: "/planes/radius/local/resourceGroups/radius-test-rg/providers/Applications.Core/secretStores/acr-credentials",
+								},
+								"ghcr.io": {
+									Kind:   datamodel.RegistryAuthDockerConfig,
+									Secret: "/planes/radius/local/resourceGroups/radius-test-rg/providers/Applications.Core/secretStores/docker-config",
+								},
+							},
+						},
+					},
+				},
+			},
+			err: nil,
+		},
 		{
 			filename: "environmentresource-invalid-missing-namespace.json",
 			err:      &v1.ErrModelConversion{PropertyName: "$.properties.compute.namespace", ValidValue: "63 characters or less"},
@@ -282,16 +466,32 @@ func TestConvertVersionedToDataModel(t *testing.T) {
 		},
 		{
 			filename: "environmentresource-invalid-templatekind.json",
-			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: "invalid template kind. Allowed formats: \"bicep\", \"terraform\""},
+			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: "invalid template kind. Allowed formats: \"bicep\", \"terraform\", \"helm\", \"manifest\""},
 		},
 		{
 			filename: "environmentresource-missing-templatekind.json",
-			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: "invalid template kind. Allowed formats: \"bicep\", \"terraform\""},
+			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: "invalid template kind. Allowed formats: \"bicep\", \"terraform\", \"helm\", \"manifest\""},
 		},
 		{
 			filename: "environmentresource-terraformrecipe-localpath.json",
 			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: fmt.Sprintf(invalidLocalModulePathFmt, "../not-allowed/")},
 		},
+		{
+			filename: "environmentresource-invalid-terraform-backend.json",
+			err:      &v1.ErrModelConversion{PropertyName: "$.properties.recipeConfig.terraform.backend.postgresql.connectionString", ValidValue: "specified for the postgresql backend"},
+		},
+		{
+			filename: "environmentresource-invalid-terraform-provider-secret.json",
+			err:      &v1.ErrModelConversion{PropertyName: "$.properties.recipeConfig.terraform.providers.vault.secrets.token", ValidValue: "secret reference with source and key"},
+		},
+		{
+			filename: "environmentresource-invalid-terraform-authentication.json",
+			err:      &v1.ErrModelConversion{PropertyName: "$.properties.recipeConfig.terraform.authentication.git.pat.dev.azure.com.secret", ValidValue: "resource ID of a secret store"},
+		},
+		{
+			filename: "environmentresource-invalid-bicep-registry-auth.json",
+			err:      &v1.ErrModelConversion{PropertyName: "$.properties.recipeConfig.bicep.authentication.myregistry.azurecr.io.kind", ValidValue: "[basic bearer dockerConfig]"},
+		},
 	}
 
 	for _, tt := range conversionTests {
@@ -372,6 +572,17 @@ func TestConvertDataModelToVersioned(t *testing.T) {
 					case *TerraformRecipeProperties:
 						require.Equal(t, "1.1.0", string(*c.TemplateVersion))
 					}
+
+					helmRecipe, ok := versioned.Properties.Recipes[ds_ctrl.MongoDatabasesResourceType]["helm-recipe"].(*HelmRecipeProperties)
+					require.True(t, ok)
+					require.Equal(t, recipes.TemplateKindHelm, *helmRecipe.TemplateKind)
+					require.Equal(t, "oci://ghcr.io/sampleregistry/charts/mongodb", *helmRecipe.TemplatePath)
+					require.Equal(t, "1.0.0", *helmRecipe.TemplateVersion)
+
+					manifestRecipe, ok := versioned.Properties.Recipes[ds_ctrl.MongoDatabasesResourceType]["manifest-recipe"].(*ManifestRecipeProperties)
+					require.True(t, ok)
+					require.Equal(t, recipes.TemplateKindManifest, *manifestRecipe.TemplateKind)
+					require.Equal(t, "git::https://github.com/sampleregistry/recipes.git//mongodb?ref=v1.0.0", *manifestRecipe.TemplatePath)
 				}
 				if tt.filename == "environmentresourcedatamodelemptyext.json" {
 					switch c := recipeDetails.(type) {
@@ -439,6 +650,141 @@ func TestConvertDataModelWithIdentityToVersioned(t *testing.T) {
 	require.Equal(t, "https://oidcurl/guid", string(*versioned.Properties.Compute.GetEnvironmentCompute().Identity.OidcIssuer))
 }
 
+func TestConvertDataModelWithTerraformBackendToVersioned(t *testing.T) {
+	rawPayload := testutil.ReadFixture("environmentresource-with-terraform-backend.json")
+	r := &EnvironmentResource{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	dm, err := r.ConvertTo()
+	require.NoError(t, err)
+
+	// act
+	versioned := &EnvironmentResource{}
+	err = versioned.ConvertFrom(dm)
+
+	// assert
+	require.NoError(t, err)
+	require.Equal(t, &RecipeConfigProperties{
+		Terraform: &TerraformConfigProperties{
+			Backend: &TerraformBackendProperties{
+				Kind: to.Ptr(TerraformBackendKindS3),
+				S3: &S3BackendProperties{
+					Bucket:        to.Ptr("tfstate"),
+					Endpoint:      to.Ptr("http://minio.minio-system:9000"),
+					DynamodbTable: to.Ptr("tflock"),
+				},
+			},
+		},
+	}, versioned.Properties.RecipeConfig)
+}
+
+func TestConvertDataModelWithTerraformProvidersToVersioned(t *testing.T) {
+	rawPayload := testutil.ReadFixture("environmentresource-with-terraform-providers.json")
+	r := &EnvironmentResource{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	dm, err := r.ConvertTo()
+	require.NoError(t, err)
+
+	// act
+	versioned := &EnvironmentResource{}
+	err = versioned.ConvertFrom(dm)
+
+	// assert
+	require.NoError(t, err)
+	require.Equal(t, &RecipeConfigProperties{
+		Terraform: &TerraformConfigProperties{
+			Providers: map[string]*ProviderConfigProperties{
+				"vault": {
+					AdditionalProperties: map[string]any{
+						"address":         "https://vault.example.com:8200",
+						"skip_tls_verify": true,
+					},
+					Secrets: map[string]*SecretReference{
+						"token": {
+							Source: to.Ptr("/planes/radius/local/resourceGroups/radius-test-rg/providers/Applications.Core/secretStores/vault"),
+							Key:    to.Ptr("token"),
+						},
+					},
+				},
+			},
+		},
+	}, versioned.Properties.RecipeConfig)
+}
+
+func TestConvertDataModelWithTerraformAuthenticationToVersioned(t *testing.T) {
+	rawPayload := testutil.ReadFixture("environmentresource-with-terraform-authentication.json")
+	r := &EnvironmentResource{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	dm, err := r.ConvertTo()
+	require.NoError(t, err)
+
+	// act
+	versioned := &EnvironmentResource{}
+	err = versioned.ConvertFrom(dm)
+
+	// assert
+	require.NoError(t, err)
+	require.Equal(t, &RecipeConfigProperties{
+		Terraform: &TerraformConfigProperties{
+			Authentication: &AuthConfig{
+				Git: &GitAuthConfig{
+					Pat: map[string]*SecretConfig{
+						"dev.azure.com": {
+							Secret: to.Ptr("/planes/radius/local/resourceGroups/radius-test-rg/providers/Applications.Core/secretStores/ado-pat"),
+						},
+					},
+					SSH: map[string]*SecretConfig{
+						"github.com": {
+							Secret: to.Ptr("/planes/radius/local/resourceGroups/radius-test-rg/providers/Applications.Core/secretStores/github-ssh"),
+						},
+					},
+				},
+				Registries: map[string]*SecretConfig{
+					"registry.example.com": {
+						Secret: to.Ptr("/planes/radius/local/resourceGroups/radius-test-rg/providers/Applications.Core/secretStores/registry-token"),
+					},
+				},
+			},
+		},
+	}, versioned.Properties.RecipeConfig)
+}
+
+func TestConvertDataModelWithBicepRegistryAuthToVersioned(t *testing.T) {
+	rawPayload := testutil.ReadFixture("environmentresource-with-bicep-registry-auth.json")
+	r := &EnvironmentResource{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	dm, err := r.ConvertTo()
+	require.NoError(t, err)
+
+	// act
+	versioned := &EnvironmentResource{}
+	err = versioned.ConvertFrom(dm)
+
+	// assert
+	require.NoError(t, err)
+	require.Equal(t, &RecipeConfigProperties{
+		Bicep: &BicepConfigProperties{
+			Authentication: map[string]*RegistryAuthConfig{
+				"myregistry.azurecr.io": {
+					Kind:   to.Ptr(RegistryAuthKindBasic),
+					Secret: to.Ptr("/planes/radius/local/resourceGroups/radius-test-rg/providers/Applications.Core/secretStores/acr-credentials"),
+				},
+				"ghcr.io": {
+					Kind:   to.Ptr(RegistryAuthKindDockerConfig),
+					Secret: to.Ptr("/planes/radius/local/resourceGroups/radius-test-rg/providers/Applications.Core/secretStores/docker-config"),
+				},
+			},
+		},
+	}, versioned.Properties.RecipeConfig)
+}
+
 func TestConvertFromValidation(t *testing.T) {
 	validationTests := []struct {
 		src v1.DataModelInterface
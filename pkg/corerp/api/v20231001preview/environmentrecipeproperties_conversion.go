@@ -38,7 +38,7 @@ func (dst *RecipeGetMetadataResponse) ConvertFrom(src v1.DataModelInterface) err
 	}
 	dst.TemplateKind = to.Ptr(recipe.TemplateKind)
 	dst.TemplatePath = to.Ptr(recipe.TemplatePath)
-	if recipe.TemplateKind == types.TemplateKindTerraform {
+	if recipe.TemplateKind == types.TemplateKindTerraform || recipe.TemplateKind == types.TemplateKindHelm {
 		dst.TemplateVersion = to.Ptr(recipe.TemplateVersion)
 	}
 	dst.Parameters = recipe.Parameters
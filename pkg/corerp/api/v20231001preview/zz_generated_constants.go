@@ -284,6 +284,58 @@ func PossibleProvisioningStateValues() []ProvisioningState {
 	}
 }
 
+// RecipeChangeType - The kind of change a recipe deployment makes to a resource.
+type RecipeChangeType string
+
+const (
+	// RecipeChangeTypeCreate - The resource doesn't exist and will be created
+	RecipeChangeTypeCreate RecipeChangeType = "Create"
+	// RecipeChangeTypeDelete - The resource exists and will be deleted
+	RecipeChangeTypeDelete RecipeChangeType = "Delete"
+	// RecipeChangeTypeNoChange - The resource exists and will not be changed
+	RecipeChangeTypeNoChange RecipeChangeType = "NoChange"
+	// RecipeChangeTypeReplace - The resource exists and will be deleted and created again
+	RecipeChangeTypeReplace RecipeChangeType = "Replace"
+	// RecipeChangeTypeUnknown - The resource will be deployed, but whether it is created or updated can't be determined before
+// the deployment
+	RecipeChangeTypeUnknown RecipeChangeType = "Unknown"
+	// RecipeChangeTypeUpdate - The resource exists and will be updated in place
+	RecipeChangeTypeUpdate RecipeChangeType = "Update"
+)
+
+// PossibleRecipeChangeTypeValues returns the possible values for the RecipeChangeType const type.
+func PossibleRecipeChangeTypeValues() []RecipeChangeType {
+	return []RecipeChangeType{	
+		RecipeChangeTypeCreate,
+		RecipeChangeTypeDelete,
+		RecipeChangeTypeNoChange,
+		RecipeChangeTypeReplace,
+		RecipeChangeTypeUnknown,
+		RecipeChangeTypeUpdate,
+	}
+}
+
+// RegistryAuthKind - The kind of the credentials of a private OCI registry.
+type RegistryAuthKind string
+
+const (
+	// RegistryAuthKindBasic - Username and password. The secret store must contain the keys 'username' and 'password'.
+	RegistryAuthKindBasic RegistryAuthKind = "basic"
+	// RegistryAuthKindBearer - Bearer token. The secret store must contain the key 'token'.
+	RegistryAuthKindBearer RegistryAuthKind = "bearer"
+	// RegistryAuthKindDockerConfig - Docker config JSON. The secret store must contain the key '.dockerconfigjson'.
+	RegistryAuthKindDockerConfig RegistryAuthKind = "dockerConfig"
+)
+
+// PossibleRegistryAuthKindValues returns the possible values for the RegistryAuthKind const type.
+func PossibleRegistryAuthKindValues() []RegistryAuthKind {
+	return []RegistryAuthKind{	
+		RegistryAuthKindBasic,
+		RegistryAuthKindBearer,
+		RegistryAuthKindDockerConfig,
+	}
+}
+
 // ResourceProvisioning - Specifies how the underlying service/resource is provisioned and managed. Available values are 'recipe',
 // where Radius manages the lifecycle of the resource through a Recipe, and 'manual', where a user
 // manages the resource and provides the values.
@@ -379,6 +431,30 @@ func PossibleTLSMinVersionValues() []TLSMinVersion {
 	}
 }
 
+// TerraformBackendKind - The kind of the backend storing the Terraform state of the Terraform Recipes.
+type TerraformBackendKind string
+
+const (
+	// TerraformBackendKindKubernetes - Kubernetes secrets in the radius-system namespace
+	TerraformBackendKindKubernetes TerraformBackendKind = "kubernetes"
+	// TerraformBackendKindLocal - Local filesystem of the Radius control plane
+	TerraformBackendKindLocal TerraformBackendKind = "local"
+	// TerraformBackendKindPostgresql - PostgreSQL database
+	TerraformBackendKindPostgresql TerraformBackendKind = "postgresql"
+	// TerraformBackendKindS3 - S3 or S3-compatible object storage
+	TerraformBackendKindS3 TerraformBackendKind = "s3"
+)
+
+// PossibleTerraformBackendKindValues returns the possible values for the TerraformBackendKind const type.
+func PossibleTerraformBackendKindValues() []TerraformBackendKind {
+	return []TerraformBackendKind{	
+		TerraformBackendKindKubernetes,
+		TerraformBackendKindLocal,
+		TerraformBackendKindPostgresql,
+		TerraformBackendKindS3,
+	}
+}
+
 // Versions - Supported API versions for the Applications.Core resource provider.
 type Versions string
 
@@ -336,6 +336,64 @@ func (client *ExtendersClient) listSecretsHandleResponse(resp *http.Response) (E
 	return result, nil
 }
 
+
+// Plan - Previews the changes the recipe of the Extender resource in the request body makes when it is deployed, without deploying
+// it
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - extenderName - The name of the Extender portable resource resource
+//   - body - The content of the action request
+//   - options - ExtendersClientPlanOptions contains the optional parameters for the ExtendersClient.Plan method.
+func (client *ExtendersClient) Plan(ctx context.Context, extenderName string, body ExtenderResource, options *ExtendersClientPlanOptions) (ExtendersClientPlanResponse, error) {
+	var err error
+	req, err := client.planCreateRequest(ctx, extenderName, body, options)
+	if err != nil {
+		return ExtendersClientPlanResponse{}, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return ExtendersClientPlanResponse{}, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK) {
+		err = runtime.NewResponseError(httpResp)
+		return ExtendersClientPlanResponse{}, err
+	}
+	resp, err := client.planHandleResponse(httpResp)
+	return resp, err
+}
+
+// planCreateRequest creates the Plan request.
+func (client *ExtendersClient) planCreateRequest(ctx context.Context, extenderName string, body ExtenderResource, options *ExtendersClientPlanOptions) (*policy.Request, error) {
+	urlPath := "/{rootScope}/providers/Applications.Core/extenders/{extenderName}/plan"
+	urlPath = strings.ReplaceAll(urlPath, "{rootScope}", client.rootScope)
+	if extenderName == "" {
+		return nil, errors.New("parameter extenderName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{extenderName}", url.PathEscape(extenderName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	if err := runtime.MarshalAsJSON(req, body); err != nil {
+	return nil, err
+}
+	return req, nil
+}
+
+// planHandleResponse handles the Plan response.
+func (client *ExtendersClient) planHandleResponse(resp *http.Response) (ExtendersClientPlanResponse, error) {
+	result := ExtendersClientPlanResponse{}
+	if err := runtime.UnmarshalAsJSON(resp, &result.RecipePlanResult); err != nil {
+		return ExtendersClientPlanResponse{}, err
+	}
+	return result, nil
+}
+
 // BeginUpdate - Update a ExtenderResource
 // If the operation fails it returns an *azcore.ResponseError type.
 //
@@ -133,6 +133,26 @@ type ApplicationResourceUpdateProperties struct {
 	Simulated *bool
 }
 
+// AuthConfig - Authentication used to download Terraform modules from private sources.
+type AuthConfig struct {
+	// Authentication for git module sources.
+	Git *GitAuthConfig
+
+	// Authentication for private Terraform module registries, keyed by the registry hostname. The secret store must contain
+// the key 'token'.
+	Registries map[string]*SecretConfig
+}
+
+// AuthConfigUpdate - Authentication used to download Terraform modules from private sources.
+type AuthConfigUpdate struct {
+	// Authentication for git module sources.
+	Git *GitAuthConfigUpdate
+
+	// Authentication for private Terraform module registries, keyed by the registry hostname. The secret store must contain
+// the key 'token'.
+	Registries map[string]*SecretConfigUpdate
+}
+
 // AzureKeyVaultVolumeProperties - Represents Azure Key Vault Volume properties
 type AzureKeyVaultVolumeProperties struct {
 	// REQUIRED; Fully qualified resource ID for the application that the portable resource is consumed by
@@ -174,6 +194,19 @@ func (a *AzureKeyVaultVolumeProperties) GetVolumeProperties() *VolumeProperties
 	}
 }
 
+// BicepConfigProperties - Configuration for Bicep Recipes. Controls how Bicep Recipes are pulled from OCI registries.
+type BicepConfigProperties struct {
+	// Authentication for private OCI registries hosting Bicep Recipes, keyed by the registry hostname.
+	Authentication map[string]*RegistryAuthConfig
+}
+
+// BicepConfigPropertiesUpdate - Configuration for Bicep Recipes. Controls how Bicep Recipes are pulled from OCI
+// registries.
+type BicepConfigPropertiesUpdate struct {
+	// Authentication for private OCI registries hosting Bicep Recipes, keyed by the registry hostname.
+	Authentication map[string]*RegistryAuthConfigUpdate
+}
+
 // BicepRecipeProperties - Represents Bicep recipe properties.
 type BicepRecipeProperties struct {
 	// REQUIRED; Discriminator property for RecipeProperties.
@@ -545,6 +578,9 @@ type EnvironmentProperties struct {
 	// Cloud providers configuration for the environment.
 	Providers *Providers
 
+	// Configuration for Recipes. Defines how each type of Recipe should be configured and run.
+	RecipeConfig *RecipeConfigProperties
+
 	// Specifies Recipes linked to the Environment.
 	Recipes map[string]map[string]RecipePropertiesClassification
 
@@ -608,6 +644,9 @@ type EnvironmentResourceUpdateProperties struct {
 	// Cloud providers configuration for the environment.
 	Providers *ProvidersUpdate
 
+	// Configuration for Recipes. Defines how each type of Recipe should be configured and run.
+	RecipeConfig *RecipeConfigPropertiesUpdate
+
 	// Specifies Recipes linked to the Environment.
 	Recipes map[string]map[string]RecipePropertiesUpdateClassification
 
@@ -926,6 +965,28 @@ type GatewayTLS struct {
 	SSLPassthrough *bool
 }
 
+// GitAuthConfig - Authentication for git module sources.
+type GitAuthConfig struct {
+	// Personal access tokens for HTTPS git module sources, keyed by the git server hostname. The secret store must contain the
+// keys 'username' and 'pat'.
+	Pat map[string]*SecretConfig
+
+	// SSH keys for SSH git module sources, keyed by the git server hostname. The secret store must contain the keys
+// 'privateKey' and 'knownHosts', the known host keys used to verify the git server.
+	SSH map[string]*SecretConfig
+}
+
+// GitAuthConfigUpdate - Authentication for git module sources.
+type GitAuthConfigUpdate struct {
+	// Personal access tokens for HTTPS git module sources, keyed by the git server hostname. The secret store must contain the
+// keys 'username' and 'pat'.
+	Pat map[string]*SecretConfigUpdate
+
+	// SSH keys for SSH git module sources, keyed by the git server hostname. The secret store must contain the keys
+// 'privateKey' and 'knownHosts', the known host keys used to verify the git server.
+	SSH map[string]*SecretConfigUpdate
+}
+
 // HTTPGetHealthProbeProperties - Specifies the properties for readiness/liveness probe using HTTP Get
 type HTTPGetHealthProbeProperties struct {
 	// REQUIRED; The listening port number
@@ -1069,6 +1130,54 @@ type HealthProbeProperties struct {
 // GetHealthProbeProperties implements the HealthProbePropertiesClassification interface for type HealthProbeProperties.
 func (h *HealthProbeProperties) GetHealthProbeProperties() *HealthProbeProperties { return h }
 
+// HelmRecipeProperties - Represents Helm chart recipe properties.
+type HelmRecipeProperties struct {
+	// REQUIRED; Discriminator property for RecipeProperties.
+	TemplateKind *string
+
+	// REQUIRED; Path to the template provided by the recipe. Currently only link to Azure Container Registry is supported.
+	TemplatePath *string
+
+	// Key/value parameters to pass to the recipe template at deployment
+	Parameters map[string]any
+
+	// Version of the chart to deploy. If omitted, the latest version of the chart is deployed.
+	TemplateVersion *string
+}
+
+// GetRecipeProperties implements the RecipePropertiesClassification interface for type HelmRecipeProperties.
+func (h *HelmRecipeProperties) GetRecipeProperties() *RecipeProperties {
+	return &RecipeProperties{
+		Parameters: h.Parameters,
+		TemplateKind: h.TemplateKind,
+		TemplatePath: h.TemplatePath,
+	}
+}
+
+// HelmRecipePropertiesUpdate - Represents Helm chart recipe properties.
+type HelmRecipePropertiesUpdate struct {
+	// REQUIRED; Discriminator property for RecipeProperties.
+	TemplateKind *string
+
+	// Key/value parameters to pass to the recipe template at deployment
+	Parameters map[string]any
+
+	// Path to the template provided by the recipe. Currently only link to Azure Container Registry is supported.
+	TemplatePath *string
+
+	// Version of the chart to deploy. If omitted, the latest version of the chart is deployed.
+	TemplateVersion *string
+}
+
+// GetRecipePropertiesUpdate implements the RecipePropertiesUpdateClassification interface for type HelmRecipePropertiesUpdate.
+func (h *HelmRecipePropertiesUpdate) GetRecipePropertiesUpdate() *RecipePropertiesUpdate {
+	return &RecipePropertiesUpdate{
+		Parameters: h.Parameters,
+		TemplateKind: h.TemplateKind,
+		TemplatePath: h.TemplatePath,
+	}
+}
+
 // IamProperties - IAM properties
 type IamProperties struct {
 	// REQUIRED; The kind of IAM provider to configure
@@ -1216,6 +1325,62 @@ type KubernetesRuntimeProperties struct {
 	Pod map[string]any
 }
 
+// LocalBackendProperties - Configuration of the local backend. The Terraform state is stored on the filesystem of the
+// Radius control plane, so it should only be used for development.
+type LocalBackendProperties struct {
+	// REQUIRED; The directory storing the Terraform state files.
+	Path *string
+}
+
+// LocalBackendPropertiesUpdate - Configuration of the local backend. The Terraform state is stored on the filesystem of
+// the Radius control plane, so it should only be used for development.
+type LocalBackendPropertiesUpdate struct {
+	// The directory storing the Terraform state files.
+	Path *string
+}
+
+// ManifestRecipeProperties - Represents Kubernetes manifest recipe properties.
+type ManifestRecipeProperties struct {
+	// REQUIRED; Discriminator property for RecipeProperties.
+	TemplateKind *string
+
+	// REQUIRED; Path to the template provided by the recipe. Currently only link to Azure Container Registry is supported.
+	TemplatePath *string
+
+	// Key/value parameters to pass to the recipe template at deployment
+	Parameters map[string]any
+}
+
+// GetRecipeProperties implements the RecipePropertiesClassification interface for type ManifestRecipeProperties.
+func (m *ManifestRecipeProperties) GetRecipeProperties() *RecipeProperties {
+	return &RecipeProperties{
+		Parameters: m.Parameters,
+		TemplateKind: m.TemplateKind,
+		TemplatePath: m.TemplatePath,
+	}
+}
+
+// ManifestRecipePropertiesUpdate - Represents Kubernetes manifest recipe properties.
+type ManifestRecipePropertiesUpdate struct {
+	// REQUIRED; Discriminator property for RecipeProperties.
+	TemplateKind *string
+
+	// Key/value parameters to pass to the recipe template at deployment
+	Parameters map[string]any
+
+	// Path to the template provided by the recipe. Currently only link to Azure Container Registry is supported.
+	TemplatePath *string
+}
+
+// GetRecipePropertiesUpdate implements the RecipePropertiesUpdateClassification interface for type ManifestRecipePropertiesUpdate.
+func (m *ManifestRecipePropertiesUpdate) GetRecipePropertiesUpdate() *RecipePropertiesUpdate {
+	return &RecipePropertiesUpdate{
+		Parameters: m.Parameters,
+		TemplateKind: m.TemplateKind,
+		TemplatePath: m.TemplatePath,
+	}
+}
+
 // ManualScalingExtension - ManualScaling Extension
 type ManualScalingExtension struct {
 	// REQUIRED; Discriminator property for Extension.
@@ -1318,6 +1483,48 @@ func (p *PersistentVolume) GetVolume() *Volume {
 	}
 }
 
+// PostgreSQLBackendProperties - Configuration of the PostgreSQL backend. The Terraform state of each resource is stored in
+// its own schema of the database.
+type PostgreSQLBackendProperties struct {
+	// REQUIRED; The connection string of the database, without credentials. For example:
+// 'postgres://postgres.example.com:5432/terraform?sslmode=require'
+	ConnectionString *string
+
+	// The name of the secret of the UCP secret store holding the 'username' and 'password' used to connect to the database.
+	SecretName *string
+}
+
+// PostgreSQLBackendPropertiesUpdate - Configuration of the PostgreSQL backend. The Terraform state of each resource is
+// stored in its own schema of the database.
+type PostgreSQLBackendPropertiesUpdate struct {
+	// The connection string of the database, without credentials. For example:
+// 'postgres://postgres.example.com:5432/terraform?sslmode=require'
+	ConnectionString *string
+
+	// The name of the secret of the UCP secret store holding the 'username' and 'password' used to connect to the database.
+	SecretName *string
+}
+
+// ProviderConfigProperties - Configuration of a Terraform provider. The properties other than secrets are added as they
+// are to the provider configuration.
+type ProviderConfigProperties struct {
+	// OPTIONAL; Contains additional key/value pairs not defined in the schema.
+	AdditionalProperties map[string]any
+
+	// The provider settings whose values are stored in secret stores, keyed by the name of the setting.
+	Secrets map[string]*SecretReference
+}
+
+// ProviderConfigPropertiesUpdate - Configuration of a Terraform provider. The properties other than secrets are added as
+// they are to the provider configuration.
+type ProviderConfigPropertiesUpdate struct {
+	// OPTIONAL; Contains additional key/value pairs not defined in the schema.
+	AdditionalProperties map[string]any
+
+	// The provider settings whose values are stored in secret stores, keyed by the name of the setting.
+	Secrets map[string]*SecretReferenceUpdate
+}
+
 // Providers - The Cloud providers configuration
 type Providers struct {
 	// The AWS cloud provider configuration
@@ -1369,6 +1576,24 @@ type Recipe struct {
 	Parameters map[string]any
 }
 
+// RecipeConfigProperties - Configuration for Recipes. Defines how each type of Recipe should be configured and run.
+type RecipeConfigProperties struct {
+	// Configuration for Bicep Recipes. Controls how Bicep Recipes are pulled from OCI registries.
+	Bicep *BicepConfigProperties
+
+	// Configuration for Terraform Recipes. Controls how Terraform plans and applies a set of resources.
+	Terraform *TerraformConfigProperties
+}
+
+// RecipeConfigPropertiesUpdate - Configuration for Recipes. Defines how each type of Recipe should be configured and run.
+type RecipeConfigPropertiesUpdate struct {
+	// Configuration for Bicep Recipes. Controls how Bicep Recipes are pulled from OCI registries.
+	Bicep *BicepConfigPropertiesUpdate
+
+	// Configuration for Terraform Recipes. Controls how Terraform plans and applies a set of resources.
+	Terraform *TerraformConfigPropertiesUpdate
+}
+
 // RecipeGetMetadata - Represents the request body of the getmetadata action.
 type RecipeGetMetadata struct {
 	// REQUIRED; The name of the recipe registered to the environment
@@ -1383,7 +1608,7 @@ type RecipeGetMetadataResponse struct {
 	// REQUIRED; The key/value parameters to pass to the recipe template at deployment.
 	Parameters map[string]any
 
-	// REQUIRED; The format of the template provided by the recipe. Allowed values: bicep, terraform.
+	// REQUIRED; The format of the template provided by the recipe. Allowed values: bicep, terraform, helm, manifest.
 	TemplateKind *string
 
 	// REQUIRED; The path to the template provided by the recipe. Currently only link to Azure Container Registry is supported.
@@ -1394,7 +1619,13 @@ type RecipeGetMetadataResponse struct {
 	TemplateVersion *string
 }
 
-// RecipeProperties - Format of the template provided by the recipe. Allowed values: bicep, terraform.
+// RecipePlanResult - The changes the recipe of a portable resource makes when the resource is deployed.
+type RecipePlanResult struct {
+	// REQUIRED; The changes to the resources deployed by the recipe
+	Changes []*RecipeResourceChange
+}
+
+// RecipeProperties - Format of the template provided by the recipe. Allowed values: bicep, terraform, helm, manifest.
 type RecipeProperties struct {
 	// REQUIRED; Discriminator property for RecipeProperties.
 	TemplateKind *string
@@ -1409,7 +1640,7 @@ type RecipeProperties struct {
 // GetRecipeProperties implements the RecipePropertiesClassification interface for type RecipeProperties.
 func (r *RecipeProperties) GetRecipeProperties() *RecipeProperties { return r }
 
-// RecipePropertiesUpdate - Format of the template provided by the recipe. Allowed values: bicep, terraform.
+// RecipePropertiesUpdate - Format of the template provided by the recipe. Allowed values: bicep, terraform, helm, manifest.
 type RecipePropertiesUpdate struct {
 	// REQUIRED; Discriminator property for RecipeProperties.
 	TemplateKind *string
@@ -1424,6 +1655,29 @@ type RecipePropertiesUpdate struct {
 // GetRecipePropertiesUpdate implements the RecipePropertiesUpdateClassification interface for type RecipePropertiesUpdate.
 func (r *RecipePropertiesUpdate) GetRecipePropertiesUpdate() *RecipePropertiesUpdate { return r }
 
+// RecipeResourceChange - The change a recipe deployment makes to a resource.
+type RecipeResourceChange struct {
+	// REQUIRED; The kind of change made to the resource
+	ChangeType *RecipeChangeType
+
+	// REQUIRED; The resource ID when it is known before the deployment, otherwise the address of the resource in the recipe
+// template
+	Resource *string
+
+	// The state of the resource after the change, if it is known
+	After map[string]any
+
+	// The state of the resource before the change, if it is known
+	Before map[string]any
+
+	// The type of the resource
+	ResourceType *string
+
+	// The template expressions of the resource which can't be evaluated before the deployment, the change is partially known
+// when there are any
+	Unresolved []*string
+}
+
 // RecipeUpdate - The recipe used to automatically deploy underlying infrastructure for a portable resource
 type RecipeUpdate struct {
 	// The name of the recipe within the environment to use
@@ -1433,6 +1687,24 @@ type RecipeUpdate struct {
 	Parameters map[string]any
 }
 
+// RegistryAuthConfig - Authentication for a private OCI registry.
+type RegistryAuthConfig struct {
+	// REQUIRED; The kind of the credentials.
+	Kind *RegistryAuthKind
+
+	// REQUIRED; The resource ID of the Applications.Core/secretStores resource holding the credentials.
+	Secret *string
+}
+
+// RegistryAuthConfigUpdate - Authentication for a private OCI registry.
+type RegistryAuthConfigUpdate struct {
+	// The kind of the credentials.
+	Kind *RegistryAuthKind
+
+	// The resource ID of the Applications.Core/secretStores resource holding the credentials.
+	Secret *string
+}
+
 // Resource - Common fields that are returned in the response for all Azure Resource Manager resources
 type Resource struct {
 	// READ-ONLY; Fully qualified resource ID for the resource. Ex - /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}
@@ -1469,6 +1741,58 @@ type RuntimesProperties struct {
 	Kubernetes *KubernetesRuntimeProperties
 }
 
+// S3BackendProperties - Configuration of the S3 backend. The AWS credential registered to UCP is used to access the
+// bucket.
+type S3BackendProperties struct {
+	// REQUIRED; The name of the bucket storing the Terraform state.
+	Bucket *string
+
+	// The name of the DynamoDB table used to lock the Terraform state. The state isn't locked if it isn't specified.
+	DynamodbTable *string
+
+	// The endpoint of S3-compatible storage, for example 'http://minio.minio-system:9000'. The AWS S3 endpoint of the region
+// is used if it isn't specified.
+	Endpoint *string
+
+	// The prefix of the keys of the Terraform state objects. Defaults to 'radius/'.
+	KeyPrefix *string
+
+	// The region of the bucket. Defaults to 'us-east-1'.
+	Region *string
+}
+
+// S3BackendPropertiesUpdate - Configuration of the S3 backend. The AWS credential registered to UCP is used to access the
+// bucket.
+type S3BackendPropertiesUpdate struct {
+	// The name of the bucket storing the Terraform state.
+	Bucket *string
+
+	// The name of the DynamoDB table used to lock the Terraform state. The state isn't locked if it isn't specified.
+	DynamodbTable *string
+
+	// The endpoint of S3-compatible storage, for example 'http://minio.minio-system:9000'. The AWS S3 endpoint of the region
+// is used if it isn't specified.
+	Endpoint *string
+
+	// The prefix of the keys of the Terraform state objects. Defaults to 'radius/'.
+	KeyPrefix *string
+
+	// The region of the bucket. Defaults to 'us-east-1'.
+	Region *string
+}
+
+// SecretConfig - Reference to an Applications.Core/secretStores resource holding credentials.
+type SecretConfig struct {
+	// REQUIRED; The resource ID of the Applications.Core/secretStores resource holding the credentials.
+	Secret *string
+}
+
+// SecretConfigUpdate - Reference to an Applications.Core/secretStores resource holding credentials.
+type SecretConfigUpdate struct {
+	// The resource ID of the Applications.Core/secretStores resource holding the credentials.
+	Secret *string
+}
+
 // SecretObjectProperties - Represents secret object properties
 type SecretObjectProperties struct {
 	// REQUIRED; The name of the secret
@@ -1484,6 +1808,24 @@ type SecretObjectProperties struct {
 	Version *string
 }
 
+// SecretReference - Reference to a secret stored in an Applications.Core/secretStores resource.
+type SecretReference struct {
+	// REQUIRED; The key of the secret in the secret store.
+	Key *string
+
+	// REQUIRED; The resource ID of the Applications.Core/secretStores resource holding the secret.
+	Source *string
+}
+
+// SecretReferenceUpdate - Reference to a secret stored in an Applications.Core/secretStores resource.
+type SecretReferenceUpdate struct {
+	// The key of the secret in the secret store.
+	Key *string
+
+	// The resource ID of the Applications.Core/secretStores resource holding the secret.
+	Source *string
+}
+
 // SecretStoreListSecretsResult - The list of secrets
 type SecretStoreListSecretsResult struct {
 	// REQUIRED; An object to represent key-value type secrets
@@ -1642,6 +1984,66 @@ func (t *TCPHealthProbeProperties) GetHealthProbeProperties() *HealthProbeProper
 	}
 }
 
+// TerraformBackendProperties - Configuration of the backend storing the Terraform state of the Terraform Recipes.
+type TerraformBackendProperties struct {
+	// REQUIRED; The kind of the backend.
+	Kind *TerraformBackendKind
+
+	// The configuration of the local backend. Required when the kind is 'local'.
+	Local *LocalBackendProperties
+
+	// The configuration of the PostgreSQL backend. Required when the kind is 'postgresql'.
+	Postgresql *PostgreSQLBackendProperties
+
+	// The configuration of the S3 backend. Required when the kind is 's3'.
+	S3 *S3BackendProperties
+}
+
+// TerraformBackendPropertiesUpdate - Configuration of the backend storing the Terraform state of the Terraform Recipes.
+type TerraformBackendPropertiesUpdate struct {
+	// The kind of the backend.
+	Kind *TerraformBackendKind
+
+	// The configuration of the local backend. Required when the kind is 'local'.
+	Local *LocalBackendPropertiesUpdate
+
+	// The configuration of the PostgreSQL backend. Required when the kind is 'postgresql'.
+	Postgresql *PostgreSQLBackendPropertiesUpdate
+
+	// The configuration of the S3 backend. Required when the kind is 's3'.
+	S3 *S3BackendPropertiesUpdate
+}
+
+// TerraformConfigProperties - Configuration for Terraform Recipes. Controls how Terraform plans and applies a set of
+// resources.
+type TerraformConfigProperties struct {
+	// Authentication used to download the Terraform modules of the Terraform Recipes from private sources.
+	Authentication *AuthConfig
+
+	// The backend storing the Terraform state of the Terraform Recipes. The state is stored in Kubernetes secrets if it isn't
+// specified.
+	Backend *TerraformBackendProperties
+
+	// Configuration of the Terraform providers, keyed by the provider name. The configuration is added to the Terraform
+// Recipes requiring the provider.
+	Providers map[string]*ProviderConfigProperties
+}
+
+// TerraformConfigPropertiesUpdate - Configuration for Terraform Recipes. Controls how Terraform plans and applies a set of
+// resources.
+type TerraformConfigPropertiesUpdate struct {
+	// Authentication used to download the Terraform modules of the Terraform Recipes from private sources.
+	Authentication *AuthConfigUpdate
+
+	// The backend storing the Terraform state of the Terraform Recipes. The state is stored in Kubernetes secrets if it isn't
+// specified.
+	Backend *TerraformBackendPropertiesUpdate
+
+	// Configuration of the Terraform providers, keyed by the provider name. The configuration is added to the Terraform
+// Recipes requiring the provider.
+	Providers map[string]*ProviderConfigPropertiesUpdate
+}
+
 // TerraformRecipeProperties - Represents Terraform recipe properties.
 type TerraformRecipeProperties struct {
 	// REQUIRED; Discriminator property for RecipeProperties.
@@ -130,6 +130,11 @@ type ExtendersClientListSecretsOptions struct {
 	// placeholder for future optional parameters
 }
 
+// ExtendersClientPlanOptions contains the optional parameters for the ExtendersClient.Plan method.
+type ExtendersClientPlanOptions struct {
+	// placeholder for future optional parameters
+}
+
 // GatewaysClientBeginCreateOptions contains the optional parameters for the GatewaysClient.BeginCreate method.
 type GatewaysClientBeginCreateOptions struct {
 	// Resumes the LRO from the provided token.
@@ -134,6 +134,10 @@ func unmarshalRecipePropertiesClassification(rawMsg json.RawMessage) (RecipeProp
 	switch m["templateKind"] {
 	case "bicep":
 		b = &BicepRecipeProperties{}
+	case "helm":
+		b = &HelmRecipeProperties{}
+	case "manifest":
+		b = &ManifestRecipeProperties{}
 	case "terraform":
 		b = &TerraformRecipeProperties{}
 	default:
@@ -176,6 +180,10 @@ func unmarshalRecipePropertiesUpdateClassification(rawMsg json.RawMessage) (Reci
 	switch m["templateKind"] {
 	case "bicep":
 		b = &BicepRecipePropertiesUpdate{}
+	case "helm":
+		b = &HelmRecipePropertiesUpdate{}
+	case "manifest":
+		b = &ManifestRecipePropertiesUpdate{}
 	case "terraform":
 		b = &TerraformRecipePropertiesUpdate{}
 	default:
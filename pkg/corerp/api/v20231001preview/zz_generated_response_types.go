@@ -135,6 +135,12 @@ type ExtendersClientListSecretsResponse struct {
 	Object map[string]any
 }
 
+// ExtendersClientPlanResponse contains the response from method ExtendersClient.Plan.
+type ExtendersClientPlanResponse struct {
+	// The changes the recipe of a portable resource makes when the resource is deployed.
+	RecipePlanResult
+}
+
 // ExtendersClientUpdateResponse contains the response from method ExtendersClient.BeginUpdate.
 type ExtendersClientUpdateResponse struct {
 	// ExtenderResource portable resource
@@ -38,11 +38,12 @@ func (e *Environment) ResourceTypeName() string {
 
 // EnvironmentProperties represents the properties of Environment.
 type EnvironmentProperties struct {
-	Compute    rpv1.EnvironmentCompute                           `json:"compute,omitempty"`
-	Recipes    map[string]map[string]EnvironmentRecipeProperties `json:"recipes,omitempty"`
-	Providers  Providers                                         `json:"providers,omitempty"`
-	Extensions []Extension                                       `json:"extensions,omitempty"`
-	Simulated  bool                                              `json:"simulated,omitempty"`
+	Compute      rpv1.EnvironmentCompute                           `json:"compute,omitempty"`
+	Recipes      map[string]map[string]EnvironmentRecipeProperties `json:"recipes,omitempty"`
+	RecipeConfig RecipeConfigProperties                            `json:"recipeConfig,omitempty"`
+	Providers    Providers                                         `json:"providers,omitempty"`
+	Extensions   []Extension                                       `json:"extensions,omitempty"`
+	Simulated    bool                                              `json:"simulated,omitempty"`
 }
 
 // EnvironmentRecipeProperties represents the properties of environment's recipe.
@@ -91,3 +92,135 @@ type ProvidersAWS struct {
 	// Scope is the target level for deploying the aws resources
 	Scope string `json:"scope,omitempty"`
 }
+
+// RecipeConfigProperties represents the configuration used by the recipes of the environment.
+type RecipeConfigProperties struct {
+	// Terraform is the configuration used by the Terraform recipes.
+	Terraform TerraformConfigProperties `json:"terraform,omitempty"`
+	// Bicep is the configuration used by the Bicep recipes.
+	Bicep BicepConfigProperties `json:"bicep,omitempty"`
+}
+
+// BicepConfigProperties represents the configuration used by the Bicep recipes of the environment.
+type BicepConfigProperties struct {
+	// Authentication is the authentication for private OCI registries hosting Bicep recipes, keyed by the registry hostname.
+	Authentication map[string]RegistryAuthConfig `json:"authentication,omitempty"`
+}
+
+const (
+	// RegistryAuthBasic authenticates with the username and password stored in the "username" and "password" keys of the secret store.
+	RegistryAuthBasic = "basic"
+	// RegistryAuthBearer authenticates with the bearer token stored in the "token" key of the secret store.
+	RegistryAuthBearer = "bearer"
+	// RegistryAuthDockerConfig authenticates with the credentials of the docker config JSON stored in the ".dockerconfigjson" key of the secret store.
+	RegistryAuthDockerConfig = "dockerConfig"
+)
+
+// RegistryAuthConfig represents the authentication for a private OCI registry.
+type RegistryAuthConfig struct {
+	// Kind is the kind of the credentials, e.g. "basic".
+	Kind string `json:"kind"`
+	// Secret is the resource ID of the secret store holding the credentials.
+	Secret string `json:"secret"`
+}
+
+// TerraformConfigProperties represents the configuration used by the Terraform recipes of the environment.
+type TerraformConfigProperties struct {
+	// Backend is the backend storing the Terraform state of the recipes. The state is stored in Kubernetes secrets
+	// when it is nil.
+	Backend *TerraformBackendProperties `json:"backend,omitempty"`
+	// Providers is the configuration of the Terraform providers, keyed by the provider name.
+	Providers map[string]ProviderConfigProperties `json:"providers,omitempty"`
+	// Authentication is the authentication used to download the Terraform modules of the recipes from private sources.
+	Authentication *AuthConfig `json:"authentication,omitempty"`
+}
+
+// AuthConfig represents the authentication used to download Terraform modules from private sources.
+type AuthConfig struct {
+	// Git is the authentication for git module sources.
+	Git GitAuthConfig `json:"git,omitempty"`
+	// Registries are the secret stores holding the tokens of private module registries, keyed by the registry hostname.
+	Registries map[string]SecretConfig `json:"registries,omitempty"`
+}
+
+// GitAuthConfig represents the authentication for git module sources.
+type GitAuthConfig struct {
+	// PAT are the secret stores holding the personal access tokens for HTTPS git sources, keyed by the git server hostname.
+	PAT map[string]SecretConfig `json:"pat,omitempty"`
+	// SSH are the secret stores holding the SSH private keys and the known host keys for SSH git sources, keyed by the git
+	// server hostname.
+	SSH map[string]SecretConfig `json:"ssh,omitempty"`
+}
+
+// SecretConfig represents a reference to an Applications.Core/secretStores resource holding credentials.
+type SecretConfig struct {
+	// Secret is the resource ID of the secret store.
+	Secret string `json:"secret"`
+}
+
+// ProviderConfigProperties represents the configuration of a Terraform provider.
+type ProviderConfigProperties struct {
+	// AdditionalProperties are the provider settings added as they are to the provider configuration.
+	AdditionalProperties map[string]any `json:"additionalProperties,omitempty"`
+	// Secrets are the provider settings whose values are stored in secret stores, keyed by the name of the setting.
+	Secrets map[string]SecretReference `json:"secrets,omitempty"`
+}
+
+// SecretReference represents a reference to a secret stored in an Applications.Core/secretStores resource.
+type SecretReference struct {
+	// Source is the resource ID of the secret store.
+	Source string `json:"source"`
+	// Key is the key of the secret in the secret store.
+	Key string `json:"key"`
+}
+
+const (
+	// TerraformBackendKubernetes stores the Terraform state in Kubernetes secrets.
+	TerraformBackendKubernetes = "kubernetes"
+	// TerraformBackendS3 stores the Terraform state in S3 or S3-compatible object storage.
+	TerraformBackendS3 = "s3"
+	// TerraformBackendPostgreSQL stores the Terraform state in a PostgreSQL database.
+	TerraformBackendPostgreSQL = "postgresql"
+	// TerraformBackendLocal stores the Terraform state on the local filesystem.
+	TerraformBackendLocal = "local"
+)
+
+// TerraformBackendProperties represents the backend storing the Terraform state of the recipes.
+type TerraformBackendProperties struct {
+	// Kind is the kind of the backend, e.g. "s3".
+	Kind string `json:"kind"`
+	// S3 is the configuration of the S3 backend.
+	S3 *S3BackendProperties `json:"s3,omitempty"`
+	// PostgreSQL is the configuration of the PostgreSQL backend.
+	PostgreSQL *PostgreSQLBackendProperties `json:"postgresql,omitempty"`
+	// Local is the configuration of the local backend.
+	Local *LocalBackendProperties `json:"local,omitempty"`
+}
+
+// S3BackendProperties represents the configuration of the S3 backend.
+type S3BackendProperties struct {
+	// Bucket is the name of the bucket storing the Terraform state.
+	Bucket string `json:"bucket"`
+	// Region is the region of the bucket.
+	Region string `json:"region,omitempty"`
+	// Endpoint is the endpoint of S3-compatible storage. The AWS S3 endpoint of the region is used when it is empty.
+	Endpoint string `json:"endpoint,omitempty"`
+	// KeyPrefix is the prefix of the keys of the Terraform state objects.
+	KeyPrefix string `json:"keyPrefix,omitempty"`
+	// DynamoDBTable is the name of the DynamoDB table used to lock the Terraform state.
+	DynamoDBTable string `json:"dynamodbTable,omitempty"`
+}
+
+// PostgreSQLBackendProperties represents the configuration of the PostgreSQL backend.
+type PostgreSQLBackendProperties struct {
+	// ConnectionString is the connection string of the database, without credentials.
+	ConnectionString string `json:"connectionString"`
+	// SecretName is the name of the secret of the UCP secret store holding the credentials of the database.
+	SecretName string `json:"secretName,omitempty"`
+}
+
+// LocalBackendProperties represents the configuration of the local backend.
+type LocalBackendProperties struct {
+	// Path is the directory storing the Terraform state files.
+	Path string `json:"path"`
+}
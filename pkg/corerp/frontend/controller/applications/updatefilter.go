@@ -29,6 +29,7 @@ import (
 	rp_kube "github.com/radius-project/radius/pkg/rp/kube"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -84,7 +85,8 @@ func CreateAppScopedNamespace(ctx context.Context, newResource, oldResource *dat
 		return rest.NewBadRequestResponse(fmt.Sprintf("Environment %s for application %s could not be found", envID.Name(), serviceCtx.ResourceID.Name())), nil
 	}
 
-	result, err := util.FindResources(ctx, envID.RootScope(), envID.Type(), envNamespaceQuery, kubeNamespace, opt.StorageClient)
+	filters := []store.QueryFilter{{Field: envNamespaceQuery, Value: kubeNamespace}}
+	result, err := util.FindResources(ctx, envID.RootScope(), envID.Type(), filters, []string{"id"}, opt.StorageClient)
 	if err != nil {
 		return nil, err
 	}
@@ -93,7 +95,11 @@ func CreateAppScopedNamespace(ctx context.Context, newResource, oldResource *dat
 	}
 
 	// Check if another application resource is using namespace
-	result, err = util.FindResources(ctx, serviceCtx.ResourceID.RootScope(), serviceCtx.ResourceID.Type(), appNamespaceQuery, kubeNamespace, opt.StorageClient)
+	filters = []store.QueryFilter{{Field: appNamespaceQuery, Value: kubeNamespace}}
+	if oldResource != nil {
+		filters = append(filters, store.QueryFilter{Field: "id", Operator: store.FilterOperatorNotEquals, Value: oldResource.ID})
+	}
+	result, err = util.FindResources(ctx, serviceCtx.ResourceID.RootScope(), serviceCtx.ResourceID.Type(), filters, []string{"id"}, opt.StorageClient)
 	if err != nil {
 		return nil, err
 	}
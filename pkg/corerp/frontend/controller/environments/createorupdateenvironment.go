@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"reflect"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
@@ -27,6 +28,10 @@ import (
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/corerp/datamodel/converter"
 	"github.com/radius-project/radius/pkg/corerp/frontend/controller/util"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
 )
 
 var _ ctrl.Controller = (*CreateOrUpdateEnvironment)(nil)
@@ -34,10 +39,12 @@ var _ ctrl.Controller = (*CreateOrUpdateEnvironment)(nil)
 // CreateOrUpdateEnvironments is the controller implementation to create or update environment resource.
 type CreateOrUpdateEnvironment struct {
 	ctrl.Operation[*datamodel.Environment, datamodel.Environment]
+	engine engine.Engine
 }
 
-// NewCreateOrUpdateEnvironment creates a new controller for creating or updating an environment resource.
-func NewCreateOrUpdateEnvironment(opts ctrl.Options) (ctrl.Controller, error) {
+// NewCreateOrUpdateEnvironment creates a new controller for creating or updating an environment resource. The engine
+// is used to read the parameters declared by the recipes registered to the environment.
+func NewCreateOrUpdateEnvironment(opts ctrl.Options, engine engine.Engine) (ctrl.Controller, error) {
 	return &CreateOrUpdateEnvironment{
 		ctrl.NewOperation(opts,
 			ctrl.ResourceOptions[datamodel.Environment]{
@@ -45,6 +52,7 @@ func NewCreateOrUpdateEnvironment(opts ctrl.Options) (ctrl.Controller, error) {
 				ResponseConverter: converter.EnvironmentDataModelToVersioned,
 			},
 		),
+		engine,
 	}, nil
 }
 
@@ -69,9 +77,17 @@ func (e *CreateOrUpdateEnvironment) Run(ctx context.Context, w http.ResponseWrit
 		return rest.NewBadRequestResponse(err.Error()), nil
 	}
 
+	if r := e.validateRecipeParameters(ctx, newResource, old); r != nil {
+		return r, nil
+	}
+
 	// Create Query filter to query kubernetes namespace used by the other environment resources.
 	namespace := newResource.Properties.Compute.KubernetesCompute.Namespace
-	result, err := util.FindResources(ctx, serviceCtx.ResourceID.RootScope(), serviceCtx.ResourceID.Type(), "properties.compute.kubernetes.namespace", namespace, e.StorageClient())
+	filters := []store.QueryFilter{{Field: "properties.compute.kubernetes.namespace", Value: namespace}}
+	if old != nil {
+		filters = append(filters, store.QueryFilter{Field: "id", Operator: store.FilterOperatorNotEquals, Value: old.ID})
+	}
+	result, err := util.FindResources(ctx, serviceCtx.ResourceID.RootScope(), serviceCtx.ResourceID.Type(), filters, []string{"id"}, e.StorageClient())
 	if err != nil {
 		return nil, err
 	}
@@ -97,3 +113,41 @@ func (e *CreateOrUpdateEnvironment) Run(ctx context.Context, w http.ResponseWrit
 
 	return e.ConstructSyncResponse(ctx, req.Method, newEtag, newResource)
 }
+
+// validateRecipeParameters validates the parameters of the recipes registered or updated by the request against the
+// parameters declared by the recipes. The recipes whose metadata can't be read are not validated, the failure is
+// reported when the recipe is deployed.
+func (e *CreateOrUpdateEnvironment) validateRecipeParameters(ctx context.Context, newResource *datamodel.Environment, old *datamodel.Environment) rest.Response {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	for resourceType, recipeProperties := range newResource.Properties.Recipes {
+		for name, properties := range recipeProperties {
+			if old != nil {
+				if oldProperties, ok := old.Properties.Recipes[resourceType][name]; ok && reflect.DeepEqual(oldProperties, properties) {
+					continue
+				}
+			}
+
+			recipeData, err := e.engine.GetRecipeMetadata(ctx, recipes.EnvironmentDefinition{
+				Name:            name,
+				Driver:          properties.TemplateKind,
+				Parameters:      properties.Parameters,
+				TemplatePath:    properties.TemplatePath,
+				TemplateVersion: properties.TemplateVersion,
+				ResourceType:    resourceType,
+			}, newResource.Properties.RecipeConfig)
+			if err != nil {
+				logger.Info(fmt.Sprintf("Unable to read the metadata of recipe %q for resource type %q, skipping parameter validation: %s", name, resourceType, err.Error()))
+				continue
+			}
+
+			// The required parameters can be set by the resources using the recipe, they are validated when the
+			// recipe is used.
+			if err := recipes.ValidateParameterValues(recipeData, properties.Parameters); err != nil {
+				return rest.NewBadRequestResponse(fmt.Sprintf("Recipe %q for resource type %q has %s", name, resourceType, err.Error()))
+			}
+		}
+	}
+
+	return nil
+}
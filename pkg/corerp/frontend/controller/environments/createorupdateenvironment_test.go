@@ -19,14 +19,19 @@ package environments
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/rpctest"
 	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/engine"
 	"github.com/radius-project/radius/pkg/ucp/store"
 
 	"github.com/golang/mock/gomock"
@@ -39,6 +44,11 @@ func TestCreateOrUpdateEnvironmentRun_20231001Preview(t *testing.T) {
 	defer mctrl.Finish()
 
 	mStorageClient := store.NewMockStorageClient(mctrl)
+	mEngine := engine.NewMockEngine(mctrl)
+	mEngine.EXPECT().
+		GetRecipeMetadata(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(map[string]any{"parameters": map[string]any{"throughput": map[string]any{"type": "int"}}}, nil).
+		AnyTimes()
 	ctx := context.Background()
 
 	createNewResourceCases := []struct {
@@ -101,7 +111,7 @@ func TestCreateOrUpdateEnvironmentRun_20231001Preview(t *testing.T) {
 				StorageClient: mStorageClient,
 			}
 
-			ctl, err := NewCreateOrUpdateEnvironment(opts)
+			ctl, err := NewCreateOrUpdateEnvironment(opts, mEngine)
 			require.NoError(t, err)
 			resp, err := ctl.Run(ctx, w, req)
 			require.NoError(t, err)
@@ -157,6 +167,9 @@ func TestCreateOrUpdateEnvironmentRun_20231001Preview(t *testing.T) {
 					EXPECT().
 					Query(gomock.Any(), gomock.Any()).
 					DoAndReturn(func(ctx context.Context, query store.Query, options ...store.QueryOptions) (*store.ObjectQueryResult, error) {
+						// The environment being updated must be excluded from the namespace conflict check.
+						require.Contains(t, query.Filters, store.QueryFilter{Field: "id", Operator: store.FilterOperatorNotEquals, Value: envDataModel.ID})
+						require.Equal(t, []string{"id"}, query.Projection)
 						return &store.ObjectQueryResult{
 							Items: []store.Object{},
 						}, nil
@@ -178,7 +191,7 @@ func TestCreateOrUpdateEnvironmentRun_20231001Preview(t *testing.T) {
 				StorageClient: mStorageClient,
 			}
 
-			ctl, err := NewCreateOrUpdateEnvironment(opts)
+			ctl, err := NewCreateOrUpdateEnvironment(opts, mEngine)
 			require.NoError(t, err)
 			resp, err := ctl.Run(ctx, w, req)
 			require.NoError(t, err)
@@ -241,7 +254,7 @@ func TestCreateOrUpdateEnvironmentRun_20231001Preview(t *testing.T) {
 				StorageClient: mStorageClient,
 			}
 
-			ctl, err := NewCreateOrUpdateEnvironment(opts)
+			ctl, err := NewCreateOrUpdateEnvironment(opts, mEngine)
 			require.NoError(t, err)
 			resp, err := ctl.Run(ctx, w, req)
 			require.NoError(t, err)
@@ -310,7 +323,7 @@ func TestCreateOrUpdateEnvironmentRun_20231001Preview(t *testing.T) {
 				StorageClient: mStorageClient,
 			}
 
-			ctl, err := NewCreateOrUpdateEnvironment(opts)
+			ctl, err := NewCreateOrUpdateEnvironment(opts, mEngine)
 			require.NoError(t, err)
 			resp, err := ctl.Run(ctx, w, req)
 			require.NoError(t, err)
@@ -399,7 +412,7 @@ func TestCreateOrUpdateEnvironmentRun_20231001Preview(t *testing.T) {
 				StorageClient: mStorageClient,
 			}
 
-			ctl, err := NewCreateOrUpdateEnvironment(opts)
+			ctl, err := NewCreateOrUpdateEnvironment(opts, mEngine)
 			require.NoError(t, err)
 			resp, err := ctl.Run(ctx, w, req)
 			require.NoError(t, err)
@@ -409,3 +422,109 @@ func TestCreateOrUpdateEnvironmentRun_20231001Preview(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateOrUpdateEnvironmentRun_ValidateRecipeParameters(t *testing.T) {
+	ctx := context.Background()
+
+	cases := []struct {
+		desc               string
+		recipeData         map[string]any
+		metadataErr        error
+		existing           bool
+		expectedStatusCode int
+		expectedMessage    string
+	}{
+		{
+			desc:               "valid-parameters",
+			recipeData:         map[string]any{"parameters": map[string]any{"throughput": map[string]any{"type": "int"}}},
+			expectedStatusCode: 200,
+		},
+		{
+			desc: "invalid-parameters",
+			recipeData: map[string]any{"parameters": map[string]any{
+				"throughput": map[string]any{"type": "string"},
+				"location":   map[string]any{"type": "string"},
+			}},
+			expectedStatusCode: 400,
+			expectedMessage:    `Recipe "mongo-azure" for resource type "Applications.Datastores/mongoDatabases" has invalid recipe parameters: parameter "throughput" is expected to be of type string, got float64`,
+		},
+		{
+			desc: "required-parameters-set-by-resources",
+			recipeData: map[string]any{"parameters": map[string]any{
+				"throughput": map[string]any{"type": "int"},
+				"location":   map[string]any{"type": "string"},
+			}},
+			expectedStatusCode: 200,
+		},
+		{
+			desc:               "metadata-unavailable",
+			metadataErr:        errors.New("failed to read the recipe from the registry"),
+			expectedStatusCode: 200,
+		},
+		{
+			desc:               "unchanged-recipe",
+			existing:           true,
+			expectedStatusCode: 200,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.desc, func(t *testing.T) {
+			mctrl := gomock.NewController(t)
+			mStorageClient := store.NewMockStorageClient(mctrl)
+			mEngine := engine.NewMockEngine(mctrl)
+
+			envInput, envDataModel, _ := getTestModels20231001preview()
+			w := httptest.NewRecorder()
+			req, err := rpctest.NewHTTPRequestFromJSON(ctx, http.MethodPut, testHeaderfile, envInput)
+			require.NoError(t, err)
+			ctx := rpctest.NewARMRequestContext(req)
+
+			mStorageClient.
+				EXPECT().
+				Get(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
+					if tt.existing {
+						return &store.Object{Metadata: store.Metadata{ID: id}, Data: envDataModel}, nil
+					}
+					return nil, &store.ErrNotFound{ID: id}
+				})
+
+			if !tt.existing {
+				mEngine.EXPECT().
+					GetRecipeMetadata(gomock.Any(), recipes.EnvironmentDefinition{
+						Name:         "mongo-azure",
+						Driver:       recipes.TemplateKindBicep,
+						TemplatePath: "ghcr.io/radius-project/dev/recipes/mongodatabases/azure:1.0",
+						ResourceType: "Applications.Datastores/mongoDatabases",
+						Parameters:   map[string]any{"throughput": float64(400)},
+					}, datamodel.RecipeConfigProperties{}).
+					Return(tt.recipeData, tt.metadataErr)
+			}
+
+			if tt.expectedStatusCode == 200 {
+				mStorageClient.
+					EXPECT().
+					Query(gomock.Any(), gomock.Any()).
+					Return(&store.ObjectQueryResult{}, nil)
+				mStorageClient.
+					EXPECT().
+					Save(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil)
+			}
+
+			ctl, err := NewCreateOrUpdateEnvironment(ctrl.Options{StorageClient: mStorageClient}, mEngine)
+			require.NoError(t, err)
+			resp, err := ctl.Run(ctx, w, req)
+			require.NoError(t, err)
+			_ = resp.Apply(ctx, w, req)
+			require.Equal(t, tt.expectedStatusCode, w.Result().StatusCode)
+
+			if tt.expectedMessage != "" {
+				actualOutput := v1.ErrorResponse{}
+				_ = json.Unmarshal(w.Body.Bytes(), &actualOutput)
+				require.Equal(t, tt.expectedMessage, actualOutput.Error.Message)
+			}
+		})
+	}
+}
@@ -82,7 +82,7 @@ func (r *GetRecipeMetadata) Run(ctx context.Context, w http.ResponseWriter, req
 		return rest.NewNotFoundMessageResponse(fmt.Sprintf("Either recipe with name %q or resource type %q not found on environment with id %q", recipeDatamodel.Name, recipeDatamodel.ResourceType, serviceCtx.ResourceID)), nil
 	}
 
-	recipeParams, err := r.GetRecipeMetadataFromRegistry(ctx, recipeProperties, recipeDatamodel)
+	recipeParams, err := r.GetRecipeMetadataFromRegistry(ctx, recipeProperties, recipeDatamodel, resource.Properties.RecipeConfig)
 	if err != nil {
 		return nil, err
 	}
@@ -101,7 +101,7 @@ func (r *GetRecipeMetadata) Run(ctx context.Context, w http.ResponseWriter, req
 	return rest.NewOKResponse(versioned), nil
 }
 
-func (r *GetRecipeMetadata) GetRecipeMetadataFromRegistry(ctx context.Context, recipeProperties datamodel.EnvironmentRecipeProperties, recipeDataModel *datamodel.Recipe) (recipeParameters map[string]any, err error) {
+func (r *GetRecipeMetadata) GetRecipeMetadataFromRegistry(ctx context.Context, recipeProperties datamodel.EnvironmentRecipeProperties, recipeDataModel *datamodel.Recipe, recipeConfig datamodel.RecipeConfigProperties) (recipeParameters map[string]any, err error) {
 	recipeDefinition := recipes.EnvironmentDefinition{
 		Name:            recipeDataModel.Name,
 		Driver:          recipeProperties.TemplateKind,
@@ -112,7 +112,7 @@ func (r *GetRecipeMetadata) GetRecipeMetadataFromRegistry(ctx context.Context, r
 	}
 
 	recipeParameters = make(map[string]any)
-	recipeData, err := r.Engine.GetRecipeMetadata(ctx, recipeDefinition)
+	recipeData, err := r.Engine.GetRecipeMetadata(ctx, recipeDefinition, recipeConfig)
 	if err != nil {
 		return recipeParameters, err
 	}
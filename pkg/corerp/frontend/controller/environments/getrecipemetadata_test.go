@@ -74,7 +74,7 @@ func TestGetRecipeMetadataRun_20231001Preview(t *testing.T) {
 				"mongodbName":    map[string]any{"type": "string"},
 			},
 		}
-		mEngine.EXPECT().GetRecipeMetadata(ctx, recipeDefinition).Return(recipeData, nil)
+		mEngine.EXPECT().GetRecipeMetadata(ctx, recipeDefinition, envDataModel.Properties.RecipeConfig).Return(recipeData, nil)
 
 		opts := ctrl.Options{
 			StorageClient: mStorageClient,
@@ -122,7 +122,7 @@ func TestGetRecipeMetadataRun_20231001Preview(t *testing.T) {
 				"mongodbName":    map[string]any{"type": "string"},
 			},
 		}
-		mEngine.EXPECT().GetRecipeMetadata(ctx, recipeDefinition).Return(recipeData, nil)
+		mEngine.EXPECT().GetRecipeMetadata(ctx, recipeDefinition, envDataModel.Properties.RecipeConfig).Return(recipeData, nil)
 
 		opts := ctrl.Options{
 			StorageClient: mStorageClient,
@@ -240,7 +240,7 @@ func TestGetRecipeMetadataRun_20231001Preview(t *testing.T) {
 			ResourceType:    *envInput.ResourceType,
 		}
 		engineErr := fmt.Errorf("could not find driver %s", "invalidDriver")
-		mEngine.EXPECT().GetRecipeMetadata(ctx, recipeDefinition).Return(nil, engineErr)
+		mEngine.EXPECT().GetRecipeMetadata(ctx, recipeDefinition, envDataModel.Properties.RecipeConfig).Return(nil, engineErr)
 
 		opts := ctrl.Options{
 			StorageClient: mStorageClient,
@@ -22,17 +22,14 @@ import (
 	"github.com/radius-project/radius/pkg/ucp/store"
 )
 
-// FindResources searches for resources of a given type with a given filter key and value, and returns the query result.
-func FindResources(ctx context.Context, rootScope, resourceType, filterKey, filterValue string, storageClient store.StorageClient) (*store.ObjectQueryResult, error) {
+// FindResources searches for resources of a given type matching all of the given filters, and returns the query result.
+// If projection is not empty, only the listed fields of the resources are returned.
+func FindResources(ctx context.Context, rootScope, resourceType string, filters []store.QueryFilter, projection []string, storageClient store.StorageClient) (*store.ObjectQueryResult, error) {
 	query := store.Query{
 		RootScope:    rootScope,
 		ResourceType: resourceType,
-		Filters: []store.QueryFilter{
-			{
-				Field: filterKey,
-				Value: filterValue,
-			},
-		},
+		Filters:      filters,
+		Projection:   projection,
 	}
 	return storageClient.Query(ctx, query)
 }
@@ -38,6 +38,7 @@ import (
 
 	ext_processor "github.com/radius-project/radius/pkg/corerp/processors/extenders"
 	pr_ctrl "github.com/radius-project/radius/pkg/portableresources/backend/controller"
+	pr_frontend_ctrl "github.com/radius-project/radius/pkg/portableresources/frontend/controller"
 )
 
 const (
@@ -54,10 +55,14 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 		ResponseConverter: converter.EnvironmentDataModelToVersioned,
 
 		Put: builder.Operation[datamodel.Environment]{
-			APIController: env_ctrl.NewCreateOrUpdateEnvironment,
+			APIController: func(opt apictrl.Options) (apictrl.Controller, error) {
+				return env_ctrl.NewCreateOrUpdateEnvironment(opt, recipeControllerConfig.Engine)
+			},
 		},
 		Patch: builder.Operation[datamodel.Environment]{
-			APIController: env_ctrl.NewCreateOrUpdateEnvironment,
+			APIController: func(opt apictrl.Options) (apictrl.Controller, error) {
+				return env_ctrl.NewCreateOrUpdateEnvironment(opt, recipeControllerConfig.Engine)
+			},
 		},
 		Custom: map[string]builder.Operation[datamodel.Environment]{
 			"getmetadata": {
@@ -226,6 +231,7 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 		Put: builder.Operation[datamodel.Extender]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.Extender]{
 				rp_frontend.PrepareRadiusResource[*datamodel.Extender],
+				rp_frontend.ValidateRecipeParameters[*datamodel.Extender](recipeControllerConfig.Engine, recipeControllerConfig.ConfigLoader),
 			},
 			AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
 				return pr_ctrl.NewCreateOrUpdateResource[*datamodel.Extender, datamodel.Extender](options, &ext_processor.Processor{}, recipeControllerConfig.Engine, recipeControllerConfig.ResourceClient, recipeControllerConfig.ConfigLoader)
@@ -236,6 +242,7 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 		Patch: builder.Operation[datamodel.Extender]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.Extender]{
 				rp_frontend.PrepareRadiusResource[*datamodel.Extender],
+				rp_frontend.ValidateRecipeParameters[*datamodel.Extender](recipeControllerConfig.Engine, recipeControllerConfig.ConfigLoader),
 			},
 			AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
 				return pr_ctrl.NewCreateOrUpdateResource[*datamodel.Extender, datamodel.Extender](options, &ext_processor.Processor{}, recipeControllerConfig.Engine, recipeControllerConfig.ResourceClient, recipeControllerConfig.ConfigLoader)
@@ -254,6 +261,13 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 			"listsecrets": {
 				APIController: ext_ctrl.NewListSecretsExtender,
 			},
+			"plan": {
+				APIController: func(opt apictrl.Options) (apictrl.Controller, error) {
+					return pr_frontend_ctrl.NewPlanResource[*datamodel.Extender, datamodel.Extender](opt, apictrl.ResourceOptions[datamodel.Extender]{
+						RequestConverter: converter.ExtenderDataModelFromVersioned,
+					}, recipeControllerConfig.Engine)
+				},
+			},
 		},
 	})
 
@@ -37,6 +37,7 @@ import (
 	app_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/applications"
 	ctr_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/containers"
 	env_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/environments"
+	ext_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/extenders"
 	gtwy_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/gateways"
 	hrt_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/httproutes"
 	secret_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/secretstores"
@@ -196,6 +197,10 @@ var handlerTests = []rpctest.HandlerTestSpec{
 		OperationType: v1.OperationType{Type: secret_ctrl.ResourceTypeName, Method: "ACTIONLISTSECRETS"},
 		Path:          "/resourcegroups/testrg/providers/applications.core/secretstores/secret0/listsecrets",
 		Method:        http.MethodPost,
+	}, {
+		OperationType: v1.OperationType{Type: ext_ctrl.ResourceTypeName, Method: "ACTIONPLAN"},
+		Path:          "/resourcegroups/testrg/providers/applications.core/extenders/ext0/plan",
+		Method:        http.MethodPost,
 	}, {
 		OperationType: v1.OperationType{Type: vol_ctrl.ResourceTypeName, Method: v1.OperationPlaneScopeList},
 		Path:          "/providers/applications.core/volumes",
@@ -116,6 +116,37 @@ func PossibleProvisioningStateValues() []ProvisioningState {
 	}
 }
 
+// RecipeChangeType - The kind of change a recipe deployment makes to a resource.
+type RecipeChangeType string
+
+const (
+	// RecipeChangeTypeCreate - The resource doesn't exist and will be created
+	RecipeChangeTypeCreate RecipeChangeType = "Create"
+	// RecipeChangeTypeDelete - The resource exists and will be deleted
+	RecipeChangeTypeDelete RecipeChangeType = "Delete"
+	// RecipeChangeTypeNoChange - The resource exists and will not be changed
+	RecipeChangeTypeNoChange RecipeChangeType = "NoChange"
+	// RecipeChangeTypeReplace - The resource exists and will be deleted and created again
+	RecipeChangeTypeReplace RecipeChangeType = "Replace"
+	// RecipeChangeTypeUnknown - The resource will be deployed, but whether it is created or updated can't be determined before
+// the deployment
+	RecipeChangeTypeUnknown RecipeChangeType = "Unknown"
+	// RecipeChangeTypeUpdate - The resource exists and will be updated in place
+	RecipeChangeTypeUpdate RecipeChangeType = "Update"
+)
+
+// PossibleRecipeChangeTypeValues returns the possible values for the RecipeChangeType const type.
+func PossibleRecipeChangeTypeValues() []RecipeChangeType {
+	return []RecipeChangeType{	
+		RecipeChangeTypeCreate,
+		RecipeChangeTypeDelete,
+		RecipeChangeTypeNoChange,
+		RecipeChangeTypeReplace,
+		RecipeChangeTypeUnknown,
+		RecipeChangeTypeUpdate,
+	}
+}
+
 // ResourceProvisioning - Specifies how the underlying service/resource is provisioned and managed. Available values are 'recipe',
 // where Radius manages the lifecycle of the resource through a Recipe, and 'manual', where a user
 // manages the resource and provides the values.
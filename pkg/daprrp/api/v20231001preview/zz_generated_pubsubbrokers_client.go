@@ -281,6 +281,64 @@ func (client *PubSubBrokersClient) listByScopeHandleResponse(resp *http.Response
 	return result, nil
 }
 
+
+// Plan - Previews the changes the recipe of the DaprPubSubBroker resource in the request body makes when it is deployed,
+// without deploying it
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - pubSubBrokerName - PubSubBroker name
+//   - body - The content of the action request
+//   - options - PubSubBrokersClientPlanOptions contains the optional parameters for the PubSubBrokersClient.Plan method.
+func (client *PubSubBrokersClient) Plan(ctx context.Context, pubSubBrokerName string, body DaprPubSubBrokerResource, options *PubSubBrokersClientPlanOptions) (PubSubBrokersClientPlanResponse, error) {
+	var err error
+	req, err := client.planCreateRequest(ctx, pubSubBrokerName, body, options)
+	if err != nil {
+		return PubSubBrokersClientPlanResponse{}, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return PubSubBrokersClientPlanResponse{}, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK) {
+		err = runtime.NewResponseError(httpResp)
+		return PubSubBrokersClientPlanResponse{}, err
+	}
+	resp, err := client.planHandleResponse(httpResp)
+	return resp, err
+}
+
+// planCreateRequest creates the Plan request.
+func (client *PubSubBrokersClient) planCreateRequest(ctx context.Context, pubSubBrokerName string, body DaprPubSubBrokerResource, options *PubSubBrokersClientPlanOptions) (*policy.Request, error) {
+	urlPath := "/{rootScope}/providers/Applications.Dapr/pubSubBrokers/{pubSubBrokerName}/plan"
+	urlPath = strings.ReplaceAll(urlPath, "{rootScope}", client.rootScope)
+	if pubSubBrokerName == "" {
+		return nil, errors.New("parameter pubSubBrokerName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{pubSubBrokerName}", url.PathEscape(pubSubBrokerName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	if err := runtime.MarshalAsJSON(req, body); err != nil {
+	return nil, err
+}
+	return req, nil
+}
+
+// planHandleResponse handles the Plan response.
+func (client *PubSubBrokersClient) planHandleResponse(resp *http.Response) (PubSubBrokersClientPlanResponse, error) {
+	result := PubSubBrokersClientPlanResponse{}
+	if err := runtime.UnmarshalAsJSON(resp, &result.RecipePlanResult); err != nil {
+		return PubSubBrokersClientPlanResponse{}, err
+	}
+	return result, nil
+}
+
 // BeginUpdate - Update a DaprPubSubBrokerResource
 // If the operation fails it returns an *azcore.ResponseError type.
 //
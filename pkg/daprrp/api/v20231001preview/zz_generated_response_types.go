@@ -36,6 +36,12 @@ type PubSubBrokersClientListByScopeResponse struct {
 	DaprPubSubBrokerResourceListResult
 }
 
+// PubSubBrokersClientPlanResponse contains the response from method PubSubBrokersClient.Plan.
+type PubSubBrokersClientPlanResponse struct {
+	// The changes the recipe of a portable resource makes when the resource is deployed.
+	RecipePlanResult
+}
+
 // PubSubBrokersClientUpdateResponse contains the response from method PubSubBrokersClient.BeginUpdate.
 type PubSubBrokersClientUpdateResponse struct {
 	// Dapr PubSubBroker portable resource
@@ -65,6 +71,12 @@ type SecretStoresClientListByScopeResponse struct {
 	DaprSecretStoreResourceListResult
 }
 
+// SecretStoresClientPlanResponse contains the response from method SecretStoresClient.Plan.
+type SecretStoresClientPlanResponse struct {
+	// The changes the recipe of a portable resource makes when the resource is deployed.
+	RecipePlanResult
+}
+
 // SecretStoresClientUpdateResponse contains the response from method SecretStoresClient.BeginUpdate.
 type SecretStoresClientUpdateResponse struct {
 	// Dapr SecretStore portable resource
@@ -94,6 +106,12 @@ type StateStoresClientListByScopeResponse struct {
 	DaprStateStoreResourceListResult
 }
 
+// StateStoresClientPlanResponse contains the response from method StateStoresClient.Plan.
+type StateStoresClientPlanResponse struct {
+	// The changes the recipe of a portable resource makes when the resource is deployed.
+	RecipePlanResult
+}
+
 // StateStoresClientUpdateResponse contains the response from method StateStoresClient.BeginUpdate.
 type StateStoresClientUpdateResponse struct {
 	// Dapr StateStore portable resource
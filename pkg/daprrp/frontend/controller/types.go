@@ -41,4 +41,7 @@ const (
 	AsyncCreateOrUpdateDaprPubSubBrokerTimeout = time.Duration(60) * time.Minute
 	// AsyncDeleteDaprPubSubBrokerTimeout is the timeout for async delete dapr pub sub broker
 	AsyncDeleteDaprPubSubBrokerTimeout = time.Duration(30) * time.Minute
+
+	// OperationPlan is the method of the action which previews the changes the recipe of a Dapr resource makes.
+	OperationPlan = "ACTIONPLAN"
 )
@@ -36,6 +36,12 @@ type MongoDatabasesClientListSecretsResponse struct {
 	MongoDatabaseListSecretsResult
 }
 
+// MongoDatabasesClientPlanResponse contains the response from method MongoDatabasesClient.Plan.
+type MongoDatabasesClientPlanResponse struct {
+	// The changes the recipe of a portable resource makes when the resource is deployed.
+	RecipePlanResult
+}
+
 // MongoDatabasesClientUpdateResponse contains the response from method MongoDatabasesClient.BeginUpdate.
 type MongoDatabasesClientUpdateResponse struct {
 	// MongoDatabase portable resource
@@ -77,6 +83,12 @@ type RedisCachesClientListSecretsResponse struct {
 	RedisCacheListSecretsResult
 }
 
+// RedisCachesClientPlanResponse contains the response from method RedisCachesClient.Plan.
+type RedisCachesClientPlanResponse struct {
+	// The changes the recipe of a portable resource makes when the resource is deployed.
+	RecipePlanResult
+}
+
 // RedisCachesClientUpdateResponse contains the response from method RedisCachesClient.BeginUpdate.
 type RedisCachesClientUpdateResponse struct {
 	// RedisCache portable resource
@@ -112,6 +124,12 @@ type SQLDatabasesClientListSecretsResponse struct {
 	SQLDatabaseListSecretsResult
 }
 
+// SQLDatabasesClientPlanResponse contains the response from method SQLDatabasesClient.Plan.
+type SQLDatabasesClientPlanResponse struct {
+	// The changes the recipe of a portable resource makes when the resource is deployed.
+	RecipePlanResult
+}
+
 // SQLDatabasesClientUpdateResponse contains the response from method SQLDatabasesClient.BeginUpdate.
 type SQLDatabasesClientUpdateResponse struct {
 	// SqlDatabase portable resource
@@ -23,8 +23,21 @@ import (
 	"github.com/radius-project/radius/pkg/datastoresrp/datamodel"
 	"github.com/radius-project/radius/pkg/portableresources/processors"
 	"github.com/radius-project/radius/pkg/portableresources/renderers"
+	"github.com/radius-project/radius/pkg/recipes"
 )
 
+// outputSchema declares the outputs returned by the recipes of MongoDatabase resources.
+var outputSchema = recipes.OutputSchema{
+	Fields: []recipes.OutputField{
+		{Name: renderers.Host, Type: recipes.OutputFieldTypeString, Required: true},
+		{Name: renderers.Port, Type: recipes.OutputFieldTypeInteger, Required: true},
+		{Name: renderers.DatabaseNameValue, Type: recipes.OutputFieldTypeString, Required: true},
+		{Name: renderers.UsernameStringValue, Type: recipes.OutputFieldTypeString},
+		{Name: renderers.PasswordStringHolder, Type: recipes.OutputFieldTypeString, Secret: true},
+		{Name: renderers.ConnectionStringValue, Type: recipes.OutputFieldTypeString, Secret: true},
+	},
+}
+
 // Processor is a processor for MongoDB resources.
 type Processor struct {
 }
@@ -57,6 +70,11 @@ func (p *Processor) Delete(ctx context.Context, resource *datamodel.MongoDatabas
 	return nil
 }
 
+// OutputSchema implements the processors.OutputSchemaProvider interface for MongoDatabase resources.
+func (p *Processor) OutputSchema() recipes.OutputSchema {
+	return outputSchema
+}
+
 func (p *Processor) computeConnectionString(resource *datamodel.MongoDatabase) string {
 	connectionString := "mongodb://"
 
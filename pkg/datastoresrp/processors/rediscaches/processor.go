@@ -23,6 +23,7 @@ import (
 	"github.com/radius-project/radius/pkg/datastoresrp/datamodel"
 	"github.com/radius-project/radius/pkg/portableresources/processors"
 	"github.com/radius-project/radius/pkg/portableresources/renderers"
+	"github.com/radius-project/radius/pkg/recipes"
 )
 
 const (
@@ -33,6 +34,19 @@ const (
 	RedisSSLPort = 6380
 )
 
+// outputSchema declares the outputs returned by the recipes of RedisCache resources.
+var outputSchema = recipes.OutputSchema{
+	Fields: []recipes.OutputField{
+		{Name: renderers.Host, Type: recipes.OutputFieldTypeString, Required: true},
+		{Name: renderers.Port, Type: recipes.OutputFieldTypeInteger, Required: true},
+		{Name: renderers.UsernameStringValue, Type: recipes.OutputFieldTypeString},
+		{Name: renderers.TLS, Type: recipes.OutputFieldTypeBoolean},
+		{Name: renderers.PasswordStringHolder, Type: recipes.OutputFieldTypeString, Secret: true},
+		{Name: renderers.ConnectionStringValue, Type: recipes.OutputFieldTypeString, Secret: true},
+		{Name: renderers.ConnectionURIValue, Type: recipes.OutputFieldTypeString, Secret: true},
+	},
+}
+
 // Processor is a processor for RedisCache resources.
 type Processor struct {
 }
@@ -70,6 +84,11 @@ func (p *Processor) Delete(ctx context.Context, resource *datamodel.RedisCache,
 	return nil
 }
 
+// OutputSchema implements the processors.OutputSchemaProvider interface for RedisCache resources.
+func (p *Processor) OutputSchema() recipes.OutputSchema {
+	return outputSchema
+}
+
 func (p *Processor) computeSSL(resource *datamodel.RedisCache) bool {
 	return resource.Properties.Port == RedisSSLPort
 }
@@ -247,3 +247,37 @@ the connection value "host" should be provided by the recipe, set '.properties.h
 the connection value "port" should be provided by the recipe, set '.properties.port' to provide a value manually`, err.Error())
 	})
 }
+
+func Test_OutputSchema(t *testing.T) {
+	processor := Processor{}
+	schema := processor.OutputSchema()
+
+	t.Run("valid output", func(t *testing.T) {
+		err := schema.Validate("Applications.Datastores/redisCaches", &recipes.RecipeOutput{
+			Values: map[string]any{
+				"host": "myredis.redis.cache.windows.net",
+				"port": float64(RedisSSLPort),
+				"tls":  true,
+			},
+			Secrets: map[string]any{
+				"password": "testpassword",
+			},
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid output", func(t *testing.T) {
+		err := schema.Validate("Applications.Datastores/redisCaches", &recipes.RecipeOutput{
+			Values: map[string]any{
+				"port":     "6380",
+				"password": "testpassword",
+			},
+		})
+		require.Error(t, err)
+		require.IsType(t, &recipes.RecipeError{}, err)
+		require.Equal(t, `code InvalidRecipeOutputs: err the recipe output doesn't match the outputs of resource type "Applications.Datastores/redisCaches": `+
+			`the field "host" is missing from the values of the recipe output; `+
+			`the field "port" of the recipe output is expected to be of type integer, got string; `+
+			`the field "password" must be returned in the secrets of the recipe output, not in the values`, err.Error())
+	})
+}
@@ -12,8 +12,21 @@ import (
 	"github.com/radius-project/radius/pkg/datastoresrp/datamodel"
 	"github.com/radius-project/radius/pkg/portableresources/processors"
 	"github.com/radius-project/radius/pkg/portableresources/renderers"
+	"github.com/radius-project/radius/pkg/recipes"
 )
 
+// outputSchema declares the outputs returned by the recipes of SqlDatabase resources.
+var outputSchema = recipes.OutputSchema{
+	Fields: []recipes.OutputField{
+		{Name: renderers.DatabaseNameValue, Type: recipes.OutputFieldTypeString, Required: true},
+		{Name: renderers.ServerNameValue, Type: recipes.OutputFieldTypeString, Required: true},
+		{Name: renderers.Port, Type: recipes.OutputFieldTypeInteger, Required: true},
+		{Name: renderers.UsernameStringValue, Type: recipes.OutputFieldTypeString},
+		{Name: renderers.PasswordStringHolder, Type: recipes.OutputFieldTypeString, Secret: true},
+		{Name: renderers.ConnectionStringValue, Type: recipes.OutputFieldTypeString, Secret: true},
+	},
+}
+
 // Processor is a processor for SQL database resources.
 type Processor struct {
 }
@@ -46,6 +59,11 @@ func (p *Processor) Delete(ctx context.Context, resource *datamodel.SqlDatabase,
 	return nil
 }
 
+// OutputSchema implements the processors.OutputSchemaProvider interface for SqlDatabase resources.
+func (p *Processor) OutputSchema() recipes.OutputSchema {
+	return outputSchema
+}
+
 func (p *Processor) computeConnectionString(resource *datamodel.SqlDatabase) string {
 	var username, password string
 	if resource.Properties.Username != "" {
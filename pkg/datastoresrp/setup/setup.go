@@ -34,6 +34,7 @@ import (
 	rds_proc "github.com/radius-project/radius/pkg/datastoresrp/processors/rediscaches"
 	sql_proc "github.com/radius-project/radius/pkg/datastoresrp/processors/sqldatabases"
 	pr_ctrl "github.com/radius-project/radius/pkg/portableresources/backend/controller"
+	pr_frontend_ctrl "github.com/radius-project/radius/pkg/portableresources/frontend/controller"
 	rp_frontend "github.com/radius-project/radius/pkg/rp/frontend"
 )
 
@@ -53,6 +54,7 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 		Put: builder.Operation[datamodel.RedisCache]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.RedisCache]{
 				rp_frontend.PrepareRadiusResource[*datamodel.RedisCache],
+				rp_frontend.ValidateRecipeParameters[*datamodel.RedisCache](recipeControllerConfig.Engine, recipeControllerConfig.ConfigLoader),
 			},
 			AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
 				return pr_ctrl.NewCreateOrUpdateResource[*datamodel.RedisCache, datamodel.RedisCache](options, &rds_proc.Processor{}, recipeControllerConfig.Engine, recipeControllerConfig.ResourceClient, recipeControllerConfig.ConfigLoader)
@@ -63,6 +65,7 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 		Patch: builder.Operation[datamodel.RedisCache]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.RedisCache]{
 				rp_frontend.PrepareRadiusResource[*datamodel.RedisCache],
+				rp_frontend.ValidateRecipeParameters[*datamodel.RedisCache](recipeControllerConfig.Engine, recipeControllerConfig.ConfigLoader),
 			},
 			AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
 				return pr_ctrl.NewCreateOrUpdateResource[*datamodel.RedisCache, datamodel.RedisCache](options, &rds_proc.Processor{}, recipeControllerConfig.Engine, recipeControllerConfig.ResourceClient, recipeControllerConfig.ConfigLoader)
@@ -81,6 +84,13 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 			"listsecrets": {
 				APIController: rds_ctrl.NewListSecretsRedisCache,
 			},
+			"plan": {
+				APIController: func(opt apictrl.Options) (apictrl.Controller, error) {
+					return pr_frontend_ctrl.NewPlanResource[*datamodel.RedisCache, datamodel.RedisCache](opt, apictrl.ResourceOptions[datamodel.RedisCache]{
+						RequestConverter: converter.RedisCacheDataModelFromVersioned,
+					}, recipeControllerConfig.Engine)
+				},
+			},
 		},
 	})
 
@@ -91,6 +101,7 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 		Put: builder.Operation[datamodel.MongoDatabase]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.MongoDatabase]{
 				rp_frontend.PrepareRadiusResource[*datamodel.MongoDatabase],
+				rp_frontend.ValidateRecipeParameters[*datamodel.MongoDatabase](recipeControllerConfig.Engine, recipeControllerConfig.ConfigLoader),
 			},
 			AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
 				return pr_ctrl.NewCreateOrUpdateResource[*datamodel.MongoDatabase, datamodel.MongoDatabase](options, &mongo_proc.Processor{}, recipeControllerConfig.Engine, recipeControllerConfig.ResourceClient, recipeControllerConfig.ConfigLoader)
@@ -101,6 +112,7 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 		Patch: builder.Operation[datamodel.MongoDatabase]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.MongoDatabase]{
 				rp_frontend.PrepareRadiusResource[*datamodel.MongoDatabase],
+				rp_frontend.ValidateRecipeParameters[*datamodel.MongoDatabase](recipeControllerConfig.Engine, recipeControllerConfig.ConfigLoader),
 			},
 			AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
 				return pr_ctrl.NewCreateOrUpdateResource[*datamodel.MongoDatabase, datamodel.MongoDatabase](options, &mongo_proc.Processor{}, recipeControllerConfig.Engine, recipeControllerConfig.ResourceClient, recipeControllerConfig.ConfigLoader)
@@ -119,6 +131,13 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 			"listsecrets": {
 				APIController: mongo_ctrl.NewListSecretsMongoDatabase,
 			},
+			"plan": {
+				APIController: func(opt apictrl.Options) (apictrl.Controller, error) {
+					return pr_frontend_ctrl.NewPlanResource[*datamodel.MongoDatabase, datamodel.MongoDatabase](opt, apictrl.ResourceOptions[datamodel.MongoDatabase]{
+						RequestConverter: converter.MongoDatabaseDataModelFromVersioned,
+					}, recipeControllerConfig.Engine)
+				},
+			},
 		},
 	})
 
@@ -129,6 +148,7 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 		Put: builder.Operation[datamodel.SqlDatabase]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.SqlDatabase]{
 				rp_frontend.PrepareRadiusResource[*datamodel.SqlDatabase],
+				rp_frontend.ValidateRecipeParameters[*datamodel.SqlDatabase](recipeControllerConfig.Engine, recipeControllerConfig.ConfigLoader),
 			},
 			AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
 				return pr_ctrl.NewCreateOrUpdateResource[*datamodel.SqlDatabase, datamodel.SqlDatabase](options, &sql_proc.Processor{}, recipeControllerConfig.Engine, recipeControllerConfig.ResourceClient, recipeControllerConfig.ConfigLoader)
@@ -139,6 +159,7 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 		Patch: builder.Operation[datamodel.SqlDatabase]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.SqlDatabase]{
 				rp_frontend.PrepareRadiusResource[*datamodel.SqlDatabase],
+				rp_frontend.ValidateRecipeParameters[*datamodel.SqlDatabase](recipeControllerConfig.Engine, recipeControllerConfig.ConfigLoader),
 			},
 			AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
 				return pr_ctrl.NewCreateOrUpdateResource[*datamodel.SqlDatabase, datamodel.SqlDatabase](options, &sql_proc.Processor{}, recipeControllerConfig.Engine, recipeControllerConfig.ResourceClient, recipeControllerConfig.ConfigLoader)
@@ -157,6 +178,13 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 			"listsecrets": {
 				APIController: sql_ctrl.NewListSecretsSqlDatabase,
 			},
+			"plan": {
+				APIController: func(opt apictrl.Options) (apictrl.Controller, error) {
+					return pr_frontend_ctrl.NewPlanResource[*datamodel.SqlDatabase, datamodel.SqlDatabase](opt, apictrl.ResourceOptions[datamodel.SqlDatabase]{
+						RequestConverter: converter.SqlDatabaseDataModelFromVersioned,
+					}, recipeControllerConfig.Engine)
+				},
+			},
 		},
 	})
 
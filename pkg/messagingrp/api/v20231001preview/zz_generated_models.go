@@ -312,6 +312,35 @@ type Recipe struct {
 	Parameters map[string]any
 }
 
+// RecipePlanResult - The changes the recipe of a portable resource makes when the resource is deployed.
+type RecipePlanResult struct {
+	// REQUIRED; The changes to the resources deployed by the recipe
+	Changes []*RecipeResourceChange
+}
+
+// RecipeResourceChange - The change a recipe deployment makes to a resource.
+type RecipeResourceChange struct {
+	// REQUIRED; The kind of change made to the resource
+	ChangeType *RecipeChangeType
+
+	// REQUIRED; The resource ID when it is known before the deployment, otherwise the address of the resource in the recipe
+// template
+	Resource *string
+
+	// The state of the resource after the change, if it is known
+	After map[string]any
+
+	// The state of the resource before the change, if it is known
+	Before map[string]any
+
+	// The type of the resource
+	ResourceType *string
+
+	// The template expressions of the resource which can't be evaluated before the deployment, the change is partially known
+// when there are any
+	Unresolved []*string
+}
+
 // RecipeUpdate - The recipe used to automatically deploy underlying infrastructure for a portable resource
 type RecipeUpdate struct {
 	// The name of the recipe within the environment to use
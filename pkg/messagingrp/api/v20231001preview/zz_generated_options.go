@@ -47,3 +47,8 @@ type RabbitMqQueuesClientListSecretsOptions struct {
 	// placeholder for future optional parameters
 }
 
+// RabbitMqQueuesClientPlanOptions contains the optional parameters for the RabbitMqQueuesClient.Plan method.
+type RabbitMqQueuesClientPlanOptions struct {
+	// placeholder for future optional parameters
+}
+
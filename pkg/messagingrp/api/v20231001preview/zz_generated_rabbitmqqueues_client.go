@@ -338,6 +338,64 @@ func (client *RabbitMqQueuesClient) listSecretsHandleResponse(resp *http.Respons
 	return result, nil
 }
 
+
+// Plan - Previews the changes the recipe of the RabbitMQQueue resource in the request body makes when it is deployed, without deploying
+// it
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - rabbitMQQueueName - The name of the RabbitMQQueue portable resource resource
+//   - body - The content of the action request
+//   - options - RabbitMqQueuesClientPlanOptions contains the optional parameters for the RabbitMqQueuesClient.Plan method.
+func (client *RabbitMqQueuesClient) Plan(ctx context.Context, rabbitMQQueueName string, body RabbitMQQueueResource, options *RabbitMqQueuesClientPlanOptions) (RabbitMqQueuesClientPlanResponse, error) {
+	var err error
+	req, err := client.planCreateRequest(ctx, rabbitMQQueueName, body, options)
+	if err != nil {
+		return RabbitMqQueuesClientPlanResponse{}, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return RabbitMqQueuesClientPlanResponse{}, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK) {
+		err = runtime.NewResponseError(httpResp)
+		return RabbitMqQueuesClientPlanResponse{}, err
+	}
+	resp, err := client.planHandleResponse(httpResp)
+	return resp, err
+}
+
+// planCreateRequest creates the Plan request.
+func (client *RabbitMqQueuesClient) planCreateRequest(ctx context.Context, rabbitMQQueueName string, body RabbitMQQueueResource, options *RabbitMqQueuesClientPlanOptions) (*policy.Request, error) {
+	urlPath := "/{rootScope}/providers/Applications.Messaging/rabbitMQQueues/{rabbitMQQueueName}/plan"
+	urlPath = strings.ReplaceAll(urlPath, "{rootScope}", client.rootScope)
+	if rabbitMQQueueName == "" {
+		return nil, errors.New("parameter rabbitMQQueueName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{rabbitMQQueueName}", url.PathEscape(rabbitMQQueueName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	if err := runtime.MarshalAsJSON(req, body); err != nil {
+	return nil, err
+}
+	return req, nil
+}
+
+// planHandleResponse handles the Plan response.
+func (client *RabbitMqQueuesClient) planHandleResponse(resp *http.Response) (RabbitMqQueuesClientPlanResponse, error) {
+	result := RabbitMqQueuesClientPlanResponse{}
+	if err := runtime.UnmarshalAsJSON(resp, &result.RecipePlanResult); err != nil {
+		return RabbitMqQueuesClientPlanResponse{}, err
+	}
+	return result, nil
+}
+
 // BeginUpdate - Update a RabbitMQQueueResource
 // If the operation fails it returns an *azcore.ResponseError type.
 //
@@ -42,6 +42,12 @@ type RabbitMqQueuesClientListSecretsResponse struct {
 	RabbitMQListSecretsResult
 }
 
+// RabbitMqQueuesClientPlanResponse contains the response from method RabbitMqQueuesClient.Plan.
+type RabbitMqQueuesClientPlanResponse struct {
+	// The changes the recipe of a portable resource makes when the resource is deployed.
+	RecipePlanResult
+}
+
 // RabbitMqQueuesClientUpdateResponse contains the response from method RabbitMqQueuesClient.BeginUpdate.
 type RabbitMqQueuesClientUpdateResponse struct {
 	// RabbitMQQueue portable resource
@@ -23,6 +23,7 @@ import (
 	msg_dm "github.com/radius-project/radius/pkg/messagingrp/datamodel"
 	"github.com/radius-project/radius/pkg/portableresources/processors"
 	"github.com/radius-project/radius/pkg/portableresources/renderers"
+	"github.com/radius-project/radius/pkg/recipes"
 )
 
 const (
@@ -31,6 +32,20 @@ const (
 	RabbitMQSSLPort = 5671
 )
 
+// outputSchema declares the outputs returned by the recipes of RabbitMQQueue resources.
+var outputSchema = recipes.OutputSchema{
+	Fields: []recipes.OutputField{
+		{Name: Queue, Type: recipes.OutputFieldTypeString, Required: true},
+		{Name: renderers.Host, Type: recipes.OutputFieldTypeString, Required: true},
+		{Name: renderers.VHost, Type: recipes.OutputFieldTypeString},
+		{Name: renderers.Port, Type: recipes.OutputFieldTypeInteger, Required: true},
+		{Name: renderers.UsernameStringValue, Type: recipes.OutputFieldTypeString},
+		{Name: renderers.PasswordStringHolder, Type: recipes.OutputFieldTypeString, Secret: true},
+		{Name: renderers.TLS, Type: recipes.OutputFieldTypeBoolean},
+		{Name: renderers.URI, Type: recipes.OutputFieldTypeString, Secret: true},
+	},
+}
+
 // Processor is a processor for RabbitMQQueue resource.
 type Processor struct {
 }
@@ -66,6 +81,11 @@ func (p *Processor) Delete(ctx context.Context, resource *msg_dm.RabbitMQQueue,
 	return nil
 }
 
+// OutputSchema implements the processors.OutputSchemaProvider interface for RabbitMQQueue resources.
+func (p *Processor) OutputSchema() recipes.OutputSchema {
+	return outputSchema
+}
+
 func (p *Processor) computeURI(resource *msg_dm.RabbitMQQueue) string {
 	rabbitMQProtocol := "amqp"
 	if resource.Properties.TLS {
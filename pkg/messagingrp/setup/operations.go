@@ -59,6 +59,16 @@ var operationList = []v1.Operation{
 		},
 		IsDataAction: false,
 	},
+	{
+		Name: "Applications.Messaging/rabbitMQQueues/plan/action",
+		Display: &v1.OperationDisplayProperties{
+			Provider:    "Applications.Messaging",
+			Resource:    "rabbitMQQueues",
+			Operation:   "Plan",
+			Description: "Previews the changes made by the recipe of a RabbitMQ queue resource.",
+		},
+		IsDataAction: false,
+	},
 	{
 		Name: "Applications.Messaging/rabbitMQQueues/listsecrets/action",
 		Display: &v1.OperationDisplayProperties{
@@ -27,6 +27,7 @@ import (
 	rmq_ctrl "github.com/radius-project/radius/pkg/messagingrp/frontend/controller/rabbitmqqueues"
 	rmq_proc "github.com/radius-project/radius/pkg/messagingrp/processors/rabbitmqqueues"
 	pr_ctrl "github.com/radius-project/radius/pkg/portableresources/backend/controller"
+	pr_frontend_ctrl "github.com/radius-project/radius/pkg/portableresources/frontend/controller"
 	rp_frontend "github.com/radius-project/radius/pkg/rp/frontend"
 )
 
@@ -46,6 +47,7 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 		Put: builder.Operation[datamodel.RabbitMQQueue]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.RabbitMQQueue]{
 				rp_frontend.PrepareRadiusResource[*datamodel.RabbitMQQueue],
+				rp_frontend.ValidateRecipeParameters[*datamodel.RabbitMQQueue](recipeControllerConfig.Engine, recipeControllerConfig.ConfigLoader),
 			},
 			AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
 				return pr_ctrl.NewCreateOrUpdateResource[*datamodel.RabbitMQQueue, datamodel.RabbitMQQueue](options, &rmq_proc.Processor{}, recipeControllerConfig.Engine, recipeControllerConfig.ResourceClient, recipeControllerConfig.ConfigLoader)
@@ -56,6 +58,7 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 		Patch: builder.Operation[datamodel.RabbitMQQueue]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.RabbitMQQueue]{
 				rp_frontend.PrepareRadiusResource[*datamodel.RabbitMQQueue],
+				rp_frontend.ValidateRecipeParameters[*datamodel.RabbitMQQueue](recipeControllerConfig.Engine, recipeControllerConfig.ConfigLoader),
 			},
 			AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
 				return pr_ctrl.NewCreateOrUpdateResource[*datamodel.RabbitMQQueue, datamodel.RabbitMQQueue](options, &rmq_proc.Processor{}, recipeControllerConfig.Engine, recipeControllerConfig.ResourceClient, recipeControllerConfig.ConfigLoader)
@@ -74,6 +77,13 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 			"listsecrets": {
 				APIController: rmq_ctrl.NewListSecretsRabbitMQQueue,
 			},
+			"plan": {
+				APIController: func(opt apictrl.Options) (apictrl.Controller, error) {
+					return pr_frontend_ctrl.NewPlanResource[*datamodel.RabbitMQQueue, datamodel.RabbitMQQueue](opt, apictrl.ResourceOptions[datamodel.RabbitMQQueue]{
+						RequestConverter: converter.RabbitMQQueueDataModelFromVersioned,
+					}, recipeControllerConfig.Engine)
+				},
+			},
 		},
 	})
 
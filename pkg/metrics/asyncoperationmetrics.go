@@ -42,6 +42,15 @@ const (
 
 	// AsyncOperationDuration is the metric name for async operation duration.
 	AsnycOperationDuration = "asyncoperation.duration"
+
+	// ScheduledAsyncOperationCount is the metric name for the count of async operations scheduled by the worker.
+	ScheduledAsyncOperationCount = "asyncoperation.scheduled.operation"
+
+	// AsyncOperationQueueWaitDuration is the metric name for the duration between enqueue and schedule of async operation.
+	AsyncOperationQueueWaitDuration = "asyncoperation.queue.wait.duration"
+
+	// ThrottledPartitionCount is the metric name for the count of partitions which reached their concurrency limit.
+	ThrottledPartitionCount = "asyncoperation.throttled.partition"
 )
 
 type asyncOperationMetrics struct {
@@ -82,6 +91,21 @@ func (a *asyncOperationMetrics) Init() error {
 		return err
 	}
 
+	a.counters[ScheduledAsyncOperationCount], err = meter.Int64Counter(ScheduledAsyncOperationCount)
+	if err != nil {
+		return err
+	}
+
+	a.valueRecorders[AsyncOperationQueueWaitDuration], err = meter.Float64Histogram(AsyncOperationQueueWaitDuration)
+	if err != nil {
+		return err
+	}
+
+	a.counters[ThrottledPartitionCount], err = meter.Int64Counter(ThrottledPartitionCount)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -123,6 +147,30 @@ func (a *asyncOperationMetrics) RecordAsyncOperationDuration(ctx context.Context
 	}
 }
 
+// RecordScheduledAsyncOperation increments the ScheduledAsyncOperationCount metric and records the duration in
+// milliseconds which the async operation waited in the queue. It should be called when the worker starts to process
+// the async operation.
+func (a *asyncOperationMetrics) RecordScheduledAsyncOperation(ctx context.Context, req *ctrl.Request, priority string, enqueuedAt time.Time) {
+	attrs := append(newAsyncOperationCommonAttributes(req, nil), priorityAttrKey.String(normalizeAttrValue(priority)))
+
+	if a.counters[ScheduledAsyncOperationCount] != nil {
+		a.counters[ScheduledAsyncOperationCount].Add(ctx, 1, metric.WithAttributes(attrs...))
+	}
+
+	if a.valueRecorders[AsyncOperationQueueWaitDuration] != nil && !enqueuedAt.IsZero() {
+		elapsedTime := float64(time.Since(enqueuedAt)) / float64(time.Millisecond)
+		a.valueRecorders[AsyncOperationQueueWaitDuration].Record(ctx, elapsedTime, metric.WithAttributes(attrs...))
+	}
+}
+
+// RecordThrottledPartition increments the ThrottledPartitionCount metric. It should be called when a partition reached
+// its concurrency limit and the worker stops dequeuing its async operations.
+func (a *asyncOperationMetrics) RecordThrottledPartition(ctx context.Context) {
+	if a.counters[ThrottledPartitionCount] != nil {
+		a.counters[ThrottledPartitionCount].Add(ctx, 1)
+	}
+}
+
 func newAsyncOperationCommonAttributes(req *ctrl.Request, res *ctrl.Result) []attribute.KeyValue {
 	attrs := make([]attribute.KeyValue, 0)
 
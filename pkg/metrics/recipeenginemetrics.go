@@ -43,12 +43,21 @@ const (
 	// terraformInitializationDuration is the metric name for the Terraform initialization duration.
 	terraformInitializationDuration = "recipe.tf.init.duration"
 
+	// terraformCacheLookups is the metric name for the number of lookups in the Terraform provider plugin and module caches.
+	terraformCacheLookups = "recipe.tf.cache.lookups"
+
+	// terraformCacheEvictions is the metric name for the number of entries evicted from the Terraform provider plugin and module caches.
+	terraformCacheEvictions = "recipe.tf.cache.evictions"
+
 	// RecipeEngineOperationExecute represents the Execute operation of the Recipe Engine.
 	RecipeEngineOperationExecute = "execute"
 
 	// RecipeEngineOperationDelete represents the Delete operation of the Recipe Engine.
 	RecipeEngineOperationDelete = "delete"
 
+	// RecipeEngineOperationPlan represents the Plan operation of the Recipe Engine.
+	RecipeEngineOperationPlan = "plan"
+
 	// RecipeEngineOperationDownloadRecipe represents the Download Recipe operation of the Recipe Engine.
 	RecipeEngineOperationDownloadRecipe = "download.recipe"
 
@@ -93,6 +102,16 @@ func (m *recipeEngineMetrics) Init() error {
 		return err
 	}
 
+	m.counters[terraformCacheLookups], err = meter.Int64Counter(terraformCacheLookups)
+	if err != nil {
+		return err
+	}
+
+	m.counters[terraformCacheEvictions], err = meter.Int64Counter(terraformCacheEvictions)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -129,6 +148,20 @@ func (m *recipeEngineMetrics) RecordTerraformInitializationDuration(ctx context.
 	}
 }
 
+// RecordTerraformCacheLookup records a lookup in a Terraform cache with the given attributes.
+func (m *recipeEngineMetrics) RecordTerraformCacheLookup(ctx context.Context, attrs []attribute.KeyValue) {
+	if m.counters[terraformCacheLookups] != nil {
+		m.counters[terraformCacheLookups].Add(ctx, 1, metric.WithAttributes(attrs...))
+	}
+}
+
+// RecordTerraformCacheEviction records the eviction of an entry from a Terraform cache with the given attributes.
+func (m *recipeEngineMetrics) RecordTerraformCacheEviction(ctx context.Context, attrs []attribute.KeyValue) {
+	if m.counters[terraformCacheEvictions] != nil {
+		m.counters[terraformCacheEvictions].Add(ctx, 1, metric.WithAttributes(attrs...))
+	}
+}
+
 // RecordRecipeGarbageCollectionDuration records the recipe garbage collection duration with the given attributes.
 func (m *recipeEngineMetrics) RecordRecipeGarbageCollectionDuration(ctx context.Context, startTime time.Time, attrs []attribute.KeyValue) {
 	if m.valueRecorders[recipeGCDuration] != nil {
@@ -33,6 +33,9 @@ const (
 	// operationErrorCodeAttrKey is the attribute name for the operation error code.
 	operationErrorCodeAttrKey = attribute.Key("operation_error_code")
 
+	// priorityAttrKey is the attribute name for the priority of the async operation.
+	priorityAttrKey = attribute.Key("priority")
+
 	// recipeNameAttrKey is the attribute name for the recipe name.
 	recipeNameAttrKey = attribute.Key("recipe_name")
 
@@ -45,9 +48,27 @@ const (
 	// TerraformVersionAttrKey is the attribute key for the Terraform version.
 	TerraformVersionAttrKey = attribute.Key("terraform_version")
 
+	// TerraformCacheTypeAttrKey is the attribute key for the type of a Terraform cache.
+	TerraformCacheTypeAttrKey = attribute.Key("terraform_cache_type")
+
+	// TerraformCacheResultAttrKey is the attribute key for the result of a lookup in a Terraform cache.
+	TerraformCacheResultAttrKey = attribute.Key("terraform_cache_result")
+
 	// SuccessfulOperationState is the value for a successful operation state.
 	SuccessfulOperationState = "success"
 
 	// FailedOperationState is the value for a failed operation state.
 	FailedOperationState = "failed"
+
+	// TerraformPluginCache is the value for the Terraform provider plugin cache type.
+	TerraformPluginCache = "plugin"
+
+	// TerraformModuleCache is the value for the Terraform module cache type.
+	TerraformModuleCache = "module"
+
+	// TerraformCacheHit is the value for a lookup finding the entry in a Terraform cache.
+	TerraformCacheHit = "hit"
+
+	// TerraformCacheMiss is the value for a lookup not finding the entry in a Terraform cache.
+	TerraformCacheMiss = "miss"
 )
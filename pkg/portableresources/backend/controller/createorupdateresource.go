@@ -18,8 +18,10 @@ package controller
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"reflect"
 
 	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
 	"github.com/radius-project/radius/pkg/portableresources/datamodel"
@@ -164,11 +166,54 @@ func (c *CreateOrUpdateResource[P, T]) executeRecipeIfNeeded(ctx context.Context
 		ResourceID:    data.GetBaseResource().ID,
 	}
 
+	outputSchema, err := c.recipeOutputSchema(data)
+	if err != nil {
+		return nil, err
+	}
+
 	return c.engine.Execute(ctx, engine.ExecuteOptions{
 		BaseOptions: engine.BaseOptions{
 			Recipe: request,
 		},
 		PreviousState: prevState,
 		Simulated:     simulated,
+		OutputSchema:  outputSchema,
 	})
 }
+
+// recipeOutputSchema returns the schema of the recipe output declared by the processor, or nil if the processor doesn't
+// declare one. The values set on the resource take precedence over the recipe output, so the recipe doesn't need to return them.
+func (c *CreateOrUpdateResource[P, T]) recipeOutputSchema(data P) (*recipes.OutputSchema, error) {
+	provider, ok := c.processor.(processors.OutputSchemaProvider)
+	if !ok {
+		return nil, nil
+	}
+
+	// Recipe outputs are bound to '.properties.<name>' and '.properties.secrets.<name>' of the resource.
+	b, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	resource := struct {
+		Properties map[string]any `json:"properties"`
+	}{}
+	if err := json.Unmarshal(b, &resource); err != nil {
+		return nil, err
+	}
+	secrets, _ := resource.Properties["secrets"].(map[string]any)
+
+	schema := provider.OutputSchema()
+	provided := []string{}
+	for _, field := range schema.Fields {
+		value := resource.Properties[field.Name]
+		if field.Secret {
+			value = secrets[field.Name]
+		}
+		if value != nil && !reflect.ValueOf(value).IsZero() {
+			provided = append(provided, field.Name)
+		}
+	}
+
+	schema = schema.WithProvided(provided...)
+	return &schema, nil
+}
@@ -119,6 +119,21 @@ func (p *ErrorProcessor) Delete(ctx context.Context, data *TestResource, options
 	return nil
 }
 
+// SchemaProcessor is a SuccessProcessor declaring the schema of the recipe output.
+type SchemaProcessor struct {
+	SuccessProcessor
+}
+
+// OutputSchema returns a schema with a field bound to a property of the TestResource.
+func (p *SchemaProcessor) OutputSchema() recipes.OutputSchema {
+	return recipes.OutputSchema{
+		Fields: []recipes.OutputField{
+			{Name: "isProcessed", Type: recipes.OutputFieldTypeBoolean, Required: true},
+			{Name: "host", Type: recipes.OutputFieldTypeString, Required: true},
+		},
+	}
+}
+
 var errorProcessorReference = processors.ResourceProcessor[*TestResource, TestResource](&ErrorProcessor{})
 var errProcessor = errors.New("processor error")
 var errConfiguration = errors.New("configuration error")
@@ -437,3 +452,39 @@ func TestCreateOrUpdateResource_Run(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateOrUpdateResource_recipeOutputSchema(t *testing.T) {
+	t.Run("processor without schema", func(t *testing.T) {
+		c := &CreateOrUpdateResource[*TestResource, TestResource]{processor: &SuccessProcessor{}}
+
+		schema, err := c.recipeOutputSchema(&TestResource{})
+		require.NoError(t, err)
+		require.Nil(t, schema)
+	})
+
+	t.Run("processor with schema", func(t *testing.T) {
+		c := &CreateOrUpdateResource[*TestResource, TestResource]{processor: &SchemaProcessor{}}
+
+		schema, err := c.recipeOutputSchema(&TestResource{})
+		require.NoError(t, err)
+		require.Equal(t, &recipes.OutputSchema{
+			Fields: []recipes.OutputField{
+				{Name: "isProcessed", Type: recipes.OutputFieldTypeBoolean, Required: true},
+				{Name: "host", Type: recipes.OutputFieldTypeString, Required: true},
+			},
+		}, schema)
+	})
+
+	t.Run("fields set on the resource are optional", func(t *testing.T) {
+		c := &CreateOrUpdateResource[*TestResource, TestResource]{processor: &SchemaProcessor{}}
+
+		schema, err := c.recipeOutputSchema(&TestResource{Properties: TestResourceProperties{IsProcessed: true}})
+		require.NoError(t, err)
+		require.Equal(t, &recipes.OutputSchema{
+			Fields: []recipes.OutputField{
+				{Name: "isProcessed", Type: recipes.OutputFieldTypeBoolean},
+				{Name: "host", Type: recipes.OutputFieldTypeString, Required: true},
+			},
+		}, schema)
+	})
+}
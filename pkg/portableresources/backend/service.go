@@ -193,6 +193,10 @@ func (s *Service) Run(ctx context.Context) error {
 		if s.Options.Config.WorkerServer.MaxOperationRetryCount != nil {
 			workerOpts.MaxOperationRetryCount = *s.Options.Config.WorkerServer.MaxOperationRetryCount
 		}
+		if s.Options.Config.WorkerServer.MaxPartitionConcurrency != nil {
+			workerOpts.MaxPartitionConcurrency = *s.Options.Config.WorkerServer.MaxPartitionConcurrency
+		}
+		workerOpts.PartitionConcurrency = s.Options.Config.WorkerServer.PartitionConcurrency
 	}
 
 	return s.Start(ctx, workerOpts)
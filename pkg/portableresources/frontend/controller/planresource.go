@@ -0,0 +1,114 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/portableresources/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+)
+
+const (
+	// PlanOperationName is the name of the custom action which plans the deployment of a portable resource.
+	PlanOperationName = "plan"
+)
+
+// PlanResource is the controller implementation to preview the changes the recipe of a portable resource makes when the
+// resource passed in the request body is deployed. Nothing is deployed.
+type PlanResource[P interface {
+	*T
+	rpv1.RadiusResourceModel
+}, T any] struct {
+	ctrl.Operation[P, T]
+	engine engine.Engine
+}
+
+// NewPlanResource creates a new PlanResource controller which computes the plan using the given recipe engine.
+func NewPlanResource[P interface {
+	*T
+	rpv1.RadiusResourceModel
+}, T any](opts ctrl.Options, resourceOpts ctrl.ResourceOptions[T], eng engine.Engine) (ctrl.Controller, error) {
+	return &PlanResource[P, T]{
+		Operation: ctrl.NewOperation[P, T](opts, resourceOpts),
+		engine:    eng,
+	}, nil
+}
+
+// Run computes the plan of the recipe of the resource in the request body. The output resources of the stored resource,
+// if it exists, are the previous state of the recipe. The plan is empty for resources which are provisioned manually.
+func (c *PlanResource[P, T]) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	sCtx := v1.ARMRequestContextFromContext(ctx)
+
+	// Request route for plan has name of the operation as suffix which should be removed to get the resource id.
+	// route id format: /planes/radius/local/resourceGroups/<resource_group>/providers/<type>/<resource_name>/plan
+	resourceID := sCtx.ResourceID.Truncate()
+
+	newResource, err := c.GetResourceFromRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	oldResource, _, err := c.GetResource(ctx, resourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	prevState := []string{}
+	if oldResource != nil {
+		for _, outputResource := range P(oldResource).OutputResources() {
+			prevState = append(prevState, outputResource.ID.String())
+		}
+	}
+
+	plan := &recipes.RecipePlan{Changes: []recipes.ResourceChange{}}
+
+	// 'any' is required here to convert to an interface type, only then can we use a type assertion.
+	data := P(newResource)
+	recipeDataModel, supportsRecipes := any(data).(datamodel.RecipeDataModel)
+	if !supportsRecipes || recipeDataModel.Recipe() == nil {
+		return rest.NewOKResponse(plan), nil
+	}
+
+	input := recipeDataModel.Recipe()
+	plan, err = c.engine.Plan(ctx, engine.ExecuteOptions{
+		BaseOptions: engine.BaseOptions{
+			Recipe: recipes.ResourceMetadata{
+				Name:          input.Name,
+				Parameters:    input.Parameters,
+				EnvironmentID: data.ResourceMetadata().Environment,
+				ApplicationID: data.ResourceMetadata().Application,
+				ResourceID:    resourceID.String(),
+			},
+		},
+		PreviousState: prevState,
+	})
+	if err != nil {
+		if recipeError, ok := err.(*recipes.RecipeError); ok {
+			return rest.NewBadRequestARMResponse(v1.ErrorResponse{Error: recipeError.ErrorDetails}), nil
+		}
+		return nil, err
+	}
+
+	return rest.NewOKResponse(plan), nil
+}
@@ -0,0 +1,234 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/portableresources"
+	"github.com/radius-project/radius/pkg/portableresources/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+const (
+	testEnvironmentID = "/planes/radius/local/resourceGroups/radius-test-rg/providers/Applications.Core/environments/test-env"
+	testApplicationID = "/planes/radius/local/resourceGroups/radius-test-rg/providers/Applications.Core/applications/test-app"
+	testResourceID    = "/planes/radius/local/resourceGroups/radius-test-rg/providers/Applications.Test/testResources/tr"
+	testOutputID      = "/planes/kubernetes/local/namespaces/test-ns/providers/apps/Deployment/redis"
+)
+
+type testResource struct {
+	v1.BaseResource
+	datamodel.PortableResourceMetadata
+
+	Properties testResourceProperties `json:"properties"`
+}
+
+func (r *testResource) ApplyDeploymentOutput(do rpv1.DeploymentOutput) error {
+	return nil
+}
+
+func (r *testResource) OutputResources() []rpv1.OutputResource {
+	return r.Properties.Status.OutputResources
+}
+
+func (r *testResource) ResourceMetadata() *rpv1.BasicResourceProperties {
+	return &r.Properties.BasicResourceProperties
+}
+
+func (r *testResource) Recipe() *portableresources.ResourceRecipe {
+	if r.Properties.ResourceProvisioning == portableresources.ResourceProvisioningManual {
+		return nil
+	}
+	return &r.Properties.Recipe
+}
+
+type testResourceProperties struct {
+	rpv1.BasicResourceProperties
+	ResourceProvisioning portableresources.ResourceProvisioning `json:"resourceProvisioning,omitempty"`
+	Recipe               portableresources.ResourceRecipe       `json:"recipe,omitempty"`
+}
+
+func testResourceFromVersioned(content []byte, version string) (*testResource, error) {
+	resource := &testResource{}
+	if err := json.Unmarshal(content, resource); err != nil {
+		return nil, err
+	}
+	return resource, nil
+}
+
+func setupPlan(t *testing.T, body *testResource) (context.Context, *http.Request, *store.MockStorageClient, *engine.MockEngine, ctrl.Controller) {
+	mctrl := gomock.NewController(t)
+	storageClient := store.NewMockStorageClient(mctrl)
+	eng := engine.NewMockEngine(mctrl)
+
+	content, err := json.Marshal(body)
+	require.NoError(t, err)
+
+	req, err := rpctest.NewHTTPRequestWithContent(context.Background(), http.MethodPost, testResourceID+"/plan?api-version=2023-10-01-preview", content)
+	require.NoError(t, err)
+	ctx := rpctest.NewARMRequestContext(req)
+
+	controller, err := NewPlanResource[*testResource, testResource](
+		ctrl.Options{StorageClient: storageClient},
+		ctrl.ResourceOptions[testResource]{RequestConverter: testResourceFromVersioned},
+		eng)
+	require.NoError(t, err)
+
+	return ctx, req, storageClient, eng, controller
+}
+
+func newTestResource(provisioning portableresources.ResourceProvisioning) *testResource {
+	return &testResource{
+		Properties: testResourceProperties{
+			BasicResourceProperties: rpv1.BasicResourceProperties{
+				Environment: testEnvironmentID,
+				Application: testApplicationID,
+			},
+			ResourceProvisioning: provisioning,
+			Recipe: portableresources.ResourceRecipe{
+				Name:       "default",
+				Parameters: map[string]any{"port": float64(6379)},
+			},
+		},
+	}
+}
+
+func TestPlanResource_Run(t *testing.T) {
+	t.Run("existing resource", func(t *testing.T) {
+		ctx, req, storageClient, eng, controller := setupPlan(t, newTestResource(portableresources.ResourceProvisioningRecipe))
+
+		stored := newTestResource(portableresources.ResourceProvisioningRecipe)
+		stored.Properties.Status.OutputResources = []rpv1.OutputResource{{ID: resources.MustParse(testOutputID)}}
+		storageClient.EXPECT().
+			Get(gomock.Any(), testResourceID).
+			Return(&store.Object{Metadata: store.Metadata{ID: testResourceID}, Data: stored}, nil)
+
+		expected := &recipes.RecipePlan{
+			Changes: []recipes.ResourceChange{
+				{Resource: testOutputID, ResourceType: "apps/Deployment", ChangeType: recipes.ChangeTypeUpdate},
+			},
+		}
+		eng.EXPECT().
+			Plan(gomock.Any(), engine.ExecuteOptions{
+				BaseOptions: engine.BaseOptions{
+					Recipe: recipes.ResourceMetadata{
+						Name:          "default",
+						Parameters:    map[string]any{"port": float64(6379)},
+						EnvironmentID: testEnvironmentID,
+						ApplicationID: testApplicationID,
+						ResourceID:    testResourceID,
+					},
+				},
+				PreviousState: []string{testOutputID},
+			}).
+			Return(expected, nil)
+
+		w := httptest.NewRecorder()
+		resp, err := controller.Run(ctx, w, req)
+		require.NoError(t, err)
+		require.NoError(t, resp.Apply(ctx, w, req))
+		require.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+		actual := &recipes.RecipePlan{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), actual))
+		require.Equal(t, expected, actual)
+	})
+
+	t.Run("new resource", func(t *testing.T) {
+		ctx, req, storageClient, eng, controller := setupPlan(t, newTestResource(portableresources.ResourceProvisioningRecipe))
+
+		storageClient.EXPECT().
+			Get(gomock.Any(), testResourceID).
+			Return(nil, &store.ErrNotFound{ID: testResourceID})
+		eng.EXPECT().
+			Plan(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, opts engine.ExecuteOptions) (*recipes.RecipePlan, error) {
+				require.Empty(t, opts.PreviousState)
+				return &recipes.RecipePlan{Changes: []recipes.ResourceChange{}}, nil
+			})
+
+		w := httptest.NewRecorder()
+		resp, err := controller.Run(ctx, w, req)
+		require.NoError(t, err)
+		require.NoError(t, resp.Apply(ctx, w, req))
+		require.Equal(t, http.StatusOK, w.Result().StatusCode)
+	})
+
+	t.Run("manual provisioning", func(t *testing.T) {
+		ctx, req, storageClient, _, controller := setupPlan(t, newTestResource(portableresources.ResourceProvisioningManual))
+
+		storageClient.EXPECT().
+			Get(gomock.Any(), testResourceID).
+			Return(nil, &store.ErrNotFound{ID: testResourceID})
+
+		w := httptest.NewRecorder()
+		resp, err := controller.Run(ctx, w, req)
+		require.NoError(t, err)
+		require.NoError(t, resp.Apply(ctx, w, req))
+		require.Equal(t, http.StatusOK, w.Result().StatusCode)
+		require.JSONEq(t, `{"changes":[]}`, w.Body.String())
+	})
+
+	t.Run("recipe error", func(t *testing.T) {
+		ctx, req, storageClient, eng, controller := setupPlan(t, newTestResource(portableresources.ResourceProvisioningRecipe))
+
+		storageClient.EXPECT().
+			Get(gomock.Any(), testResourceID).
+			Return(nil, &store.ErrNotFound{ID: testResourceID})
+		eng.EXPECT().
+			Plan(gomock.Any(), gomock.Any()).
+			Return(nil, recipes.NewRecipeError(recipes.RecipeDownloadFailed, "failed to pull the recipe", "", nil))
+
+		w := httptest.NewRecorder()
+		resp, err := controller.Run(ctx, w, req)
+		require.NoError(t, err)
+		require.NoError(t, resp.Apply(ctx, w, req))
+		require.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+
+		actual := &v1.ErrorResponse{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), actual))
+		require.Equal(t, recipes.RecipeDownloadFailed, actual.Error.Code)
+	})
+
+	t.Run("storage error", func(t *testing.T) {
+		ctx, req, storageClient, _, controller := setupPlan(t, newTestResource(portableresources.ResourceProvisioningRecipe))
+
+		storageClient.EXPECT().
+			Get(gomock.Any(), testResourceID).
+			Return(nil, errors.New("storage unavailable"))
+
+		w := httptest.NewRecorder()
+		_, err := controller.Run(ctx, w, req)
+		require.EqualError(t, err, "storage unavailable")
+	})
+}
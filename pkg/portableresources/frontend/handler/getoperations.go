@@ -104,6 +104,16 @@ func (opctrl *GetOperations) availableOperationsV1() *v1.PaginatedList {
 				},
 				IsDataAction: false,
 			},
+			&v1.Operation{
+				Name: "Applications.Dapr/secretStores/plan/action",
+				Display: &v1.OperationDisplayProperties{
+					Provider:    DaprProviderNamespace,
+					Resource:    "daprSecretStores",
+					Operation:   "Plan",
+					Description: "Previews the changes made by the recipe of a daprSecretStore resource.",
+				},
+				IsDataAction: false,
+			},
 			&v1.Operation{
 				Name: "Applications.Dapr/stateStores/read",
 				Display: &v1.OperationDisplayProperties{
@@ -134,6 +144,16 @@ func (opctrl *GetOperations) availableOperationsV1() *v1.PaginatedList {
 				},
 				IsDataAction: false,
 			},
+			&v1.Operation{
+				Name: "Applications.Dapr/stateStores/plan/action",
+				Display: &v1.OperationDisplayProperties{
+					Provider:    DaprProviderNamespace,
+					Resource:    "daprStateStores",
+					Operation:   "Plan",
+					Description: "Previews the changes made by the recipe of a daprStateStore resource.",
+				},
+				IsDataAction: false,
+			},
 			&v1.Operation{
 				Name: "Applications.Dapr/pubSubBrokers/read",
 				Display: &v1.OperationDisplayProperties{
@@ -164,6 +184,16 @@ func (opctrl *GetOperations) availableOperationsV1() *v1.PaginatedList {
 				},
 				IsDataAction: false,
 			},
+			&v1.Operation{
+				Name: "Applications.Dapr/pubSubBrokers/plan/action",
+				Display: &v1.OperationDisplayProperties{
+					Provider:    DaprProviderNamespace,
+					Resource:    "daprPubSubBrokers",
+					Operation:   "Plan",
+					Description: "Previews the changes made by the recipe of a daprPubSubBroker resource.",
+				},
+				IsDataAction: false,
+			},
 		},
 	}
 }
@@ -26,6 +26,8 @@ import (
 	frontend_ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/defaultoperation"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/server"
+	pr_frontend_ctrl "github.com/radius-project/radius/pkg/portableresources/frontend/controller"
+	"github.com/radius-project/radius/pkg/recipes/engine"
 	rp_frontend "github.com/radius-project/radius/pkg/rp/frontend"
 	"github.com/radius-project/radius/pkg/validator"
 	"github.com/radius-project/radius/swagger"
@@ -49,8 +51,9 @@ const (
 	AsyncOperationRetryAfter = time.Duration(5) * time.Second
 )
 
-// AddRoutes configures routes and handlers for Dapr Resource Providers.
-func AddRoutes(ctx context.Context, router chi.Router, isARM bool, ctrlOpts frontend_ctrl.Options) error {
+// AddRoutes configures routes and handlers for Dapr Resource Providers. The recipe engine is used to preview the changes
+// made by the recipes of the resources.
+func AddRoutes(ctx context.Context, router chi.Router, isARM bool, ctrlOpts frontend_ctrl.Options, eng engine.Engine) error {
 	rootScopePath := ctrlOpts.PathBase
 	rootScopePath += getRootScopePath(isARM)
 
@@ -61,7 +64,7 @@ func AddRoutes(ctx context.Context, router chi.Router, isARM bool, ctrlOpts fron
 		rootScopePath,
 	}
 
-	err := AddDaprRoutes(ctx, router, rootScopePath, prefixes, isARM, ctrlOpts)
+	err := AddDaprRoutes(ctx, router, rootScopePath, prefixes, isARM, ctrlOpts, eng)
 	if err != nil {
 		return err
 	}
@@ -71,7 +74,7 @@ func AddRoutes(ctx context.Context, router chi.Router, isARM bool, ctrlOpts fron
 
 // AddDaprRoutes configures the default ARM handlers and adds handlers for Dapr resources such as Dapr PubSubBroker,
 // SecretStore and StateStore. It registers handlers for various operations on these resources.
-func AddDaprRoutes(ctx context.Context, r chi.Router, rootScopePath string, prefixes []string, isARM bool, ctrlOpts frontend_ctrl.Options) error {
+func AddDaprRoutes(ctx context.Context, r chi.Router, rootScopePath string, prefixes []string, isARM bool, ctrlOpts frontend_ctrl.Options, eng engine.Engine) error {
 
 	// Dapr - Configure the default ARM handlers.
 	err := server.ConfigureDefaultHandlers(ctx, r, rootScopePath, isARM, DaprProviderNamespace, NewGetOperations, ctrlOpts)
@@ -190,6 +193,20 @@ func AddDaprRoutes(ctx context.Context, r chi.Router, rootScopePath string, pref
 				)
 			},
 		},
+		{
+			ParentRouter: pubsubResourceRouter,
+			Path:         "/" + pr_frontend_ctrl.PlanOperationName,
+			ResourceType: dapr_ctrl.DaprPubSubBrokersResourceType,
+			Method:       dapr_ctrl.OperationPlan,
+			ControllerFactory: func(opt frontend_ctrl.Options) (frontend_ctrl.Controller, error) {
+				return pr_frontend_ctrl.NewPlanResource[*dapr_dm.DaprPubSubBroker, dapr_dm.DaprPubSubBroker](opt,
+					frontend_ctrl.ResourceOptions[dapr_dm.DaprPubSubBroker]{
+						RequestConverter: dapr_conv.PubSubBrokerDataModelFromVersioned,
+					},
+					eng,
+				)
+			},
+		},
 	}
 
 	secretStorePlaneRouter := server.NewSubrouter(r, rootScopePath+"/providers/applications.dapr/secretstores", validator)
@@ -287,6 +304,20 @@ func AddDaprRoutes(ctx context.Context, r chi.Router, rootScopePath string, pref
 				)
 			},
 		},
+		{
+			ParentRouter: secretStoreResourceRouter,
+			Path:         "/" + pr_frontend_ctrl.PlanOperationName,
+			ResourceType: dapr_ctrl.DaprSecretStoresResourceType,
+			Method:       dapr_ctrl.OperationPlan,
+			ControllerFactory: func(opt frontend_ctrl.Options) (frontend_ctrl.Controller, error) {
+				return pr_frontend_ctrl.NewPlanResource[*dapr_dm.DaprSecretStore, dapr_dm.DaprSecretStore](opt,
+					frontend_ctrl.ResourceOptions[dapr_dm.DaprSecretStore]{
+						RequestConverter: dapr_conv.SecretStoreDataModelFromVersioned,
+					},
+					eng,
+				)
+			},
+		},
 	}...)
 
 	stateStorePlaneRouter := server.NewSubrouter(r, rootScopePath+"/providers/applications.dapr/statestores", validator)
@@ -384,6 +415,20 @@ func AddDaprRoutes(ctx context.Context, r chi.Router, rootScopePath string, pref
 				)
 			},
 		},
+		{
+			ParentRouter: stateStoreResourceRouter,
+			Path:         "/" + pr_frontend_ctrl.PlanOperationName,
+			ResourceType: dapr_ctrl.DaprStateStoresResourceType,
+			Method:       dapr_ctrl.OperationPlan,
+			ControllerFactory: func(opt frontend_ctrl.Options) (frontend_ctrl.Controller, error) {
+				return pr_frontend_ctrl.NewPlanResource[*dapr_dm.DaprStateStore, dapr_dm.DaprStateStore](opt,
+					frontend_ctrl.ResourceOptions[dapr_dm.DaprStateStore]{
+						RequestConverter: dapr_conv.StateStoreDataModelFromVersioned,
+					},
+					eng,
+				)
+			},
+		},
 	}...)
 
 	for _, h := range handlerOptions {
@@ -56,6 +56,10 @@ var handlerTests = []rpctest.HandlerTestSpec{
 		OperationType: v1.OperationType{Type: dapr_ctrl.DaprPubSubBrokersResourceType, Method: v1.OperationDelete},
 		Path:          "/resourcegroups/testrg/providers/applications.dapr/pubsubbrokers/daprpubsub",
 		Method:        http.MethodDelete,
+	}, {
+		OperationType: v1.OperationType{Type: dapr_ctrl.DaprPubSubBrokersResourceType, Method: dapr_ctrl.OperationPlan},
+		Path:          "/resourcegroups/testrg/providers/applications.dapr/pubsubbrokers/daprpubsub/plan",
+		Method:        http.MethodPost,
 	}, {
 		OperationType: v1.OperationType{Type: dapr_ctrl.DaprSecretStoresResourceType, Method: v1.OperationList},
 		Path:          "/providers/applications.dapr/secretstores",
@@ -80,6 +84,10 @@ var handlerTests = []rpctest.HandlerTestSpec{
 		OperationType: v1.OperationType{Type: dapr_ctrl.DaprSecretStoresResourceType, Method: v1.OperationDelete},
 		Path:          "/resourcegroups/testrg/providers/applications.dapr/secretstores/daprsecretstore",
 		Method:        http.MethodDelete,
+	}, {
+		OperationType: v1.OperationType{Type: dapr_ctrl.DaprSecretStoresResourceType, Method: dapr_ctrl.OperationPlan},
+		Path:          "/resourcegroups/testrg/providers/applications.dapr/secretstores/daprsecretstore/plan",
+		Method:        http.MethodPost,
 	}, {
 		OperationType: v1.OperationType{Type: dapr_ctrl.DaprStateStoresResourceType, Method: v1.OperationList},
 		Path:          "/providers/applications.dapr/statestores",
@@ -104,6 +112,10 @@ var handlerTests = []rpctest.HandlerTestSpec{
 		OperationType: v1.OperationType{Type: dapr_ctrl.DaprStateStoresResourceType, Method: v1.OperationDelete},
 		Path:          "/resourcegroups/testrg/providers/applications.dapr/statestores/daprstatestore",
 		Method:        http.MethodDelete,
+	}, {
+		OperationType: v1.OperationType{Type: dapr_ctrl.DaprStateStoresResourceType, Method: dapr_ctrl.OperationPlan},
+		Path:          "/resourcegroups/testrg/providers/applications.dapr/statestores/daprstatestore/plan",
+		Method:        http.MethodPost,
 	},
 	{
 		OperationType: v1.OperationType{Type: "Applications.Dapr/operationStatuses", Method: v1.OperationGet},
@@ -131,7 +143,7 @@ func TestHandlers(t *testing.T) {
 		// Test handlers for UCP resources.
 		rpctest.AssertRouters(t, handlerTests, "/api.ucp.dev", "/planes/radius/local", func(ctx context.Context) (chi.Router, error) {
 			r := chi.NewRouter()
-			return r, AddRoutes(ctx, r, false, ctrl.Options{PathBase: "/api.ucp.dev", DataProvider: mockSP})
+			return r, AddRoutes(ctx, r, false, ctrl.Options{PathBase: "/api.ucp.dev", DataProvider: mockSP}, nil)
 		})
 	})
 
@@ -150,7 +162,7 @@ func TestHandlers(t *testing.T) {
 		// Test handlers for Azure resources
 		rpctest.AssertRouters(t, azureHandlerTests, "", "/subscriptions/00000000-0000-0000-0000-000000000000", func(ctx context.Context) (chi.Router, error) {
 			r := chi.NewRouter()
-			return r, AddRoutes(ctx, r, true, ctrl.Options{PathBase: "", DataProvider: mockSP})
+			return r, AddRoutes(ctx, r, true, ctrl.Options{PathBase: "", DataProvider: mockSP}, nil)
 		})
 	})
 }
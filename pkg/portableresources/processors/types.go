@@ -39,6 +39,13 @@ type ResourceProcessor[P interface {
 	Delete(ctx context.Context, resource P, options Options) error
 }
 
+// OutputSchemaProvider is implemented by resource processors whose resource type declares the outputs its recipes
+// must return. The recipe engine validates the recipe output against the schema right after executing the recipe.
+type OutputSchemaProvider interface {
+	// OutputSchema returns the schema of the recipe output of the resource type.
+	OutputSchema() recipes.OutputSchema
+}
+
 // Options defines the options passed to the resource processor.
 type Options struct {
 	// RuntimeConfiguration represents the configuration of the target runtime.
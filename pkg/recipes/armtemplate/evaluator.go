@@ -0,0 +1,615 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package armtemplate evaluates ARM JSON templates compiled from Bicep without deploying them. It resolves the subset of
+// template expressions which only depend on parameters, variables and the names of the resources of the template, and
+// leaves the other expressions unresolved. The unresolved expressions are reported with the reason they can't be
+// evaluated, so that the results computed from the evaluated template can be reported as partial.
+package armtemplate
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const (
+	// maxDepth is the maximum nesting of parameters and variables evaluated from each other.
+	maxDepth = 32
+)
+
+var (
+	// errUnresolved is returned when an expression can't be evaluated without deploying the template.
+	errUnresolved = errors.New("expression can't be resolved before deployment")
+
+	// errUnsupported is returned when an expression calls a template function which isn't supported by the Evaluator.
+	errUnsupported = errors.New("template function isn't supported")
+)
+
+// UnresolvedExpression is a template expression which can't be evaluated before the deployment.
+type UnresolvedExpression struct {
+	// Expression is the template expression, including the enclosing square brackets.
+	Expression string
+
+	// Reason explains why the expression can't be evaluated.
+	Reason string
+
+	// Unsupported is true if the expression calls a template function which isn't supported by the Evaluator, rather
+	// than depending on values which are only known during the deployment.
+	Unsupported bool
+}
+
+// String returns the expression followed by the reason it can't be evaluated.
+func (u UnresolvedExpression) String() string {
+	return u.Expression + " (" + u.Reason + ")"
+}
+
+// Evaluator evaluates the expressions of an ARM JSON template.
+type Evaluator struct {
+	// Scope is the Radius resource group scope the template is deployed to, e.g. /planes/radius/local/resourceGroups/rg.
+	// When it is set, the IDs of Radius resources declared by the template can be resolved.
+	Scope string
+
+	template   map[string]any
+	parameters map[string]any
+	depth      int
+
+	// unresolved are the expressions which couldn't be evaluated by Evaluate, in the order they were found.
+	unresolved []UnresolvedExpression
+}
+
+// NewEvaluator creates an Evaluator for the template deployed with the given parameters. The parameters use the ARM
+// deployment format, where the value of each parameter is wrapped in an object with a "value" property. The default
+// values of the template are used for parameters which aren't set.
+func NewEvaluator(template map[string]any, parameters map[string]any) (*Evaluator, error) {
+	// Parameters may be Go structs, for example the recipe context, so they are converted to their JSON representation
+	// for expressions to access their properties.
+	b, err := json.Marshal(parameters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the template parameters: %w", err)
+	}
+
+	values := map[string]any{}
+	if err := json.Unmarshal(b, &values); err != nil {
+		return nil, fmt.Errorf("failed to read the template parameters: %w", err)
+	}
+
+	e := &Evaluator{
+		template:   template,
+		parameters: map[string]any{},
+	}
+	for name, value := range values {
+		if wrapped, ok := value.(map[string]any); ok {
+			if v, ok := wrapped["value"]; ok {
+				e.parameters[name] = v
+			}
+		}
+	}
+
+	return e, nil
+}
+
+// Evaluate returns a copy of value where the template expressions are replaced by their values. Expressions which can't
+// be resolved are left as is and added to the expressions returned by Unresolved, and the returned boolean is false if
+// there is at least one of them.
+func (e *Evaluator) Evaluate(value any) (any, bool) {
+	switch v := value.(type) {
+	case string:
+		result, err := e.evaluateString(v)
+		if err != nil {
+			e.unresolved = append(e.unresolved, UnresolvedExpression{
+				Expression:  v,
+				Reason:      err.Error(),
+				Unsupported: errors.Is(err, errUnsupported),
+			})
+			return v, false
+		}
+		return result, true
+	case map[string]any:
+		resolved := true
+		result := map[string]any{}
+		for key, item := range v {
+			evaluated, ok := e.Evaluate(item)
+			resolved = resolved && ok
+			result[key] = evaluated
+		}
+		return result, resolved
+	case []any:
+		resolved := true
+		result := make([]any, len(v))
+		for i, item := range v {
+			evaluated, ok := e.Evaluate(item)
+			resolved = resolved && ok
+			result[i] = evaluated
+		}
+		return result, resolved
+	default:
+		return value, true
+	}
+}
+
+// Unresolved returns the expressions which couldn't be evaluated by Evaluate, in the order they were found.
+func (e *Evaluator) Unresolved() []UnresolvedExpression {
+	return e.unresolved
+}
+
+// resolve returns a copy of value where the template expressions are replaced by their values, or the error of the
+// first expression which can't be resolved.
+func (e *Evaluator) resolve(value any) (any, error) {
+	switch v := value.(type) {
+	case string:
+		return e.evaluateString(v)
+	case map[string]any:
+		result := map[string]any{}
+		for key, item := range v {
+			evaluated, err := e.resolve(item)
+			if err != nil {
+				return nil, err
+			}
+			result[key] = evaluated
+		}
+		return result, nil
+	case []any:
+		result := make([]any, len(v))
+		for i, item := range v {
+			evaluated, err := e.resolve(item)
+			if err != nil {
+				return nil, err
+			}
+			result[i] = evaluated
+		}
+		return result, nil
+	default:
+		return value, nil
+	}
+}
+
+// evaluateString evaluates a template string, which is an expression when it is enclosed in square brackets.
+func (e *Evaluator) evaluateString(s string) (any, error) {
+	if !strings.HasPrefix(s, "[") || !strings.HasSuffix(s, "]") {
+		return s, nil
+	}
+
+	// "[[" escapes a literal string starting with "[".
+	if strings.HasPrefix(s, "[[") {
+		return s[1:], nil
+	}
+
+	p := &parser{evaluator: e, input: s[1 : len(s)-1]}
+	value, err := p.parseExpression()
+	if err != nil {
+		return nil, err
+	}
+
+	p.skipSpaces()
+	if p.pos != len(p.input) {
+		return nil, fmt.Errorf("%w: unexpected character at position %d", errUnresolved, p.pos)
+	}
+
+	if ref, ok := value.(resourceReference); ok {
+		return nil, fmt.Errorf("%w: reference to resource %q", errUnresolved, ref.symbolicName)
+	}
+
+	return value, nil
+}
+
+// parameter returns the value of a template parameter.
+func (e *Evaluator) parameter(name string) (any, error) {
+	if value, ok := e.parameters[name]; ok {
+		return value, nil
+	}
+
+	definitions, _ := e.template["parameters"].(map[string]any)
+	definition, _ := definitions[name].(map[string]any)
+	defaultValue, ok := definition["defaultValue"]
+	if !ok {
+		return nil, fmt.Errorf("%w: parameter %q isn't set", errUnresolved, name)
+	}
+
+	return e.nested(defaultValue)
+}
+
+// variable returns the value of a template variable.
+func (e *Evaluator) variable(name string) (any, error) {
+	variables, _ := e.template["variables"].(map[string]any)
+	value, ok := variables[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: variable %q isn't defined", errUnresolved, name)
+	}
+
+	return e.nested(value)
+}
+
+// nested evaluates a value referenced from an expression, such as a default value or a variable. The error of the
+// first expression of the value which can't be resolved is returned, so that it's reported for the referencing
+// expression.
+func (e *Evaluator) nested(value any) (any, error) {
+	if e.depth >= maxDepth {
+		return nil, fmt.Errorf("%w: parameters and variables are nested too deeply or reference each other", errUnresolved)
+	}
+
+	e.depth++
+	defer func() { e.depth-- }()
+
+	return e.resolve(value)
+}
+
+// resourceReference is the value of reference() and resourceInfo() expressions. Only the ID of the referenced resource
+// can be resolved.
+type resourceReference struct {
+	symbolicName string
+}
+
+// resourceID returns the ID of the resource declared by the template with the given symbolic name.
+func (e *Evaluator) resourceID(symbolicName string) (string, error) {
+	declarations, _ := e.template["resources"].(map[string]any)
+	declaration, ok := declarations[symbolicName].(map[string]any)
+	if !ok || e.depth >= maxDepth {
+		return "", fmt.Errorf("%w: ID of resource %q", errUnresolved, symbolicName)
+	}
+
+	e.depth++
+	defer func() { e.depth-- }()
+
+	resource := e.resourceIdentity(symbolicName, declaration)
+	if resource.ID == "" {
+		return "", fmt.Errorf("%w: ID of resource %q", errUnresolved, symbolicName)
+	}
+
+	return resource.ID, nil
+}
+
+// parser is a recursive descent parser evaluating a template expression:
+//
+//	expression := primary accessor*
+//	primary    := string | number | function
+//	function   := identifier '(' [expression (',' expression)*] ')'
+//	accessor   := '.' identifier | '[' expression ']'
+type parser struct {
+	evaluator *Evaluator
+	input     string
+	pos       int
+}
+
+func (p *parser) skipSpaces() {
+	for p.pos < len(p.input) && unicode.IsSpace(rune(p.input[p.pos])) {
+		p.pos++
+	}
+}
+
+func (p *parser) peek() byte {
+	p.skipSpaces()
+	if p.pos >= len(p.input) {
+		return 0
+	}
+	return p.input[p.pos]
+}
+
+func (p *parser) expect(c byte) error {
+	if p.peek() != c {
+		return fmt.Errorf("%w: expected %q at position %d", errUnresolved, c, p.pos)
+	}
+	p.pos++
+	return nil
+}
+
+func (p *parser) parseExpression() (any, error) {
+	value, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		switch p.peek() {
+		case '.':
+			p.pos++
+			name := p.parseIdentifier()
+			if name == "" {
+				return nil, fmt.Errorf("%w: expected a property name at position %d", errUnresolved, p.pos)
+			}
+			value, err = p.evaluator.property(value, name)
+		case '[':
+			p.pos++
+			var key any
+			key, err = p.parseExpression()
+			if err != nil {
+				return nil, err
+			}
+			if err = p.expect(']'); err != nil {
+				return nil, err
+			}
+			value, err = p.evaluator.index(value, key)
+		default:
+			return value, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (p *parser) parsePrimary() (any, error) {
+	c := p.peek()
+	switch {
+	case c == '\'':
+		return p.parseString()
+	case c == '-' || (c >= '0' && c <= '9'):
+		return p.parseNumber()
+	}
+
+	name := p.parseIdentifier()
+	if name == "" {
+		return nil, fmt.Errorf("%w: unexpected character at position %d", errUnresolved, p.pos)
+	}
+
+	if err := p.expect('('); err != nil {
+		return nil, err
+	}
+
+	args := []any{}
+	if p.peek() != ')' {
+		for {
+			arg, err := p.parseExpression()
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, arg)
+
+			if p.peek() != ',' {
+				break
+			}
+			p.pos++
+		}
+	}
+	if err := p.expect(')'); err != nil {
+		return nil, err
+	}
+
+	return p.evaluator.call(name, args)
+}
+
+func (p *parser) parseIdentifier() string {
+	p.skipSpaces()
+	start := p.pos
+	for p.pos < len(p.input) {
+		c := rune(p.input[p.pos])
+		if !unicode.IsLetter(c) && !unicode.IsDigit(c) && c != '_' && c != '$' {
+			break
+		}
+		p.pos++
+	}
+	return p.input[start:p.pos]
+}
+
+func (p *parser) parseString() (any, error) {
+	p.pos++
+	var sb strings.Builder
+	for p.pos < len(p.input) {
+		c := p.input[p.pos]
+		p.pos++
+		if c != '\'' {
+			sb.WriteByte(c)
+			continue
+		}
+
+		// A quote is escaped by doubling it.
+		if p.pos < len(p.input) && p.input[p.pos] == '\'' {
+			sb.WriteByte('\'')
+			p.pos++
+			continue
+		}
+		return sb.String(), nil
+	}
+
+	return nil, fmt.Errorf("%w: unterminated string", errUnresolved)
+}
+
+func (p *parser) parseNumber() (any, error) {
+	start := p.pos
+	if p.input[p.pos] == '-' {
+		p.pos++
+	}
+	for p.pos < len(p.input) && p.input[p.pos] >= '0' && p.input[p.pos] <= '9' {
+		p.pos++
+	}
+
+	n, err := strconv.ParseInt(p.input[start:p.pos], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid number %q", errUnresolved, p.input[start:p.pos])
+	}
+
+	// Numbers are represented like JSON numbers decoded from the template.
+	return float64(n), nil
+}
+
+// call evaluates a template function.
+func (e *Evaluator) call(name string, args []any) (any, error) {
+	switch strings.ToLower(name) {
+	case "parameters":
+		s, err := stringArg(name, args, 0, 1)
+		if err != nil {
+			return nil, err
+		}
+		return e.parameter(s)
+	case "variables":
+		s, err := stringArg(name, args, 0, 1)
+		if err != nil {
+			return nil, err
+		}
+		return e.variable(s)
+	case "reference", "resourceinfo":
+		s, ok := "", len(args) > 0
+		if ok {
+			s, ok = args[0].(string)
+		}
+		if !ok {
+			return nil, fmt.Errorf("%w: invalid arguments for function %q", errUnresolved, name)
+		}
+		return resourceReference{symbolicName: s}, nil
+	case "format":
+		format, err := stringArg(name, args, 0, -1)
+		if err != nil {
+			return nil, err
+		}
+		result := format
+		for i := len(args) - 1; i >= 1; i-- {
+			s, err := toString(args[i])
+			if err != nil {
+				return nil, err
+			}
+			result = strings.ReplaceAll(result, "{"+strconv.Itoa(i-1)+"}", s)
+		}
+		return result, nil
+	case "concat":
+		if len(args) > 0 {
+			if _, ok := args[0].([]any); ok {
+				result := []any{}
+				for _, arg := range args {
+					items, ok := arg.([]any)
+					if !ok {
+						return nil, fmt.Errorf("%w: invalid arguments for function %q", errUnresolved, name)
+					}
+					result = append(result, items...)
+				}
+				return result, nil
+			}
+		}
+		var sb strings.Builder
+		for _, arg := range args {
+			s, err := toString(arg)
+			if err != nil {
+				return nil, err
+			}
+			sb.WriteString(s)
+		}
+		return sb.String(), nil
+	case "tolower":
+		s, err := stringArg(name, args, 0, 1)
+		return strings.ToLower(s), err
+	case "toupper":
+		s, err := stringArg(name, args, 0, 1)
+		return strings.ToUpper(s), err
+	case "string":
+		if len(args) != 1 {
+			return nil, fmt.Errorf("%w: invalid arguments for function %q", errUnresolved, name)
+		}
+		return toString(args[0])
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "null":
+		return nil, nil
+	case "if":
+		condition, ok := false, len(args) == 3
+		if ok {
+			condition, ok = args[0].(bool)
+		}
+		if !ok {
+			return nil, fmt.Errorf("%w: invalid arguments for function %q", errUnresolved, name)
+		}
+		if condition {
+			return args[1], nil
+		}
+		return args[2], nil
+	default:
+		return nil, fmt.Errorf("%w: %q", errUnsupported, name)
+	}
+}
+
+// property evaluates the access to a property of a value.
+func (e *Evaluator) property(value any, name string) (any, error) {
+	switch v := value.(type) {
+	case resourceReference:
+		if !strings.EqualFold(name, "id") {
+			return nil, fmt.Errorf("%w: property %q of resource %q", errUnresolved, name, v.symbolicName)
+		}
+		return e.resourceID(v.symbolicName)
+	case map[string]any:
+		result, ok := v[name]
+		if !ok {
+			// Property names are case-insensitive in template expressions.
+			for key, item := range v {
+				if strings.EqualFold(key, name) {
+					return item, nil
+				}
+			}
+			return nil, fmt.Errorf("%w: property %q isn't set", errUnresolved, name)
+		}
+		return result, nil
+	default:
+		return nil, fmt.Errorf("%w: property %q", errUnresolved, name)
+	}
+}
+
+// index evaluates the access to an element of an array or to a property of an object.
+func (e *Evaluator) index(value any, key any) (any, error) {
+	switch k := key.(type) {
+	case string:
+		return e.property(value, k)
+	case float64:
+		items, ok := value.([]any)
+		if !ok || k < 0 || int(k) >= len(items) {
+			return nil, fmt.Errorf("%w: index %v is out of range", errUnresolved, k)
+		}
+		return items[int(k)], nil
+	default:
+		return nil, fmt.Errorf("%w: invalid index %v", errUnresolved, k)
+	}
+}
+
+// stringArg returns the string argument at index i of a function call. If count isn't negative, the function must be
+// called with exactly count arguments.
+func stringArg(function string, args []any, i int, count int) (string, error) {
+	if (count >= 0 && len(args) != count) || i >= len(args) {
+		return "", fmt.Errorf("%w: invalid arguments for function %q", errUnresolved, function)
+	}
+
+	s, ok := args[i].(string)
+	if !ok {
+		return "", fmt.Errorf("%w: invalid arguments for function %q", errUnresolved, function)
+	}
+
+	return s, nil
+}
+
+// toString converts a value to a string in the same way as the string() template function.
+func toString(value any) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case bool:
+		if v {
+			return "True", nil
+		}
+		return "False", nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case nil:
+		return "", nil
+	case map[string]any, []any:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return "", err
+		}
+		return string(b), nil
+	default:
+		return "", fmt.Errorf("%w: value of type %T can't be converted to a string", errUnresolved, v)
+	}
+}
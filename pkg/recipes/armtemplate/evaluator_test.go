@@ -0,0 +1,166 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package armtemplate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Evaluate(t *testing.T) {
+	template := map[string]any{
+		"parameters": map[string]any{
+			"name":     map[string]any{"type": "string"},
+			"size":     map[string]any{"type": "int", "defaultValue": float64(3)},
+			"prefix":   map[string]any{"type": "string", "defaultValue": "[format('{0}-db', parameters('name'))]"},
+			"location": map[string]any{"type": "string", "defaultValue": "[resourceGroup().location]"},
+		},
+		"variables": map[string]any{
+			"tags":  map[string]any{"app": "[parameters('name')]"},
+			"ports": []any{float64(80), float64(443)},
+		},
+	}
+
+	e, err := NewEvaluator(template, map[string]any{
+		"name":    map[string]any{"value": "todo"},
+		"context": map[string]any{"value": struct{ Resource map[string]string }{Resource: map[string]string{"name": "redis"}}},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		value    any
+		expected any
+		resolved bool
+	}{
+		{name: "literal", value: "todo", expected: "todo", resolved: true},
+		{name: "escaped", value: "[[literal]", expected: "[literal]", resolved: true},
+		{name: "parameter", value: "[parameters('name')]", expected: "todo", resolved: true},
+		{name: "default value", value: "[parameters('size')]", expected: float64(3), resolved: true},
+		{name: "default value expression", value: "[parameters('prefix')]", expected: "todo-db", resolved: true},
+		{name: "struct parameter", value: "[parameters('context').Resource.name]", expected: "redis", resolved: true},
+		{name: "indexed property", value: "[parameters('context')['Resource']['name']]", expected: "redis", resolved: true},
+		{name: "variable", value: "[variables('tags').app]", expected: "todo", resolved: true},
+		{name: "array index", value: "[variables('ports')[1]]", expected: float64(443), resolved: true},
+		{name: "format", value: "[format('{0}-{1}-{0}', parameters('name'), 2)]", expected: "todo-2-todo", resolved: true},
+		{name: "concat", value: "[concat('a', toUpper('b'), string(true()))]", expected: "aBTrue", resolved: true},
+		{name: "escaped quote", value: "[format('{0}''s', parameters('name'))]", expected: "todo's", resolved: true},
+		{name: "if", value: "[if(false(), 'a', 'b')]", expected: "b", resolved: true},
+		{name: "object", value: map[string]any{"a": "[parameters('name')]", "b": []any{"[toLower('X')]"}}, expected: map[string]any{"a": "todo", "b": []any{"x"}}, resolved: true},
+		{name: "unsupported function", value: "[parameters('location')]", expected: "[parameters('location')]", resolved: false},
+		{name: "unknown parameter", value: "[parameters('missing')]", expected: "[parameters('missing')]", resolved: false},
+		{name: "reference property", value: "[reference('redis').properties.host]", expected: "[reference('redis').properties.host]", resolved: false},
+		{name: "invalid expression", value: "[parameters('name']", expected: "[parameters('name']", resolved: false},
+		{name: "partially resolved object", value: map[string]any{"a": "[parameters('name')]", "b": "[uniqueString('x')]"}, expected: map[string]any{"a": "todo", "b": "[uniqueString('x')]"}, resolved: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			value, resolved := e.Evaluate(tc.value)
+			require.Equal(t, tc.resolved, resolved)
+			require.Equal(t, tc.expected, value)
+		})
+	}
+}
+
+func Test_Evaluate_RecursiveVariables(t *testing.T) {
+	template := map[string]any{
+		"variables": map[string]any{
+			"a": "[variables('b')]",
+			"b": "[variables('a')]",
+		},
+	}
+
+	e, err := NewEvaluator(template, nil)
+	require.NoError(t, err)
+
+	_, resolved := e.Evaluate("[variables('a')]")
+	require.False(t, resolved)
+}
+
+func Test_Evaluate_Unresolved(t *testing.T) {
+	template := map[string]any{
+		"parameters": map[string]any{
+			"name":     map[string]any{"type": "string"},
+			"suffix":   map[string]any{"type": "string", "defaultValue": "[concat('-', toLower(uniqueString(parameters('name'))))]"},
+			"location": map[string]any{"type": "string", "defaultValue": "[resourceGroup().location]"},
+		},
+		"variables": map[string]any{
+			"prefix": "[format('{0}-db', toUpper(parameters('name')))]",
+		},
+	}
+
+	tests := []struct {
+		name        string
+		value       string
+		expected    any
+		reason      string
+		unsupported bool
+	}{
+		{
+			name:     "nested expression",
+			value:    "[concat(toLower(variables('prefix')), format('-{0}', parameters('name')))]",
+			expected: "todo-db-todo",
+		},
+		{
+			name:        "unsupported function",
+			value:       "[uniqueString(parameters('name'))]",
+			reason:      `template function isn't supported: "uniqueString"`,
+			unsupported: true,
+		},
+		{
+			name:        "nested unsupported function",
+			value:       "[format('{0}{1}', variables('prefix'), parameters('suffix'))]",
+			reason:      `template function isn't supported: "uniqueString"`,
+			unsupported: true,
+		},
+		{
+			name:        "unsupported function in default value",
+			value:       "[parameters('location')]",
+			reason:      `template function isn't supported: "resourceGroup"`,
+			unsupported: true,
+		},
+		{
+			name:   "reference property",
+			value:  "[reference('redis').properties.host]",
+			reason: "expression can't be resolved before deployment",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			e, err := NewEvaluator(template, map[string]any{"name": map[string]any{"value": "todo"}})
+			require.NoError(t, err)
+
+			value, resolved := e.Evaluate(tc.value)
+			if tc.reason == "" {
+				require.True(t, resolved)
+				require.Equal(t, tc.expected, value)
+				require.Empty(t, e.Unresolved())
+				return
+			}
+
+			require.False(t, resolved)
+			require.Equal(t, tc.value, value)
+			require.Len(t, e.Unresolved(), 1)
+			require.Equal(t, tc.value, e.Unresolved()[0].Expression)
+			require.Contains(t, e.Unresolved()[0].Reason, tc.reason)
+			require.Equal(t, tc.unsupported, e.Unresolved()[0].Unsupported)
+		})
+	}
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package armtemplate
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	// ImportRadius is the name of the import of Radius resources.
+	ImportRadius = "radius"
+
+	// ImportKubernetes is the name of the import of Kubernetes resources.
+	ImportKubernetes = "kubernetes"
+)
+
+// Resource represents a resource declared by a template, with the template expressions resolved by the Evaluator.
+type Resource struct {
+	// SymbolicName is the symbolic name of the resource. It is empty for templates which declare resources as an array.
+	SymbolicName string
+
+	// Import is the name of the extensibility provider of the resource, e.g. "radius" or "kubernetes". It is empty for
+	// ARM resources.
+	Import string
+
+	// Type is the resource type, without the API version.
+	Type string
+
+	// APIVersion is the API version of the resource type.
+	APIVersion string
+
+	// Name is the name of the resource. It is the unresolved expression when NameResolved is false.
+	Name string
+
+	// NameResolved is false if the name of the resource can't be known before the deployment.
+	NameResolved bool
+
+	// ID is the resource ID of Radius resources when the Evaluator has a scope, otherwise it is empty.
+	ID string
+
+	// Existing is true if the template references an existing resource which isn't deployed by the template.
+	Existing bool
+
+	// Body is the body of the resource: the properties object for resources of extensibility providers, the whole
+	// resource declaration for ARM resources.
+	Body map[string]any
+
+	// Unresolved are the expressions of the condition, name and body of the resource which can't be evaluated before
+	// the deployment. They are left as is in the name and body, so the resource is only partially known when it isn't
+	// empty.
+	Unresolved []UnresolvedExpression
+}
+
+// Resources returns the resources declared by the template, sorted by symbolic name for templates which declare resources
+// as an object. Resources whose condition evaluates to false are omitted.
+func (e *Evaluator) Resources() ([]Resource, error) {
+	declarations := []map[string]any{}
+	symbolicNames := []string{}
+
+	switch v := e.template["resources"].(type) {
+	case nil:
+	case []any:
+		for _, item := range v {
+			declaration, ok := item.(map[string]any)
+			if !ok {
+				return nil, errors.New("template resources must be objects")
+			}
+			declarations = append(declarations, declaration)
+			symbolicNames = append(symbolicNames, "")
+		}
+	case map[string]any:
+		names := make([]string, 0, len(v))
+		for name := range v {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			declaration, ok := v[name].(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("template resource %q must be an object", name)
+			}
+			declarations = append(declarations, declaration)
+			symbolicNames = append(symbolicNames, name)
+		}
+	default:
+		return nil, errors.New("template resources must be an array or an object")
+	}
+
+	resources := []Resource{}
+	for i, declaration := range declarations {
+		start := len(e.unresolved)
+		if condition, ok := declaration["condition"]; ok {
+			if value, ok := e.Evaluate(condition); ok && value == false {
+				continue
+			}
+		}
+
+		resource := e.resource(symbolicNames[i], declaration)
+		if len(e.unresolved) > start {
+			resource.Unresolved = append([]UnresolvedExpression{}, e.unresolved[start:]...)
+		}
+		resources = append(resources, resource)
+	}
+
+	return resources, nil
+}
+
+// resource evaluates the declaration of a resource.
+func (e *Evaluator) resource(symbolicName string, declaration map[string]any) Resource {
+	// The name is part of the body as well, so its unresolved expressions are reported once, when evaluating the body.
+	start := len(e.unresolved)
+	resource := e.resourceIdentity(symbolicName, declaration)
+	e.unresolved = e.unresolved[:start]
+
+	var body any
+	if resource.Import == "" {
+		body, _ = e.Evaluate(declaration)
+	} else {
+		body, _ = e.Evaluate(declaration["properties"])
+	}
+	resource.Body, _ = body.(map[string]any)
+
+	return resource
+}
+
+// resourceIdentity evaluates the type, name and ID of a resource, without its body.
+func (e *Evaluator) resourceIdentity(symbolicName string, declaration map[string]any) Resource {
+	resource := Resource{
+		SymbolicName: symbolicName,
+		Existing:     declaration["existing"] == true,
+	}
+
+	resourceType, _ := declaration["type"].(string)
+	resource.Import, _ = declaration["import"].(string)
+	if resource.Import == "" {
+		resource.Type = resourceType
+		resource.APIVersion, _ = declaration["apiVersion"].(string)
+		resource.Name, resource.NameResolved = e.evaluateName(declaration["name"])
+		return resource
+	}
+
+	// Resources of extensibility providers use the <type>@<version> format, and declare their name in their properties.
+	resource.Type, resource.APIVersion, _ = strings.Cut(resourceType, "@")
+	properties, _ := declaration["properties"].(map[string]any)
+
+	var name any
+	if n, ok := properties["name"]; ok {
+		name = n
+	} else if metadata, ok := properties["metadata"].(map[string]any); ok {
+		name = metadata["name"]
+	}
+	resource.Name, resource.NameResolved = e.evaluateName(name)
+
+	if strings.EqualFold(resource.Import, ImportRadius) && resource.NameResolved && e.Scope != "" {
+		resource.ID = strings.TrimSuffix(e.Scope, "/") + "/providers/" + resource.Type + "/" + resource.Name
+	}
+
+	return resource
+}
+
+// evaluateName evaluates the name of a resource, returning false if it isn't a string known before the deployment.
+func (e *Evaluator) evaluateName(name any) (string, bool) {
+	raw, _ := name.(string)
+	value, ok := e.Evaluate(name)
+	s, isString := value.(string)
+	if !ok || !isString || s == "" {
+		return raw, false
+	}
+
+	return s, true
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package armtemplate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Resources_SymbolicNames(t *testing.T) {
+	template := map[string]any{
+		"languageVersion": "1.9-experimental",
+		"parameters": map[string]any{
+			"name":        map[string]any{"type": "string"},
+			"environment": map[string]any{"type": "string"},
+		},
+		"resources": map[string]any{
+			"app": map[string]any{
+				"import": "radius",
+				"type":   "Applications.Core/applications@2023-10-01-preview",
+				"properties": map[string]any{
+					"name": "[parameters('name')]",
+					"properties": map[string]any{
+						"environment": "[parameters('environment')]",
+					},
+				},
+			},
+			"redis": map[string]any{
+				"import": "radius",
+				"type":   "Applications.Datastores/redisCaches@2023-10-01-preview",
+				"properties": map[string]any{
+					"name": "[format('{0}-redis', parameters('name'))]",
+					"properties": map[string]any{
+						"application": "[reference('app').id]",
+					},
+				},
+			},
+			"deployment": map[string]any{
+				"import": "kubernetes",
+				"type":   "apps/Deployment@v1",
+				"properties": map[string]any{
+					"metadata": map[string]any{"name": "[uniqueString(parameters('name'))]"},
+				},
+			},
+			"storage": map[string]any{
+				"type":       "Microsoft.Storage/storageAccounts",
+				"apiVersion": "2022-09-01",
+				"name":       "[parameters('name')]",
+				"existing":   true,
+			},
+			"disabled": map[string]any{
+				"type":       "Microsoft.Storage/storageAccounts",
+				"apiVersion": "2022-09-01",
+				"name":       "disabled",
+				"condition":  "[false()]",
+			},
+		},
+	}
+
+	e, err := NewEvaluator(template, map[string]any{
+		"name":        map[string]any{"value": "todo"},
+		"environment": map[string]any{"value": "/planes/radius/local/resourceGroups/rg/providers/Applications.Core/environments/env"},
+	})
+	require.NoError(t, err)
+	e.Scope = "/planes/radius/local/resourceGroups/rg"
+
+	resources, err := e.Resources()
+	require.NoError(t, err)
+	require.Len(t, resources, 4)
+
+	require.Equal(t, "app", resources[0].SymbolicName)
+	require.Equal(t, "radius", resources[0].Import)
+	require.Equal(t, "Applications.Core/applications", resources[0].Type)
+	require.Equal(t, "2023-10-01-preview", resources[0].APIVersion)
+	require.Equal(t, "todo", resources[0].Name)
+	require.True(t, resources[0].NameResolved)
+	require.Equal(t, "/planes/radius/local/resourceGroups/rg/providers/Applications.Core/applications/todo", resources[0].ID)
+
+	require.Equal(t, "deployment", resources[1].SymbolicName)
+	require.Equal(t, "apps/Deployment", resources[1].Type)
+	require.False(t, resources[1].NameResolved)
+	require.Equal(t, "[uniqueString(parameters('name'))]", resources[1].Name)
+	require.Empty(t, resources[1].ID)
+	require.Equal(t, []UnresolvedExpression{
+		{
+			Expression:  "[uniqueString(parameters('name'))]",
+			Reason:      `template function isn't supported: "uniqueString"`,
+			Unsupported: true,
+		},
+	}, resources[1].Unresolved)
+
+	require.Equal(t, "redis", resources[2].SymbolicName)
+	require.Equal(t, "todo-redis", resources[2].Name)
+	require.Equal(t, map[string]any{
+		"name": "todo-redis",
+		"properties": map[string]any{
+			"application": "/planes/radius/local/resourceGroups/rg/providers/Applications.Core/applications/todo",
+		},
+	}, resources[2].Body)
+	require.Empty(t, resources[2].Unresolved)
+
+	require.Equal(t, "storage", resources[3].SymbolicName)
+	require.Equal(t, "Microsoft.Storage/storageAccounts", resources[3].Type)
+	require.Equal(t, "todo", resources[3].Name)
+	require.True(t, resources[3].Existing)
+}
+
+func Test_Resources_Array(t *testing.T) {
+	template := map[string]any{
+		"resources": []any{
+			map[string]any{
+				"type":       "Microsoft.Cache/redis",
+				"apiVersion": "2022-06-01",
+				"name":       "cache",
+				"properties": map[string]any{"sku": map[string]any{"name": "Basic"}},
+			},
+		},
+	}
+
+	e, err := NewEvaluator(template, nil)
+	require.NoError(t, err)
+
+	resources, err := e.Resources()
+	require.NoError(t, err)
+	require.Len(t, resources, 1)
+	require.Empty(t, resources[0].SymbolicName)
+	require.Equal(t, "Microsoft.Cache/redis", resources[0].Type)
+	require.Equal(t, "cache", resources[0].Name)
+	require.Equal(t, template["resources"].([]any)[0], resources[0].Body)
+}
+
+func Test_Resources_Invalid(t *testing.T) {
+	e, err := NewEvaluator(map[string]any{"resources": "invalid"}, nil)
+	require.NoError(t, err)
+
+	_, err = e.Resources()
+	require.EqualError(t, err, "template resources must be an array or an object")
+}
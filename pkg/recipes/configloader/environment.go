@@ -115,6 +115,14 @@ func getConfiguration(environment *v20231001preview.EnvironmentResource, applica
 		config.Simulated = true
 	}
 
+	if environment.Properties.RecipeConfig != nil {
+		env, err := environment.ConvertTo()
+		if err != nil {
+			return nil, err
+		}
+		config.RecipeConfig = env.(*datamodel.Environment).Properties.RecipeConfig
+	}
+
 	return &config, nil
 }
 
@@ -161,6 +169,8 @@ func getRecipeDefinition(environment *v20231001preview.EnvironmentResource, reci
 	switch c := found.(type) {
 	case *v20231001preview.TerraformRecipeProperties:
 		definition.TemplateVersion = *c.TemplateVersion
+	case *v20231001preview.HelmRecipeProperties:
+		definition.TemplateVersion = to.String(c.TemplateVersion)
 	}
 
 	return definition, nil
@@ -39,6 +39,7 @@ const (
 
 	recipeName      = "cosmosDB"
 	terraformRecipe = "terraform-cosmosDB"
+	helmRecipe      = "helm-mongodb"
 )
 
 func TestGetConfiguration(t *testing.T) {
@@ -140,6 +141,49 @@ func TestGetConfiguration(t *testing.T) {
 				Providers: createAWSProvider(),
 			},
 		},
+		{
+			name: "terraform backend with env resource",
+			envResource: &model.EnvironmentResource{
+				Properties: &model.EnvironmentProperties{
+					Compute: &model.KubernetesCompute{
+						Kind:       to.Ptr(kind),
+						Namespace:  to.Ptr(envNamespace),
+						ResourceID: to.Ptr(envResourceId),
+					},
+					RecipeConfig: &model.RecipeConfigProperties{
+						Terraform: &model.TerraformConfigProperties{
+							Backend: &model.TerraformBackendProperties{
+								Kind: to.Ptr(model.TerraformBackendKindS3),
+								S3: &model.S3BackendProperties{
+									Bucket: to.Ptr("tfstate"),
+									Region: to.Ptr("us-west-2"),
+								},
+							},
+						},
+					},
+				},
+			},
+			appResource: nil,
+			expectedConfig: &recipes.Configuration{
+				Runtime: recipes.RuntimeConfiguration{
+					Kubernetes: &recipes.KubernetesRuntime{
+						Namespace:            envNamespace,
+						EnvironmentNamespace: envNamespace,
+					},
+				},
+				RecipeConfig: datamodel.RecipeConfigProperties{
+					Terraform: datamodel.TerraformConfigProperties{
+						Backend: &datamodel.TerraformBackendProperties{
+							Kind: datamodel.TerraformBackendS3,
+							S3: &datamodel.S3BackendProperties{
+								Bucket: "tfstate",
+								Region: "us-west-2",
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "invalid app resource",
 			envResource: &model.EnvironmentResource{
@@ -234,6 +278,11 @@ func TestGetRecipeDefinition(t *testing.T) {
 						TemplatePath:    to.Ptr("Azure/cosmosdb/azurerm"),
 						TemplateVersion: to.Ptr("1.1.0"),
 					},
+					helmRecipe: &model.HelmRecipeProperties{
+						TemplateKind:    to.Ptr(recipes.TemplateKindHelm),
+						TemplatePath:    to.Ptr("oci://ghcr.io/radius-project/dev/charts/mongodb"),
+						TemplateVersion: to.Ptr("1.0.0"),
+					},
 				},
 			},
 		},
@@ -287,6 +336,20 @@ func TestGetRecipeDefinition(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, recipeDef, &expected)
 	})
+	t.Run("success-helm", func(t *testing.T) {
+		metadata := recipeMetadata
+		metadata.Name = helmRecipe
+		expected := recipes.EnvironmentDefinition{
+			Name:            helmRecipe,
+			Driver:          recipes.TemplateKindHelm,
+			ResourceType:    "Applications.Datastores/mongoDatabases",
+			TemplatePath:    "oci://ghcr.io/radius-project/dev/charts/mongodb",
+			TemplateVersion: "1.0.0",
+		}
+		recipeDef, err := getRecipeDefinition(&envResource, &metadata)
+		require.NoError(t, err)
+		require.Equal(t, recipeDef, &expected)
+	})
 	t.Run("no recipes registered to the environment", func(t *testing.T) {
 		envResourceNilRecipe := envResource
 		envResourceNilRecipe.Properties.Recipes = nil
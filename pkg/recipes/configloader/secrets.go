@@ -0,0 +1,98 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package configloader
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+//go:generate mockgen -destination=./mock_secrets_loader.go -package=configloader -self_package github.com/radius-project/radius/pkg/recipes/configloader github.com/radius-project/radius/pkg/recipes/configloader SecretsLoader
+
+var _ SecretsLoader = (*secretsLoader)(nil)
+
+// NewSecretStoreLoader creates a new secretsLoader instance with the given ARM Client Options.
+func NewSecretStoreLoader(armOptions *arm.ClientOptions) SecretsLoader {
+	return &secretsLoader{ArmClientOptions: armOptions}
+}
+
+// secretsLoader loads the secrets of Applications.Core/secretStores resources.
+type secretsLoader struct {
+	// ArmClientOptions represents the client options for ARM clients.
+	ArmClientOptions *arm.ClientOptions
+}
+
+// LoadSecrets lists the secrets of each secret store and returns the values of the requested keys. It returns an error
+// if a secret store cannot be fetched or a requested key doesn't exist in the secret store.
+func (e *secretsLoader) LoadSecrets(ctx context.Context, secretStoreIDs map[string][]string) (map[string]recipes.SecretData, error) {
+	loaded := map[string]recipes.SecretData{}
+	for secretStoreID, keys := range secretStoreIDs {
+		id, err := resources.ParseResource(secretStoreID)
+		if err != nil {
+			return nil, err
+		}
+
+		client, err := v20231001preview.NewSecretStoresClient(id.RootScope(), &aztoken.AnonymousCredential{}, e.ArmClientOptions)
+		if err != nil {
+			return nil, err
+		}
+
+		response, err := client.ListSecrets(ctx, id.Name(), map[string]any{}, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list secrets of secret store %q: %w", secretStoreID, err)
+		}
+
+		loaded[secretStoreID], err = toSecretData(secretStoreID, &response.SecretStoreListSecretsResult, keys)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return loaded, nil
+}
+
+// toSecretData returns the decoded values of the given keys of the secrets listed from a secret store.
+func toSecretData(secretStoreID string, secrets *v20231001preview.SecretStoreListSecretsResult, keys []string) (recipes.SecretData, error) {
+	data := recipes.SecretData{}
+	for _, key := range keys {
+		secret, ok := secrets.Data[key]
+		if !ok || secret == nil {
+			return nil, fmt.Errorf("secret key %q is not found in secret store %q", key, secretStoreID)
+		}
+
+		value := to.String(secret.Value)
+		if secret.Encoding != nil && *secret.Encoding == v20231001preview.SecretValueEncodingBase64 {
+			decoded, err := base64.StdEncoding.DecodeString(value)
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode secret key %q of secret store %q: %w", key, secretStoreID, err)
+			}
+			value = string(decoded)
+		}
+
+		data[key] = value
+	}
+
+	return data, nil
+}
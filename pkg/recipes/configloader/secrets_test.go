@@ -0,0 +1,88 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package configloader
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/stretchr/testify/require"
+)
+
+const secretStoreID = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/secretStores/vault"
+
+func TestToSecretData(t *testing.T) {
+	secrets := &v20231001preview.SecretStoreListSecretsResult{
+		Type: to.Ptr(v20231001preview.SecretStoreDataTypeGeneric),
+		Data: map[string]*v20231001preview.SecretValueProperties{
+			"token": {
+				Value: to.Ptr("s.token"),
+			},
+			"password": {
+				Encoding: to.Ptr(v20231001preview.SecretValueEncodingBase64),
+				Value:    to.Ptr("cGFzc3dvcmQ="),
+			},
+			"invalid": {
+				Encoding: to.Ptr(v20231001preview.SecretValueEncodingBase64),
+				Value:    to.Ptr("not base64"),
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		keys     []string
+		expected recipes.SecretData
+		err      string
+	}{
+		{
+			name:     "raw and base64 values",
+			keys:     []string{"token", "password"},
+			expected: recipes.SecretData{"token": "s.token", "password": "password"},
+		},
+		{
+			name:     "no keys",
+			keys:     nil,
+			expected: recipes.SecretData{},
+		},
+		{
+			name: "missing key",
+			keys: []string{"missing"},
+			err:  `secret key "missing" is not found in secret store "` + secretStoreID + `"`,
+		},
+		{
+			name: "invalid base64 value",
+			keys: []string{"invalid"},
+			err:  `failed to decode secret key "invalid"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := toSecretData(secretStoreID, secrets, tc.keys)
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, data)
+		})
+	}
+}
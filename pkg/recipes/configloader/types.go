@@ -28,3 +28,10 @@ type ConfigurationLoader interface {
 	// LoadRecipe fetches the recipe information from the environment.
 	LoadRecipe(ctx context.Context, recipe *recipes.ResourceMetadata) (*recipes.EnvironmentDefinition, error)
 }
+
+// SecretsLoader is the interface for loading the secrets referenced by the recipe configuration of an environment.
+type SecretsLoader interface {
+	// LoadSecrets fetches the secrets of the given secret stores. secretStoreIDs maps the resource ID of each secret
+	// store to the keys of the secrets to load. It returns the secrets keyed by the secret store resource ID.
+	LoadSecrets(ctx context.Context, secretStoreIDs map[string][]string) (map[string]recipes.SecretData, error)
+}
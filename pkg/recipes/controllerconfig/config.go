@@ -17,6 +17,7 @@ limitations under the License.
 package controllerconfig
 
 import (
+	"path/filepath"
 	"strconv"
 
 	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
@@ -27,6 +28,7 @@ import (
 	"github.com/radius-project/radius/pkg/recipes/configloader"
 	"github.com/radius-project/radius/pkg/recipes/driver"
 	"github.com/radius-project/radius/pkg/recipes/engine"
+	"github.com/radius-project/radius/pkg/recipes/terraform"
 	"github.com/radius-project/radius/pkg/sdk"
 	"github.com/radius-project/radius/pkg/sdk/clients"
 	"github.com/radius-project/radius/pkg/ucp/secret/provider"
@@ -87,6 +89,7 @@ func New(options hostoptions.HostOptions) (*RecipeControllerConfig, error) {
 	}
 
 	cfg.ConfigLoader = configloader.NewEnvironmentLoader(clientOptions)
+	secretsLoader := configloader.NewSecretStoreLoader(clientOptions)
 	cfg.Engine = engine.NewEngine(engine.Options{
 		ConfigurationLoader: cfg.ConfigLoader,
 		Drivers: map[string]driver.Driver{
@@ -94,17 +97,43 @@ func New(options hostoptions.HostOptions) (*RecipeControllerConfig, error) {
 				clientOptions,
 				cfg.DeploymentEngineClient,
 				cfg.ResourceClient,
+				secretsLoader,
 				driver.BicepOptions{
 					DeleteRetryCount:        bicepDeleteRetryCount,
 					DeleteRetryDelaySeconds: bicepDeleteRetryDeleteSeconds,
 				},
 			),
 			recipes.TemplateKindTerraform: driver.NewTerraformDriver(options.UCPConnection, provider.NewSecretProvider(options.Config.SecretProvider),
+				secretsLoader,
 				driver.TerraformOptions{
-					Path: options.Config.Terraform.Path,
+					Path:  options.Config.Terraform.Path,
+					Cache: terraformCacheOptions(options.Config.Terraform),
 				}, cfg.K8sClients.ClientSet),
+			recipes.TemplateKindHelm: driver.NewHelmDriver(options.K8sConfig, driver.HelmOptions{}),
+			recipes.TemplateKindManifest: driver.NewManifestDriver(
+				cfg.K8sClients.RuntimeClient,
+				cfg.ResourceClient,
+				driver.ManifestOptions{
+					DeleteRetryCount:        bicepDeleteRetryCount,
+					DeleteRetryDelaySeconds: bicepDeleteRetryDeleteSeconds,
+				},
+			),
 		},
 	})
 
 	return cfg, nil
 }
+
+// terraformCacheOptions returns the options of the Terraform provider plugin and module caches stored in the "cache"
+// subdirectory of the Terraform path, or nil if the caches are disabled.
+func terraformCacheOptions(options hostoptions.TerraformOptions) *terraform.CacheOptions {
+	if !options.Cache.Enabled {
+		return nil
+	}
+
+	return &terraform.CacheOptions{
+		Dir:                filepath.Join(options.Path, "cache"),
+		PluginCacheMaxSize: options.Cache.PluginCacheMaxSizeMB * 1024 * 1024,
+		ModuleCacheMaxSize: options.Cache.ModuleCacheMaxSizeMB * 1024 * 1024,
+	}
+}
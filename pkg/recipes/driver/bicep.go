@@ -20,27 +20,29 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
-	"github.com/radius-project/radius/pkg/to"
-	"golang.org/x/sync/errgroup"
 
 	"github.com/go-logr/logr"
 	"github.com/radius-project/radius/pkg/metrics"
 	"github.com/radius-project/radius/pkg/portableresources/datamodel"
 	"github.com/radius-project/radius/pkg/portableresources/processors"
 	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/armtemplate"
+	"github.com/radius-project/radius/pkg/recipes/configloader"
 	"github.com/radius-project/radius/pkg/recipes/recipecontext"
 	recipes_util "github.com/radius-project/radius/pkg/recipes/util"
 	"github.com/radius-project/radius/pkg/rp/util"
-	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 	clients "github.com/radius-project/radius/pkg/sdk/clients"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	resources_radius "github.com/radius-project/radius/pkg/ucp/resources/radius"
-	"github.com/radius-project/radius/pkg/ucp/ucplog"
+	"golang.org/x/exp/slices"
+	"oras.land/oras-go/v2/registry/remote"
+	"oras.land/oras-go/v2/registry/remote/auth"
 
 	coredm "github.com/radius-project/radius/pkg/corerp/datamodel"
 )
@@ -50,16 +52,26 @@ const (
 	deploymentPrefix = "recipe"
 	pollFrequency    = time.Second * 5
 	recipeParameters = "parameters"
+
+	// registryUsernameKey and registryPasswordKey are the keys of the secret store holding the basic credentials of a registry.
+	registryUsernameKey = "username"
+	registryPasswordKey = "password"
+	// registryTokenKey is the key of the secret store holding the bearer token of a registry.
+	registryTokenKey = "token"
+	// registryDockerConfigKey is the key of the secret store holding the docker config JSON with the credentials of a registry.
+	registryDockerConfigKey = ".dockerconfigjson"
 )
 
 var _ Driver = (*bicepDriver)(nil)
 
-// NewBicepDriver creates a new bicep driver instance with the given ARM client options, deployment client, resource client, and options.
-func NewBicepDriver(armOptions *arm.ClientOptions, deploymentClient *clients.ResourceDeploymentsClient, client processors.ResourceClient, options BicepOptions) Driver {
+// NewBicepDriver creates a new bicep driver instance with the given ARM client options, deployment client, resource client,
+// secrets loader, and options. The secrets loader loads the credentials of the private registries hosting the recipes.
+func NewBicepDriver(armOptions *arm.ClientOptions, deploymentClient *clients.ResourceDeploymentsClient, client processors.ResourceClient, secretsLoader configloader.SecretsLoader, options BicepOptions) Driver {
 	return &bicepDriver{
 		ArmClientOptions: armOptions,
 		DeploymentClient: deploymentClient,
 		ResourceClient:   client,
+		secretsLoader:    secretsLoader,
 		options:          options,
 	}
 }
@@ -73,6 +85,7 @@ type bicepDriver struct {
 	ArmClientOptions *arm.ClientOptions
 	DeploymentClient *clients.ResourceDeploymentsClient
 	ResourceClient   processors.ResourceClient
+	secretsLoader    configloader.SecretsLoader
 	options          BicepOptions
 }
 
@@ -83,9 +96,14 @@ func (d *bicepDriver) Execute(ctx context.Context, opts ExecuteOptions) (*recipe
 	logger := logr.FromContextOrDiscard(ctx)
 	logger.Info(fmt.Sprintf("Deploying recipe: %q, template: %q", opts.Definition.Name, opts.Definition.TemplatePath))
 
+	registryClient, err := d.newRegistryClient(ctx, opts.Configuration)
+	if err != nil {
+		return nil, err
+	}
+
 	recipeData := make(map[string]any)
 	downloadStartTime := time.Now()
-	err := util.ReadFromRegistry(ctx, opts.Definition.TemplatePath, &recipeData)
+	err = util.ReadFromRegistry(ctx, opts.Definition.TemplatePath, &recipeData, registryClient)
 	if err != nil {
 		metrics.DefaultRecipeEngineMetrics.RecordRecipeDownloadDuration(ctx, downloadStartTime,
 			metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDownloadRecipe, opts.Recipe.Name, &opts.Definition, recipes.RecipeDownloadFailed))
@@ -160,7 +178,7 @@ func (d *bicepDriver) Execute(ctx context.Context, opts ExecuteOptions) (*recipe
 	// as bicep does not take care of automatically deleting the unused resources.
 	// Identify the output resources that are no longer relevant to the recipe.
 	garbageCollectionStartTime := time.Now()
-	diff, err := d.getGCOutputResources(recipeResponse.Resources, opts.PrevState)
+	diff, err := getGCOutputResources(recipeResponse.Resources, opts.PrevState)
 	if err != nil {
 		return nil, err
 	}
@@ -179,63 +197,194 @@ func (d *bicepDriver) Execute(ctx context.Context, opts ExecuteOptions) (*recipe
 	return recipeResponse, nil
 }
 
-// Delete deletes all of the output resources that are marked as managed by Radius.
-// It will create a goroutine for each resource to be deleted and wait for them to finish,
-// retrying if necessary.
-// We don't have context on the dependency ordering here, so we need to try to delete them
-// all in parallel. Since some resources may depend on others, we may need to retry.
-func (d *bicepDriver) Delete(ctx context.Context, opts DeleteOptions) error {
-	logger := ucplog.FromContextOrDiscard(ctx)
+// Plan fetches recipe contents from container registry and evaluates the template with the recipe parameters, in the
+// style of a what-if deployment. Resources whose name is known before the deployment are matched against the output
+// resources of the previous deployment to find out whether they are created or updated, and previous output resources
+// which are no longer declared by the template are deleted.
+func (d *bicepDriver) Plan(ctx context.Context, opts ExecuteOptions) (*recipes.RecipePlan, error) {
+	logger := logr.FromContextOrDiscard(ctx)
+	logger.Info(fmt.Sprintf("Planning recipe: %q, template: %q", opts.Definition.Name, opts.Definition.TemplatePath))
 
-	// Create a waitgroup to track the deletion of each output resource
-	g, groupCtx := errgroup.WithContext(ctx)
+	registryClient, err := d.newRegistryClient(ctx, opts.Configuration)
+	if err != nil {
+		return nil, err
+	}
 
-	for i := range opts.OutputResources {
-		outputResource := opts.OutputResources[i]
+	recipeData := make(map[string]any)
+	err = util.ReadFromRegistry(ctx, opts.Definition.TemplatePath, &recipeData, registryClient)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDownloadFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
 
-		// Create a goroutine that handles the deletion of one resource
-		g.Go(func() error {
-			id := outputResource.ID.String()
-			logger.V(ucplog.LevelInfo).Info(fmt.Sprintf("Deleting output resource: %v, LocalID: %s, resource type: %s\n", outputResource.ID, outputResource.LocalID, outputResource.GetResourceType()))
+	recipeContext, err := recipecontext.New(&opts.Recipe, &opts.Configuration)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
 
-			// If the resource is not managed by Radius, skip the deletion
-			if outputResource.RadiusManaged == nil || !*outputResource.RadiusManaged {
-				logger.Info(fmt.Sprintf("Skipping deletion of output resource: %q, not managed by Radius", id))
-				return nil
-			}
+	isContextParameterDefined := hasContextParameter(recipeData)
+	parameters := createRecipeParameters(opts.Recipe.Parameters, opts.Definition.Parameters, isContextParameterDefined, recipeContext)
 
-			var err error
-			for attempt := 0; attempt <= d.options.DeleteRetryCount; attempt++ {
-				logger.WithValues("attempt", attempt)
-				ctx := logr.NewContext(groupCtx, logger)
-				logger.V(ucplog.LevelDebug).Info("beginning attempt")
+	if opts.Configuration.Simulated {
+		logger.Info("simulated environment enabled, skipping plan")
+		return &recipes.RecipePlan{Changes: []recipes.ResourceChange{}}, nil
+	}
 
-				err = d.ResourceClient.Delete(ctx, id)
-				if err != nil {
-					if attempt <= d.options.DeleteRetryCount {
-						logger.V(ucplog.LevelInfo).Error(err, "attempt failed", "delay", d.options.DeleteRetryDelaySeconds)
-						time.Sleep(time.Duration(d.options.DeleteRetryDelaySeconds) * time.Second)
-						continue
-					}
+	plan, err := planBicepTemplate(recipeData, parameters, opts.PrevState)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
 
-					return recipes.NewRecipeError(recipes.RecipeDeletionFailed, err.Error(), "", recipes.GetErrorDetails(err))
-				}
+	return plan, nil
+}
 
-				// If the err is nil, then the resource is deleted successfully
-				logger.V(ucplog.LevelInfo).Info(fmt.Sprintf("Deleted output resource: %q", id))
-				return nil
+// planBicepTemplate computes the changes made by deploying the template with the given parameters. Resources whose name
+// can't be resolved before the deployment have the Unknown change type, and the previous output resources of the same
+// type aren't reported as deleted since they may be updated by them. Values derived from secureString and secureObject
+// parameters are replaced by "(sensitive)".
+func planBicepTemplate(template map[string]any, parameters map[string]any, prevState []string) (*recipes.RecipePlan, error) {
+	parameters, placeholders := replaceSecureParameters(template, parameters)
+	evaluator, err := armtemplate.NewEvaluator(template, parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	templateResources, err := evaluator.Resources()
+	if err != nil {
+		return nil, err
+	}
+
+	previous := []resources.ID{}
+	for _, id := range prevState {
+		parsed, err := resources.ParseResource(id)
+		if err != nil {
+			return nil, err
+		}
+		previous = append(previous, parsed)
+	}
+	matched := make([]bool, len(previous))
+	unresolvedTypes := map[string]bool{}
+
+	plan := &recipes.RecipePlan{Changes: []recipes.ResourceChange{}}
+	for _, resource := range templateResources {
+		if resource.Existing {
+			continue
+		}
+
+		change := recipes.ResourceChange{
+			Resource:     resource.Type + "/" + resource.Name,
+			ResourceType: resource.Type,
+			ChangeType:   recipes.ChangeTypeCreate,
+			After:        maskSecureValues(resource.Body, placeholders),
+		}
+		for _, unresolved := range resource.Unresolved {
+			change.Unresolved = append(change.Unresolved, unresolved.String())
+		}
+
+		if !resource.NameResolved || maskSecureValues(resource.Name, placeholders) != resource.Name {
+			if resource.SymbolicName != "" {
+				change.Resource = resource.SymbolicName
 			}
+			change.ChangeType = recipes.ChangeTypeUnknown
+			unresolvedTypes[strings.ToLower(resource.Type)] = true
+			plan.Changes = append(plan.Changes, change)
+			continue
+		}
+
+		// Names of child resources include the names of their parents, while resource IDs have a segment per type.
+		name := resource.Name[strings.LastIndex(resource.Name, "/")+1:]
+		for i, id := range previous {
+			if !matched[i] && strings.EqualFold(id.Type(), resource.Type) && strings.EqualFold(id.Name(), name) {
+				matched[i] = true
+				change.Resource = id.String()
+				change.ChangeType = recipes.ChangeTypeUpdate
+				break
+			}
+		}
 
-			deletionErr := fmt.Errorf("failed to delete resource after %d attempt(s), last error: %s", d.options.DeleteRetryCount+1, err.Error())
-			return recipes.NewRecipeError(recipes.RecipeDeletionFailed, deletionErr.Error(), "", recipes.GetErrorDetails(deletionErr))
+		plan.Changes = append(plan.Changes, change)
+	}
+
+	for i, id := range previous {
+		if matched[i] || unresolvedTypes[strings.ToLower(id.Type())] {
+			continue
+		}
+
+		plan.Changes = append(plan.Changes, recipes.ResourceChange{
+			Resource:     id.String(),
+			ResourceType: id.Type(),
+			ChangeType:   recipes.ChangeTypeDelete,
 		})
 	}
 
-	if err := g.Wait(); err != nil {
-		return err
+	return plan, nil
+}
+
+// replaceSecureParameters returns a copy of the parameters where the values of the secureString and secureObject
+// parameters of the template are replaced by placeholders, along with the placeholders. The values set by default in the
+// template are replaced as well.
+func replaceSecureParameters(template map[string]any, parameters map[string]any) (map[string]any, []string) {
+	result := map[string]any{}
+	for name, value := range parameters {
+		result[name] = value
+	}
+
+	placeholders := []string{}
+	definitions, _ := template["parameters"].(map[string]any)
+	for name, definition := range definitions {
+		definition, _ := definition.(map[string]any)
+		parameterType, _ := definition["type"].(string)
+		if !strings.EqualFold(parameterType, "secureString") && !strings.EqualFold(parameterType, "secureObject") {
+			continue
+		}
+
+		_, isSet := parameters[name]
+		_, hasDefault := definition["defaultValue"]
+		if !isSet && !hasDefault {
+			continue
+		}
+
+		placeholder := fmt.Sprintf("__radius_secure_parameter_%s__", name)
+		result[name] = map[string]any{"value": placeholder}
+		placeholders = append(placeholders, placeholder)
+	}
+
+	return result, placeholders
+}
+
+// maskSecureValues returns a copy of value where the strings containing one of the placeholders of secure parameters are
+// replaced by "(sensitive)".
+func maskSecureValues(value any, placeholders []string) any {
+	switch v := value.(type) {
+	case string:
+		for _, placeholder := range placeholders {
+			if strings.Contains(v, placeholder) {
+				return sensitivePlanValue
+			}
+		}
+		return v
+	case map[string]any:
+		if v == nil {
+			return v
+		}
+		result := map[string]any{}
+		for key, item := range v {
+			result[key] = maskSecureValues(item, placeholders)
+		}
+		return result
+	case []any:
+		result := []any{}
+		for _, item := range v {
+			result = append(result, maskSecureValues(item, placeholders))
+		}
+		return result
+	default:
+		return v
 	}
+}
 
-	return nil
+// Delete deletes all of the output resources that are marked as managed by Radius.
+func (d *bicepDriver) Delete(ctx context.Context, opts DeleteOptions) error {
+	return deleteOutputResources(ctx, d.ResourceClient, opts.OutputResources, d.options.DeleteRetryCount, d.options.DeleteRetryDelaySeconds)
 }
 
 // GetRecipeMetadata gets the Bicep recipe parameters information from the container registry
@@ -257,8 +406,13 @@ func (d *bicepDriver) GetRecipeMetadata(ctx context.Context, opts BaseOptions) (
 	//			}
 	//		}
 	//	}
+	registryClient, err := d.newRegistryClient(ctx, opts.Configuration)
+	if err != nil {
+		return nil, err
+	}
+
 	recipeData := make(map[string]any)
-	err := util.ReadFromRegistry(ctx, opts.Definition.TemplatePath, &recipeData)
+	err = util.ReadFromRegistry(ctx, opts.Definition.TemplatePath, &recipeData, registryClient)
 	if err != nil {
 		return nil, err
 	}
@@ -266,6 +420,70 @@ func (d *bicepDriver) GetRecipeMetadata(ctx context.Context, opts BaseOptions) (
 	return recipeData, nil
 }
 
+// newRegistryClient creates the client of the registries hosting the recipes, which authenticates with the credentials
+// configured for the Bicep recipes of the environment. It returns nil if no registry authentication is configured, so
+// that the registries are accessed anonymously.
+func (d *bicepDriver) newRegistryClient(ctx context.Context, envConfig recipes.Configuration) (remote.Client, error) {
+	authentication := envConfig.RecipeConfig.Bicep.Authentication
+	if len(authentication) == 0 {
+		return nil, nil
+	}
+
+	secretStoreIDs := map[string][]string{}
+	for _, config := range authentication {
+		keys, err := registrySecretKeys(config.Kind)
+		if err != nil {
+			return nil, recipes.NewRecipeError(recipes.LoadSecretsFailed, err.Error(), recipes_util.RecipeSetupError, nil)
+		}
+		for _, key := range keys {
+			if !slices.Contains(secretStoreIDs[config.Secret], key) {
+				secretStoreIDs[config.Secret] = append(secretStoreIDs[config.Secret], key)
+			}
+		}
+	}
+
+	if d.secretsLoader == nil {
+		return nil, recipes.NewRecipeError(recipes.LoadSecretsFailed, "secrets loader is not configured for the Bicep driver", recipes_util.RecipeSetupError, nil)
+	}
+
+	secrets, err := d.secretsLoader.LoadSecrets(ctx, secretStoreIDs)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.LoadSecretsFailed, fmt.Sprintf("failed to load registry credentials for Bicep recipes: %s", err.Error()), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	credentials := map[string]auth.Credential{}
+	for host, config := range authentication {
+		data := secrets[config.Secret]
+		switch config.Kind {
+		case coredm.RegistryAuthBasic:
+			credentials[host] = auth.Credential{Username: data[registryUsernameKey], Password: data[registryPasswordKey]}
+		case coredm.RegistryAuthBearer:
+			credentials[host] = auth.Credential{AccessToken: data[registryTokenKey]}
+		case coredm.RegistryAuthDockerConfig:
+			credentials[host], err = util.DockerConfigCredential([]byte(data[registryDockerConfigKey]), host)
+			if err != nil {
+				return nil, recipes.NewRecipeError(recipes.LoadSecretsFailed, err.Error(), recipes_util.RecipeSetupError, nil)
+			}
+		}
+	}
+
+	return util.NewRegistryClient(credentials), nil
+}
+
+// registrySecretKeys returns the keys of the secret store holding the registry credentials of the given kind.
+func registrySecretKeys(kind string) ([]string, error) {
+	switch kind {
+	case coredm.RegistryAuthBasic:
+		return []string{registryUsernameKey, registryPasswordKey}, nil
+	case coredm.RegistryAuthBearer:
+		return []string{registryTokenKey}, nil
+	case coredm.RegistryAuthDockerConfig:
+		return []string{registryDockerConfigKey}, nil
+	default:
+		return nil, fmt.Errorf("unsupported registry authentication kind %q", kind)
+	}
+}
+
 func hasContextParameter(recipeData map[string]any) bool {
 	parametersAny, ok := recipeData[recipeParameters]
 	if !ok {
@@ -369,34 +587,3 @@ func (d *bicepDriver) prepareRecipeResponse(outputs any, resources []*armresourc
 
 	return recipeResponse, nil
 }
-
-// getGCOutputResources [GC stands for Garbage Collection] compares two slices of resource ids and
-// returns a slice of OutputResources that contains the elements that are in the "previous" slice but not in the "current".
-func (d *bicepDriver) getGCOutputResources(current []string, previous []string) ([]rpv1.OutputResource, error) {
-	// We can easily determine which resources have changed via a brute-force search comparing IDs.
-	// The lists of resources we work with are small, so this is fine.
-	diff := []rpv1.OutputResource{}
-	for _, prevResourceId := range previous {
-		found := false
-		for _, currentResourceId := range current {
-			if prevResourceId == currentResourceId {
-				found = true
-				break
-			}
-		}
-
-		if !found {
-			id, err := resources.Parse(prevResourceId)
-			if err != nil {
-				return nil, recipes.NewRecipeError(recipes.RecipeGarbageCollectionFailed, err.Error(), recipes_util.ExecutionError, nil)
-			}
-
-			diff = append(diff, rpv1.OutputResource{
-				ID:            id,
-				RadiusManaged: to.Ptr(true),
-			})
-		}
-	}
-
-	return diff, nil
-}
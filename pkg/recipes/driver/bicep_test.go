@@ -17,6 +17,7 @@ limitations under the License.
 package driver
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 
@@ -26,7 +27,9 @@ import (
 	corerp_datamodel "github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/portableresources/processors"
 	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/configloader"
 	"github.com/radius-project/radius/pkg/recipes/recipecontext"
+	recipes_util "github.com/radius-project/radius/pkg/recipes/util"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 	clients "github.com/radius-project/radius/pkg/sdk/clients"
 	"github.com/radius-project/radius/pkg/to"
@@ -34,6 +37,7 @@ import (
 	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
 	"github.com/radius-project/radius/test/testcontext"
 	"github.com/stretchr/testify/require"
+	"oras.land/oras-go/v2/registry/remote/auth"
 )
 
 func Test_CreateRecipeParameters_NoContextParameter(t *testing.T) {
@@ -519,7 +523,6 @@ func Test_Bicep_GetRecipeMetadata_Error(t *testing.T) {
 }
 
 func Test_GetGCOutputResources(t *testing.T) {
-	d := &bicepDriver{}
 	before := []string{
 		"/subscriptions/test-sub/resourceGroups/test-rg/providers/System.Test/testResources/resource1",
 		"/subscriptions/test-sub/resourceGroups/test-rg/providers/System.Test/testResources/resource2",
@@ -538,13 +541,12 @@ func Test_GetGCOutputResources(t *testing.T) {
 			RadiusManaged: to.Ptr(true),
 		},
 	}
-	res, err := d.getGCOutputResources(after, before)
+	res, err := getGCOutputResources(after, before)
 	require.NoError(t, err)
 	require.Equal(t, exp, res)
 }
 
 func Test_GetGCOutputResources_NoDiff(t *testing.T) {
-	d := &bicepDriver{}
 	before := []string{
 		"/subscriptions/test-sub/resourceGroups/test-rg/providers/System.Test/testResources/resource1",
 		"/subscriptions/test-sub/resourceGroups/test-rg/providers/System.Test/testResources/resource2",
@@ -554,7 +556,7 @@ func Test_GetGCOutputResources_NoDiff(t *testing.T) {
 		"/subscriptions/test-sub/resourceGroups/test-rg/providers/System.Test/testResources/resource2",
 	}
 	exp := []rpv1.OutputResource{}
-	res, err := d.getGCOutputResources(after, before)
+	res, err := getGCOutputResources(after, before)
 	require.NoError(t, err)
 	require.Equal(t, exp, res)
 }
@@ -590,3 +592,180 @@ func Test_Bicep_Delete_Success_AfterRetry(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+func Test_PlanBicepTemplate(t *testing.T) {
+	template := map[string]any{
+		"languageVersion": "1.9-experimental",
+		"parameters": map[string]any{
+			"context": map[string]any{"type": "object"},
+		},
+		"resources": map[string]any{
+			"deployment": map[string]any{
+				"import": "kubernetes",
+				"type":   "apps/Deployment@v1",
+				"properties": map[string]any{
+					"metadata": map[string]any{"name": "[format('redis-{0}', parameters('context').resource.name)]"},
+				},
+			},
+			"service": map[string]any{
+				"import": "kubernetes",
+				"type":   "core/Service@v1",
+				"properties": map[string]any{
+					"metadata": map[string]any{"name": "[format('redis-{0}', uniqueString(parameters('context').resource.id))]"},
+				},
+			},
+			"cache": map[string]any{
+				"type":       "Microsoft.Cache/redis",
+				"apiVersion": "2022-06-01",
+				"name":       "[parameters('context').resource.name]",
+			},
+		},
+	}
+	recipeContext := &recipecontext.Context{
+		Resource: recipecontext.Resource{
+			ResourceInfo: recipecontext.ResourceInfo{
+				Name: "mycache",
+				ID:   "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/mycache",
+			},
+		},
+	}
+	parameters := createRecipeParameters(nil, nil, true, recipeContext)
+
+	plan, err := planBicepTemplate(template, parameters, []string{
+		"/planes/kubernetes/local/namespaces/app-ns/providers/apps/Deployment/redis-mycache",
+		"/planes/kubernetes/local/namespaces/app-ns/providers/core/Service/redis-abc",
+		"/planes/kubernetes/local/namespaces/app-ns/providers/core/Secret/redis",
+	})
+	require.NoError(t, err)
+	require.Len(t, plan.Changes, 4)
+
+	require.Equal(t, "Microsoft.Cache/redis/mycache", plan.Changes[0].Resource)
+	require.Equal(t, recipes.ChangeTypeCreate, plan.Changes[0].ChangeType)
+
+	require.Equal(t, "/planes/kubernetes/local/namespaces/app-ns/providers/apps/Deployment/redis-mycache", plan.Changes[1].Resource)
+	require.Equal(t, "apps/Deployment", plan.Changes[1].ResourceType)
+	require.Equal(t, recipes.ChangeTypeUpdate, plan.Changes[1].ChangeType)
+	require.Equal(t, map[string]any{"metadata": map[string]any{"name": "redis-mycache"}}, plan.Changes[1].After)
+	require.Empty(t, plan.Changes[1].Unresolved)
+
+	require.Equal(t, "service", plan.Changes[2].Resource)
+	require.Equal(t, recipes.ChangeTypeUnknown, plan.Changes[2].ChangeType)
+	require.Equal(t, []string{
+		`[format('redis-{0}', uniqueString(parameters('context').resource.id))] (template function isn't supported: "uniqueString")`,
+	}, plan.Changes[2].Unresolved)
+
+	// The previous Service isn't reported as deleted since it may be the Service whose name is unknown.
+	require.Equal(t, "/planes/kubernetes/local/namespaces/app-ns/providers/core/Secret/redis", plan.Changes[3].Resource)
+	require.Equal(t, recipes.ChangeTypeDelete, plan.Changes[3].ChangeType)
+}
+
+func Test_PlanBicepTemplate_SecureParameters(t *testing.T) {
+	template := map[string]any{
+		"languageVersion": "1.9-experimental",
+		"parameters": map[string]any{
+			"password":    map[string]any{"type": "securestring"},
+			"credentials": map[string]any{"type": "secureObject", "defaultValue": map[string]any{"token": "default-token"}},
+			"port":        map[string]any{"type": "int"},
+		},
+		"resources": map[string]any{
+			"secret": map[string]any{
+				"import": "kubernetes",
+				"type":   "core/Secret@v1",
+				"properties": map[string]any{
+					"metadata": map[string]any{"name": "redis"},
+					"stringData": map[string]any{
+						"password":         "[parameters('password')]",
+						"connectionString": "[format('redis:{0},password={1}', parameters('port'), parameters('password'))]",
+						"credentials":      "[parameters('credentials')]",
+					},
+				},
+			},
+			"named": map[string]any{
+				"import": "kubernetes",
+				"type":   "core/Secret@v1",
+				"properties": map[string]any{
+					"metadata": map[string]any{"name": "[parameters('password')]"},
+				},
+			},
+		},
+	}
+	parameters := createRecipeParameters(map[string]any{"password": "s3cr3t", "port": 6380}, nil, false, nil)
+
+	plan, err := planBicepTemplate(template, parameters, nil)
+	require.NoError(t, err)
+	require.Len(t, plan.Changes, 2)
+
+	// A resource named after a secure parameter is reported with its symbolic name.
+	require.Equal(t, "named", plan.Changes[0].Resource)
+	require.Equal(t, recipes.ChangeTypeUnknown, plan.Changes[0].ChangeType)
+	require.Equal(t, map[string]any{"metadata": map[string]any{"name": sensitivePlanValue}}, plan.Changes[0].After)
+
+	require.Equal(t, "core/Secret/redis", plan.Changes[1].Resource)
+	require.Equal(t, map[string]any{
+		"metadata": map[string]any{"name": "redis"},
+		"stringData": map[string]any{
+			"password":         sensitivePlanValue,
+			"connectionString": sensitivePlanValue,
+			"credentials":      sensitivePlanValue,
+		},
+	}, plan.Changes[1].After)
+}
+
+func Test_Bicep_NewRegistryClient(t *testing.T) {
+	basicSecretStore := "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/secretStores/basic"
+	dockerConfigSecretStore := "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/secretStores/dockerconfig"
+	envConfig := recipes.Configuration{
+		RecipeConfig: corerp_datamodel.RecipeConfigProperties{
+			Bicep: corerp_datamodel.BicepConfigProperties{
+				Authentication: map[string]corerp_datamodel.RegistryAuthConfig{
+					"myregistry.azurecr.io": {Kind: corerp_datamodel.RegistryAuthBasic, Secret: basicSecretStore},
+					"ghcr.io":               {Kind: corerp_datamodel.RegistryAuthDockerConfig, Secret: dockerConfigSecretStore},
+				},
+			},
+		},
+	}
+
+	t.Run("no authentication", func(t *testing.T) {
+		driver := bicepDriver{}
+		client, err := driver.newRegistryClient(testcontext.New(t), recipes.Configuration{})
+		require.NoError(t, err)
+		require.Nil(t, client)
+	})
+
+	t.Run("credentials are loaded from secret stores", func(t *testing.T) {
+		ctx := testcontext.New(t)
+		secretsLoader := configloader.NewMockSecretsLoader(gomock.NewController(t))
+		driver := bicepDriver{secretsLoader: secretsLoader}
+
+		secretsLoader.EXPECT().LoadSecrets(ctx, map[string][]string{
+			basicSecretStore:        {"username", "password"},
+			dockerConfigSecretStore: {".dockerconfigjson"},
+		}).Times(1).Return(map[string]recipes.SecretData{
+			basicSecretStore:        {"username": "user", "password": "secret"},
+			dockerConfigSecretStore: {".dockerconfigjson": `{"auths":{"ghcr.io":{"username":"ghuser","password":"ghsecret"}}}`},
+		}, nil)
+
+		client, err := driver.newRegistryClient(ctx, envConfig)
+		require.NoError(t, err)
+
+		credential, err := client.(*auth.Client).Credential(ctx, "myregistry.azurecr.io")
+		require.NoError(t, err)
+		require.Equal(t, auth.Credential{Username: "user", Password: "secret"}, credential)
+
+		credential, err = client.(*auth.Client).Credential(ctx, "ghcr.io")
+		require.NoError(t, err)
+		require.Equal(t, auth.Credential{Username: "ghuser", Password: "ghsecret"}, credential)
+	})
+
+	t.Run("loading secrets fails", func(t *testing.T) {
+		ctx := testcontext.New(t)
+		secretsLoader := configloader.NewMockSecretsLoader(gomock.NewController(t))
+		driver := bicepDriver{secretsLoader: secretsLoader}
+
+		secretsLoader.EXPECT().LoadSecrets(ctx, gomock.Any()).Times(1).Return(nil, errors.New("secret store not found"))
+
+		_, err := driver.newRegistryClient(ctx, envConfig)
+		expErr := recipes.NewRecipeError(recipes.LoadSecretsFailed, "failed to load registry credentials for Bicep recipes: secret store not found", recipes_util.RecipeSetupError, nil)
+		require.Equal(t, expErr, err)
+	})
+}
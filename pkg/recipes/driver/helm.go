@@ -0,0 +1,321 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"strings"
+	"time"
+
+	"golang.org/x/exp/slices"
+	"k8s.io/client-go/rest"
+
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/helm"
+	"github.com/radius-project/radius/pkg/recipes/recipecontext"
+	recipes_util "github.com/radius-project/radius/pkg/recipes/util"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// helmReleaseNameMaxLength is the maximum length of a Helm release name.
+	helmReleaseNameMaxLength = 53
+)
+
+var _ Driver = (*helmDriver)(nil)
+
+// NewHelmDriver creates a new instance of driver to execute a Helm chart recipe.
+func NewHelmDriver(k8sConfig *rest.Config, options HelmOptions) Driver {
+	return &helmDriver{
+		helmClient: helm.NewClient(k8sConfig, helm.ClientOptions{Timeout: options.Timeout}),
+	}
+}
+
+// HelmOptions represents the options required for execution of Helm driver.
+type HelmOptions struct {
+	// Timeout is the maximum time to wait for a chart to be installed, upgraded or uninstalled.
+	Timeout time.Duration
+}
+
+// helmDriver represents a driver to interact with Helm chart recipes - install or upgrade a release, uninstall it, etc.
+type helmDriver struct {
+	// helmClient is used to download charts and to manage Helm releases.
+	helmClient helm.HelmClient
+}
+
+// Execute installs the chart referenced by the recipe as a Helm release in the recipe's Kubernetes namespace, or upgrades
+// the release if it already exists. The recipe context and parameters are passed to the chart as values. It returns
+// the recipe output read from the release and the Kubernetes objects of the release as output resources.
+func (d *helmDriver) Execute(ctx context.Context, opts ExecuteOptions) (*recipes.RecipeOutput, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	recipeContext, releaseName, err := helmReleaseInfo(opts.BaseOptions)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+	namespace := recipeContext.Runtime.Kubernetes.Namespace
+
+	values, err := createHelmValues(opts.Recipe.Parameters, opts.Definition.Parameters, recipeContext)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	if opts.Configuration.Simulated {
+		logger.Info("simulated environment is set to true, skipping deployment")
+		return nil, nil
+	}
+
+	logger.Info(fmt.Sprintf("Deploying helm recipe: %q, template: %q, release: %q, namespace: %q", opts.Recipe.Name, opts.Definition.TemplatePath, releaseName, namespace))
+	chart, err := d.helmClient.LoadChart(ctx, opts.Definition.TemplatePath, opts.Definition.TemplateVersion)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDownloadFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	release, err := d.helmClient.InstallOrUpgrade(ctx, helm.InstallOptions{
+		Namespace:   namespace,
+		ReleaseName: releaseName,
+		Chart:       chart,
+		Values:      values,
+	})
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	recipeOutputs, err := d.prepareRecipeResponse(release)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.InvalidRecipeOutputs, fmt.Sprintf("failed to read the recipe output %q: %s", recipes.ResultPropertyName, err.Error()), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	return recipeOutputs, nil
+}
+
+// Plan renders the chart referenced by the recipe with a dry run of the Helm release installation or upgrade, and returns
+// the changes to the Kubernetes objects of the release.
+func (d *helmDriver) Plan(ctx context.Context, opts ExecuteOptions) (*recipes.RecipePlan, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	recipeContext, releaseName, err := helmReleaseInfo(opts.BaseOptions)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+	namespace := recipeContext.Runtime.Kubernetes.Namespace
+
+	values, err := createHelmValues(opts.Recipe.Parameters, opts.Definition.Parameters, recipeContext)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	if opts.Configuration.Simulated {
+		logger.Info("simulated environment is set to true, skipping plan")
+		return &recipes.RecipePlan{Changes: []recipes.ResourceChange{}}, nil
+	}
+
+	logger.Info(fmt.Sprintf("Planning helm recipe: %q, template: %q, release: %q, namespace: %q", opts.Recipe.Name, opts.Definition.TemplatePath, releaseName, namespace))
+	chart, err := d.helmClient.LoadChart(ctx, opts.Definition.TemplatePath, opts.Definition.TemplateVersion)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDownloadFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	release, err := d.helmClient.InstallOrUpgrade(ctx, helm.InstallOptions{
+		Namespace:   namespace,
+		ReleaseName: releaseName,
+		Chart:       chart,
+		Values:      values,
+		DryRun:      true,
+	})
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	return planKubernetesObjects(release.Objects, opts.PrevState), nil
+}
+
+// Delete uninstalls the Helm release of the recipe, which deletes all the Kubernetes objects created by the chart.
+func (d *helmDriver) Delete(ctx context.Context, opts DeleteOptions) error {
+	recipeContext, releaseName, err := helmReleaseInfo(opts.BaseOptions)
+	if err != nil {
+		return recipes.NewRecipeError(recipes.RecipeDeletionFailed, err.Error(), "", recipes.GetErrorDetails(err))
+	}
+
+	err = d.helmClient.Uninstall(ctx, recipeContext.Runtime.Kubernetes.Namespace, releaseName)
+	if err != nil {
+		return recipes.NewRecipeError(recipes.RecipeDeletionFailed, err.Error(), "", recipes.GetErrorDetails(err))
+	}
+
+	return nil
+}
+
+// GetRecipeMetadata returns the top-level values of the chart as the recipe parameters.
+func (d *helmDriver) GetRecipeMetadata(ctx context.Context, opts BaseOptions) (map[string]any, error) {
+	chart, err := d.helmClient.LoadChart(ctx, opts.Definition.TemplatePath, opts.Definition.TemplateVersion)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeGetMetadataFailed, err.Error(), "", recipes.GetErrorDetails(err))
+	}
+
+	parameters := map[string]any{}
+	for name, value := range chart.Values {
+		parameters[name] = map[string]any{
+			"type":         helmValueType(value),
+			"defaultValue": value,
+		}
+	}
+
+	return map[string]any{
+		recipeParameters: parameters,
+	}, nil
+}
+
+// prepareRecipeResponse populates the recipe response from the release. The notes of the chart can render the recipe
+// output as a JSON object with a "result" property, and ConfigMaps and Secrets marked with RecipeOutputKey add values
+// and secrets to it. All the Kubernetes objects of the release are returned as output resources.
+func (d *helmDriver) prepareRecipeResponse(release *helm.Release) (*recipes.RecipeOutput, error) {
+	recipeResponse := &recipes.RecipeOutput{}
+	if release == nil {
+		return recipeResponse, errors.New("helm release is empty")
+	}
+
+	// Notes that are not a JSON object are regular chart notes and don't contain recipe output.
+	notes := strings.TrimSpace(release.Notes)
+	if strings.HasPrefix(notes, "{") {
+		out := map[string]any{}
+		if err := json.Unmarshal([]byte(notes), &out); err != nil {
+			return &recipes.RecipeOutput{}, fmt.Errorf("failed to parse the chart notes: %w", err)
+		}
+
+		if result, ok := out[recipes.ResultPropertyName].(map[string]any); ok {
+			if err := recipeResponse.PrepareRecipeResponse(result); err != nil {
+				return &recipes.RecipeOutput{}, err
+			}
+		}
+	}
+
+	if recipeResponse.Values == nil {
+		recipeResponse.Values = map[string]any{}
+	}
+	if recipeResponse.Secrets == nil {
+		recipeResponse.Secrets = map[string]any{}
+	}
+
+	uniqueResourceIDs := []string{}
+	for _, val := range recipeResponse.Resources {
+		uniqueResourceIDs = append(uniqueResourceIDs, strings.ToLower(val))
+	}
+
+	for _, obj := range release.Objects {
+		if isRecipeOutputObject(obj) {
+			if err := addKubernetesRecipeOutput(recipeResponse, obj); err != nil {
+				return &recipes.RecipeOutput{}, err
+			}
+		}
+
+		id := kubernetesOutputResourceID(obj)
+		if !slices.Contains(uniqueResourceIDs, strings.ToLower(id)) {
+			uniqueResourceIDs = append(uniqueResourceIDs, strings.ToLower(id))
+			recipeResponse.Resources = append(recipeResponse.Resources, id)
+		}
+	}
+
+	return recipeResponse, nil
+}
+
+// helmReleaseInfo returns the recipe context and the name of the Helm release for the recipe. The release is installed
+// into the Kubernetes namespace of the recipe context and named after the resource deploying the recipe.
+func helmReleaseInfo(opts BaseOptions) (*recipecontext.Context, string, error) {
+	if opts.Configuration.Runtime.Kubernetes == nil {
+		return nil, "", errors.New("kubernetes runtime configuration is required to deploy a helm recipe")
+	}
+
+	recipeContext, err := recipecontext.New(&opts.Recipe, &opts.Configuration)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if recipeContext.Runtime.Kubernetes.Namespace == "" {
+		return nil, "", errors.New("kubernetes namespace is required to deploy a helm recipe")
+	}
+
+	releaseName, err := helmReleaseName(opts.Recipe.ResourceID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return recipeContext, releaseName, nil
+}
+
+// helmReleaseName creates the release name from the name of the resource and a hash of its resource ID, so that
+// resources with the same name in different applications or environments sharing a namespace don't collide.
+func helmReleaseName(resourceID string) (string, error) {
+	id, err := resources.ParseResource(resourceID)
+	if err != nil {
+		return "", err
+	}
+
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(strings.ToLower(resourceID)))
+	suffix := fmt.Sprintf("%08x", h.Sum32())
+
+	name := strings.ToLower(id.Name())
+	if maxLength := helmReleaseNameMaxLength - len(suffix) - 1; len(name) > maxLength {
+		name = strings.TrimRight(name[:maxLength], "-")
+	}
+
+	return name + "-" + suffix, nil
+}
+
+// createHelmValues creates the values to be passed to the chart after handling conflicts in parameters set by operator
+// and developer. In case of conflict the developer parameter takes precedence. The recipe context is always passed as the
+// "context" value.
+func createHelmValues(devParams, operatorParams map[string]any, recipeContext *recipecontext.Context) (map[string]any, error) {
+	values := map[string]any{}
+	for k, v := range operatorParams {
+		values[k] = v
+	}
+	for k, v := range devParams {
+		values[k] = v
+	}
+
+	contextValue, err := recipeContextValue(recipeContext)
+	if err != nil {
+		return nil, err
+	}
+	values[recipecontext.RecipeContextParamKey] = contextValue
+
+	return values, nil
+}
+
+// helmValueType returns the parameter type of a chart value.
+func helmValueType(value any) string {
+	switch value.(type) {
+	case string:
+		return "string"
+	case bool:
+		return "bool"
+	case int, int64, float64:
+		return "number"
+	case []any:
+		return "list"
+	case map[string]any:
+		return "object"
+	default:
+		return "any"
+	}
+}
@@ -0,0 +1,449 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	gomock "github.com/golang/mock/gomock"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/helm"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+	"helm.sh/helm/v3/pkg/chart"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func setupHelm(t *testing.T) (*helm.MockHelmClient, helmDriver) {
+	ctrl := gomock.NewController(t)
+	helmClient := helm.NewMockHelmClient(ctrl)
+
+	return helmClient, helmDriver{helmClient: helmClient}
+}
+
+func buildHelmTestInputs() (recipes.Configuration, recipes.ResourceMetadata, recipes.EnvironmentDefinition) {
+	envConfig := recipes.Configuration{
+		Runtime: recipes.RuntimeConfiguration{
+			Kubernetes: &recipes.KubernetesRuntime{
+				Namespace:            "app-ns",
+				EnvironmentNamespace: "env-ns",
+			},
+		},
+	}
+
+	recipeMetadata := recipes.ResourceMetadata{
+		Name:          "redis",
+		ApplicationID: "/planes/radius/local/resourcegroups/test-rg/providers/applications.core/applications/app1",
+		EnvironmentID: "/planes/radius/local/resourcegroups/test-rg/providers/applications.core/environments/env1",
+		ResourceID:    "/planes/radius/local/resourceGroups/test-rg/providers/applications.datastores/rediscaches/test-redis",
+		Parameters: map[string]any{
+			"replicas": 2,
+		},
+	}
+
+	envRecipe := recipes.EnvironmentDefinition{
+		Name:            "redis",
+		Driver:          recipes.TemplateKindHelm,
+		TemplatePath:    "oci://myregistry.azurecr.io/charts/redis",
+		TemplateVersion: "1.0.0",
+		ResourceType:    "Applications.Datastores/redisCaches",
+		Parameters: map[string]any{
+			"replicas": 1,
+			"tier":     "basic",
+		},
+	}
+
+	return envConfig, recipeMetadata, envRecipe
+}
+
+func newUnstructured(apiVersion, kind, namespace, name string, fields map[string]any) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]any{}}
+	for k, v := range fields {
+		obj.Object[k] = v
+	}
+	obj.SetAPIVersion(apiVersion)
+	obj.SetKind(kind)
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+	return obj
+}
+
+func Test_Helm_Execute_Success(t *testing.T) {
+	ctx := testcontext.New(t)
+	helmClient, driver := setupHelm(t)
+	envConfig, recipeMetadata, envRecipe := buildHelmTestInputs()
+
+	outputConfigMap := newUnstructured("v1", "ConfigMap", "app-ns", "redis-output", map[string]any{
+		"data": map[string]any{"host": "redis.app-ns.svc.cluster.local"},
+	})
+	outputConfigMap.SetLabels(map[string]string{RecipeOutputKey: "true"})
+	outputSecret := newUnstructured("v1", "Secret", "app-ns", "redis-secret", map[string]any{
+		"data":       map[string]any{"password": "c2VjcmV0"},
+		"stringData": map[string]any{"connectionString": "redis://redis:6379"},
+	})
+	outputSecret.SetLabels(map[string]string{RecipeOutputKey: "true"})
+
+	testChart := &chart.Chart{}
+	helmClient.EXPECT().LoadChart(ctx, envRecipe.TemplatePath, envRecipe.TemplateVersion).Times(1).Return(testChart, nil)
+	helmClient.EXPECT().
+		InstallOrUpgrade(ctx, gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ any, options helm.InstallOptions) (*helm.Release, error) {
+			require.Equal(t, "app-ns", options.Namespace)
+			require.True(t, strings.HasPrefix(options.ReleaseName, "test-redis-"))
+			require.Same(t, testChart, options.Chart)
+			require.Equal(t, 2, options.Values["replicas"])
+			require.Equal(t, "basic", options.Values["tier"])
+
+			recipeContext := options.Values["context"].(map[string]any)
+			require.Equal(t, "test-redis", recipeContext["resource"].(map[string]any)["name"])
+			require.Equal(t, "app-ns", recipeContext["runtime"].(map[string]any)["kubernetes"].(map[string]any)["namespace"])
+
+			return &helm.Release{
+				Name:      options.ReleaseName,
+				Namespace: options.Namespace,
+				Notes:     `{"result": {"values": {"port": 6379}, "resources": ["/planes/kubernetes/local/namespaces/app-ns/providers/core/Service/redis"]}}`,
+				Objects: []*unstructured.Unstructured{
+					newUnstructured("v1", "Service", "app-ns", "redis", nil),
+					newUnstructured("apps/v1", "StatefulSet", "app-ns", "redis", nil),
+					newUnstructured("rbac.authorization.k8s.io/v1", "ClusterRole", "", "redis-reader", nil),
+					outputConfigMap,
+					outputSecret,
+				},
+			}, nil
+		})
+
+	recipeOutput, err := driver.Execute(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.NoError(t, err)
+
+	expected := &recipes.RecipeOutput{
+		Values: map[string]any{
+			"port": float64(6379),
+			"host": "redis.app-ns.svc.cluster.local",
+		},
+		Secrets: map[string]any{
+			"password":         "secret",
+			"connectionString": "redis://redis:6379",
+		},
+		Resources: []string{
+			"/planes/kubernetes/local/namespaces/app-ns/providers/core/Service/redis",
+			"/planes/kubernetes/local/namespaces/app-ns/providers/apps/StatefulSet/redis",
+			"/planes/kubernetes/local/providers/rbac.authorization.k8s.io/ClusterRole/redis-reader",
+			"/planes/kubernetes/local/namespaces/app-ns/providers/core/ConfigMap/redis-output",
+			"/planes/kubernetes/local/namespaces/app-ns/providers/core/Secret/redis-secret",
+		},
+	}
+	require.Equal(t, expected, recipeOutput)
+}
+
+func Test_Helm_Execute_PlainNotes(t *testing.T) {
+	ctx := testcontext.New(t)
+	helmClient, driver := setupHelm(t)
+	envConfig, recipeMetadata, envRecipe := buildHelmTestInputs()
+
+	helmClient.EXPECT().LoadChart(ctx, gomock.Any(), gomock.Any()).Times(1).Return(&chart.Chart{}, nil)
+	helmClient.EXPECT().InstallOrUpgrade(ctx, gomock.Any()).Times(1).Return(&helm.Release{
+		Notes: "Thank you for installing redis.",
+	}, nil)
+
+	recipeOutput, err := driver.Execute(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, &recipes.RecipeOutput{Values: map[string]any{}, Secrets: map[string]any{}}, recipeOutput)
+}
+
+func Test_Helm_Execute_InvalidNotes(t *testing.T) {
+	ctx := testcontext.New(t)
+	helmClient, driver := setupHelm(t)
+	envConfig, recipeMetadata, envRecipe := buildHelmTestInputs()
+
+	helmClient.EXPECT().LoadChart(ctx, gomock.Any(), gomock.Any()).Times(1).Return(&chart.Chart{}, nil)
+	helmClient.EXPECT().InstallOrUpgrade(ctx, gomock.Any()).Times(1).Return(&helm.Release{
+		Notes: `{"result": {"unknown": "field"}}`,
+	}, nil)
+
+	_, err := driver.Execute(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.Error(t, err)
+	require.Equal(t, recipes.InvalidRecipeOutputs, err.(*recipes.RecipeError).ErrorDetails.Code)
+}
+
+func Test_Helm_Execute_DownloadFailure(t *testing.T) {
+	ctx := testcontext.New(t)
+	helmClient, driver := setupHelm(t)
+	envConfig, recipeMetadata, envRecipe := buildHelmTestInputs()
+
+	helmClient.EXPECT().LoadChart(ctx, gomock.Any(), gomock.Any()).Times(1).Return(nil, errors.New("chart not found"))
+
+	_, err := driver.Execute(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+
+	expErr := recipes.RecipeError{
+		ErrorDetails: v1.ErrorDetails{
+			Code:    recipes.RecipeDownloadFailed,
+			Message: "chart not found",
+		},
+		DeploymentStatus: "setupError",
+	}
+	require.Equal(t, &expErr, err)
+}
+
+func Test_Helm_Execute_DeploymentFailure(t *testing.T) {
+	ctx := testcontext.New(t)
+	helmClient, driver := setupHelm(t)
+	envConfig, recipeMetadata, envRecipe := buildHelmTestInputs()
+
+	helmClient.EXPECT().LoadChart(ctx, gomock.Any(), gomock.Any()).Times(1).Return(&chart.Chart{}, nil)
+	helmClient.EXPECT().InstallOrUpgrade(ctx, gomock.Any()).Times(1).Return(nil, errors.New("timed out waiting for the condition"))
+
+	_, err := driver.Execute(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+
+	expErr := recipes.RecipeError{
+		ErrorDetails: v1.ErrorDetails{
+			Code:    recipes.RecipeDeploymentFailed,
+			Message: "timed out waiting for the condition",
+		},
+		DeploymentStatus: "executionError",
+	}
+	require.Equal(t, &expErr, err)
+}
+
+func Test_Helm_Execute_MissingKubernetesRuntime(t *testing.T) {
+	_, driver := setupHelm(t)
+	_, recipeMetadata, envRecipe := buildHelmTestInputs()
+
+	_, err := driver.Execute(testcontext.New(t), ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: recipes.Configuration{},
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+
+	expErr := recipes.RecipeError{
+		ErrorDetails: v1.ErrorDetails{
+			Code:    recipes.RecipeDeploymentFailed,
+			Message: "kubernetes runtime configuration is required to deploy a helm recipe",
+		},
+		DeploymentStatus: "setupError",
+	}
+	require.Equal(t, &expErr, err)
+}
+
+func Test_Helm_Execute_SimulatedEnvironment(t *testing.T) {
+	_, driver := setupHelm(t)
+	envConfig, recipeMetadata, envRecipe := buildHelmTestInputs()
+	envConfig.Simulated = true
+
+	recipeOutput, err := driver.Execute(testcontext.New(t), ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.NoError(t, err)
+	require.Nil(t, recipeOutput)
+}
+
+func Test_Helm_Delete_Success(t *testing.T) {
+	ctx := testcontext.New(t)
+	helmClient, driver := setupHelm(t)
+	envConfig, recipeMetadata, envRecipe := buildHelmTestInputs()
+
+	releaseName, err := helmReleaseName(recipeMetadata.ResourceID)
+	require.NoError(t, err)
+	helmClient.EXPECT().Uninstall(ctx, "app-ns", releaseName).Times(1).Return(nil)
+
+	err = driver.Delete(ctx, DeleteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.NoError(t, err)
+}
+
+func Test_Helm_Delete_Failure(t *testing.T) {
+	ctx := testcontext.New(t)
+	helmClient, driver := setupHelm(t)
+	envConfig, recipeMetadata, envRecipe := buildHelmTestInputs()
+
+	helmClient.EXPECT().Uninstall(ctx, gomock.Any(), gomock.Any()).Times(1).Return(errors.New("failed to delete release"))
+
+	err := driver.Delete(ctx, DeleteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+
+	expErr := recipes.RecipeError{
+		ErrorDetails: v1.ErrorDetails{
+			Code:    recipes.RecipeDeletionFailed,
+			Message: "failed to delete release",
+		},
+	}
+	require.Equal(t, &expErr, err)
+}
+
+func Test_Helm_GetRecipeMetadata(t *testing.T) {
+	ctx := testcontext.New(t)
+	helmClient, driver := setupHelm(t)
+	_, recipeMetadata, envRecipe := buildHelmTestInputs()
+
+	helmClient.EXPECT().LoadChart(ctx, envRecipe.TemplatePath, envRecipe.TemplateVersion).Times(1).Return(&chart.Chart{
+		Values: map[string]any{
+			"replicas": float64(1),
+			"image":    map[string]any{"repository": "redis"},
+		},
+	}, nil)
+
+	metadata, err := driver.GetRecipeMetadata(ctx, BaseOptions{
+		Recipe:     recipeMetadata,
+		Definition: envRecipe,
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{
+		"parameters": map[string]any{
+			"replicas": map[string]any{"type": "number", "defaultValue": float64(1)},
+			"image":    map[string]any{"type": "object", "defaultValue": map[string]any{"repository": "redis"}},
+		},
+	}, metadata)
+}
+
+func Test_HelmReleaseName(t *testing.T) {
+	name, err := helmReleaseName("/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/Test-Redis")
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(name, "test-redis-"))
+	require.Len(t, name, len("test-redis-")+8)
+
+	other, err := helmReleaseName("/planes/radius/local/resourceGroups/other-rg/providers/Applications.Datastores/redisCaches/Test-Redis")
+	require.NoError(t, err)
+	require.NotEqual(t, name, other)
+
+	long, err := helmReleaseName("/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/" + strings.Repeat("a", 60))
+	require.NoError(t, err)
+	require.Len(t, long, helmReleaseNameMaxLength)
+
+	_, err = helmReleaseName("invalid")
+	require.Error(t, err)
+}
+
+func Test_Helm_Plan_Success(t *testing.T) {
+	ctx := testcontext.New(t)
+	helmClient, driver := setupHelm(t)
+	envConfig, recipeMetadata, envRecipe := buildHelmTestInputs()
+
+	secret := newUnstructured("v1", "Secret", "app-ns", "redis-secret", map[string]any{
+		"data": map[string]any{"password": "c2VjcmV0"},
+	})
+	helmClient.EXPECT().LoadChart(ctx, envRecipe.TemplatePath, envRecipe.TemplateVersion).Times(1).Return(&chart.Chart{}, nil)
+	helmClient.EXPECT().
+		InstallOrUpgrade(ctx, gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ any, options helm.InstallOptions) (*helm.Release, error) {
+			require.True(t, options.DryRun)
+			return &helm.Release{
+				Name:      options.ReleaseName,
+				Namespace: options.Namespace,
+				Objects: []*unstructured.Unstructured{
+					newUnstructured("v1", "Service", "app-ns", "redis", nil),
+					secret,
+				},
+			}, nil
+		})
+
+	plan, err := driver.Plan(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+		PrevState: []string{
+			"/planes/kubernetes/local/namespaces/app-ns/providers/core/Service/redis",
+			"/planes/kubernetes/local/namespaces/app-ns/providers/apps/StatefulSet/redis",
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, plan.Changes, 3)
+
+	require.Equal(t, "/planes/kubernetes/local/namespaces/app-ns/providers/core/Service/redis", plan.Changes[0].Resource)
+	require.Equal(t, "core/Service", plan.Changes[0].ResourceType)
+	require.Equal(t, recipes.ChangeTypeUpdate, plan.Changes[0].ChangeType)
+
+	require.Equal(t, "/planes/kubernetes/local/namespaces/app-ns/providers/core/Secret/redis-secret", plan.Changes[1].Resource)
+	require.Equal(t, recipes.ChangeTypeCreate, plan.Changes[1].ChangeType)
+	require.Equal(t, map[string]any{"password": "(sensitive)"}, plan.Changes[1].After.(map[string]any)["data"])
+	require.Equal(t, "c2VjcmV0", secret.Object["data"].(map[string]any)["password"])
+
+	require.Equal(t, "/planes/kubernetes/local/namespaces/app-ns/providers/apps/StatefulSet/redis", plan.Changes[2].Resource)
+	require.Equal(t, "apps/StatefulSet", plan.Changes[2].ResourceType)
+	require.Equal(t, recipes.ChangeTypeDelete, plan.Changes[2].ChangeType)
+	require.Nil(t, plan.Changes[2].After)
+}
+
+func Test_Helm_Plan_Failure(t *testing.T) {
+	ctx := testcontext.New(t)
+	helmClient, driver := setupHelm(t)
+	envConfig, recipeMetadata, envRecipe := buildHelmTestInputs()
+
+	helmClient.EXPECT().LoadChart(ctx, gomock.Any(), gomock.Any()).Times(1).Return(&chart.Chart{}, nil)
+	helmClient.EXPECT().InstallOrUpgrade(ctx, gomock.Any()).Times(1).Return(nil, errors.New("template: invalid value"))
+
+	_, err := driver.Plan(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.Error(t, err)
+	require.Equal(t, recipes.RecipePlanFailed, err.(*recipes.RecipeError).ErrorDetails.Code)
+}
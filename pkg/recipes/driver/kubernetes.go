@@ -0,0 +1,183 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/recipecontext"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	kubernetesresources "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
+)
+
+const (
+	// RecipeOutputKey is the label or annotation which marks a ConfigMap or Secret deployed by a Kubernetes based recipe
+	// as recipe output when set to "true". The data of a marked ConfigMap is returned as recipe output values and the data
+	// of a marked Secret as recipe output secrets.
+	RecipeOutputKey = "radapp.io/recipe-output"
+)
+
+// isRecipeOutputObject returns true if the object is marked as recipe output with either a label or an annotation.
+func isRecipeOutputObject(obj *unstructured.Unstructured) bool {
+	return obj.GetLabels()[RecipeOutputKey] == "true" || obj.GetAnnotations()[RecipeOutputKey] == "true"
+}
+
+// addKubernetesRecipeOutput adds the data of an output ConfigMap to the recipe output values, and the data of an output Secret
+// to the recipe output secrets.
+func addKubernetesRecipeOutput(recipeResponse *recipes.RecipeOutput, obj *unstructured.Unstructured) error {
+	gvk := obj.GroupVersionKind()
+	if gvk.Group != "" {
+		return nil
+	}
+
+	switch gvk.Kind {
+	case "ConfigMap":
+		data, _, err := unstructured.NestedStringMap(obj.Object, "data")
+		if err != nil {
+			return fmt.Errorf("failed to read the data of ConfigMap %q: %w", obj.GetName(), err)
+		}
+		for k, v := range data {
+			recipeResponse.Values[k] = v
+		}
+	case "Secret":
+		data, _, err := unstructured.NestedStringMap(obj.Object, "data")
+		if err != nil {
+			return fmt.Errorf("failed to read the data of Secret %q: %w", obj.GetName(), err)
+		}
+		for k, v := range data {
+			decoded, err := base64.StdEncoding.DecodeString(v)
+			if err != nil {
+				return fmt.Errorf("failed to decode the data %q of Secret %q: %w", k, obj.GetName(), err)
+			}
+			recipeResponse.Secrets[k] = string(decoded)
+		}
+
+		stringData, _, err := unstructured.NestedStringMap(obj.Object, "stringData")
+		if err != nil {
+			return fmt.Errorf("failed to read the stringData of Secret %q: %w", obj.GetName(), err)
+		}
+		for k, v := range stringData {
+			recipeResponse.Secrets[k] = v
+		}
+	}
+
+	return nil
+}
+
+// kubernetesOutputResourceID returns the UCP resource ID of a Kubernetes object deployed by a recipe.
+func kubernetesOutputResourceID(obj *unstructured.Unstructured) string {
+	gvk := obj.GroupVersionKind()
+	return kubernetesresources.IDFromParts(kubernetesresources.PlaneNameTODO, gvk.Group, gvk.Kind, obj.GetNamespace(), obj.GetName()).String()
+}
+
+// recipeContextValue converts the recipe context to its JSON representation, so that templates can access it through
+// maps using the same property names as Bicep and Terraform recipes.
+func recipeContextValue(recipeContext *recipecontext.Context) (map[string]any, error) {
+	b, err := json.Marshal(recipeContext)
+	if err != nil {
+		return nil, err
+	}
+
+	value := map[string]any{}
+	if err := json.Unmarshal(b, &value); err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+// planKubernetesObjects returns the plan for deploying the Kubernetes objects rendered by a recipe. Objects which are output
+// resources of the previous deployment are updated, other objects are created, and the previous output resources which
+// are no longer rendered are deleted. The data of Secrets is redacted.
+func planKubernetesObjects(objects []*unstructured.Unstructured, prevState []string) *recipes.RecipePlan {
+	plan := &recipes.RecipePlan{Changes: []recipes.ResourceChange{}}
+
+	previous := map[string]bool{}
+	for _, id := range prevState {
+		previous[strings.ToLower(id)] = true
+	}
+
+	rendered := map[string]bool{}
+	for _, obj := range objects {
+		id := kubernetesOutputResourceID(obj)
+		rendered[strings.ToLower(id)] = true
+
+		changeType := recipes.ChangeTypeCreate
+		if previous[strings.ToLower(id)] {
+			changeType = recipes.ChangeTypeUpdate
+		}
+
+		plan.Changes = append(plan.Changes, recipes.ResourceChange{
+			Resource:     id,
+			ResourceType: kubernetesResourceType(id),
+			ChangeType:   changeType,
+			After:        redactSecretData(obj).Object,
+		})
+	}
+
+	for _, id := range prevState {
+		if rendered[strings.ToLower(id)] {
+			continue
+		}
+
+		plan.Changes = append(plan.Changes, recipes.ResourceChange{
+			Resource:     id,
+			ResourceType: kubernetesResourceType(id),
+			ChangeType:   recipes.ChangeTypeDelete,
+		})
+	}
+
+	return plan
+}
+
+// kubernetesResourceType returns the type of the resource ID, or an empty string if the ID is invalid.
+func kubernetesResourceType(id string) string {
+	parsed, err := resources.ParseResource(id)
+	if err != nil {
+		return ""
+	}
+
+	return parsed.Type()
+}
+
+// redactSecretData returns a copy of a Secret with the values of its data replaced, or the object itself if it isn't
+// a Secret.
+func redactSecretData(obj *unstructured.Unstructured) *unstructured.Unstructured {
+	gvk := obj.GroupVersionKind()
+	if gvk.Group != "" || gvk.Kind != "Secret" {
+		return obj
+	}
+
+	redacted := obj.DeepCopy()
+	for _, field := range []string{"data", "stringData"} {
+		data, ok := redacted.Object[field].(map[string]any)
+		if !ok {
+			continue
+		}
+		for k := range data {
+			data[k] = sensitivePlanValue
+		}
+	}
+
+	return redacted
+}
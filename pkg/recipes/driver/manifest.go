@@ -0,0 +1,280 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"golang.org/x/exp/slices"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/radius-project/radius/pkg/portableresources/processors"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/manifest"
+	"github.com/radius-project/radius/pkg/recipes/recipecontext"
+	recipes_util "github.com/radius-project/radius/pkg/recipes/util"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+var _ Driver = (*manifestDriver)(nil)
+
+// NewManifestDriver creates a new instance of driver to execute a Kubernetes manifest or Kustomize recipe.
+func NewManifestDriver(runtimeClient runtimeclient.Client, resourceClient processors.ResourceClient, options ManifestOptions) Driver {
+	return &manifestDriver{
+		manifestClient: manifest.NewClient(runtimeClient),
+		ResourceClient: resourceClient,
+		options:        options,
+	}
+}
+
+// ManifestOptions represents the options required for execution of manifest driver.
+type ManifestOptions struct {
+	DeleteRetryCount        int
+	DeleteRetryDelaySeconds int
+}
+
+// manifestDriver represents a driver to interact with Kubernetes manifest recipes - fetch the manifests, apply the objects,
+// delete them, etc.
+type manifestDriver struct {
+	// manifestClient is used to download the manifests and to apply the objects.
+	manifestClient manifest.ManifestClient
+
+	// ResourceClient is used to delete the objects created by the recipe.
+	ResourceClient processors.ResourceClient
+	options        ManifestOptions
+}
+
+// Execute downloads the manifests referenced by the recipe, templates the recipe context and parameters into them, builds
+// the kustomization if there is one, and server-side applies the objects in the recipe's Kubernetes namespace. Objects
+// applied by the previous deployment of the recipe which are no longer part of it are deleted.
+func (d *manifestDriver) Execute(ctx context.Context, opts ExecuteOptions) (*recipes.RecipeOutput, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	recipeContext, err := manifestRecipeContext(opts.BaseOptions)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+	namespace := recipeContext.Runtime.Kubernetes.Namespace
+
+	data, err := createManifestData(opts.Recipe.Parameters, opts.Definition.Parameters, recipeContext)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	if opts.Configuration.Simulated {
+		logger.Info("simulated environment is set to true, skipping deployment")
+		return nil, nil
+	}
+
+	logger.Info(fmt.Sprintf("Deploying manifest recipe: %q, template: %q, namespace: %q", opts.Recipe.Name, opts.Definition.TemplatePath, namespace))
+	dir, err := os.MkdirTemp("", "manifest-recipe-")
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDownloadFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+	defer os.RemoveAll(dir)
+
+	if err := d.manifestClient.Fetch(ctx, opts.Definition.TemplatePath, dir); err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDownloadFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	objects, err := manifest.Render(dir, data)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeLanguageFailure, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	applied, err := d.manifestClient.Apply(ctx, namespace, objects)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	recipeResponse, err := d.prepareRecipeResponse(applied)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.InvalidRecipeOutputs, fmt.Sprintf("failed to read the recipe output: %s", err.Error()), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	// Kubernetes doesn't delete objects which were removed from the manifests, so objects applied by the previous deployment
+	// which are no longer part of the recipe are garbage collected.
+	diff, err := getGCOutputResources(recipeResponse.Resources, opts.PrevState)
+	if err != nil {
+		return nil, err
+	}
+
+	err = d.Delete(ctx, DeleteOptions{
+		OutputResources: diff,
+	})
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeGarbageCollectionFailed, err.Error(), recipes_util.ExecutionError, nil)
+	}
+
+	return recipeResponse, nil
+}
+
+// Plan downloads and renders the manifests referenced by the recipe like Execute, and validates the objects with a
+// server-side dry run. It returns the changes to the objects, including the objects applied by the previous deployment
+// which would be garbage collected.
+func (d *manifestDriver) Plan(ctx context.Context, opts ExecuteOptions) (*recipes.RecipePlan, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	recipeContext, err := manifestRecipeContext(opts.BaseOptions)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+	namespace := recipeContext.Runtime.Kubernetes.Namespace
+
+	data, err := createManifestData(opts.Recipe.Parameters, opts.Definition.Parameters, recipeContext)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	if opts.Configuration.Simulated {
+		logger.Info("simulated environment is set to true, skipping plan")
+		return &recipes.RecipePlan{Changes: []recipes.ResourceChange{}}, nil
+	}
+
+	logger.Info(fmt.Sprintf("Planning manifest recipe: %q, template: %q, namespace: %q", opts.Recipe.Name, opts.Definition.TemplatePath, namespace))
+	dir, err := os.MkdirTemp("", "manifest-recipe-")
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDownloadFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+	defer os.RemoveAll(dir)
+
+	if err := d.manifestClient.Fetch(ctx, opts.Definition.TemplatePath, dir); err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDownloadFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	objects, err := manifest.Render(dir, data)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeLanguageFailure, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	validated, err := d.manifestClient.DryRunApply(ctx, namespace, objects)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	return planKubernetesObjects(validated, opts.PrevState), nil
+}
+
+// Delete deletes all of the output resources that are marked as managed by Radius.
+func (d *manifestDriver) Delete(ctx context.Context, opts DeleteOptions) error {
+	return deleteOutputResources(ctx, d.ResourceClient, opts.OutputResources, d.options.DeleteRetryCount, d.options.DeleteRetryDelaySeconds)
+}
+
+// GetRecipeMetadata returns the parameters referenced by the manifests as the recipe parameters. Manifests don't declare
+// the types of their parameters, so the type of every parameter is "any". Parameters that are not set render as empty
+// values, so none of them is required.
+func (d *manifestDriver) GetRecipeMetadata(ctx context.Context, opts BaseOptions) (map[string]any, error) {
+	dir, err := os.MkdirTemp("", "manifest-recipe-")
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeGetMetadataFailed, err.Error(), "", recipes.GetErrorDetails(err))
+	}
+	defer os.RemoveAll(dir)
+
+	if err := d.manifestClient.Fetch(ctx, opts.Definition.TemplatePath, dir); err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeGetMetadataFailed, err.Error(), "", recipes.GetErrorDetails(err))
+	}
+
+	names, err := manifest.Parameters(dir)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeGetMetadataFailed, err.Error(), "", recipes.GetErrorDetails(err))
+	}
+
+	parameters := map[string]any{}
+	for _, name := range names {
+		parameters[name] = map[string]any{
+			"type":     "any",
+			"required": false,
+		}
+	}
+
+	return map[string]any{
+		recipeParameters: parameters,
+	}, nil
+}
+
+// prepareRecipeResponse populates the recipe response from the applied objects. ConfigMaps and Secrets marked with
+// RecipeOutputKey add values and secrets to the recipe output, and all the applied objects are returned as output resources.
+func (d *manifestDriver) prepareRecipeResponse(objects []*unstructured.Unstructured) (*recipes.RecipeOutput, error) {
+	recipeResponse := &recipes.RecipeOutput{
+		Values:    map[string]any{},
+		Secrets:   map[string]any{},
+		Resources: []string{},
+	}
+
+	uniqueResourceIDs := []string{}
+	for _, obj := range objects {
+		if isRecipeOutputObject(obj) {
+			if err := addKubernetesRecipeOutput(recipeResponse, obj); err != nil {
+				return &recipes.RecipeOutput{}, err
+			}
+		}
+
+		id := kubernetesOutputResourceID(obj)
+		if !slices.Contains(uniqueResourceIDs, strings.ToLower(id)) {
+			uniqueResourceIDs = append(uniqueResourceIDs, strings.ToLower(id))
+			recipeResponse.Resources = append(recipeResponse.Resources, id)
+		}
+	}
+
+	return recipeResponse, nil
+}
+
+// manifestRecipeContext returns the recipe context for the recipe. Namespace-scoped objects are applied to the Kubernetes
+// namespace of the recipe context.
+func manifestRecipeContext(opts BaseOptions) (*recipecontext.Context, error) {
+	if opts.Configuration.Runtime.Kubernetes == nil {
+		return nil, errors.New("kubernetes runtime configuration is required to deploy a manifest recipe")
+	}
+
+	recipeContext, err := recipecontext.New(&opts.Recipe, &opts.Configuration)
+	if err != nil {
+		return nil, err
+	}
+
+	if recipeContext.Runtime.Kubernetes.Namespace == "" {
+		return nil, errors.New("kubernetes namespace is required to deploy a manifest recipe")
+	}
+
+	return recipeContext, nil
+}
+
+// createManifestData creates the data used to template the manifests after handling conflicts in parameters set by operator
+// and developer. In case of conflict the developer parameter takes precedence.
+func createManifestData(devParams, operatorParams map[string]any, recipeContext *recipecontext.Context) (map[string]any, error) {
+	parameters := map[string]any{}
+	for k, v := range operatorParams {
+		parameters[k] = v
+	}
+	for k, v := range devParams {
+		parameters[k] = v
+	}
+
+	contextValue, err := recipeContextValue(recipeContext)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		manifest.ContextKey:    contextValue,
+		manifest.ParametersKey: parameters,
+	}, nil
+}
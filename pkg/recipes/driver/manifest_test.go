@@ -0,0 +1,320 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	gomock "github.com/golang/mock/gomock"
+	"github.com/radius-project/radius/pkg/portableresources/processors"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/manifest"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	testManifestTemplatePath = "oci://myregistry.azurecr.io/manifests/redis:1.0.0"
+
+	testManifest = `apiVersion: v1
+kind: Service
+metadata:
+  name: {{ .context.resource.name }}
+spec:
+  ports:
+    - port: {{ .parameters.port }}
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: {{ .context.resource.name }}-output
+  annotations:
+    radapp.io/recipe-output: "true"
+data:
+  host: {{ .context.resource.name }}.{{ .context.runtime.kubernetes.namespace }}.svc.cluster.local
+  tier: {{ .parameters.tier }}
+`
+)
+
+func setupManifest(t *testing.T) (*manifest.MockManifestClient, *processors.MockResourceClient, manifestDriver) {
+	ctrl := gomock.NewController(t)
+	manifestClient := manifest.NewMockManifestClient(ctrl)
+	resourceClient := processors.NewMockResourceClient(ctrl)
+
+	return manifestClient, resourceClient, manifestDriver{manifestClient: manifestClient, ResourceClient: resourceClient}
+}
+
+func buildManifestTestInputs() (recipes.Configuration, recipes.ResourceMetadata, recipes.EnvironmentDefinition) {
+	envConfig, recipeMetadata, envRecipe := buildHelmTestInputs()
+	recipeMetadata.Parameters = map[string]any{
+		"port": 6380,
+	}
+	envRecipe.Driver = recipes.TemplateKindManifest
+	envRecipe.TemplatePath = testManifestTemplatePath
+	envRecipe.TemplateVersion = ""
+	envRecipe.Parameters = map[string]any{
+		"port": 6379,
+		"tier": "basic",
+	}
+
+	return envConfig, recipeMetadata, envRecipe
+}
+
+func writeTestManifest(_ any, _ string, dir string) error {
+	return os.WriteFile(filepath.Join(dir, "redis.yaml"), []byte(testManifest), 0644)
+}
+
+func Test_Manifest_Execute_Success(t *testing.T) {
+	ctx := testcontext.New(t)
+	manifestClient, resourceClient, driver := setupManifest(t)
+	envConfig, recipeMetadata, envRecipe := buildManifestTestInputs()
+
+	previousID := "/planes/kubernetes/local/namespaces/app-ns/providers/apps/Deployment/test-redis"
+	manifestClient.EXPECT().Fetch(ctx, testManifestTemplatePath, gomock.Any()).Times(1).DoAndReturn(writeTestManifest)
+	manifestClient.EXPECT().
+		Apply(ctx, "app-ns", gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ any, namespace string, objects []*unstructured.Unstructured) ([]*unstructured.Unstructured, error) {
+			require.Len(t, objects, 2)
+			require.Equal(t, "Service", objects[0].GetKind())
+			require.Equal(t, "test-redis", objects[0].GetName())
+			ports, _, _ := unstructured.NestedSlice(objects[0].Object, "spec", "ports")
+			require.Equal(t, int64(6380), ports[0].(map[string]any)["port"])
+
+			applied := []*unstructured.Unstructured{}
+			for _, obj := range objects {
+				obj = obj.DeepCopy()
+				obj.SetNamespace(namespace)
+				applied = append(applied, obj)
+			}
+			return applied, nil
+		})
+	resourceClient.EXPECT().Delete(gomock.Any(), previousID).Times(1).Return(nil)
+
+	recipeOutput, err := driver.Execute(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+		PrevState: []string{
+			"/planes/kubernetes/local/namespaces/app-ns/providers/core/Service/test-redis",
+			previousID,
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, &recipes.RecipeOutput{
+		Values: map[string]any{
+			"host": "test-redis.app-ns.svc.cluster.local",
+			"tier": "basic",
+		},
+		Secrets: map[string]any{},
+		Resources: []string{
+			"/planes/kubernetes/local/namespaces/app-ns/providers/core/Service/test-redis",
+			"/planes/kubernetes/local/namespaces/app-ns/providers/core/ConfigMap/test-redis-output",
+		},
+	}, recipeOutput)
+}
+
+func Test_Manifest_Execute_Simulated(t *testing.T) {
+	ctx := testcontext.New(t)
+	_, _, driver := setupManifest(t)
+	envConfig, recipeMetadata, envRecipe := buildManifestTestInputs()
+	envConfig.Simulated = true
+
+	recipeOutput, err := driver.Execute(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.NoError(t, err)
+	require.Nil(t, recipeOutput)
+}
+
+func Test_Manifest_Execute_MissingKubernetesRuntime(t *testing.T) {
+	ctx := testcontext.New(t)
+	_, _, driver := setupManifest(t)
+	_, recipeMetadata, envRecipe := buildManifestTestInputs()
+
+	_, err := driver.Execute(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: recipes.Configuration{},
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.Error(t, err)
+	require.Equal(t, recipes.RecipeDeploymentFailed, err.(*recipes.RecipeError).ErrorDetails.Code)
+}
+
+func Test_Manifest_Execute_FetchError(t *testing.T) {
+	ctx := testcontext.New(t)
+	manifestClient, _, driver := setupManifest(t)
+	envConfig, recipeMetadata, envRecipe := buildManifestTestInputs()
+
+	manifestClient.EXPECT().Fetch(ctx, testManifestTemplatePath, gomock.Any()).Times(1).Return(errors.New("not found"))
+
+	_, err := driver.Execute(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.Error(t, err)
+	require.Equal(t, recipes.RecipeDownloadFailed, err.(*recipes.RecipeError).ErrorDetails.Code)
+}
+
+func Test_Manifest_Execute_RenderError(t *testing.T) {
+	ctx := testcontext.New(t)
+	manifestClient, _, driver := setupManifest(t)
+	envConfig, recipeMetadata, envRecipe := buildManifestTestInputs()
+
+	manifestClient.EXPECT().
+		Fetch(ctx, testManifestTemplatePath, gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ any, _ string, dir string) error {
+			return os.WriteFile(filepath.Join(dir, "redis.yaml"), []byte("name: {{ .context"), 0644)
+		})
+
+	_, err := driver.Execute(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.Error(t, err)
+	require.Equal(t, recipes.RecipeLanguageFailure, err.(*recipes.RecipeError).ErrorDetails.Code)
+}
+
+func Test_Manifest_Execute_ApplyError(t *testing.T) {
+	ctx := testcontext.New(t)
+	manifestClient, _, driver := setupManifest(t)
+	envConfig, recipeMetadata, envRecipe := buildManifestTestInputs()
+
+	manifestClient.EXPECT().Fetch(ctx, testManifestTemplatePath, gomock.Any()).Times(1).DoAndReturn(writeTestManifest)
+	manifestClient.EXPECT().Apply(ctx, "app-ns", gomock.Any()).Times(1).Return(nil, errors.New("forbidden"))
+
+	_, err := driver.Execute(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.Error(t, err)
+	require.Equal(t, recipes.RecipeDeploymentFailed, err.(*recipes.RecipeError).ErrorDetails.Code)
+}
+
+func Test_Manifest_Delete_Success(t *testing.T) {
+	ctx := testcontext.New(t)
+	_, resourceClient, driver := setupManifest(t)
+
+	id := "/planes/kubernetes/local/namespaces/app-ns/providers/core/Service/test-redis"
+	resourceClient.EXPECT().Delete(gomock.Any(), id).Times(1).Return(nil)
+
+	err := driver.Delete(ctx, DeleteOptions{
+		OutputResources: []rpv1.OutputResource{
+			{
+				ID:            resources.MustParse(id),
+				RadiusManaged: to.Ptr(true),
+			},
+		},
+	})
+	require.NoError(t, err)
+}
+
+func Test_Manifest_GetRecipeMetadata(t *testing.T) {
+	ctx := testcontext.New(t)
+	manifestClient, _, driver := setupManifest(t)
+	_, _, envRecipe := buildManifestTestInputs()
+
+	manifestClient.EXPECT().Fetch(ctx, testManifestTemplatePath, gomock.Any()).Times(1).DoAndReturn(writeTestManifest)
+
+	metadata, err := driver.GetRecipeMetadata(ctx, BaseOptions{Definition: envRecipe})
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{
+		"parameters": map[string]any{
+			"port": map[string]any{"type": "any", "required": false},
+			"tier": map[string]any{"type": "any", "required": false},
+		},
+	}, metadata)
+}
+
+func Test_Manifest_Plan_Success(t *testing.T) {
+	ctx := testcontext.New(t)
+	manifestClient, _, driver := setupManifest(t)
+	envConfig, recipeMetadata, envRecipe := buildManifestTestInputs()
+
+	manifestClient.EXPECT().Fetch(ctx, testManifestTemplatePath, gomock.Any()).Times(1).DoAndReturn(writeTestManifest)
+	manifestClient.EXPECT().
+		DryRunApply(ctx, "app-ns", gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ any, namespace string, objects []*unstructured.Unstructured) ([]*unstructured.Unstructured, error) {
+			validated := []*unstructured.Unstructured{}
+			for _, obj := range objects {
+				obj = obj.DeepCopy()
+				obj.SetNamespace(namespace)
+				validated = append(validated, obj)
+			}
+			return validated, nil
+		})
+
+	plan, err := driver.Plan(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+		PrevState: []string{
+			"/planes/kubernetes/local/namespaces/app-ns/providers/core/Service/test-redis",
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, plan.Changes, 2)
+	require.Equal(t, recipes.ChangeTypeUpdate, plan.Changes[0].ChangeType)
+	require.Equal(t, "/planes/kubernetes/local/namespaces/app-ns/providers/core/Service/test-redis", plan.Changes[0].Resource)
+	require.Equal(t, recipes.ChangeTypeCreate, plan.Changes[1].ChangeType)
+	require.Equal(t, "/planes/kubernetes/local/namespaces/app-ns/providers/core/ConfigMap/test-redis-output", plan.Changes[1].Resource)
+}
+
+func Test_Manifest_Plan_Simulated(t *testing.T) {
+	ctx := testcontext.New(t)
+	_, _, driver := setupManifest(t)
+	envConfig, recipeMetadata, envRecipe := buildManifestTestInputs()
+	envConfig.Simulated = true
+
+	plan, err := driver.Plan(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.NoError(t, err)
+	require.Empty(t, plan.Changes)
+}
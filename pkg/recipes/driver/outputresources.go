@@ -0,0 +1,124 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/radius-project/radius/pkg/portableresources/processors"
+	"github.com/radius-project/radius/pkg/recipes"
+	recipes_util "github.com/radius-project/radius/pkg/recipes/util"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// deleteOutputResources deletes all of the output resources that are marked as managed by Radius.
+// It will create a goroutine for each resource to be deleted and wait for them to finish,
+// retrying if necessary.
+// We don't have context on the dependency ordering here, so we need to try to delete them
+// all in parallel. Since some resources may depend on others, we may need to retry.
+func deleteOutputResources(ctx context.Context, resourceClient processors.ResourceClient, outputResources []rpv1.OutputResource, retryCount int, retryDelaySeconds int) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	// Create a waitgroup to track the deletion of each output resource
+	g, groupCtx := errgroup.WithContext(ctx)
+
+	for i := range outputResources {
+		outputResource := outputResources[i]
+
+		// Create a goroutine that handles the deletion of one resource
+		g.Go(func() error {
+			id := outputResource.ID.String()
+			logger.V(ucplog.LevelInfo).Info(fmt.Sprintf("Deleting output resource: %v, LocalID: %s, resource type: %s\n", outputResource.ID, outputResource.LocalID, outputResource.GetResourceType()))
+
+			// If the resource is not managed by Radius, skip the deletion
+			if outputResource.RadiusManaged == nil || !*outputResource.RadiusManaged {
+				logger.Info(fmt.Sprintf("Skipping deletion of output resource: %q, not managed by Radius", id))
+				return nil
+			}
+
+			var err error
+			for attempt := 0; attempt <= retryCount; attempt++ {
+				logger.WithValues("attempt", attempt)
+				ctx := logr.NewContext(groupCtx, logger)
+				logger.V(ucplog.LevelDebug).Info("beginning attempt")
+
+				err = resourceClient.Delete(ctx, id)
+				if err != nil {
+					if attempt <= retryCount {
+						logger.V(ucplog.LevelInfo).Error(err, "attempt failed", "delay", retryDelaySeconds)
+						time.Sleep(time.Duration(retryDelaySeconds) * time.Second)
+						continue
+					}
+
+					return recipes.NewRecipeError(recipes.RecipeDeletionFailed, err.Error(), "", recipes.GetErrorDetails(err))
+				}
+
+				// If the err is nil, then the resource is deleted successfully
+				logger.V(ucplog.LevelInfo).Info(fmt.Sprintf("Deleted output resource: %q", id))
+				return nil
+			}
+
+			deletionErr := fmt.Errorf("failed to delete resource after %d attempt(s), last error: %s", retryCount+1, err.Error())
+			return recipes.NewRecipeError(recipes.RecipeDeletionFailed, deletionErr.Error(), "", recipes.GetErrorDetails(deletionErr))
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// getGCOutputResources [GC stands for Garbage Collection] compares two slices of resource ids and
+// returns a slice of OutputResources that contains the elements that are in the "previous" slice but not in the "current".
+func getGCOutputResources(current []string, previous []string) ([]rpv1.OutputResource, error) {
+	// We can easily determine which resources have changed via a brute-force search comparing IDs.
+	// The lists of resources we work with are small, so this is fine.
+	diff := []rpv1.OutputResource{}
+	for _, prevResourceId := range previous {
+		found := false
+		for _, currentResourceId := range current {
+			if prevResourceId == currentResourceId {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			id, err := resources.Parse(prevResourceId)
+			if err != nil {
+				return nil, recipes.NewRecipeError(recipes.RecipeGarbageCollectionFailed, err.Error(), recipes_util.ExecutionError, nil)
+			}
+
+			diff = append(diff, rpv1.OutputResource{
+				ID:            id,
+				RadiusManaged: to.Ptr(true),
+			})
+		}
+	}
+
+	return diff, nil
+}
@@ -30,6 +30,7 @@ import (
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/configloader"
 
 	"github.com/radius-project/radius/pkg/recipes/terraform"
 	recipes_util "github.com/radius-project/radius/pkg/recipes/util"
@@ -47,9 +48,15 @@ import (
 var _ Driver = (*terraformDriver)(nil)
 
 // NewTerraformDriver creates a new instance of driver to execute a Terraform recipe.
-func NewTerraformDriver(ucpConn sdk.Connection, secretProvider *ucp_provider.SecretProvider, options TerraformOptions, k8sClientSet kubernetes.Interface) Driver {
+func NewTerraformDriver(ucpConn sdk.Connection, secretProvider *ucp_provider.SecretProvider, secretsLoader configloader.SecretsLoader, options TerraformOptions, k8sClientSet kubernetes.Interface) Driver {
+	var cache *terraform.Cache
+	if options.Cache != nil {
+		cache = terraform.NewCache(*options.Cache)
+	}
+
 	return &terraformDriver{
-		terraformExecutor: terraform.NewExecutor(ucpConn, secretProvider, k8sClientSet),
+		terraformExecutor: terraform.NewExecutor(ucpConn, secretProvider, k8sClientSet, cache),
+		secretsLoader:     secretsLoader,
 		options:           options,
 	}
 }
@@ -58,6 +65,9 @@ func NewTerraformDriver(ucpConn sdk.Connection, secretProvider *ucp_provider.Sec
 type TerraformOptions struct {
 	// Path is the path to the directory mounted to the container where terraform can be installed and executed.
 	Path string
+
+	// Cache is the configuration of the provider plugin and module caches shared by the executions. Nothing is cached if it's nil.
+	Cache *terraform.CacheOptions
 }
 
 // terraformDriver represents a driver to interact with Terraform Recipe - deploy recipe, delete resources, etc.
@@ -65,6 +75,9 @@ type terraformDriver struct {
 	// terraformExecutor is used to execute Terraform commands - deploy, destroy, etc.
 	terraformExecutor terraform.TerraformExecutor
 
+	// secretsLoader is used to load the secrets referenced by the Terraform recipe configuration of the environment.
+	secretsLoader configloader.SecretsLoader
+
 	// options contains options required to execute a Terraform recipe, such as the path to the directory mounted to the container where Terraform can be executed in sub directories.
 	options TerraformOptions
 }
@@ -89,11 +102,17 @@ func (d *terraformDriver) Execute(ctx context.Context, opts ExecuteOptions) (*re
 		return nil, nil
 	}
 
+	secrets, err := d.loadSecrets(ctx, opts.Configuration)
+	if err != nil {
+		return nil, err
+	}
+
 	tfState, err := d.terraformExecutor.Deploy(ctx, terraform.Options{
 		RootDir:        requestDirPath,
 		EnvConfig:      &opts.Configuration,
 		ResourceRecipe: &opts.Recipe,
 		EnvRecipe:      &opts.Definition,
+		Secrets:        secrets,
 	})
 	if err != nil {
 		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
@@ -107,6 +126,45 @@ func (d *terraformDriver) Execute(ctx context.Context, opts ExecuteOptions) (*re
 	return recipeOutputs, nil
 }
 
+// Plan creates a unique directory for the execution of terraform and runs terraform plan on the recipe module. The changes
+// are computed against the Terraform state of the previous deployment of the recipe, so PrevState is not used.
+func (d *terraformDriver) Plan(ctx context.Context, opts ExecuteOptions) (*recipes.RecipePlan, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	requestDirPath, err := d.createExecutionDirectory(ctx, opts.Recipe, opts.Definition)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+	defer func() {
+		if err := os.RemoveAll(requestDirPath); err != nil {
+			logger.Info(fmt.Sprintf("Failed to cleanup Terraform execution directory %q. Err: %s", requestDirPath, err.Error()))
+		}
+	}()
+
+	if opts.Configuration.Simulated {
+		logger.Info("simulated environment is set to true, skipping plan")
+		return &recipes.RecipePlan{Changes: []recipes.ResourceChange{}}, nil
+	}
+
+	secrets, err := d.loadSecrets(ctx, opts.Configuration)
+	if err != nil {
+		return nil, err
+	}
+
+	tfPlan, err := d.terraformExecutor.Plan(ctx, terraform.Options{
+		RootDir:        requestDirPath,
+		EnvConfig:      &opts.Configuration,
+		ResourceRecipe: &opts.Recipe,
+		EnvRecipe:      &opts.Definition,
+		Secrets:        secrets,
+	})
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	return convertTerraformPlan(tfPlan), nil
+}
+
 // Delete returns an error if called as it is not yet implemented.
 func (d *terraformDriver) Delete(ctx context.Context, opts DeleteOptions) error {
 	logger := ucplog.FromContextOrDiscard(ctx)
@@ -121,11 +179,17 @@ func (d *terraformDriver) Delete(ctx context.Context, opts DeleteOptions) error
 		}
 	}()
 
+	secrets, err := d.loadSecrets(ctx, opts.Configuration)
+	if err != nil {
+		return err
+	}
+
 	err = d.terraformExecutor.Delete(ctx, terraform.Options{
 		RootDir:        requestDirPath,
 		EnvConfig:      &opts.Configuration,
 		ResourceRecipe: &opts.Recipe,
 		EnvRecipe:      &opts.Definition,
+		Secrets:        secrets,
 	})
 	if err != nil {
 		return recipes.NewRecipeError(recipes.RecipeDeletionFailed, err.Error(), "", recipes.GetErrorDetails(err))
@@ -134,6 +198,52 @@ func (d *terraformDriver) Delete(ctx context.Context, opts DeleteOptions) error
 	return nil
 }
 
+// loadSecrets loads the secrets referenced by the Terraform provider configurations and the module source authentication
+// of the environment. The returned map is keyed by secret store ID and contains only the referenced keys.
+func (d *terraformDriver) loadSecrets(ctx context.Context, envConfig recipes.Configuration) (map[string]recipes.SecretData, error) {
+	secretStoreIDs := map[string][]string{}
+	addSecret := func(secretStoreID string, key string) {
+		if !slices.Contains(secretStoreIDs[secretStoreID], key) {
+			secretStoreIDs[secretStoreID] = append(secretStoreIDs[secretStoreID], key)
+		}
+	}
+
+	for _, providerConfig := range envConfig.RecipeConfig.Terraform.Providers {
+		for _, secret := range providerConfig.Secrets {
+			addSecret(secret.Source, secret.Key)
+		}
+	}
+
+	if auth := envConfig.RecipeConfig.Terraform.Authentication; auth != nil {
+		for _, pat := range auth.Git.PAT {
+			addSecret(pat.Secret, terraform.GitPATUsernameKey)
+			addSecret(pat.Secret, terraform.GitPATKey)
+		}
+		for _, ssh := range auth.Git.SSH {
+			addSecret(ssh.Secret, terraform.GitSSHPrivateKeyKey)
+			addSecret(ssh.Secret, terraform.GitSSHKnownHostsKey)
+		}
+		for _, registry := range auth.Registries {
+			addSecret(registry.Secret, terraform.RegistryTokenKey)
+		}
+	}
+
+	if len(secretStoreIDs) == 0 {
+		return nil, nil
+	}
+
+	if d.secretsLoader == nil {
+		return nil, recipes.NewRecipeError(recipes.LoadSecretsFailed, "secrets loader is not configured for the Terraform driver", recipes_util.RecipeSetupError, nil)
+	}
+
+	secrets, err := d.secretsLoader.LoadSecrets(ctx, secretStoreIDs)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.LoadSecretsFailed, fmt.Sprintf("failed to load secrets for Terraform recipe configuration: %s", err.Error()), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	return secrets, nil
+}
+
 // prepareRecipeResponse populates the recipe response from the module output named "result" and the
 // resources deployed by the Terraform module. The outputs and resources are retrieved from the input Terraform JSON state.
 func (d *terraformDriver) prepareRecipeResponse(ctx context.Context, tfState *tfjson.State) (*recipes.RecipeOutput, error) {
@@ -172,6 +282,102 @@ func (d *terraformDriver) prepareRecipeResponse(ctx context.Context, tfState *tf
 	return recipeResponse, nil
 }
 
+// convertTerraformPlan converts the managed resource changes of a Terraform plan to a recipe plan. Sensitive values are
+// masked and values only known after apply are marked as such.
+func convertTerraformPlan(tfPlan *tfjson.Plan) *recipes.RecipePlan {
+	plan := &recipes.RecipePlan{Changes: []recipes.ResourceChange{}}
+	if tfPlan == nil {
+		return plan
+	}
+
+	for _, rc := range tfPlan.ResourceChanges {
+		if rc == nil || rc.Change == nil || rc.Mode == tfjson.DataResourceMode {
+			continue
+		}
+
+		plan.Changes = append(plan.Changes, recipes.ResourceChange{
+			Resource:     rc.Address,
+			ResourceType: rc.Type,
+			ChangeType:   terraformChangeType(rc.Change.Actions),
+			Before:       maskPlanValue(rc.Change.Before, rc.Change.BeforeSensitive, nil),
+			After:        maskPlanValue(rc.Change.After, rc.Change.AfterSensitive, rc.Change.AfterUnknown),
+		})
+	}
+
+	return plan
+}
+
+// terraformChangeType maps the actions of a Terraform resource change to a change type.
+func terraformChangeType(actions tfjson.Actions) recipes.ChangeType {
+	switch {
+	case actions.Replace():
+		return recipes.ChangeTypeReplace
+	case actions.Create():
+		return recipes.ChangeTypeCreate
+	case actions.Update():
+		return recipes.ChangeTypeUpdate
+	case actions.Delete():
+		return recipes.ChangeTypeDelete
+	case actions.NoOp(), actions.Read():
+		return recipes.ChangeTypeNoChange
+	default:
+		return recipes.ChangeTypeUnknown
+	}
+}
+
+const (
+	sensitivePlanValue   = "(sensitive)"
+	knownAfterApplyValue = "(known after apply)"
+)
+
+// maskPlanValue returns a copy of the value of a Terraform plan where the values marked as sensitive are replaced by
+// "(sensitive)" and the values marked as unknown are replaced by "(known after apply)". sensitive and unknown have the
+// same structure as value, with true at the positions of the marked values.
+func maskPlanValue(value any, sensitive any, unknown any) any {
+	if b, ok := sensitive.(bool); ok && b {
+		return sensitivePlanValue
+	}
+	if b, ok := unknown.(bool); ok && b {
+		return knownAfterApplyValue
+	}
+
+	switch v := value.(type) {
+	case map[string]any:
+		sensitiveMap, _ := sensitive.(map[string]any)
+		unknownMap, _ := unknown.(map[string]any)
+		result := map[string]any{}
+		for key, item := range v {
+			result[key] = maskPlanValue(item, sensitiveMap[key], unknownMap[key])
+		}
+		// Attributes only known after apply are not present in the value.
+		for key, item := range unknownMap {
+			if b, ok := item.(bool); ok && b {
+				if _, ok := result[key]; !ok {
+					result[key] = knownAfterApplyValue
+				}
+			}
+		}
+		return result
+	case []any:
+		sensitiveList, _ := sensitive.([]any)
+		unknownList, _ := unknown.([]any)
+		result := make([]any, len(v))
+		for i, item := range v {
+			var s, u any
+			if i < len(sensitiveList) {
+				s = sensitiveList[i]
+			}
+			if i < len(unknownList) {
+				u = unknownList[i]
+			}
+			result[i] = maskPlanValue(item, s, u)
+		}
+		return result
+	default:
+		return value
+	}
+}
+
 // createExecutionDirectory creates a unique directory for each execution of terraform.
 func (d *terraformDriver) createExecutionDirectory(ctx context.Context, recipe recipes.ResourceMetadata, definition recipes.EnvironmentDefinition) (string, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
@@ -216,10 +422,17 @@ func (d *terraformDriver) GetRecipeMetadata(ctx context.Context, opts BaseOption
 		}
 	}()
 
+	secrets, err := d.loadSecrets(ctx, opts.Configuration)
+	if err != nil {
+		return nil, err
+	}
+
 	recipeData, err := d.terraformExecutor.GetRecipeMetadata(ctx, terraform.Options{
 		RootDir:        requestDirPath,
+		EnvConfig:      &opts.Configuration,
 		ResourceRecipe: &opts.Recipe,
 		EnvRecipe:      &opts.Definition,
+		Secrets:        secrets,
 	})
 	if err != nil {
 		return nil, recipes.NewRecipeError(recipes.RecipeGetMetadataFailed, err.Error(), "", recipes.GetErrorDetails(err))
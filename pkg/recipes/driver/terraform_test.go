@@ -30,6 +30,8 @@ import (
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/configloader"
+	recipes_util "github.com/radius-project/radius/pkg/recipes/util"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 
 	"github.com/radius-project/radius/pkg/recipes/terraform"
@@ -41,7 +43,7 @@ func setup(t *testing.T) (terraform.MockTerraformExecutor, terraformDriver) {
 	ctrl := gomock.NewController(t)
 	tfExecutor := terraform.NewMockTerraformExecutor(ctrl)
 
-	driver := terraformDriver{tfExecutor, TerraformOptions{Path: t.TempDir()}}
+	driver := terraformDriver{terraformExecutor: tfExecutor, options: TerraformOptions{Path: t.TempDir()}}
 
 	return *tfExecutor, driver
 }
@@ -318,6 +320,124 @@ func Test_Terraform_Execute_SimulatedEnvironment(t *testing.T) {
 	require.Nil(t, recipeOutput)
 }
 
+func Test_Terraform_Execute_ProviderSecrets(t *testing.T) {
+	ctx := testcontext.New(t)
+	armCtx := &v1.ARMRequestContext{
+		OperationID: uuid.New(),
+	}
+	ctx = v1.WithARMRequestContext(ctx, armCtx)
+
+	secretStoreID := "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/secretStores/vault"
+	envConfig, recipeMetadata, envRecipe := buildTestInputs()
+	envConfig.RecipeConfig.Terraform.Providers = map[string]datamodel.ProviderConfigProperties{
+		"vault": {
+			AdditionalProperties: map[string]any{
+				"address": "https://vault.example.com:8200",
+			},
+			Secrets: map[string]datamodel.SecretReference{
+				"token": {
+					Source: secretStoreID,
+					Key:    "token",
+				},
+			},
+		},
+	}
+
+	t.Run("secrets are passed to the executor", func(t *testing.T) {
+		tfExecutor, driver := setup(t)
+		secretsLoader := configloader.NewMockSecretsLoader(gomock.NewController(t))
+		driver.secretsLoader = secretsLoader
+
+		secrets := map[string]recipes.SecretData{
+			secretStoreID: {"token": "vault-token"},
+		}
+		secretsLoader.EXPECT().LoadSecrets(ctx, map[string][]string{secretStoreID: {"token"}}).Times(1).Return(secrets, nil)
+		tfExecutor.EXPECT().Deploy(ctx, gomock.Any()).Times(1).DoAndReturn(func(ctx context.Context, options terraform.Options) (*tfjson.State, error) {
+			require.Equal(t, secrets, options.Secrets)
+			return &tfjson.State{
+				Values: &tfjson.StateValues{
+					Outputs: map[string]*tfjson.StateOutput{
+						recipes.ResultPropertyName: {
+							Value: map[string]any{},
+						},
+					},
+				},
+			}, nil
+		})
+
+		_, err := driver.Execute(ctx, ExecuteOptions{
+			BaseOptions: BaseOptions{
+				Configuration: envConfig,
+				Recipe:        recipeMetadata,
+				Definition:    envRecipe,
+			},
+		})
+		require.NoError(t, err)
+		verifyDirectoryCleanup(t, driver.options.Path, armCtx.OperationID.String())
+	})
+
+	t.Run("loading secrets fails", func(t *testing.T) {
+		_, driver := setup(t)
+		secretsLoader := configloader.NewMockSecretsLoader(gomock.NewController(t))
+		driver.secretsLoader = secretsLoader
+
+		secretsLoader.EXPECT().LoadSecrets(ctx, gomock.Any()).Times(1).Return(nil, errors.New("secret store not found"))
+
+		_, err := driver.Execute(ctx, ExecuteOptions{
+			BaseOptions: BaseOptions{
+				Configuration: envConfig,
+				Recipe:        recipeMetadata,
+				Definition:    envRecipe,
+			},
+		})
+		expErr := recipes.NewRecipeError(recipes.LoadSecretsFailed, "failed to load secrets for Terraform recipe configuration: secret store not found", recipes_util.RecipeSetupError, nil)
+		require.Equal(t, expErr, err)
+		verifyDirectoryCleanup(t, driver.options.Path, armCtx.OperationID.String())
+	})
+}
+
+func Test_Terraform_LoadSecrets_Authentication(t *testing.T) {
+	ctx := testcontext.New(t)
+	_, driver := setup(t)
+	secretsLoader := configloader.NewMockSecretsLoader(gomock.NewController(t))
+	driver.secretsLoader = secretsLoader
+
+	gitSecretStore := "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/secretStores/git"
+	registrySecretStore := "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/secretStores/registry"
+	envConfig := recipes.Configuration{
+		RecipeConfig: datamodel.RecipeConfigProperties{
+			Terraform: datamodel.TerraformConfigProperties{
+				Authentication: &datamodel.AuthConfig{
+					Git: datamodel.GitAuthConfig{
+						PAT: map[string]datamodel.SecretConfig{
+							"dev.azure.com": {Secret: gitSecretStore},
+						},
+						SSH: map[string]datamodel.SecretConfig{
+							"github.com": {Secret: gitSecretStore},
+						},
+					},
+					Registries: map[string]datamodel.SecretConfig{
+						"registry.example.com": {Secret: registrySecretStore},
+					},
+				},
+			},
+		},
+	}
+
+	secrets := map[string]recipes.SecretData{
+		gitSecretStore:      {"username": "user", "pat": "pat", "privateKey": "key", "knownHosts": "github.com ssh-ed25519 AAAA"},
+		registrySecretStore: {"token": "token"},
+	}
+	secretsLoader.EXPECT().LoadSecrets(ctx, map[string][]string{
+		gitSecretStore:      {"username", "pat", "privateKey", "knownHosts"},
+		registrySecretStore: {"token"},
+	}).Times(1).Return(secrets, nil)
+
+	loaded, err := driver.loadSecrets(ctx, envConfig)
+	require.NoError(t, err)
+	require.Equal(t, secrets, loaded)
+}
+
 func TestTerraformDriver_GetRecipeMetadata_Success(t *testing.T) {
 	ctx := testcontext.New(t)
 	armCtx := &v1.ARMRequestContext{
@@ -749,3 +869,117 @@ func Test_Terraform_PrepareRecipeResponse(t *testing.T) {
 		})
 	}
 }
+
+func Test_Terraform_Plan_Success(t *testing.T) {
+	ctx := testcontext.New(t)
+	armCtx := &v1.ARMRequestContext{
+		OperationID: uuid.New(),
+	}
+	ctx = v1.WithARMRequestContext(ctx, armCtx)
+
+	tfExecutor, driver := setup(t)
+	envConfig, recipeMetadata, envRecipe := buildTestInputs()
+
+	tfPlan := &tfjson.Plan{
+		ResourceChanges: []*tfjson.ResourceChange{
+			{
+				Address: "azurerm_redis_cache.cache",
+				Mode:    tfjson.ManagedResourceMode,
+				Type:    "azurerm_redis_cache",
+				Change: &tfjson.Change{
+					Actions:         tfjson.Actions{tfjson.ActionUpdate},
+					Before:          map[string]any{"capacity": float64(1), "primary_access_key": "secret"},
+					After:           map[string]any{"capacity": float64(2), "primary_access_key": "secret"},
+					BeforeSensitive: map[string]any{"primary_access_key": true},
+					AfterSensitive:  map[string]any{"primary_access_key": true},
+					AfterUnknown:    map[string]any{},
+				},
+			},
+			{
+				Address: "kubernetes_deployment.redis",
+				Mode:    tfjson.ManagedResourceMode,
+				Type:    "kubernetes_deployment",
+				Change: &tfjson.Change{
+					Actions:      tfjson.Actions{tfjson.ActionCreate},
+					After:        map[string]any{"metadata": []any{map[string]any{"name": "redis"}}},
+					AfterUnknown: map[string]any{"id": true, "metadata": []any{map[string]any{"uid": true}}},
+				},
+			},
+			{
+				Address: "kubernetes_service.redis",
+				Mode:    tfjson.ManagedResourceMode,
+				Type:    "kubernetes_service",
+				Change: &tfjson.Change{
+					Actions: tfjson.Actions{tfjson.ActionDelete, tfjson.ActionCreate},
+				},
+			},
+			{
+				Address: "data.azurerm_client_config.current",
+				Mode:    tfjson.DataResourceMode,
+				Type:    "azurerm_client_config",
+				Change: &tfjson.Change{
+					Actions: tfjson.Actions{tfjson.ActionRead},
+				},
+			},
+		},
+	}
+	tfExecutor.EXPECT().Plan(ctx, gomock.Any()).Times(1).Return(tfPlan, nil)
+
+	plan, err := driver.Plan(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, &recipes.RecipePlan{
+		Changes: []recipes.ResourceChange{
+			{
+				Resource:     "azurerm_redis_cache.cache",
+				ResourceType: "azurerm_redis_cache",
+				ChangeType:   recipes.ChangeTypeUpdate,
+				Before:       map[string]any{"capacity": float64(1), "primary_access_key": "(sensitive)"},
+				After:        map[string]any{"capacity": float64(2), "primary_access_key": "(sensitive)"},
+			},
+			{
+				Resource:     "kubernetes_deployment.redis",
+				ResourceType: "kubernetes_deployment",
+				ChangeType:   recipes.ChangeTypeCreate,
+				After: map[string]any{
+					"id":       "(known after apply)",
+					"metadata": []any{map[string]any{"name": "redis", "uid": "(known after apply)"}},
+				},
+			},
+			{
+				Resource:     "kubernetes_service.redis",
+				ResourceType: "kubernetes_service",
+				ChangeType:   recipes.ChangeTypeReplace,
+			},
+		},
+	}, plan)
+	verifyDirectoryCleanup(t, driver.options.Path, armCtx.OperationID.String())
+}
+
+func Test_Terraform_Plan_Failure(t *testing.T) {
+	ctx := testcontext.New(t)
+	armCtx := &v1.ARMRequestContext{
+		OperationID: uuid.New(),
+	}
+	ctx = v1.WithARMRequestContext(ctx, armCtx)
+
+	tfExecutor, driver := setup(t)
+	envConfig, recipeMetadata, envRecipe := buildTestInputs()
+
+	tfExecutor.EXPECT().Plan(ctx, gomock.Any()).Times(1).Return(nil, errors.New("terraform plan failure"))
+
+	_, err := driver.Plan(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.Error(t, err)
+	require.Equal(t, recipes.RecipePlanFailed, err.(*recipes.RecipeError).ErrorDetails.Code)
+}
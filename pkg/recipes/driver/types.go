@@ -39,6 +39,9 @@ type Driver interface {
 
 	// Gets the Recipe metadata and parameters from Recipe's template path
 	GetRecipeMetadata(ctx context.Context, opts BaseOptions) (map[string]any, error)
+
+	// Plan fetches the recipe contents and returns the changes that deploying the recipe would make, without deploying it.
+	Plan(ctx context.Context, opts ExecuteOptions) (*recipes.RecipePlan, error)
 }
 
 // BaseOptions is the base options for the driver operations.
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/metrics"
 	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/recipes/configloader"
@@ -53,7 +54,7 @@ func (e *engine) Execute(ctx context.Context, opts ExecuteOptions) (*recipes.Rec
 	executionStart := time.Now()
 	result := metrics.SuccessfulOperationState
 
-	recipeOutput, definition, err := e.executeCore(ctx, opts.Recipe, opts.PreviousState)
+	recipeOutput, definition, err := e.executeCore(ctx, opts.Recipe, opts.PreviousState, opts.OutputSchema)
 	if err != nil {
 		result = metrics.FailedOperationState
 		if recipes.GetErrorDetails(err) != nil {
@@ -70,7 +71,7 @@ func (e *engine) Execute(ctx context.Context, opts ExecuteOptions) (*recipes.Rec
 
 // executeCore function is the core logic of the Execute function.
 // Any changes to the core logic of the Execute function should be made here.
-func (e *engine) executeCore(ctx context.Context, recipe recipes.ResourceMetadata, prevState []string) (*recipes.RecipeOutput, *recipes.EnvironmentDefinition, error) {
+func (e *engine) executeCore(ctx context.Context, recipe recipes.ResourceMetadata, prevState []string, outputSchema *recipes.OutputSchema) (*recipes.RecipeOutput, *recipes.EnvironmentDefinition, error) {
 	definition, driver, err := e.getDriver(ctx, recipe)
 	if err != nil {
 		return nil, nil, err
@@ -93,9 +94,65 @@ func (e *engine) executeCore(ctx context.Context, recipe recipes.ResourceMetadat
 		return nil, definition, err
 	}
 
+	if outputSchema != nil {
+		err = outputSchema.Validate(definition.ResourceType, res)
+		if err != nil {
+			return nil, definition, err
+		}
+	}
+
 	return res, definition, nil
 }
 
+// Plan loads the recipe definition and the configuration associated with the recipe in the same way as Execute,
+// and then computes the changes that deploying the recipe would make using the driver. Nothing is deployed.
+func (e *engine) Plan(ctx context.Context, opts ExecuteOptions) (*recipes.RecipePlan, error) {
+	planStart := time.Now()
+	result := metrics.SuccessfulOperationState
+
+	plan, definition, err := e.planCore(ctx, opts.Recipe, opts.PreviousState)
+	if err != nil {
+		result = metrics.FailedOperationState
+		if recipes.GetErrorDetails(err) != nil {
+			result = recipes.GetErrorDetails(err).Code
+		}
+	}
+
+	metrics.DefaultRecipeEngineMetrics.RecordRecipeOperationDuration(ctx, planStart,
+		metrics.NewRecipeAttributes(metrics.RecipeEngineOperationPlan, opts.Recipe.Name,
+			definition, result))
+
+	return plan, err
+}
+
+// planCore function is the core logic of the Plan function.
+// Any changes to the core logic of the Plan function should be made here.
+func (e *engine) planCore(ctx context.Context, recipe recipes.ResourceMetadata, prevState []string) (*recipes.RecipePlan, *recipes.EnvironmentDefinition, error) {
+	definition, driver, err := e.getDriver(ctx, recipe)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	configuration, err := e.options.ConfigurationLoader.LoadConfiguration(ctx, recipe)
+	if err != nil {
+		return nil, definition, recipes.NewRecipeError(recipes.RecipeConfigurationFailure, err.Error(), util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	plan, err := driver.Plan(ctx, recipedriver.ExecuteOptions{
+		BaseOptions: recipedriver.BaseOptions{
+			Configuration: *configuration,
+			Recipe:        recipe,
+			Definition:    *definition,
+		},
+		PrevState: prevState,
+	})
+	if err != nil {
+		return nil, definition, err
+	}
+
+	return plan, definition, nil
+}
+
 // Delete calls the Delete method of the driver specified in the recipe definition to delete the output resources.
 func (e *engine) Delete(ctx context.Context, opts DeleteOptions) error {
 	deletionStart := time.Now()
@@ -145,8 +202,8 @@ func (e *engine) deleteCore(ctx context.Context, recipe recipes.ResourceMetadata
 }
 
 // Gets the Recipe metadata and parameters from Recipe's template path.
-func (e *engine) GetRecipeMetadata(ctx context.Context, recipeDefinition recipes.EnvironmentDefinition) (map[string]any, error) {
-	recipeData, err := e.getRecipeMetadataCore(ctx, recipeDefinition)
+func (e *engine) GetRecipeMetadata(ctx context.Context, recipeDefinition recipes.EnvironmentDefinition, recipeConfig datamodel.RecipeConfigProperties) (map[string]any, error) {
+	recipeData, err := e.getRecipeMetadataCore(ctx, recipeDefinition, recipeConfig)
 	if err != nil {
 		return nil, err
 	}
@@ -156,7 +213,7 @@ func (e *engine) GetRecipeMetadata(ctx context.Context, recipeDefinition recipes
 
 // getRecipeMetadataCore function is the core logic of the GetRecipeMetadata function.
 // Any changes to the core logic of the GetRecipeMetadata function should be made here.
-func (e *engine) getRecipeMetadataCore(ctx context.Context, recipeDefinition recipes.EnvironmentDefinition) (map[string]any, error) {
+func (e *engine) getRecipeMetadataCore(ctx context.Context, recipeDefinition recipes.EnvironmentDefinition, recipeConfig datamodel.RecipeConfigProperties) (map[string]any, error) {
 	// Determine Recipe driver type
 	driver, ok := e.options.Drivers[recipeDefinition.Driver]
 	if !ok {
@@ -164,8 +221,9 @@ func (e *engine) getRecipeMetadataCore(ctx context.Context, recipeDefinition rec
 	}
 
 	return driver.GetRecipeMetadata(ctx, recipedriver.BaseOptions{
-		Recipe:     recipes.ResourceMetadata{},
-		Definition: recipeDefinition,
+		Configuration: recipes.Configuration{RecipeConfig: recipeConfig},
+		Recipe:        recipes.ResourceMetadata{},
+		Definition:    recipeDefinition,
 	})
 }
 
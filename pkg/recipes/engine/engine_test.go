@@ -22,6 +22,7 @@ import (
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/recipes/configloader"
@@ -186,6 +187,100 @@ func Test_Engine_Execute_Failure(t *testing.T) {
 	require.Equal(t, err.Error(), "failed to execute recipe")
 }
 
+func Test_Engine_Execute_OutputSchema(t *testing.T) {
+	recipeMetadata := recipes.ResourceMetadata{
+		Name:          "mongo-azure",
+		ApplicationID: "/planes/radius/local/resourcegroups/test-rg/providers/applications.core/applications/app1",
+		EnvironmentID: "/planes/radius/local/resourcegroups/test-rg/providers/applications.core/environments/env1",
+		ResourceID:    "/planes/radius/local/resourceGroups/test-rg/providers/Microsoft.Resources/deployments/recipe",
+	}
+	envConfig := &recipes.Configuration{
+		Runtime: recipes.RuntimeConfiguration{
+			Kubernetes: &recipes.KubernetesRuntime{
+				Namespace: "default",
+			},
+		},
+	}
+	recipeDefinition := &recipes.EnvironmentDefinition{
+		Driver:       recipes.TemplateKindBicep,
+		TemplatePath: "ghcr.io/radius-project/dev/recipes/functionaltest/basic/mongodatabases/azure:1.0",
+		ResourceType: "Applications.Datastores/mongoDatabases",
+	}
+	outputSchema := &recipes.OutputSchema{
+		Fields: []recipes.OutputField{
+			{Name: "host", Type: recipes.OutputFieldTypeString, Required: true},
+			{Name: "port", Type: recipes.OutputFieldTypeInteger, Required: true},
+			{Name: "connectionString", Type: recipes.OutputFieldTypeString, Secret: true},
+		},
+	}
+
+	tests := []struct {
+		desc            string
+		recipeResult    *recipes.RecipeOutput
+		expectedDetails []v1.ErrorDetails
+	}{
+		{
+			desc: "valid output",
+			recipeResult: &recipes.RecipeOutput{
+				Secrets: map[string]any{"connectionString": "mongodb://testAccount1.mongo.cosmos.azure.com:10255"},
+				Values:  map[string]any{"host": "testAccount1.mongo.cosmos.azure.com", "port": 10255},
+			},
+		},
+		{
+			desc: "simulated deployment",
+		},
+		{
+			desc: "invalid output",
+			recipeResult: &recipes.RecipeOutput{
+				Values: map[string]any{"port": "10255", "connectionString": "mongodb://testAccount1.mongo.cosmos.azure.com:10255"},
+			},
+			expectedDetails: []v1.ErrorDetails{
+				{Code: recipes.InvalidRecipeOutputs, Message: "the field \"host\" is missing from the values of the recipe output", Target: "values.host"},
+				{Code: recipes.InvalidRecipeOutputs, Message: "the field \"port\" of the recipe output is expected to be of type integer, got string", Target: "values.port"},
+				{Code: recipes.InvalidRecipeOutputs, Message: "the field \"connectionString\" must be returned in the secrets of the recipe output, not in the values", Target: "secrets.connectionString"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			ctx := testcontext.New(t)
+			engine, configLoader, driver := setup(t)
+
+			configLoader.EXPECT().
+				LoadConfiguration(ctx, recipeMetadata).
+				Times(1).
+				Return(envConfig, nil)
+			configLoader.EXPECT().
+				LoadRecipe(ctx, &recipeMetadata).
+				Times(1).
+				Return(recipeDefinition, nil)
+			driver.EXPECT().
+				Execute(ctx, gomock.Any()).
+				Times(1).
+				Return(tt.recipeResult, nil)
+
+			result, err := engine.Execute(ctx, ExecuteOptions{
+				BaseOptions: BaseOptions{
+					Recipe: recipeMetadata,
+				},
+				OutputSchema: outputSchema,
+			})
+			if tt.expectedDetails == nil {
+				require.NoError(t, err)
+				require.Equal(t, tt.recipeResult, result)
+				return
+			}
+
+			require.Nil(t, result)
+			recipeError, ok := err.(*recipes.RecipeError)
+			require.True(t, ok)
+			require.Equal(t, recipes.InvalidRecipeOutputs, recipeError.ErrorDetails.Code)
+			require.Equal(t, tt.expectedDetails, recipeError.ErrorDetails.Details)
+		})
+	}
+}
+
 func Test_Engine_Terraform_Success(t *testing.T) {
 	recipeMetadata := recipes.ResourceMetadata{
 		Name:          "mongo-azure",
@@ -515,7 +610,7 @@ func Test_Engine_GetRecipeMetadata_Success(t *testing.T) {
 		Definition: recipeDefinition,
 	}).Times(1).Return(outputParams, nil)
 
-	recipeData, err := engine.GetRecipeMetadata(ctx, recipeDefinition)
+	recipeData, err := engine.GetRecipeMetadata(ctx, recipeDefinition, datamodel.RecipeConfigProperties{})
 	require.NoError(t, err)
 	require.Equal(t, outputParams, recipeData)
 }
@@ -531,7 +626,7 @@ func Test_GetRecipeMetadata_Driver_Error(t *testing.T) {
 		Definition: recipeDefinition,
 	}).Times(1).Return(nil, errors.New("driver failure"))
 
-	_, err := engine.GetRecipeMetadata(ctx, recipeDefinition)
+	_, err := engine.GetRecipeMetadata(ctx, recipeDefinition, datamodel.RecipeConfigProperties{})
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "driver failure")
 }
@@ -543,11 +638,87 @@ func Test_GetRecipeMetadata_Driver_InvalidDriver(t *testing.T) {
 	ctx := testcontext.New(t)
 	engine, _, _ := setup(t)
 
-	_, err := engine.GetRecipeMetadata(ctx, recipeDefinition)
+	_, err := engine.GetRecipeMetadata(ctx, recipeDefinition, datamodel.RecipeConfigProperties{})
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "could not find driver invalid")
 }
 
+func Test_Engine_Plan_Success(t *testing.T) {
+	recipeMetadata, recipeDefinition, _ := getRecipeInputs()
+	prevState := []string{
+		"/planes/kubernetes/local/namespaces/default/providers/apps/Deployment/redis",
+	}
+	envConfig := &recipes.Configuration{
+		Runtime: recipes.RuntimeConfiguration{
+			Kubernetes: &recipes.KubernetesRuntime{
+				Namespace: "default",
+			},
+		},
+	}
+	recipePlan := &recipes.RecipePlan{
+		Changes: []recipes.ResourceChange{
+			{
+				Resource:   prevState[0],
+				ChangeType: recipes.ChangeTypeDelete,
+			},
+		},
+	}
+	ctx := testcontext.New(t)
+	engine, configLoader, driver := setup(t)
+
+	configLoader.EXPECT().
+		LoadRecipe(ctx, &recipeMetadata).
+		Times(1).
+		Return(&recipeDefinition, nil)
+	configLoader.EXPECT().
+		LoadConfiguration(ctx, recipeMetadata).
+		Times(1).
+		Return(envConfig, nil)
+	driver.EXPECT().
+		Plan(ctx, recipedriver.ExecuteOptions{
+			BaseOptions: recipedriver.BaseOptions{
+				Configuration: *envConfig,
+				Recipe:        recipeMetadata,
+				Definition:    recipeDefinition,
+			},
+			PrevState: prevState,
+		}).
+		Times(1).
+		Return(recipePlan, nil)
+
+	plan, err := engine.Plan(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Recipe: recipeMetadata,
+		},
+		PreviousState: prevState,
+	})
+	require.NoError(t, err)
+	require.Equal(t, recipePlan, plan)
+}
+
+func Test_Engine_Plan_Load_Error(t *testing.T) {
+	recipeMetadata, recipeDefinition, _ := getRecipeInputs()
+	ctx := testcontext.New(t)
+	engine, configLoader, _ := setup(t)
+
+	configLoader.EXPECT().
+		LoadRecipe(ctx, &recipeMetadata).
+		Times(1).
+		Return(&recipeDefinition, nil)
+	configLoader.EXPECT().
+		LoadConfiguration(ctx, recipeMetadata).
+		Times(1).
+		Return(nil, errors.New("unable to fetch namespace information"))
+
+	_, err := engine.Plan(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Recipe: recipeMetadata,
+		},
+	})
+	require.Error(t, err)
+	require.Equal(t, recipes.RecipeConfigurationFailure, err.(*recipes.RecipeError).ErrorDetails.Code)
+}
+
 func getRecipeInputs() (recipes.ResourceMetadata, recipes.EnvironmentDefinition, []rpv1.OutputResource) {
 	recipeMetadata := recipes.ResourceMetadata{
 		Name:          "mongo-azure",
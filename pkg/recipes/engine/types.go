@@ -19,6 +19,7 @@ package engine
 import (
 	"context"
 
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/recipes"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 )
@@ -33,8 +34,14 @@ type Engine interface {
 	// Delete handles deletion of output resources for the recipe deployment.
 	Delete(ctx context.Context, opts DeleteOptions) error
 
-	// Gets the Recipe metadata and parameters from Recipe's template path
-	GetRecipeMetadata(ctx context.Context, recipeDefinition recipes.EnvironmentDefinition) (map[string]any, error)
+	// Gets the Recipe metadata and parameters from Recipe's template path. recipeConfig is the recipe configuration
+	// of the environment, which holds the credentials used to access the template.
+	GetRecipeMetadata(ctx context.Context, recipeDefinition recipes.EnvironmentDefinition, recipeConfig datamodel.RecipeConfigProperties) (map[string]any, error)
+
+	// Plan gathers environment configuration, recipe definition and calls the driver to compute the changes that
+	// deploying the recipe would make, without deploying it. prevState is used to find the resources that would be
+	// deleted, like in Execute.
+	Plan(ctx context.Context, opts ExecuteOptions) (*recipes.RecipePlan, error)
 }
 
 // BaseOptions is the base options for the engine operations.
@@ -50,6 +57,8 @@ type ExecuteOptions struct {
 	PreviousState []string
 	// Simulated is the flag to indicate if the execution is a simulation.
 	Simulated bool
+	// OutputSchema is the schema the recipe output is validated against after the recipe is executed (may be nil).
+	OutputSchema *recipes.OutputSchema
 }
 
 // DeleteOptions is the options for the Delete method.
@@ -20,6 +20,9 @@ const (
 	// Used for recipe deployment failures.
 	RecipeDeploymentFailed = "RecipeDeploymentFailed"
 
+	// Used for recipe plan failures.
+	RecipePlanFailed = "RecipePlanFailed"
+
 	// Used for recipe validation failures.
 	RecipeValidationFailed = "RecipeValidationFailed"
 
@@ -46,4 +49,7 @@ const (
 
 	// Used for errors with recipe configuration
 	RecipeConfigurationFailure = "RecipeConfigurationFailure"
+
+	// Used for errors encountered when loading the secrets referenced by the recipe configuration.
+	LoadSecretsFailed = "LoadSecretsFailed"
 )
@@ -0,0 +1,299 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/cli"
+	"helm.sh/helm/v3/pkg/registry"
+	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/storage/driver"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/restmapper"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+const (
+	// DefaultTimeout is the default time to wait for a release to be installed, upgraded or uninstalled.
+	DefaultTimeout = 10 * time.Minute
+
+	// helmStorageDriver makes Helm store the release information as Kubernetes secrets in the release namespace.
+	helmStorageDriver = "secret"
+
+	// maxHistory is the maximum number of release revisions kept by Helm for a release.
+	maxHistory = 10
+)
+
+var _ HelmClient = (*client)(nil)
+
+// NewClient creates a new HelmClient which manages releases in the Kubernetes cluster described by config.
+func NewClient(config *rest.Config, options ClientOptions) HelmClient {
+	if options.Timeout == 0 {
+		options.Timeout = DefaultTimeout
+	}
+
+	return &client{
+		config:  config,
+		options: options,
+	}
+}
+
+// client implements HelmClient using the Helm action package.
+type client struct {
+	config  *rest.Config
+	options ClientOptions
+}
+
+// LoadChart locates the chart referenced by templatePath, downloads it into a temporary directory and loads it.
+func (c *client) LoadChart(ctx context.Context, templatePath string, version string) (*chart.Chart, error) {
+	repoURL, chartRef, err := ParseChartReference(templatePath)
+	if err != nil {
+		return nil, err
+	}
+
+	registryClient, err := registry.NewClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create registry client: %w", err)
+	}
+
+	dir, err := os.MkdirTemp("", "helm-recipe-")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create directory to download chart: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	settings := cli.New()
+	settings.RepositoryCache = dir
+	settings.RepositoryConfig = filepath.Join(dir, "repositories.yaml")
+
+	// The chart path options need the registry client from an action to pull charts from OCI registries.
+	install := action.NewInstall(&action.Configuration{RegistryClient: registryClient})
+	install.ChartPathOptions.RepoURL = repoURL
+	install.ChartPathOptions.Version = version
+
+	chartPath, err := install.ChartPathOptions.LocateChart(chartRef, settings)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download chart %q: %w", templatePath, err)
+	}
+
+	return loader.Load(chartPath)
+}
+
+// InstallOrUpgrade installs the release if it does not exist yet, otherwise it upgrades the existing release.
+func (c *client) InstallOrUpgrade(ctx context.Context, options InstallOptions) (*Release, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	cfg, err := c.actionConfig(ctx, options.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	history := action.NewHistory(cfg)
+	history.Max = 1
+
+	var rel *release.Release
+	_, err = history.Run(options.ReleaseName)
+	if errors.Is(err, driver.ErrReleaseNotFound) {
+		logger.Info(fmt.Sprintf("Installing Helm release %q in namespace %q", options.ReleaseName, options.Namespace))
+		install := action.NewInstall(cfg)
+		install.Namespace = options.Namespace
+		install.ReleaseName = options.ReleaseName
+		install.Wait = !options.DryRun
+		install.DryRun = options.DryRun
+		install.Timeout = c.options.Timeout
+		rel, err = install.RunWithContext(ctx, options.Chart, options.Values)
+	} else if err == nil {
+		logger.Info(fmt.Sprintf("Upgrading Helm release %q in namespace %q", options.ReleaseName, options.Namespace))
+		upgrade := action.NewUpgrade(cfg)
+		upgrade.Namespace = options.Namespace
+		upgrade.Wait = !options.DryRun
+		upgrade.DryRun = options.DryRun
+		upgrade.Timeout = c.options.Timeout
+		upgrade.MaxHistory = maxHistory
+		rel, err = upgrade.RunWithContext(ctx, options.ReleaseName, options.Chart, options.Values)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	objects, err := releaseObjects(cfg, rel)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Release{
+		Name:      rel.Name,
+		Namespace: rel.Namespace,
+		Revision:  rel.Version,
+		Objects:   objects,
+	}
+	if rel.Info != nil {
+		result.Notes = rel.Info.Notes
+	}
+
+	return result, nil
+}
+
+// Uninstall uninstalls the release, ignoring releases which are not found.
+func (c *client) Uninstall(ctx context.Context, namespace string, releaseName string) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	cfg, err := c.actionConfig(ctx, namespace)
+	if err != nil {
+		return err
+	}
+
+	uninstall := action.NewUninstall(cfg)
+	uninstall.Wait = true
+	uninstall.Timeout = c.options.Timeout
+
+	_, err = uninstall.Run(releaseName)
+	if errors.Is(err, driver.ErrReleaseNotFound) {
+		logger.Info(fmt.Sprintf("Helm release %q in namespace %q was not found, skipping uninstall", releaseName, namespace))
+		return nil
+	}
+
+	return err
+}
+
+// actionConfig creates the Helm action configuration for the namespace.
+func (c *client) actionConfig(ctx context.Context, namespace string) (*action.Configuration, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	cfg := &action.Configuration{}
+	getter := &restClientGetter{config: c.config, namespace: namespace}
+	err := cfg.Init(getter, namespace, helmStorageDriver, func(format string, v ...any) {
+		logger.V(ucplog.LevelDebug).Info(fmt.Sprintf(format, v...))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize Helm configuration: %w", err)
+	}
+
+	return cfg, nil
+}
+
+// releaseObjects decodes the objects of the release manifest. The Kubernetes client resolves the scope of each object
+// so that the release namespace is set on namespace-scoped objects that don't specify one.
+func releaseObjects(cfg *action.Configuration, rel *release.Release) ([]*unstructured.Unstructured, error) {
+	infos, err := cfg.KubeClient.Build(bytes.NewBufferString(rel.Manifest), false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the manifest of release %q: %w", rel.Name, err)
+	}
+
+	objects := []*unstructured.Unstructured{}
+	for _, info := range infos {
+		obj, ok := info.Object.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+
+		if info.Namespaced() {
+			obj.SetNamespace(info.Namespace)
+		}
+		objects = append(objects, obj)
+	}
+
+	return objects, nil
+}
+
+// ParseChartReference splits the template path of a Helm recipe into the chart repository URL and the chart reference
+// used to locate the chart. The following template paths are supported:
+//
+//   - oci://<registry>/<repository>/<chart>: a chart stored in an OCI registry.
+//   - http(s)://<host>/<path>/<chart>-<version>.tgz: a chart archive downloaded directly.
+//   - http(s)://<host>/<path>/<chart>: a chart in the HTTP chart repository at http(s)://<host>/<path>.
+//
+// The repository URL is empty unless the chart is located through an HTTP chart repository.
+func ParseChartReference(templatePath string) (repoURL string, chartRef string, err error) {
+	if registry.IsOCI(templatePath) {
+		return "", templatePath, nil
+	}
+
+	u, err := url.Parse(templatePath)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return "", "", fmt.Errorf("invalid chart reference %q: the template path must be an oci:// or http(s):// URL", templatePath)
+	}
+
+	if strings.HasSuffix(u.Path, ".tgz") {
+		return "", templatePath, nil
+	}
+
+	chartName := path.Base(u.Path)
+	if chartName == "/" || chartName == "." {
+		return "", "", fmt.Errorf("invalid chart reference %q: the template path must include the chart name", templatePath)
+	}
+
+	u.Path = strings.TrimSuffix(path.Dir(u.Path), "/")
+	return u.String(), chartName, nil
+}
+
+// restClientGetter implements the RESTClientGetter used by Helm for a rest.Config, with clients scoped to a namespace.
+type restClientGetter struct {
+	config    *rest.Config
+	namespace string
+}
+
+// ToRESTConfig returns a copy of the REST config.
+func (g *restClientGetter) ToRESTConfig() (*rest.Config, error) {
+	return rest.CopyConfig(g.config), nil
+}
+
+// ToDiscoveryClient returns a discovery client with an in-memory cache.
+func (g *restClientGetter) ToDiscoveryClient() (discovery.CachedDiscoveryInterface, error) {
+	dc, err := discovery.NewDiscoveryClientForConfig(rest.CopyConfig(g.config))
+	if err != nil {
+		return nil, err
+	}
+
+	return memory.NewMemCacheClient(dc), nil
+}
+
+// ToRESTMapper returns a REST mapper backed by the discovery client.
+func (g *restClientGetter) ToRESTMapper() (meta.RESTMapper, error) {
+	dc, err := g.ToDiscoveryClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return restmapper.NewDeferredDiscoveryRESTMapper(dc), nil
+}
+
+// ToRawKubeConfigLoader returns a client config which only provides the namespace.
+func (g *restClientGetter) ToRawKubeConfigLoader() clientcmd.ClientConfig {
+	return clientcmd.NewDefaultClientConfig(clientcmdapi.Config{}, &clientcmd.ConfigOverrides{
+		Context: clientcmdapi.Context{Namespace: g.namespace},
+	})
+}
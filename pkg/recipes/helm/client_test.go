@@ -0,0 +1,101 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/rest"
+)
+
+func Test_ParseChartReference(t *testing.T) {
+	tests := []struct {
+		name         string
+		templatePath string
+		repoURL      string
+		chartRef     string
+		err          string
+	}{
+		{
+			name:         "oci registry",
+			templatePath: "oci://myregistry.azurecr.io/charts/redis",
+			chartRef:     "oci://myregistry.azurecr.io/charts/redis",
+		},
+		{
+			name:         "http chart repository",
+			templatePath: "https://charts.example.com/stable/redis",
+			repoURL:      "https://charts.example.com/stable",
+			chartRef:     "redis",
+		},
+		{
+			name:         "http chart repository at the root",
+			templatePath: "http://charts.example.com/redis",
+			repoURL:      "http://charts.example.com",
+			chartRef:     "redis",
+		},
+		{
+			name:         "chart archive",
+			templatePath: "https://charts.example.com/stable/redis-1.0.0.tgz",
+			chartRef:     "https://charts.example.com/stable/redis-1.0.0.tgz",
+		},
+		{
+			name:         "missing chart name",
+			templatePath: "https://charts.example.com/",
+			err:          "invalid chart reference \"https://charts.example.com/\": the template path must include the chart name",
+		},
+		{
+			name:         "unsupported scheme",
+			templatePath: "ghcr.io/radius-project/recipes/redis:latest",
+			err:          "invalid chart reference \"ghcr.io/radius-project/recipes/redis:latest\": the template path must be an oci:// or http(s):// URL",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			repoURL, chartRef, err := ParseChartReference(tc.templatePath)
+			if tc.err != "" {
+				require.EqualError(t, err, tc.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.repoURL, repoURL)
+			require.Equal(t, tc.chartRef, chartRef)
+		})
+	}
+}
+
+func Test_RESTClientGetter(t *testing.T) {
+	config := &rest.Config{Host: "https://localhost:6443"}
+	getter := &restClientGetter{config: config, namespace: "app-ns"}
+
+	restConfig, err := getter.ToRESTConfig()
+	require.NoError(t, err)
+	require.Equal(t, config.Host, restConfig.Host)
+	require.NotSame(t, config, restConfig)
+
+	namespace, overridden, err := getter.ToRawKubeConfigLoader().Namespace()
+	require.NoError(t, err)
+	require.True(t, overridden)
+	require.Equal(t, "app-ns", namespace)
+}
+
+func Test_NewClient_DefaultTimeout(t *testing.T) {
+	c := NewClient(&rest.Config{}, ClientOptions{})
+	require.Equal(t, DefaultTimeout, c.(*client).options.Timeout)
+}
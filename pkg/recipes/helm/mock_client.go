@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/radius-project/radius/pkg/recipes/helm (interfaces: HelmClient)
+
+// Package helm is a generated GoMock package.
+package helm
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	chart "helm.sh/helm/v3/pkg/chart"
+)
+
+// MockHelmClient is a mock of HelmClient interface.
+type MockHelmClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockHelmClientMockRecorder
+}
+
+// MockHelmClientMockRecorder is the mock recorder for MockHelmClient.
+type MockHelmClientMockRecorder struct {
+	mock *MockHelmClient
+}
+
+// NewMockHelmClient creates a new mock instance.
+func NewMockHelmClient(ctrl *gomock.Controller) *MockHelmClient {
+	mock := &MockHelmClient{ctrl: ctrl}
+	mock.recorder = &MockHelmClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHelmClient) EXPECT() *MockHelmClientMockRecorder {
+	return m.recorder
+}
+
+// InstallOrUpgrade mocks base method.
+func (m *MockHelmClient) InstallOrUpgrade(arg0 context.Context, arg1 InstallOptions) (*Release, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstallOrUpgrade", arg0, arg1)
+	ret0, _ := ret[0].(*Release)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InstallOrUpgrade indicates an expected call of InstallOrUpgrade.
+func (mr *MockHelmClientMockRecorder) InstallOrUpgrade(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallOrUpgrade", reflect.TypeOf((*MockHelmClient)(nil).InstallOrUpgrade), arg0, arg1)
+}
+
+// LoadChart mocks base method.
+func (m *MockHelmClient) LoadChart(arg0 context.Context, arg1, arg2 string) (*chart.Chart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoadChart", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*chart.Chart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LoadChart indicates an expected call of LoadChart.
+func (mr *MockHelmClientMockRecorder) LoadChart(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadChart", reflect.TypeOf((*MockHelmClient)(nil).LoadChart), arg0, arg1, arg2)
+}
+
+// Uninstall mocks base method.
+func (m *MockHelmClient) Uninstall(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Uninstall", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Uninstall indicates an expected call of Uninstall.
+func (mr *MockHelmClientMockRecorder) Uninstall(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Uninstall", reflect.TypeOf((*MockHelmClient)(nil).Uninstall), arg0, arg1, arg2)
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"context"
+	"time"
+
+	"helm.sh/helm/v3/pkg/chart"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+//go:generate mockgen -destination=./mock_client.go -package=helm -self_package github.com/radius-project/radius/pkg/recipes/helm github.com/radius-project/radius/pkg/recipes/helm HelmClient
+
+// HelmClient is an interface to install, upgrade and uninstall the Helm releases created for Helm recipes.
+type HelmClient interface {
+	// LoadChart downloads the chart referenced by the recipe template path from an OCI registry or an HTTP chart repository.
+	// An empty version selects the latest version of the chart.
+	LoadChart(ctx context.Context, templatePath string, version string) (*chart.Chart, error)
+
+	// InstallOrUpgrade installs the chart as a new release, or upgrades the release if it already exists, and waits
+	// for the release resources to be ready. With InstallOptions.DryRun, the release is rendered but not deployed.
+	InstallOrUpgrade(ctx context.Context, options InstallOptions) (*Release, error)
+
+	// Uninstall uninstalls the release and waits for its resources to be deleted. Uninstalling a release that does not exist
+	// is not an error.
+	Uninstall(ctx context.Context, namespace string, releaseName string) error
+}
+
+// ClientOptions represents the options used to create a HelmClient.
+type ClientOptions struct {
+	// Timeout is the maximum time to wait for a release to be installed, upgraded or uninstalled. Defaults to DefaultTimeout.
+	Timeout time.Duration
+}
+
+// InstallOptions represents the options required to install or upgrade a Helm release.
+type InstallOptions struct {
+	// Namespace is the Kubernetes namespace the release is installed into.
+	Namespace string
+
+	// ReleaseName is the name of the Helm release.
+	ReleaseName string
+
+	// Chart is the chart to install.
+	Chart *chart.Chart
+
+	// Values are the values passed to the chart. They override the default values of the chart.
+	Values map[string]any
+
+	// DryRun renders the chart and returns the resulting release without installing or upgrading it.
+	DryRun bool
+}
+
+// Release represents a deployed Helm release.
+type Release struct {
+	// Name is the name of the release.
+	Name string
+
+	// Namespace is the Kubernetes namespace of the release.
+	Namespace string
+
+	// Revision is the revision number of the release.
+	Revision int
+
+	// Notes are the rendered notes of the chart.
+	Notes string
+
+	// Objects are the Kubernetes objects rendered by the chart. The namespace is set for all namespace-scoped objects.
+	Objects []*unstructured.Unstructured
+}
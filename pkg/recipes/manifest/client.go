@@ -0,0 +1,236 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content/file"
+	"oras.land/oras-go/v2/registry"
+	"oras.land/oras-go/v2/registry/remote"
+
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// FieldManager is the field manager used to server-side apply the objects of manifest recipes.
+	FieldManager = "radius-recipe"
+
+	ociPrefix = "oci://"
+	gitPrefix = "git::"
+)
+
+var _ ManifestClient = (*client)(nil)
+
+// NewClient creates a new ManifestClient which applies objects using the given Kubernetes client.
+func NewClient(runtimeClient runtimeclient.Client) ManifestClient {
+	return &client{runtimeClient: runtimeClient}
+}
+
+// client implements ManifestClient.
+type client struct {
+	runtimeClient runtimeclient.Client
+}
+
+// Fetch downloads the manifests from an OCI registry or a git repository.
+func (c *client) Fetch(ctx context.Context, templatePath string, dir string) error {
+	switch {
+	case strings.HasPrefix(templatePath, ociPrefix):
+		return fetchOCI(ctx, templatePath, dir)
+	case strings.HasPrefix(templatePath, gitPrefix):
+		return fetchGit(ctx, templatePath, dir)
+	default:
+		return fmt.Errorf("invalid template path %q: the template path must start with %q or %q", templatePath, ociPrefix, gitPrefix)
+	}
+}
+
+// Apply server-side applies the objects in order, forcing ownership of conflicting fields.
+func (c *client) Apply(ctx context.Context, namespace string, objects []*unstructured.Unstructured) ([]*unstructured.Unstructured, error) {
+	return c.apply(ctx, namespace, objects, false)
+}
+
+// DryRunApply server-side applies each object with a dry run. Objects whose namespace doesn't exist yet can't be validated
+// by the API server, so they are returned as rendered.
+func (c *client) DryRunApply(ctx context.Context, namespace string, objects []*unstructured.Unstructured) ([]*unstructured.Unstructured, error) {
+	return c.apply(ctx, namespace, objects, true)
+}
+
+func (c *client) apply(ctx context.Context, namespace string, objects []*unstructured.Unstructured, dryRun bool) ([]*unstructured.Unstructured, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	opts := []runtimeclient.PatchOption{runtimeclient.FieldOwner(FieldManager), runtimeclient.ForceOwnership}
+	if dryRun {
+		opts = append(opts, runtimeclient.DryRunAll)
+	}
+
+	applied := []*unstructured.Unstructured{}
+	for _, obj := range objects {
+		obj = obj.DeepCopy()
+
+		namespaced, err := c.runtimeClient.IsObjectNamespaced(obj)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find the scope of %s %q: %w", obj.GetKind(), obj.GetName(), err)
+		}
+		if namespaced && obj.GetNamespace() == "" {
+			obj.SetNamespace(namespace)
+		}
+
+		logger.Info(fmt.Sprintf("Applying %s %q in namespace %q (dry run: %t)", obj.GetKind(), obj.GetName(), obj.GetNamespace(), dryRun))
+		rendered := obj.DeepCopy()
+		err = c.runtimeClient.Patch(ctx, obj, runtimeclient.Apply, opts...)
+		if dryRun && namespaced && apierrors.IsNotFound(err) {
+			applied = append(applied, rendered)
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to apply %s %q: %w", obj.GetKind(), obj.GetName(), err)
+		}
+		applied = append(applied, obj)
+	}
+
+	return applied, nil
+}
+
+// fetchOCI pulls the files of the OCI artifact referenced by templatePath into dir. Files are stored using the file
+// name annotation of each layer, and directories pushed as a single layer are extracted.
+func fetchOCI(ctx context.Context, templatePath string, dir string) error {
+	ref, err := registry.ParseReference(strings.TrimPrefix(templatePath, ociPrefix))
+	if err != nil {
+		return fmt.Errorf("invalid template path %q: %w", templatePath, err)
+	}
+	if ref.Reference == "" {
+		ref.Reference = "latest"
+	}
+
+	repo, err := remote.NewRepository(ref.Registry + "/" + ref.Repository)
+	if err != nil {
+		return fmt.Errorf("failed to create client to registry %s", err.Error())
+	}
+
+	store, err := file.New(dir)
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	_, err = oras.Copy(ctx, repo, ref.Reference, store, ref.Reference, oras.DefaultCopyOptions)
+	if err != nil {
+		return fmt.Errorf("failed to pull %q: %w", templatePath, err)
+	}
+
+	return nil
+}
+
+// fetchGit clones the git repository referenced by templatePath and copies the referenced path into dir.
+// It requires the git executable.
+func fetchGit(ctx context.Context, templatePath string, dir string) error {
+	repoURL, subDir, ref, err := ParseGitSource(templatePath)
+	if err != nil {
+		return err
+	}
+
+	cloneDir, err := os.MkdirTemp("", "manifest-recipe-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(cloneDir)
+
+	args := []string{"clone", "--depth", "1"}
+	if ref != "" {
+		args = append(args, "--branch", ref)
+	}
+	args = append(args, repoURL, cloneDir)
+
+	out, err := exec.CommandContext(ctx, "git", args...).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("failed to clone %q: %w: %s", repoURL, err, strings.TrimSpace(string(out)))
+	}
+
+	return copyDir(filepath.Join(cloneDir, subDir), dir)
+}
+
+// ParseGitSource parses a git template path of the form git::<repository-url>//<path>?ref=<ref> into the repository
+// URL, the path in the repository and the git reference. The path and the reference are optional.
+func ParseGitSource(templatePath string) (repoURL string, subDir string, ref string, err error) {
+	u, err := url.Parse(strings.TrimPrefix(templatePath, gitPrefix))
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return "", "", "", fmt.Errorf("invalid template path %q: the git repository must be a URL", templatePath)
+	}
+
+	ref = u.Query().Get("ref")
+	u.RawQuery = ""
+
+	if before, after, found := strings.Cut(u.Path, "//"); found {
+		u.Path = before
+		subDir = filepath.Clean(after)
+		if filepath.IsAbs(subDir) || subDir == ".." || strings.HasPrefix(subDir, "../") {
+			return "", "", "", fmt.Errorf("invalid template path %q: the path must be within the git repository", templatePath)
+		}
+	}
+
+	return u.String(), subDir, ref, nil
+}
+
+// copyDir copies the regular files of src into dst, skipping the .git directory.
+func copyDir(src string, dst string) error {
+	info, err := os.Stat(src)
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		return errors.New("the path in the git repository must be a directory")
+	}
+
+	return filepath.WalkDir(src, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			if d.Name() == ".git" {
+				return filepath.SkipDir
+			}
+			return os.MkdirAll(filepath.Join(dst, rel), 0755)
+		}
+
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(filepath.Join(dst, rel), b, 0644)
+	})
+}
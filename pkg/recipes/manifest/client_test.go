@@ -0,0 +1,79 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParseGitSource(t *testing.T) {
+	tests := []struct {
+		name         string
+		templatePath string
+		repoURL      string
+		subDir       string
+		ref          string
+		err          string
+	}{
+		{
+			name:         "repository root",
+			templatePath: "git::https://github.com/myorg/recipes.git",
+			repoURL:      "https://github.com/myorg/recipes.git",
+		},
+		{
+			name:         "path and ref",
+			templatePath: "git::https://github.com/myorg/recipes.git//kubernetes/redis?ref=v1.0.0",
+			repoURL:      "https://github.com/myorg/recipes.git",
+			subDir:       "kubernetes/redis",
+			ref:          "v1.0.0",
+		},
+		{
+			name:         "path outside the repository",
+			templatePath: "git::https://github.com/myorg/recipes.git//../redis",
+			err:          "invalid template path \"git::https://github.com/myorg/recipes.git//../redis\": the path must be within the git repository",
+		},
+		{
+			name:         "not a url",
+			templatePath: "git::myorg/recipes",
+			err:          "invalid template path \"git::myorg/recipes\": the git repository must be a URL",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			repoURL, subDir, ref, err := ParseGitSource(tc.templatePath)
+			if tc.err != "" {
+				require.EqualError(t, err, tc.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.repoURL, repoURL)
+			require.Equal(t, tc.subDir, subDir)
+			require.Equal(t, tc.ref, ref)
+		})
+	}
+}
+
+func Test_Fetch_InvalidTemplatePath(t *testing.T) {
+	c := NewClient(nil)
+	err := c.Fetch(context.Background(), "https://example.com/manifests.yaml", t.TempDir())
+	require.EqualError(t, err, "invalid template path \"https://example.com/manifests.yaml\": the template path must start with \"oci://\" or \"git::\"")
+}
@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/radius-project/radius/pkg/recipes/manifest (interfaces: ManifestClient)
+
+// Package manifest is a generated GoMock package.
+package manifest
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	unstructured "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// MockManifestClient is a mock of ManifestClient interface.
+type MockManifestClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockManifestClientMockRecorder
+}
+
+// MockManifestClientMockRecorder is the mock recorder for MockManifestClient.
+type MockManifestClientMockRecorder struct {
+	mock *MockManifestClient
+}
+
+// NewMockManifestClient creates a new mock instance.
+func NewMockManifestClient(ctrl *gomock.Controller) *MockManifestClient {
+	mock := &MockManifestClient{ctrl: ctrl}
+	mock.recorder = &MockManifestClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockManifestClient) EXPECT() *MockManifestClientMockRecorder {
+	return m.recorder
+}
+
+// Apply mocks base method.
+func (m *MockManifestClient) Apply(arg0 context.Context, arg1 string, arg2 []*unstructured.Unstructured) ([]*unstructured.Unstructured, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Apply", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*unstructured.Unstructured)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Apply indicates an expected call of Apply.
+func (mr *MockManifestClientMockRecorder) Apply(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Apply", reflect.TypeOf((*MockManifestClient)(nil).Apply), arg0, arg1, arg2)
+}
+
+// DryRunApply mocks base method.
+func (m *MockManifestClient) DryRunApply(arg0 context.Context, arg1 string, arg2 []*unstructured.Unstructured) ([]*unstructured.Unstructured, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DryRunApply", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*unstructured.Unstructured)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DryRunApply indicates an expected call of DryRunApply.
+func (mr *MockManifestClientMockRecorder) DryRunApply(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DryRunApply", reflect.TypeOf((*MockManifestClient)(nil).DryRunApply), arg0, arg1, arg2)
+}
+
+// Fetch mocks base method.
+func (m *MockManifestClient) Fetch(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Fetch", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Fetch indicates an expected call of Fetch.
+func (mr *MockManifestClientMockRecorder) Fetch(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fetch", reflect.TypeOf((*MockManifestClient)(nil).Fetch), arg0, arg1, arg2)
+}
@@ -0,0 +1,305 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+	"text/template/parse"
+
+	"golang.org/x/exp/maps"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8syaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/kustomize/api/krusty"
+	"sigs.k8s.io/kustomize/kyaml/filesys"
+)
+
+const (
+	// ContextKey is the key used to access the recipe context in the manifests, e.g. {{ .context.resource.name }}.
+	ContextKey = "context"
+
+	// ParametersKey is the key used to access the recipe parameters in the manifests, e.g. {{ .parameters.size }}.
+	ParametersKey = "parameters"
+)
+
+// kustomizationFileNames are the file names which identify a directory as a Kustomize kustomization.
+var kustomizationFileNames = []string{"kustomization.yaml", "kustomization.yml", "Kustomization"}
+
+// Render templates the manifests in dir with the given data and returns the resulting Kubernetes objects. Files with
+// .yaml, .yml or .json extensions are Go templates which can reference the recipe context and parameters. If dir contains
+// a kustomization, the templated files are built with Kustomize, otherwise the objects of every file are returned in
+// the order of the file names.
+func Render(dir string, data map[string]any) ([]*unstructured.Unstructured, error) {
+	files, err := templateFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	rendered := map[string][]byte{}
+	for _, name := range files {
+		tmpl, err := parseTemplate(dir, name)
+		if err != nil {
+			return nil, err
+		}
+
+		buf := &bytes.Buffer{}
+		if err := tmpl.Execute(buf, data); err != nil {
+			return nil, fmt.Errorf("failed to render %q: %w", name, err)
+		}
+
+		// Missing keys render as "<no value>" when the data is a map, which is never a meaningful manifest value.
+		rendered[name] = bytes.ReplaceAll(buf.Bytes(), []byte("<no value>"), []byte(""))
+	}
+
+	if isKustomization(files) {
+		return kustomize(rendered)
+	}
+
+	objects := []*unstructured.Unstructured{}
+	for _, name := range files {
+		decoded, err := decode(rendered[name])
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode %q: %w", name, err)
+		}
+		objects = append(objects, decoded...)
+	}
+
+	if len(objects) == 0 {
+		return nil, errors.New("the recipe doesn't contain any Kubernetes manifests")
+	}
+
+	return objects, nil
+}
+
+// Parameters returns the names of the parameters referenced by the manifests in dir, in sorted order.
+func Parameters(dir string) ([]string, error) {
+	files, err := templateFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	parameters := map[string]bool{}
+	for _, name := range files {
+		tmpl, err := parseTemplate(dir, name)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, t := range tmpl.Templates() {
+			if t.Tree != nil {
+				findParameters(t.Tree.Root, parameters)
+			}
+		}
+	}
+
+	names := maps.Keys(parameters)
+	sort.Strings(names)
+	return names, nil
+}
+
+// templateFiles returns the sorted paths, relative to dir, of the manifest files in dir.
+func templateFiles(dir string) ([]string, error) {
+	files := []string{}
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+
+		switch strings.ToLower(filepath.Ext(path)) {
+		case ".yaml", ".yml", ".json":
+			files = append(files, filepath.ToSlash(rel))
+		default:
+			if d.Name() == "Kustomization" {
+				files = append(files, filepath.ToSlash(rel))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the recipe manifests: %w", err)
+	}
+
+	sort.Strings(files)
+	return files, nil
+}
+
+// parseTemplate parses the manifest file with the given path relative to dir as a Go template.
+func parseTemplate(dir string, name string) (*template.Template, error) {
+	b, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(name)))
+	if err != nil {
+		return nil, err
+	}
+
+	tmpl, err := template.New(name).Funcs(templateFuncs).Option("missingkey=zero").Parse(string(b))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %q: %w", name, err)
+	}
+
+	return tmpl, nil
+}
+
+// templateFuncs are the functions available to manifest templates in addition to the Go template builtins.
+var templateFuncs = template.FuncMap{
+	"default": func(def any, value any) any {
+		if value == nil || value == "" {
+			return def
+		}
+		return value
+	},
+	"quote": func(value any) string {
+		return fmt.Sprintf("%q", fmt.Sprint(value))
+	},
+	"toJson": func(value any) (string, error) {
+		b, err := json.Marshal(value)
+		return string(b), err
+	},
+	"b64enc": func(value string) string {
+		return base64.StdEncoding.EncodeToString([]byte(value))
+	},
+}
+
+// findParameters adds the names of the parameters referenced as .parameters.<name> in the node to parameters.
+func findParameters(node parse.Node, parameters map[string]bool) {
+	switch n := node.(type) {
+	case *parse.FieldNode:
+		if len(n.Ident) > 1 && n.Ident[0] == ParametersKey {
+			parameters[n.Ident[1]] = true
+		}
+	case *parse.ListNode:
+		if n != nil {
+			for _, child := range n.Nodes {
+				findParameters(child, parameters)
+			}
+		}
+	case *parse.ActionNode:
+		findParameters(n.Pipe, parameters)
+	case *parse.PipeNode:
+		if n != nil {
+			for _, cmd := range n.Cmds {
+				findParameters(cmd, parameters)
+			}
+		}
+	case *parse.CommandNode:
+		for _, arg := range n.Args {
+			findParameters(arg, parameters)
+		}
+	case *parse.IfNode:
+		findBranchParameters(&n.BranchNode, parameters)
+	case *parse.RangeNode:
+		findBranchParameters(&n.BranchNode, parameters)
+	case *parse.WithNode:
+		findBranchParameters(&n.BranchNode, parameters)
+	case *parse.TemplateNode:
+		findParameters(n.Pipe, parameters)
+	}
+}
+
+func findBranchParameters(n *parse.BranchNode, parameters map[string]bool) {
+	findParameters(n.Pipe, parameters)
+	findParameters(n.List, parameters)
+	findParameters(n.ElseList, parameters)
+}
+
+// isKustomization returns true if the files contain a kustomization at the root.
+func isKustomization(files []string) bool {
+	for _, name := range kustomizationFileNames {
+		for _, file := range files {
+			if file == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// kustomize builds the kustomization in the rendered files using an in-memory file system.
+func kustomize(rendered map[string][]byte) ([]*unstructured.Unstructured, error) {
+	fSys := filesys.MakeFsInMemory()
+	for name, content := range rendered {
+		if err := fSys.WriteFile("/"+name, content); err != nil {
+			return nil, err
+		}
+	}
+
+	resMap, err := krusty.MakeKustomizer(krusty.MakeDefaultOptions()).Run(fSys, "/")
+	if err != nil {
+		return nil, fmt.Errorf("failed to build the kustomization: %w", err)
+	}
+
+	b, err := resMap.AsYaml()
+	if err != nil {
+		return nil, err
+	}
+
+	return decode(b)
+}
+
+// decode decodes the Kubernetes objects of a YAML or JSON stream, skipping empty documents.
+func decode(b []byte) ([]*unstructured.Unstructured, error) {
+	objects := []*unstructured.Unstructured{}
+	decoder := k8syaml.NewYAMLOrJSONDecoder(bytes.NewReader(b), 4096)
+	for {
+		obj := map[string]any{}
+		err := decoder.Decode(&obj)
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+
+		if len(obj) == 0 {
+			continue
+		}
+
+		u := &unstructured.Unstructured{Object: obj}
+		if u.GetKind() == "" || u.GetAPIVersion() == "" {
+			return nil, fmt.Errorf("object %q must specify apiVersion and kind", u.GetName())
+		}
+
+		// Round-trip through the unstructured JSON decoder so that numbers are decoded as int64 like other objects
+		// read from Kubernetes.
+		b, err := json.Marshal(obj)
+		if err != nil {
+			return nil, err
+		}
+		u = &unstructured.Unstructured{}
+		if err := u.UnmarshalJSON(b); err != nil {
+			return nil, err
+		}
+		objects = append(objects, u)
+	}
+
+	return objects, nil
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testData() map[string]any {
+	return map[string]any{
+		ContextKey: map[string]any{
+			"resource": map[string]any{
+				"name": "redis",
+			},
+			"runtime": map[string]any{
+				"kubernetes": map[string]any{
+					"namespace": "default-app",
+				},
+			},
+		},
+		ParametersKey: map[string]any{
+			"port":     6380,
+			"replicas": 3,
+		},
+	}
+}
+
+func Test_Render(t *testing.T) {
+	objects, err := Render("testdata/plain", testData())
+	require.NoError(t, err)
+	require.Len(t, objects, 3)
+
+	require.Equal(t, "ConfigMap", objects[0].GetKind())
+	require.Equal(t, "redis", objects[0].GetName())
+	require.Equal(t, map[string]any{
+		"host": "redis.default-app.svc.cluster.local",
+		"port": "6380",
+	}, objects[0].Object["data"])
+
+	require.Equal(t, "Deployment", objects[1].GetKind())
+	spec := objects[1].Object["spec"].(map[string]any)
+	require.Equal(t, int64(3), spec["replicas"])
+	containers := spec["template"].(map[string]any)["spec"].(map[string]any)["containers"].([]any)
+	require.Equal(t, "redis:6", containers[0].(map[string]any)["image"])
+
+	require.Equal(t, "Service", objects[2].GetKind())
+}
+
+func Test_Render_Kustomize(t *testing.T) {
+	objects, err := Render("testdata/kustomize", testData())
+	require.NoError(t, err)
+	require.Len(t, objects, 1)
+
+	require.Equal(t, "Service", objects[0].GetKind())
+	require.Equal(t, "redis-redis", objects[0].GetName())
+	require.Equal(t, map[string]string{"app": "redis"}, objects[0].GetLabels())
+}
+
+func Test_Render_Invalid(t *testing.T) {
+	t.Run("no manifests", func(t *testing.T) {
+		_, err := Render(t.TempDir(), testData())
+		require.EqualError(t, err, "the recipe doesn't contain any Kubernetes manifests")
+	})
+
+	t.Run("missing kind", func(t *testing.T) {
+		dir := t.TempDir()
+		err := os.WriteFile(filepath.Join(dir, "configmap.yaml"), []byte("apiVersion: v1\nmetadata:\n  name: test\n"), 0644)
+		require.NoError(t, err)
+
+		_, err = Render(dir, testData())
+		require.EqualError(t, err, "failed to decode \"configmap.yaml\": object \"test\" must specify apiVersion and kind")
+	})
+
+	t.Run("invalid template", func(t *testing.T) {
+		dir := t.TempDir()
+		err := os.WriteFile(filepath.Join(dir, "configmap.yaml"), []byte("name: {{ .context.resource.name "), 0644)
+		require.NoError(t, err)
+
+		_, err = Render(dir, testData())
+		require.ErrorContains(t, err, "failed to parse \"configmap.yaml\"")
+	})
+}
+
+func Test_Parameters(t *testing.T) {
+	parameters, err := Parameters("testdata/plain")
+	require.NoError(t, err)
+	require.Equal(t, []string{"image", "port", "replicas"}, parameters)
+
+	parameters, err = Parameters("testdata/kustomize")
+	require.NoError(t, err)
+	require.Equal(t, []string{"port"}, parameters)
+}
@@ -0,0 +1,41 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+//go:generate mockgen -destination=./mock_client.go -package=manifest -self_package github.com/radius-project/radius/pkg/recipes/manifest github.com/radius-project/radius/pkg/recipes/manifest ManifestClient
+
+// ManifestClient is an interface to download and apply the Kubernetes manifests of manifest recipes.
+type ManifestClient interface {
+	// Fetch downloads the manifests referenced by the recipe template path into dir. The template path references
+	// either an OCI artifact (oci://<registry>/<repository>:<tag>) or a path in a git repository
+	// (git::<repository-url>//<path>?ref=<ref>).
+	Fetch(ctx context.Context, templatePath string, dir string) error
+
+	// Apply applies the objects using server-side apply and returns the applied objects. Namespace-scoped objects which
+	// don't specify a namespace are applied to the given namespace.
+	Apply(ctx context.Context, namespace string, objects []*unstructured.Unstructured) ([]*unstructured.Unstructured, error)
+
+	// DryRunApply validates the objects with a server-side dry run of Apply and returns the objects as they would be
+	// applied, without persisting them.
+	DryRunApply(ctx context.Context, namespace string, objects []*unstructured.Unstructured) ([]*unstructured.Unstructured, error)
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recipes
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/recipes/util"
+)
+
+// OutputFieldType represents the type of the value of a recipe output field.
+type OutputFieldType string
+
+const (
+	// OutputFieldTypeString indicates the field is a string.
+	OutputFieldTypeString OutputFieldType = "string"
+
+	// OutputFieldTypeInteger indicates the field is an integer number.
+	OutputFieldTypeInteger OutputFieldType = "integer"
+
+	// OutputFieldTypeBoolean indicates the field is a boolean.
+	OutputFieldTypeBoolean OutputFieldType = "boolean"
+)
+
+// OutputField describes a field of the recipe output.
+type OutputField struct {
+	// Name is the name of the field.
+	Name string
+
+	// Type is the type of the value of the field.
+	Type OutputFieldType
+
+	// Secret indicates the field must be returned in the secrets of the recipe output rather than in the values.
+	Secret bool
+
+	// Required indicates the recipe must return the field.
+	Required bool
+}
+
+// OutputSchema describes the outputs a recipe must return for a resource type. The outputs not described by the
+// schema are not validated.
+type OutputSchema struct {
+	// Fields is the list of fields of the recipe output.
+	Fields []OutputField
+}
+
+// WithProvided returns a copy of the schema where the given fields are optional. It is used for the fields whose value
+// is provided by the resource, which takes precedence over the recipe output.
+func (s OutputSchema) WithProvided(names ...string) OutputSchema {
+	provided := map[string]bool{}
+	for _, name := range names {
+		provided[name] = true
+	}
+
+	fields := make([]OutputField, len(s.Fields))
+	for i, field := range s.Fields {
+		if provided[field.Name] {
+			field.Required = false
+		}
+		fields[i] = field
+	}
+
+	return OutputSchema{Fields: fields}
+}
+
+// Validate checks the recipe output against the schema. It returns a RecipeError with the InvalidRecipeOutputs code
+// listing every field that is missing, has the wrong type, or is returned as a value instead of a secret (or vice versa).
+func (s OutputSchema) Validate(resourceType string, output *RecipeOutput) error {
+	if output == nil {
+		return nil
+	}
+
+	details := []*v1.ErrorDetails{}
+	for _, field := range s.Fields {
+		location, otherLocation := "values", "secrets"
+		outputs, otherOutputs := output.Values, output.Secrets
+		if field.Secret {
+			location, otherLocation = otherLocation, location
+			outputs, otherOutputs = otherOutputs, outputs
+		}
+
+		value, ok := outputs[field.Name]
+		if !ok {
+			if _, misplaced := otherOutputs[field.Name]; misplaced {
+				details = append(details, outputError(location, field.Name, fmt.Sprintf("the field %q must be returned in the %s of the recipe output, not in the %s", field.Name, location, otherLocation)))
+			} else if field.Required {
+				details = append(details, outputError(location, field.Name, fmt.Sprintf("the field %q is missing from the %s of the recipe output", field.Name, location)))
+			}
+			continue
+		}
+
+		if !field.Type.matches(value) {
+			details = append(details, outputError(location, field.Name, fmt.Sprintf("the field %q of the recipe output is expected to be of type %s, got %T", field.Name, field.Type, value)))
+		}
+	}
+
+	if len(details) == 0 {
+		return nil
+	}
+
+	messages := []string{}
+	for _, detail := range details {
+		messages = append(messages, detail.Message)
+	}
+	message := fmt.Sprintf("the recipe output doesn't match the outputs of resource type %q: %s", resourceType, strings.Join(messages, "; "))
+	return NewRecipeError(InvalidRecipeOutputs, message, util.ExecutionError, details...)
+}
+
+func (t OutputFieldType) matches(value any) bool {
+	switch t {
+	case OutputFieldTypeString:
+		_, ok := value.(string)
+		return ok
+	case OutputFieldTypeBoolean:
+		_, ok := value.(bool)
+		return ok
+	case OutputFieldTypeInteger:
+		switch v := value.(type) {
+		case int, int32, int64:
+			return true
+		case float64:
+			// Numbers decoded from JSON are float64.
+			return v == math.Trunc(v)
+		}
+		return false
+	}
+
+	return true
+}
+
+func outputError(location string, name string, message string) *v1.ErrorDetails {
+	return &v1.ErrorDetails{
+		Code:    InvalidRecipeOutputs,
+		Message: message,
+		Target:  location + "." + name,
+	}
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recipes
+
+import (
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/recipes/util"
+	"github.com/stretchr/testify/require"
+)
+
+var testOutputSchema = OutputSchema{
+	Fields: []OutputField{
+		{Name: "host", Type: OutputFieldTypeString, Required: true},
+		{Name: "port", Type: OutputFieldTypeInteger, Required: true},
+		{Name: "tls", Type: OutputFieldTypeBoolean},
+		{Name: "password", Type: OutputFieldTypeString, Secret: true},
+	},
+}
+
+func TestOutputSchema_Validate(t *testing.T) {
+	tests := []struct {
+		desc            string
+		output          *RecipeOutput
+		expectedDetails []v1.ErrorDetails
+	}{
+		{
+			desc: "valid output",
+			output: &RecipeOutput{
+				Values:  map[string]any{"host": "myredis", "port": float64(6379), "tls": true, "extra": "value"},
+				Secrets: map[string]any{"password": "secret"},
+			},
+		},
+		{
+			desc: "optional fields omitted",
+			output: &RecipeOutput{
+				Values: map[string]any{"host": "myredis", "port": 6379},
+			},
+		},
+		{
+			desc: "no output",
+		},
+		{
+			desc: "missing fields",
+			output: &RecipeOutput{
+				Values: map[string]any{"host": "myredis"},
+			},
+			expectedDetails: []v1.ErrorDetails{
+				{Code: InvalidRecipeOutputs, Message: "the field \"port\" is missing from the values of the recipe output", Target: "values.port"},
+			},
+		},
+		{
+			desc: "mistyped fields",
+			output: &RecipeOutput{
+				Values:  map[string]any{"host": "myredis", "port": "6379", "tls": "true"},
+				Secrets: map[string]any{"password": 1234},
+			},
+			expectedDetails: []v1.ErrorDetails{
+				{Code: InvalidRecipeOutputs, Message: "the field \"port\" of the recipe output is expected to be of type integer, got string", Target: "values.port"},
+				{Code: InvalidRecipeOutputs, Message: "the field \"tls\" of the recipe output is expected to be of type boolean, got string", Target: "values.tls"},
+				{Code: InvalidRecipeOutputs, Message: "the field \"password\" of the recipe output is expected to be of type string, got int", Target: "secrets.password"},
+			},
+		},
+		{
+			desc: "fractional integer",
+			output: &RecipeOutput{
+				Values: map[string]any{"host": "myredis", "port": 6379.5},
+			},
+			expectedDetails: []v1.ErrorDetails{
+				{Code: InvalidRecipeOutputs, Message: "the field \"port\" of the recipe output is expected to be of type integer, got float64", Target: "values.port"},
+			},
+		},
+		{
+			desc: "misplaced fields",
+			output: &RecipeOutput{
+				Values:  map[string]any{"port": 6379, "password": "secret"},
+				Secrets: map[string]any{"host": "myredis"},
+			},
+			expectedDetails: []v1.ErrorDetails{
+				{Code: InvalidRecipeOutputs, Message: "the field \"host\" must be returned in the values of the recipe output, not in the secrets", Target: "values.host"},
+				{Code: InvalidRecipeOutputs, Message: "the field \"password\" must be returned in the secrets of the recipe output, not in the values", Target: "secrets.password"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			err := testOutputSchema.Validate("Applications.Datastores/redisCaches", tt.output)
+			if tt.expectedDetails == nil {
+				require.NoError(t, err)
+				return
+			}
+
+			recipeError, ok := err.(*RecipeError)
+			require.True(t, ok)
+			require.Equal(t, InvalidRecipeOutputs, recipeError.ErrorDetails.Code)
+			require.Equal(t, util.ExecutionError, recipeError.DeploymentStatus)
+			require.Contains(t, recipeError.ErrorDetails.Message, "the recipe output doesn't match the outputs of resource type \"Applications.Datastores/redisCaches\"")
+			require.Equal(t, tt.expectedDetails, recipeError.ErrorDetails.Details)
+		})
+	}
+}
+
+func TestOutputSchema_WithProvided(t *testing.T) {
+	schema := testOutputSchema.WithProvided("host", "password")
+
+	output := &RecipeOutput{
+		Values: map[string]any{"port": 6379},
+	}
+	require.NoError(t, schema.Validate("Applications.Datastores/redisCaches", output))
+	require.Error(t, testOutputSchema.Validate("Applications.Datastores/redisCaches", output))
+
+	// The field returned by the recipe is still validated.
+	output.Values["host"] = 1234
+	require.Error(t, schema.Validate("Applications.Datastores/redisCaches", output))
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recipes
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/portableresources/datamodel"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+)
+
+// recipeParametersKey is the key of the parameters declared by the recipe in the recipe metadata returned by the drivers.
+const recipeParametersKey = "parameters"
+
+// ValidateParameters validates recipe parameters against the parameters declared in the recipe metadata returned by
+// the drivers. Each of the parameters maps is checked for unknown parameter names and values not matching the declared
+// type or allowed values, and the required parameters must be set by at least one of the maps. It returns nil if the
+// recipe metadata doesn't declare the parameters.
+func ValidateParameters(recipeData map[string]any, parameters ...map[string]any) error {
+	return validateParameters(recipeData, true, parameters...)
+}
+
+// ValidateParameterValues validates recipe parameters like ValidateParameters, without checking that the required
+// parameters are set. It is used when the other parameters are provided later, such as the parameters of a recipe
+// registered in an environment, which can be completed by the parameters of each resource.
+func ValidateParameterValues(recipeData map[string]any, parameters ...map[string]any) error {
+	return validateParameters(recipeData, false, parameters...)
+}
+
+// validateParameters validates the recipe parameters, and checks that the required parameters are set if checkRequired
+// is true.
+func validateParameters(recipeData map[string]any, checkRequired bool, parameters ...map[string]any) error {
+	declared, ok := recipeData[recipeParametersKey].(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	msgs := []string{}
+	provided := map[string]bool{}
+	for _, params := range parameters {
+		for _, name := range sortedKeys(params) {
+			provided[name] = true
+
+			if name == datamodel.RecipeContextParameter {
+				msgs = append(msgs, fmt.Sprintf("parameter %q is set by Radius and can't be provided", name))
+				continue
+			}
+
+			details, ok := declared[name].(map[string]any)
+			if !ok {
+				msgs = append(msgs, fmt.Sprintf("parameter %q is not declared by the recipe", name))
+				continue
+			}
+
+			parameterType, _ := details["type"].(string)
+			if !matchesParameterType(parameterType, params[name]) {
+				msgs = append(msgs, fmt.Sprintf("parameter %q is expected to be of type %s, got %T", name, parameterType, params[name]))
+				continue
+			}
+
+			if allowed, ok := details["allowedValues"].([]any); ok && !slices.ContainsFunc(allowed, func(v any) bool { return reflect.DeepEqual(v, params[name]) }) {
+				msgs = append(msgs, fmt.Sprintf("parameter %q must be one of %v, got %v", name, allowed, params[name]))
+			}
+		}
+	}
+
+	for _, name := range sortedKeys(declared) {
+		details, _ := declared[name].(map[string]any)
+		if checkRequired && name != datamodel.RecipeContextParameter && isRequiredParameter(details) && !provided[name] {
+			msgs = append(msgs, fmt.Sprintf("parameter %q is required by the recipe", name))
+		}
+	}
+
+	if len(msgs) > 0 {
+		return fmt.Errorf("invalid recipe parameters: %s", strings.Join(msgs, "; "))
+	}
+
+	return nil
+}
+
+// isRequiredParameter returns true if the parameter must be set. Terraform variables declare whether they are required,
+// and the parameters of the other recipes are required unless they have a default value.
+func isRequiredParameter(details map[string]any) bool {
+	if required, ok := details["required"].(bool); ok {
+		return required
+	}
+	if nullable, ok := details["nullable"].(bool); ok && nullable {
+		return false
+	}
+
+	_, hasDefault := details["defaultValue"]
+	return !hasDefault
+}
+
+// matchesParameterType returns true if value is valid for the declared parameter type. Both Bicep types (e.g. "int",
+// "secureString") and Terraform types (e.g. "number", "list(string)") are supported. Unknown types match any value.
+func matchesParameterType(parameterType string, value any) bool {
+	parameterType = strings.ToLower(parameterType)
+	if i := strings.Index(parameterType, "("); i >= 0 {
+		parameterType = parameterType[:i]
+	}
+
+	switch parameterType {
+	case "string", "securestring":
+		_, ok := value.(string)
+		return ok
+	case "bool":
+		_, ok := value.(bool)
+		return ok
+	case "int":
+		switch v := value.(type) {
+		case int, int32, int64:
+			return true
+		case float64:
+			return v == math.Trunc(v)
+		}
+		return false
+	case "number":
+		switch value.(type) {
+		case int, int32, int64, float64:
+			return true
+		}
+		return false
+	case "object", "secureobject", "map":
+		_, ok := value.(map[string]any)
+		return ok
+	case "array", "list", "set", "tuple":
+		_, ok := value.([]any)
+		return ok
+	}
+
+	return true
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := maps.Keys(m)
+	sort.Strings(keys)
+	return keys
+}
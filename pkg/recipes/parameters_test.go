@@ -0,0 +1,128 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recipes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateParameters(t *testing.T) {
+	recipeData := map[string]any{
+		"parameters": map[string]any{
+			"context":  map[string]any{"type": "object"},
+			"name":     map[string]any{"type": "string"},
+			"port":     map[string]any{"type": "int", "defaultValue": float64(6379)},
+			"sku":      map[string]any{"type": "string", "defaultValue": "Basic", "allowedValues": []any{"Basic", "Standard"}},
+			"tags":     map[string]any{"type": "map(string)", "required": false},
+			"zones":    map[string]any{"type": "list(string)", "nullable": true},
+			"replicas": map[string]any{"type": "number", "required": false},
+		},
+	}
+
+	tests := []struct {
+		desc       string
+		recipeData map[string]any
+		parameters []map[string]any
+		err        string
+	}{
+		{
+			desc:       "valid parameters",
+			recipeData: recipeData,
+			parameters: []map[string]any{
+				{"name": "myredis", "port": float64(6380)},
+				{"sku": "Standard", "tags": map[string]any{"env": "dev"}, "zones": []any{"1"}, "replicas": 1.5},
+			},
+		},
+		{
+			desc:       "required parameter set by any of the maps",
+			recipeData: recipeData,
+			parameters: []map[string]any{nil, {"name": "myredis"}},
+		},
+		{
+			desc:       "missing required parameter",
+			recipeData: recipeData,
+			parameters: []map[string]any{{"port": 6380}},
+			err:        `invalid recipe parameters: parameter "name" is required by the recipe`,
+		},
+		{
+			desc:       "undeclared parameter",
+			recipeData: recipeData,
+			parameters: []map[string]any{{"name": "myredis", "size": "large"}},
+			err:        `invalid recipe parameters: parameter "size" is not declared by the recipe`,
+		},
+		{
+			desc:       "context parameter",
+			recipeData: recipeData,
+			parameters: []map[string]any{{"name": "myredis", "context": map[string]any{}}},
+			err:        `invalid recipe parameters: parameter "context" is set by Radius and can't be provided`,
+		},
+		{
+			desc:       "mistyped parameters",
+			recipeData: recipeData,
+			parameters: []map[string]any{{"name": true, "port": 6379.5, "tags": []any{"dev"}, "zones": "1"}},
+			err: `invalid recipe parameters: parameter "name" is expected to be of type string, got bool; ` +
+				`parameter "port" is expected to be of type int, got float64; ` +
+				`parameter "tags" is expected to be of type map(string), got []interface {}; ` +
+				`parameter "zones" is expected to be of type list(string), got string`,
+		},
+		{
+			desc:       "value not allowed",
+			recipeData: recipeData,
+			parameters: []map[string]any{{"name": "myredis", "sku": "Premium"}},
+			err:        `invalid recipe parameters: parameter "sku" must be one of [Basic Standard], got Premium`,
+		},
+		{
+			desc:       "untyped parameters",
+			recipeData: map[string]any{"parameters": map[string]any{"port": map[string]any{"type": "any", "required": false}}},
+			parameters: []map[string]any{{"port": "6379"}},
+		},
+		{
+			desc:       "no declared parameters",
+			recipeData: map[string]any{},
+			parameters: []map[string]any{{"name": "myredis"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			err := ValidateParameters(tt.recipeData, tt.parameters...)
+			if tt.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestValidateParameterValues(t *testing.T) {
+	recipeData := map[string]any{
+		"parameters": map[string]any{
+			"name": map[string]any{"type": "string"},
+			"sku":  map[string]any{"type": "string", "defaultValue": "Basic", "allowedValues": []any{"Basic", "Standard"}},
+		},
+	}
+
+	// The required parameters are not checked.
+	err := ValidateParameterValues(recipeData, map[string]any{"sku": "Standard"})
+	require.NoError(t, err)
+
+	err = ValidateParameterValues(recipeData, map[string]any{"size": "large", "sku": "Premium"})
+	require.EqualError(t, err, `invalid recipe parameters: parameter "size" is not declared by the recipe; parameter "sku" must be one of [Basic Standard], got Premium`)
+}
@@ -0,0 +1,215 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+)
+
+const (
+	// GitPATUsernameKey is the key of the username in the secret store referenced by a git personal access token configuration.
+	GitPATUsernameKey = "username"
+	// GitPATKey is the key of the personal access token in the secret store referenced by a git personal access token configuration.
+	GitPATKey = "pat"
+	// GitSSHPrivateKeyKey is the key of the SSH private key in the secret store referenced by a git SSH configuration.
+	GitSSHPrivateKeyKey = "privateKey"
+	// GitSSHKnownHostsKey is the key of the known host keys of the git server, in the known_hosts file format, in the
+	// secret store referenced by a git SSH configuration.
+	GitSSHKnownHostsKey = "knownHosts"
+	// RegistryTokenKey is the key of the token in the secret store referenced by a module registry configuration.
+	RegistryTokenKey = "token"
+
+	authDirPattern  = "radius-tf-auth-"
+	authFileMode    = 0600
+	sshConfigFile   = "ssh_config"
+	knownHostsFile  = "known_hosts"
+	gitPATEnvPrefix = "RADIUS_GIT_PAT_"
+)
+
+// moduleAuth holds the environment of the Terraform commands authenticating to the private module sources
+// configured for the environment. The secrets are passed through environment variables so that they are
+// neither logged nor written to the Terraform working directory. SSH private keys need to be files, so they are
+// written to a temporary directory outside of the working directory, which is removed by cleanup.
+type moduleAuth struct {
+	// env are the environment variables to be set for the Terraform commands.
+	env map[string]string
+
+	// dir is the temporary directory holding the SSH configuration. It is empty if no SSH key is configured.
+	dir string
+}
+
+// newModuleAuth builds the environment of the Terraform commands from the authentication configured for Terraform recipes
+// in the environment, using the secrets loaded from the referenced secret stores. It returns an error if a referenced secret is not loaded.
+func newModuleAuth(envConfig *recipes.Configuration, secrets map[string]recipes.SecretData) (*moduleAuth, error) {
+	auth := &moduleAuth{env: map[string]string{}}
+	if envConfig == nil || envConfig.RecipeConfig.Terraform.Authentication == nil {
+		return auth, nil
+	}
+	config := envConfig.RecipeConfig.Terraform.Authentication
+
+	gitConfig := [][2]string{}
+	for i, host := range sortedHosts(config.Git.PAT) {
+		username, err := getSecret(secrets, config.Git.PAT[host], GitPATUsernameKey)
+		if err != nil {
+			return nil, err
+		}
+		pat, err := getSecret(secrets, config.Git.PAT[host], GitPATKey)
+		if err != nil {
+			return nil, err
+		}
+
+		// The credential helper reads the credentials from environment variables of the git process, so that they don't
+		// appear in the git configuration or the URLs of the module sources, which may be logged.
+		usernameVar, patVar := gitPATEnvPrefix+"USERNAME_"+strconv.Itoa(i), gitPATEnvPrefix+strconv.Itoa(i)
+		auth.env[usernameVar] = username
+		auth.env[patVar] = pat
+		gitConfig = append(gitConfig, [2]string{
+			fmt.Sprintf("credential.https://%s.helper", host),
+			fmt.Sprintf(`!f() { test "$1" = get && echo "username=${%s}" && echo "password=${%s}"; }; f`, usernameVar, patVar),
+		})
+	}
+
+	if len(config.Git.SSH) > 0 {
+		if err := auth.configureSSH(config.Git.SSH, secrets); err != nil {
+			_ = auth.cleanup()
+			return nil, err
+		}
+	}
+
+	if len(gitConfig) > 0 {
+		// GIT_CONFIG_COUNT, GIT_CONFIG_KEY_<n> and GIT_CONFIG_VALUE_<n> add configuration to git commands without writing a configuration file.
+		auth.env["GIT_CONFIG_COUNT"] = strconv.Itoa(len(gitConfig))
+		for i, entry := range gitConfig {
+			auth.env["GIT_CONFIG_KEY_"+strconv.Itoa(i)] = entry[0]
+			auth.env["GIT_CONFIG_VALUE_"+strconv.Itoa(i)] = entry[1]
+		}
+	}
+
+	for _, host := range sortedHosts(config.Registries) {
+		token, err := getSecret(secrets, config.Registries[host], RegistryTokenKey)
+		if err != nil {
+			_ = auth.cleanup()
+			return nil, err
+		}
+		auth.env[registryTokenEnvVar(host)] = token
+	}
+
+	if len(auth.env) > 0 {
+		// Fail instead of waiting for input if the credentials are rejected.
+		auth.env["GIT_TERMINAL_PROMPT"] = "0"
+	}
+
+	return auth, nil
+}
+
+// configureSSH writes the SSH private keys, the known host keys and an SSH configuration selecting the keys of each host
+// to a temporary directory, and sets GIT_SSH_COMMAND to use the configuration. The host keys are always verified against
+// the configured known host keys, so an error is returned if none are configured for a host.
+func (a *moduleAuth) configureSSH(hosts map[string]datamodel.SecretConfig, secrets map[string]recipes.SecretData) error {
+	dir, err := os.MkdirTemp("", authDirPattern)
+	if err != nil {
+		return fmt.Errorf("failed to create directory for SSH keys: %w", err)
+	}
+	a.dir = dir
+
+	sshConfig := strings.Builder{}
+	for i, host := range sortedHosts(hosts) {
+		privateKey, err := getSecret(secrets, hosts[host], GitSSHPrivateKeyKey)
+		if err != nil {
+			return err
+		}
+		if !strings.HasSuffix(privateKey, "\n") {
+			privateKey += "\n"
+		}
+
+		knownHosts, err := getSecret(secrets, hosts[host], GitSSHKnownHostsKey)
+		if err != nil {
+			return err
+		}
+		if strings.TrimSpace(knownHosts) == "" {
+			return fmt.Errorf("secret key %q of secret store %q is empty, the host keys of %q can't be verified", GitSSHKnownHostsKey, hosts[host].Secret, host)
+		}
+		if !strings.HasSuffix(knownHosts, "\n") {
+			knownHosts += "\n"
+		}
+
+		keyFile := filepath.Join(dir, "id_"+strconv.Itoa(i))
+		if err := os.WriteFile(keyFile, []byte(privateKey), authFileMode); err != nil {
+			return fmt.Errorf("failed to write SSH key for host %q: %w", host, err)
+		}
+
+		knownHostsPath := filepath.Join(dir, knownHostsFile+"_"+strconv.Itoa(i))
+		if err := os.WriteFile(knownHostsPath, []byte(knownHosts), authFileMode); err != nil {
+			return fmt.Errorf("failed to write known host keys for host %q: %w", host, err)
+		}
+
+		fmt.Fprintf(&sshConfig, "Host %s\n  IdentityFile %s\n  IdentitiesOnly yes\n  StrictHostKeyChecking yes\n  UserKnownHostsFile %s\n  GlobalKnownHostsFile /dev/null\n",
+			host, keyFile, knownHostsPath)
+	}
+
+	configFile := filepath.Join(dir, sshConfigFile)
+	if err := os.WriteFile(configFile, []byte(sshConfig.String()), authFileMode); err != nil {
+		return fmt.Errorf("failed to write SSH configuration: %w", err)
+	}
+	a.env["GIT_SSH_COMMAND"] = fmt.Sprintf("ssh -F %s", configFile)
+
+	return nil
+}
+
+// cleanup removes the temporary directory holding the SSH keys.
+func (a *moduleAuth) cleanup() error {
+	if a.dir == "" {
+		return nil
+	}
+
+	return os.RemoveAll(a.dir)
+}
+
+// getSecret returns the value of the given key of the secret store referenced by the configuration.
+func getSecret(secrets map[string]recipes.SecretData, config datamodel.SecretConfig, key string) (string, error) {
+	value, ok := secrets[config.Secret][key]
+	if !ok {
+		return "", fmt.Errorf("secret key %q of secret store %q is not loaded", key, config.Secret)
+	}
+
+	return value, nil
+}
+
+// registryTokenEnvVar returns the name of the environment variable Terraform reads the token of the registry host from.
+// https://developer.hashicorp.com/terraform/cli/config/config-file#environment-variable-credentials
+func registryTokenEnvVar(host string) string {
+	name := strings.ReplaceAll(host, "-", "__")
+	name = strings.ReplaceAll(name, ".", "_")
+	return "TF_TOKEN_" + name
+}
+
+func sortedHosts(configs map[string]datamodel.SecretConfig) []string {
+	hosts := []string{}
+	for host := range configs {
+		hosts = append(hosts, host)
+	}
+	sort.Strings(hosts)
+	return hosts
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testPATSecretStore      = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/secretStores/git-pat"
+	testSSHSecretStore      = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/secretStores/git-ssh"
+	testRegistrySecretStore = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/secretStores/registry"
+)
+
+func getAuthEnvConfig() *recipes.Configuration {
+	return &recipes.Configuration{
+		RecipeConfig: datamodel.RecipeConfigProperties{
+			Terraform: datamodel.TerraformConfigProperties{
+				Authentication: &datamodel.AuthConfig{
+					Git: datamodel.GitAuthConfig{
+						PAT: map[string]datamodel.SecretConfig{
+							"dev.azure.com": {Secret: testPATSecretStore},
+						},
+						SSH: map[string]datamodel.SecretConfig{
+							"github.com": {Secret: testSSHSecretStore},
+						},
+					},
+					Registries: map[string]datamodel.SecretConfig{
+						"tf-registry.example.com": {Secret: testRegistrySecretStore},
+					},
+				},
+			},
+		},
+	}
+}
+
+func Test_NewModuleAuth(t *testing.T) {
+	secrets := map[string]recipes.SecretData{
+		testPATSecretStore:      {GitPATUsernameKey: "user", GitPATKey: "pat-value"},
+		testSSHSecretStore:      {GitSSHPrivateKeyKey: "private-key", GitSSHKnownHostsKey: "github.com ssh-ed25519 AAAA"},
+		testRegistrySecretStore: {RegistryTokenKey: "registry-token"},
+	}
+
+	auth, err := newModuleAuth(getAuthEnvConfig(), secrets)
+	require.NoError(t, err)
+	require.NotEmpty(t, auth.dir)
+
+	sshConfigPath := filepath.Join(auth.dir, sshConfigFile)
+	require.Equal(t, map[string]string{
+		"RADIUS_GIT_PAT_USERNAME_0":         "user",
+		"RADIUS_GIT_PAT_0":                  "pat-value",
+		"GIT_CONFIG_COUNT":                  "1",
+		"GIT_CONFIG_KEY_0":                  "credential.https://dev.azure.com.helper",
+		"GIT_CONFIG_VALUE_0":                `!f() { test "$1" = get && echo "username=${RADIUS_GIT_PAT_USERNAME_0}" && echo "password=${RADIUS_GIT_PAT_0}"; }; f`,
+		"GIT_SSH_COMMAND":                   "ssh -F " + sshConfigPath,
+		"GIT_TERMINAL_PROMPT":               "0",
+		"TF_TOKEN_tf__registry_example_com": "registry-token",
+	}, auth.env)
+
+	key, err := os.ReadFile(filepath.Join(auth.dir, "id_0"))
+	require.NoError(t, err)
+	require.Equal(t, "private-key\n", string(key))
+
+	info, err := os.Stat(filepath.Join(auth.dir, "id_0"))
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(authFileMode), info.Mode().Perm())
+
+	sshConfig, err := os.ReadFile(sshConfigPath)
+	require.NoError(t, err)
+	require.Equal(t, "Host github.com\n  IdentityFile "+filepath.Join(auth.dir, "id_0")+"\n  IdentitiesOnly yes\n"+
+		"  StrictHostKeyChecking yes\n  UserKnownHostsFile "+filepath.Join(auth.dir, "known_hosts_0")+"\n  GlobalKnownHostsFile /dev/null\n", string(sshConfig))
+
+	knownHosts, err := os.ReadFile(filepath.Join(auth.dir, "known_hosts_0"))
+	require.NoError(t, err)
+	require.Equal(t, "github.com ssh-ed25519 AAAA\n", string(knownHosts))
+
+	require.NoError(t, auth.cleanup())
+	_, err = os.Stat(auth.dir)
+	require.True(t, os.IsNotExist(err))
+}
+
+func Test_NewModuleAuth_NoAuthentication(t *testing.T) {
+	auth, err := newModuleAuth(&recipes.Configuration{}, nil)
+	require.NoError(t, err)
+	require.Empty(t, auth.env)
+	require.Empty(t, auth.dir)
+	require.NoError(t, auth.cleanup())
+}
+
+func Test_NewModuleAuth_SecretNotLoaded(t *testing.T) {
+	secrets := map[string]recipes.SecretData{
+		testPATSecretStore: {GitPATUsernameKey: "user", GitPATKey: "pat-value"},
+	}
+
+	_, err := newModuleAuth(getAuthEnvConfig(), secrets)
+	require.EqualError(t, err, `secret key "privateKey" of secret store "`+testSSHSecretStore+`" is not loaded`)
+}
+
+func Test_NewModuleAuth_NoKnownHosts(t *testing.T) {
+	t.Run("not loaded", func(t *testing.T) {
+		secrets := map[string]recipes.SecretData{
+			testPATSecretStore: {GitPATUsernameKey: "user", GitPATKey: "pat-value"},
+			testSSHSecretStore: {GitSSHPrivateKeyKey: "private-key"},
+		}
+
+		_, err := newModuleAuth(getAuthEnvConfig(), secrets)
+		require.EqualError(t, err, `secret key "knownHosts" of secret store "`+testSSHSecretStore+`" is not loaded`)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		secrets := map[string]recipes.SecretData{
+			testPATSecretStore: {GitPATUsernameKey: "user", GitPATKey: "pat-value"},
+			testSSHSecretStore: {GitSSHPrivateKeyKey: "private-key", GitSSHKnownHostsKey: " \n"},
+		}
+
+		_, err := newModuleAuth(getAuthEnvConfig(), secrets)
+		require.EqualError(t, err, `secret key "knownHosts" of secret store "`+testSSHSecretStore+`" is empty, the host keys of "github.com" can't be verified`)
+	})
+}
+
+func Test_RegistryTokenEnvVar(t *testing.T) {
+	require.Equal(t, "TF_TOKEN_app_terraform_io", registryTokenEnvVar("app.terraform.io"))
+	require.Equal(t, "TF_TOKEN_tf__registry_example_com", registryTokenEnvVar("tf-registry.example.com"))
+}
@@ -0,0 +1,615 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-exec/tfexec"
+	"github.com/radius-project/radius/pkg/metrics"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+	"go.opentelemetry.io/otel/attribute"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	pluginCacheSubDir = "plugins"
+	moduleCacheSubDir = "modules"
+
+	// moduleManifestFile is the manifest of the modules installed by Terraform in the .terraform/modules directory.
+	moduleManifestFile = "modules.json"
+
+	// cacheTempPrefix is the prefix of the temporary directories of the module cache entries being stored.
+	cacheTempPrefix = ".tmp-"
+
+	// cachedModuleName is the name of the root module in the module cache entries. It's replaced by the local name of
+	// the module when an entry is restored, so that the entries are shared by the recipes using the same module.
+	cachedModuleName = "module"
+)
+
+var (
+	// commitRefRegex matches the git commit hashes used as refs of module sources.
+	commitRefRegex = regexp.MustCompile(`^[0-9a-fA-F]{40}$`)
+)
+
+// CacheOptions represents the options of the Terraform provider plugin and module caches.
+type CacheOptions struct {
+	// Dir is the directory storing the caches, which must be kept across the recipe executions.
+	Dir string
+
+	// PluginCacheMaxSize is the maximum size of the provider plugin cache in bytes. The size is not limited if zero.
+	PluginCacheMaxSize int64
+
+	// ModuleCacheMaxSize is the maximum size of the module cache in bytes. The size is not limited if zero.
+	ModuleCacheMaxSize int64
+}
+
+// Cache is a cache of Terraform provider plugins and modules shared by the Terraform recipe executions, so that they
+// aren't downloaded by every execution. Terraform stores the provider plugins in its plugin cache directory, with an
+// entry for each version of a provider. Modules are stored with an entry for each module source and version, and only
+// the modules pinned to a version, a semantic version tag or a commit are cached.
+//
+// The least recently used entries are evicted when a cache exceeds its maximum size. A nil *Cache doesn't cache anything.
+type Cache struct {
+	options CacheOptions
+
+	// installMu serializes the installation of the provider plugins, since Terraform doesn't support concurrent writes
+	// to its plugin cache directory. It's also locked by the eviction, so that the plugins being installed aren't removed.
+	installMu sync.Mutex
+
+	// mu protects inUse, and is locked by the eviction so that the entries aren't marked as used while they're evicted.
+	mu sync.Mutex
+
+	// inUse holds the paths of the cache entries used by each execution, keyed by the working directory of the
+	// execution. The plugins linked from the working directory of an execution are never evicted during the execution.
+	inUse map[string][]string
+}
+
+// NewCache creates a new Terraform provider plugin and module cache with the given options.
+func NewCache(options CacheOptions) *Cache {
+	return &Cache{options: options, inUse: map[string][]string{}}
+}
+
+// acquire registers the execution running in the working directory as a user of the cache until the returned function
+// is called. The returned function evicts the least recently used entries which aren't used by other executions.
+func (c *Cache) acquire(ctx context.Context, workingDir string) func() {
+	if c == nil {
+		return func() {}
+	}
+
+	c.mu.Lock()
+	c.inUse[workingDir] = []string{}
+	c.mu.Unlock()
+
+	return func() {
+		c.mu.Lock()
+		delete(c.inUse, workingDir)
+		c.mu.Unlock()
+		c.evict(ctx)
+	}
+}
+
+// use marks the cache entry as used by the execution running in the working directory.
+func (c *Cache) use(workingDir, entry string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if entries, ok := c.inUse[workingDir]; ok {
+		c.inUse[workingDir] = append(entries, entry)
+	}
+}
+
+// env returns the given environment of the Terraform commands with the plugin cache configured.
+func (c *Cache) env(env map[string]string) map[string]string {
+	if c == nil {
+		return env
+	}
+
+	result := map[string]string{
+		"TF_PLUGIN_CACHE_DIR": c.pluginDir(),
+		// The working directories don't have a dependency lock file, which Terraform requires by default to use the plugin cache.
+		"TF_PLUGIN_CACHE_MAY_BREAK_DEPENDENCY_LOCK_FILE": "true",
+	}
+	for k, v := range env {
+		result[k] = v
+	}
+
+	return result
+}
+
+// init runs terraform init in the working directory, installing the provider plugins from the plugin cache. The
+// plugins are installed first without initializing the backend, which is the only step serialized with the other
+// executions, then terraform init is run again to initialize the backend with the installed plugins.
+func (c *Cache) init(ctx context.Context, tf *tfexec.Terraform, workingDir string) error {
+	if c == nil {
+		return tf.Init(ctx)
+	}
+
+	if err := c.installPlugins(ctx, tf, workingDir); err != nil {
+		return err
+	}
+
+	return tf.Init(ctx, tfexec.Get(false))
+}
+
+// installPlugins installs the provider plugins of the working directory from the plugin cache, and marks the plugin
+// cache entries linked from the working directory as used by the execution.
+func (c *Cache) installPlugins(ctx context.Context, tf *tfexec.Terraform, workingDir string) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	c.installMu.Lock()
+	defer c.installMu.Unlock()
+
+	// Terraform requires the plugin cache directory to exist.
+	if err := os.MkdirAll(c.pluginDir(), workingDirFileMode); err != nil {
+		return fmt.Errorf("failed to create the Terraform plugin cache directory: %w", err)
+	}
+
+	cached, err := pluginEntries(c.pluginDir())
+	if err != nil {
+		return err
+	}
+
+	if err := tf.Init(ctx, tfexec.Backend(false), tfexec.Get(false)); err != nil {
+		return err
+	}
+
+	// The providers installed in the working directory are linked to the entries of the plugin cache, they were
+	// downloaded if the entries didn't exist before.
+	installed, err := pluginEntries(filepath.Join(workingDir, ".terraform", "providers"))
+	if err != nil {
+		return err
+	}
+	for _, entry := range installed {
+		result := metrics.TerraformCacheMiss
+		if slices.Contains(cached, entry) {
+			result = metrics.TerraformCacheHit
+		}
+		logger.Info(fmt.Sprintf("Terraform plugin cache %s for provider %q", result, entry))
+		recordCacheLookup(ctx, metrics.TerraformPluginCache, result)
+		c.use(workingDir, filepath.Join(c.pluginDir(), entry))
+		touch(filepath.Join(c.pluginDir(), entry))
+	}
+
+	return nil
+}
+
+// moduleKey returns the key of the module cache entry of the recipe module, and false if the module isn't cached.
+// Modules are cached only if they're pinned, since the module installed by a source or version constraint changes over time.
+// The key includes the authentication configuration of the environment, so that a module downloaded with the credentials
+// of an environment isn't used by environments without access to the module.
+func (c *Cache) moduleKey(options Options) (string, bool) {
+	if c == nil || options.EnvRecipe == nil || !isPinnedModule(options.EnvRecipe.TemplatePath, options.EnvRecipe.TemplateVersion) {
+		return "", false
+	}
+
+	auth := []byte{}
+	if options.EnvConfig != nil && options.EnvConfig.RecipeConfig.Terraform.Authentication != nil {
+		var err error
+		if auth, err = json.Marshal(options.EnvConfig.RecipeConfig.Terraform.Authentication); err != nil {
+			return "", false
+		}
+	}
+
+	h := sha256.New()
+	for _, part := range [][]byte{[]byte(options.EnvRecipe.TemplatePath), []byte(options.EnvRecipe.TemplateVersion), auth} {
+		_, _ = h.Write(part)
+		_, _ = h.Write([]byte{0})
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), true
+}
+
+// restoreModule installs the module cached with the given key in the working directory as the module with the given
+// local name. It returns false if the module isn't cached.
+func (c *Cache) restoreModule(ctx context.Context, key, workingDir, localModuleName string) (bool, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	// The entry is marked as used before it's looked up, so that it isn't evicted while it's copied.
+	entry := filepath.Join(c.moduleDir(), key)
+	c.use(workingDir, entry)
+	if _, err := os.Stat(entry); errors.Is(err, fs.ErrNotExist) {
+		logger.Info(fmt.Sprintf("Terraform module cache miss for module %q", localModuleName))
+		recordCacheLookup(ctx, metrics.TerraformModuleCache, metrics.TerraformCacheMiss)
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	if err := copyModules(entry, cachedModuleName, filepath.Join(workingDir, moduleRootDir), localModuleName); err != nil {
+		return false, fmt.Errorf("failed to restore the module from the Terraform module cache: %w", err)
+	}
+
+	logger.Info(fmt.Sprintf("Terraform module cache hit for module %q", localModuleName))
+	recordCacheLookup(ctx, metrics.TerraformModuleCache, metrics.TerraformCacheHit)
+	touch(entry)
+
+	return true, nil
+}
+
+// storeModule stores the module with the given local name installed in the working directory in the module cache with
+// the given key. The entry is written to a temporary directory and renamed, so that concurrent executions never see a
+// partial entry, and the entry stored first is kept if the module is stored by concurrent executions.
+func (c *Cache) storeModule(ctx context.Context, key, workingDir, localModuleName string) error {
+	if err := os.MkdirAll(c.moduleDir(), workingDirFileMode); err != nil {
+		return fmt.Errorf("failed to create the Terraform module cache directory: %w", err)
+	}
+
+	tmp, err := os.MkdirTemp(c.moduleDir(), cacheTempPrefix)
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmp)
+
+	if err := copyModules(filepath.Join(workingDir, moduleRootDir), localModuleName, tmp, cachedModuleName); err != nil {
+		return fmt.Errorf("failed to store the module in the Terraform module cache: %w", err)
+	}
+
+	entry := filepath.Join(c.moduleDir(), key)
+	if err := os.Rename(tmp, entry); err != nil {
+		// The module was stored by a concurrent execution.
+		if _, statErr := os.Stat(entry); statErr == nil {
+			return nil
+		}
+		return fmt.Errorf("failed to store the module in the Terraform module cache: %w", err)
+	}
+
+	ucplog.FromContextOrDiscard(ctx).Info(fmt.Sprintf("Stored module %q in the Terraform module cache", localModuleName))
+	return nil
+}
+
+// evict removes the least recently used entries of the caches exceeding their maximum size. The entries used by the
+// executions in progress are kept.
+func (c *Cache) evict(ctx context.Context) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	c.installMu.Lock()
+	defer c.installMu.Unlock()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	used := map[string]bool{}
+	for _, entries := range c.inUse {
+		for _, entry := range entries {
+			used[entry] = true
+		}
+	}
+
+	plugins, err := pluginEntries(c.pluginDir())
+	if err != nil {
+		logger.Info(fmt.Sprintf("Failed to list the entries of the Terraform plugin cache: %s", err.Error()))
+	}
+	for i := range plugins {
+		plugins[i] = filepath.Join(c.pluginDir(), plugins[i])
+	}
+	evictEntries(ctx, metrics.TerraformPluginCache, plugins, used, c.options.PluginCacheMaxSize)
+
+	modules, err := moduleEntries(c.moduleDir())
+	if err != nil {
+		logger.Info(fmt.Sprintf("Failed to list the entries of the Terraform module cache: %s", err.Error()))
+	}
+	evictEntries(ctx, metrics.TerraformModuleCache, modules, used, c.options.ModuleCacheMaxSize)
+}
+
+func (c *Cache) pluginDir() string {
+	return filepath.Join(c.options.Dir, pluginCacheSubDir)
+}
+
+func (c *Cache) moduleDir() string {
+	return filepath.Join(c.options.Dir, moduleCacheSubDir)
+}
+
+// isPinnedModule returns true if the module source and version always refer to the same module: the version of a
+// registry module is an exact version, or the ref of a git module is a semantic version tag or a commit.
+func isPinnedModule(source, moduleVersion string) bool {
+	if moduleVersion != "" {
+		_, err := version.NewSemver(moduleVersion)
+		return err == nil
+	}
+
+	_, query, ok := strings.Cut(source, "?")
+	if !ok {
+		return false
+	}
+	values, err := url.ParseQuery(query)
+	if err != nil {
+		return false
+	}
+
+	ref := values.Get("ref")
+	if commitRefRegex.MatchString(ref) {
+		return true
+	}
+	_, err = version.NewSemver(ref)
+	return ref != "" && err == nil
+}
+
+// moduleManifest is the manifest of the modules installed by Terraform in the .terraform/modules directory.
+type moduleManifest struct {
+	Modules []moduleRecord `json:"Modules"`
+}
+
+// moduleRecord is the record of a module installed by Terraform.
+type moduleRecord struct {
+	Key     string `json:"Key"`
+	Source  string `json:"Source"`
+	Version string `json:"Version,omitempty"`
+	Dir     string `json:"Dir"`
+}
+
+// copyModules copies the module named srcName and its nested modules from the srcDir modules directory to the dstDir
+// modules directory, renaming the module to dstName. The manifest of the modules is rewritten with the new name.
+func copyModules(srcDir, srcName, dstDir, dstName string) error {
+	b, err := os.ReadFile(filepath.Join(srcDir, moduleManifestFile))
+	if err != nil {
+		return err
+	}
+	manifest := moduleManifest{}
+	if err := json.Unmarshal(b, &manifest); err != nil {
+		return err
+	}
+
+	// The nested modules are keyed by the key of the parent module followed by a dot and the name of the module call.
+	rename := func(name, sep string) (string, bool) {
+		if name == srcName {
+			return dstName, true
+		}
+		if rest, ok := strings.CutPrefix(name, srcName+sep); ok {
+			return dstName + sep + rest, true
+		}
+		return "", false
+	}
+
+	result := moduleManifest{Modules: []moduleRecord{}}
+	for _, record := range manifest.Modules {
+		if record.Key == "" {
+			result.Modules = append(result.Modules, record)
+			continue
+		}
+
+		key, ok := rename(record.Key, ".")
+		if !ok {
+			continue
+		}
+		record.Key = key
+
+		// Registry and remote modules are installed in .terraform/modules/<key>, and local nested modules are in subdirectories of their parent module.
+		dir := filepath.ToSlash(record.Dir)
+		if rest, ok := strings.CutPrefix(dir, moduleRootDir+"/"); ok {
+			first, path, _ := strings.Cut(rest, "/")
+			if renamed, ok := rename(first, "."); ok {
+				record.Dir = strings.TrimSuffix(moduleRootDir+"/"+renamed+"/"+path, "/")
+			}
+		}
+		result.Modules = append(result.Modules, record)
+	}
+
+	entries, err := os.ReadDir(srcDir)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dstDir, workingDirFileMode); err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if name, ok := rename(entry.Name(), "."); ok && entry.IsDir() {
+			if err := copyDir(filepath.Join(srcDir, entry.Name()), filepath.Join(dstDir, name)); err != nil {
+				return err
+			}
+		}
+	}
+
+	b, err = json.Marshal(result)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dstDir, moduleManifestFile), b, 0600)
+}
+
+// copyDir copies the src directory to dst, preserving the file modes and symbolic links.
+func copyDir(src, dst string) error {
+	return filepath.WalkDir(src, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case d.IsDir():
+			return os.MkdirAll(target, info.Mode().Perm()|0700)
+		case info.Mode()&fs.ModeSymlink != 0:
+			link, err := os.Readlink(path)
+			if err != nil {
+				return err
+			}
+			return os.Symlink(link, target)
+		case info.Mode().IsRegular():
+			return copyFile(path, target, info.Mode().Perm())
+		}
+
+		return nil
+	})
+}
+
+func copyFile(src, dst string, perm fs.FileMode) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, perm)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		_ = out.Close()
+		return err
+	}
+
+	return out.Close()
+}
+
+// pluginEntries returns the provider versions in the given plugin directory, as paths relative to the directory
+// in the <hostname>/<namespace>/<type>/<version> layout used by Terraform.
+func pluginEntries(dir string) ([]string, error) {
+	matches, err := filepath.Glob(filepath.Join(dir, "*", "*", "*", "*"))
+	if err != nil {
+		return nil, err
+	}
+
+	entries := []string{}
+	for _, match := range matches {
+		rel, err := filepath.Rel(dir, match)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, rel)
+	}
+
+	return entries, nil
+}
+
+// moduleEntries returns the paths of the entries of the module cache.
+func moduleEntries(dir string) ([]string, error) {
+	files, err := os.ReadDir(dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return []string{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	entries := []string{}
+	for _, file := range files {
+		if file.IsDir() && !strings.HasPrefix(file.Name(), cacheTempPrefix) {
+			entries = append(entries, filepath.Join(dir, file.Name()))
+		}
+	}
+
+	return entries, nil
+}
+
+// evictEntries removes the least recently used entries until the total size of the entries doesn't exceed maxSize.
+// The used entries are never removed, even if the total size exceeds maxSize.
+func evictEntries(ctx context.Context, cacheType string, entries []string, used map[string]bool, maxSize int64) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	if maxSize <= 0 {
+		return
+	}
+
+	type cacheEntry struct {
+		path    string
+		size    int64
+		modTime time.Time
+	}
+
+	cacheEntries := []cacheEntry{}
+	var total int64
+	for _, path := range entries {
+		info, err := os.Stat(path)
+		if err != nil {
+			continue
+		}
+		size, err := dirSize(path)
+		if err != nil {
+			logger.Info(fmt.Sprintf("Failed to compute the size of the Terraform %s cache entry %q: %s", cacheType, path, err.Error()))
+			continue
+		}
+		cacheEntries = append(cacheEntries, cacheEntry{path: path, size: size, modTime: info.ModTime()})
+		total += size
+	}
+
+	sort.Slice(cacheEntries, func(i, j int) bool {
+		return cacheEntries[i].modTime.Before(cacheEntries[j].modTime)
+	})
+
+	for _, entry := range cacheEntries {
+		if total <= maxSize {
+			return
+		}
+		if used[entry.path] {
+			continue
+		}
+
+		logger.Info(fmt.Sprintf("Evicting the Terraform %s cache entry %q", cacheType, entry.path))
+		if err := os.RemoveAll(entry.path); err != nil {
+			logger.Info(fmt.Sprintf("Failed to evict the Terraform %s cache entry %q: %s", cacheType, entry.path, err.Error()))
+			continue
+		}
+		metrics.DefaultRecipeEngineMetrics.RecordTerraformCacheEviction(ctx, []attribute.KeyValue{metrics.TerraformCacheTypeAttrKey.String(cacheType)})
+		total -= entry.size
+	}
+}
+
+// dirSize returns the total size of the regular files in the directory.
+func dirSize(dir string) (int64, error) {
+	var size int64
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.Type().IsRegular() {
+			info, err := d.Info()
+			if err != nil {
+				return err
+			}
+			size += info.Size()
+		}
+		return nil
+	})
+
+	return size, err
+}
+
+// touch updates the modification time of the cache entry, which is used to evict the least recently used entries.
+func touch(path string) {
+	now := time.Now()
+	_ = os.Chtimes(path, now, now)
+}
+
+func recordCacheLookup(ctx context.Context, cacheType, result string) {
+	metrics.DefaultRecipeEngineMetrics.RecordTerraformCacheLookup(ctx, []attribute.KeyValue{
+		metrics.TerraformCacheTypeAttrKey.String(cacheType),
+		metrics.TerraformCacheResultAttrKey.String(result),
+	})
+}
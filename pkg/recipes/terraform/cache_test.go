@@ -0,0 +1,205 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+func writeTestFile(t *testing.T, path string, size int) {
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0700))
+	require.NoError(t, os.WriteFile(path, make([]byte, size), 0600))
+}
+
+func TestCache_Nil(t *testing.T) {
+	var c *Cache
+
+	env := map[string]string{"GIT_CONFIG_COUNT": "1"}
+	require.Equal(t, env, c.env(env))
+
+	_, ok := c.moduleKey(Options{EnvRecipe: &recipes.EnvironmentDefinition{TemplatePath: "Azure/redis/azurerm", TemplateVersion: "1.0.0"}})
+	require.False(t, ok)
+
+	release := c.acquire(testcontext.New(t), t.TempDir())
+	release()
+}
+
+func TestCache_env(t *testing.T) {
+	c := NewCache(CacheOptions{Dir: "/terraform/cache"})
+
+	env := c.env(map[string]string{"GIT_CONFIG_COUNT": "1"})
+	require.Equal(t, map[string]string{
+		"GIT_CONFIG_COUNT":                               "1",
+		"TF_PLUGIN_CACHE_DIR":                            "/terraform/cache/plugins",
+		"TF_PLUGIN_CACHE_MAY_BREAK_DEPENDENCY_LOCK_FILE": "true",
+	}, env)
+}
+
+func Test_isPinnedModule(t *testing.T) {
+	tests := []struct {
+		source  string
+		version string
+		pinned  bool
+	}{
+		{source: "Azure/redis/azurerm", version: "1.0.0", pinned: true},
+		{source: "Azure/redis/azurerm", version: "~> 1.0", pinned: false},
+		{source: "Azure/redis/azurerm", pinned: false},
+		{source: "git::https://github.com/org/recipes.git//redis?ref=v1.2.0", pinned: true},
+		{source: "git::https://github.com/org/recipes.git//redis?ref=0123456789abcdef0123456789abcdef01234567", pinned: true},
+		{source: "git::https://github.com/org/recipes.git//redis?ref=main", pinned: false},
+		{source: "git::https://github.com/org/recipes.git//redis", pinned: false},
+		{source: "https://example.com/recipes/redis.zip", pinned: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.source+"@"+tt.version, func(t *testing.T) {
+			require.Equal(t, tt.pinned, isPinnedModule(tt.source, tt.version))
+		})
+	}
+}
+
+func TestCache_moduleKey(t *testing.T) {
+	c := NewCache(CacheOptions{Dir: t.TempDir()})
+
+	options := func(version string, auth *datamodel.AuthConfig) Options {
+		return Options{
+			EnvRecipe: &recipes.EnvironmentDefinition{TemplatePath: "Azure/redis/azurerm", TemplateVersion: version},
+			EnvConfig: &recipes.Configuration{RecipeConfig: datamodel.RecipeConfigProperties{
+				Terraform: datamodel.TerraformConfigProperties{Authentication: auth},
+			}},
+		}
+	}
+
+	key, ok := c.moduleKey(options("1.0.0", nil))
+	require.True(t, ok)
+
+	other, ok := c.moduleKey(options("1.0.0", nil))
+	require.True(t, ok)
+	require.Equal(t, key, other, "the key of the same module must be stable")
+
+	other, ok = c.moduleKey(options("1.0.1", nil))
+	require.True(t, ok)
+	require.NotEqual(t, key, other, "the versions of a module must have different keys")
+
+	auth := &datamodel.AuthConfig{Git: datamodel.GitAuthConfig{PAT: map[string]datamodel.SecretConfig{
+		"dev.azure.com": {Secret: "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/secretStores/github"},
+	}}}
+	other, ok = c.moduleKey(options("1.0.0", auth))
+	require.True(t, ok)
+	require.NotEqual(t, key, other, "the module downloaded with credentials must have a different key")
+
+	_, ok = c.moduleKey(options("", nil))
+	require.False(t, ok)
+}
+
+func TestCache_StoreAndRestoreModule(t *testing.T) {
+	ctx := testcontext.New(t)
+	c := NewCache(CacheOptions{Dir: t.TempDir()})
+
+	// Install a module with a nested registry module and a nested local module in a working directory.
+	workingDir := t.TempDir()
+	modulesDir := filepath.Join(workingDir, moduleRootDir)
+	writeTestFile(t, filepath.Join(modulesDir, "redis", "main.tf"), 10)
+	writeTestFile(t, filepath.Join(modulesDir, "redis", "modules", "network", "main.tf"), 10)
+	writeTestFile(t, filepath.Join(modulesDir, "redis.cache", "main.tf"), 10)
+	manifest := moduleManifest{Modules: []moduleRecord{
+		{Key: "", Source: "", Dir: "."},
+		{Key: "redis", Source: "registry.terraform.io/Azure/redis/azurerm", Version: "1.0.0", Dir: ".terraform/modules/redis"},
+		{Key: "redis.cache", Source: "registry.terraform.io/Azure/cache/azurerm", Version: "2.0.0", Dir: ".terraform/modules/redis.cache"},
+		{Key: "redis.network", Source: "./modules/network", Dir: ".terraform/modules/redis/modules/network"},
+	}}
+	b, err := json.Marshal(manifest)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(modulesDir, moduleManifestFile), b, 0600))
+
+	restored, err := c.restoreModule(ctx, "key", t.TempDir(), "redis")
+	require.NoError(t, err)
+	require.False(t, restored)
+
+	require.NoError(t, c.storeModule(ctx, "key", workingDir, "redis"))
+	// Storing an existing entry is a no-op.
+	require.NoError(t, c.storeModule(ctx, "key", workingDir, "redis"))
+
+	// Restore the module in another working directory with another local name.
+	otherDir := t.TempDir()
+	restored, err = c.restoreModule(ctx, "key", otherDir, "cache")
+	require.NoError(t, err)
+	require.True(t, restored)
+
+	otherModulesDir := filepath.Join(otherDir, moduleRootDir)
+	require.FileExists(t, filepath.Join(otherModulesDir, "cache", "main.tf"))
+	require.FileExists(t, filepath.Join(otherModulesDir, "cache", "modules", "network", "main.tf"))
+	require.FileExists(t, filepath.Join(otherModulesDir, "cache.cache", "main.tf"))
+
+	b, err = os.ReadFile(filepath.Join(otherModulesDir, moduleManifestFile))
+	require.NoError(t, err)
+	restoredManifest := moduleManifest{}
+	require.NoError(t, json.Unmarshal(b, &restoredManifest))
+	require.Equal(t, []moduleRecord{
+		{Key: "", Source: "", Dir: "."},
+		{Key: "cache", Source: "registry.terraform.io/Azure/redis/azurerm", Version: "1.0.0", Dir: ".terraform/modules/cache"},
+		{Key: "cache.cache", Source: "registry.terraform.io/Azure/cache/azurerm", Version: "2.0.0", Dir: ".terraform/modules/cache.cache"},
+		{Key: "cache.network", Source: "./modules/network", Dir: ".terraform/modules/cache/modules/network"},
+	}, restoredManifest.Modules)
+}
+
+func TestCache_evict(t *testing.T) {
+	ctx := testcontext.New(t)
+	dir := t.TempDir()
+	c := NewCache(CacheOptions{Dir: dir, PluginCacheMaxSize: 250, ModuleCacheMaxSize: 150})
+
+	// Create the cache entries, from the least to the most recently used.
+	entries := []string{
+		filepath.Join(dir, pluginCacheSubDir, "registry.terraform.io", "hashicorp", "aws", "5.0.0"),
+		filepath.Join(dir, pluginCacheSubDir, "registry.terraform.io", "hashicorp", "aws", "5.1.0"),
+		filepath.Join(dir, pluginCacheSubDir, "registry.terraform.io", "hashicorp", "azurerm", "3.0.0"),
+		filepath.Join(dir, moduleCacheSubDir, "module1"),
+		filepath.Join(dir, moduleCacheSubDir, "module2"),
+	}
+	modTime := time.Now().Add(-time.Hour)
+	for i, entry := range entries {
+		writeTestFile(t, filepath.Join(entry, "linux_amd64", "provider"), 100)
+		require.NoError(t, os.Chtimes(entry, modTime, modTime.Add(time.Duration(i)*time.Minute)))
+	}
+
+	// The least recently used plugin is used by another execution, so the next one is evicted.
+	releaseOther := c.acquire(ctx, filepath.Join(dir, "other"))
+	c.use(filepath.Join(dir, "other"), entries[0])
+
+	release := c.acquire(ctx, filepath.Join(dir, "current"))
+	release()
+	require.DirExists(t, entries[0])
+	require.NoDirExists(t, entries[1])
+	require.DirExists(t, entries[2])
+	require.NoDirExists(t, entries[3])
+	require.DirExists(t, entries[4])
+
+	// The caches don't exceed their maximum size anymore.
+	releaseOther()
+	require.DirExists(t, entries[0])
+	require.DirExists(t, entries[2])
+	require.DirExists(t, entries[4])
+}
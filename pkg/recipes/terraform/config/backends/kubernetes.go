@@ -33,8 +33,6 @@ import (
 	"k8s.io/client-go/tools/clientcmd"
 )
 
-var _ Backend = (*kubernetesBackend)(nil)
-
 const (
 	RadiusNamespace   = "radius-system"
 	BackendKubernetes = "kubernetes"
@@ -61,7 +59,7 @@ func NewKubernetesBackend(k8sClientSet kubernetes.Interface) Backend {
 // It returns an error if the in cluster config cannot be retrieved, and uses default kubeconfig file if
 // in-cluster config is not present.
 // https://developer.hashicorp.com/terraform/language/settings/backends/kubernetes
-func (p *kubernetesBackend) BuildBackend(resourceRecipe *recipes.ResourceMetadata) (map[string]any, error) {
+func (p *kubernetesBackend) BuildBackend(ctx context.Context, resourceRecipe *recipes.ResourceMetadata) (map[string]any, error) {
 	secretSuffix, err := generateSecretSuffix(resourceRecipe)
 	if err != nil {
 		return nil, err
@@ -70,13 +68,17 @@ func (p *kubernetesBackend) BuildBackend(resourceRecipe *recipes.ResourceMetadat
 	return generateKubernetesBackendConfig(secretSuffix)
 }
 
-// ValidateBackendExists checks if the Kubernetes secret for Terraform state file exists.
-// name is the name of the backend Kubernetes secret resource that is created as a part of terraform apply
-// during recipe deployment.
-func (p *kubernetesBackend) ValidateBackendExists(ctx context.Context, name string) (bool, error) {
+// ValidateBackendExists checks if the Kubernetes secret for Terraform state file exists. The secret is created
+// as a part of terraform apply during recipe deployment.
+func (p *kubernetesBackend) ValidateBackendExists(ctx context.Context, resourceRecipe *recipes.ResourceMetadata) (bool, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
-	_, err := p.k8sClientSet.CoreV1().Secrets(RadiusNamespace).Get(ctx, name, metav1.GetOptions{})
+	name, err := kubernetesSecretName(resourceRecipe)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = p.k8sClientSet.CoreV1().Secrets(RadiusNamespace).Get(ctx, name, metav1.GetOptions{})
 	if err != nil {
 		if k8s_errors.IsNotFound(err) {
 			logger.Info(fmt.Sprintf("Kubernetes secret %q does not exist: %s", name, err.Error()))
@@ -89,8 +91,33 @@ func (p *kubernetesBackend) ValidateBackendExists(ctx context.Context, name stri
 	return true, nil
 }
 
+// DeleteBackend deletes the Kubernetes secret created for Terraform state file.
+func (p *kubernetesBackend) DeleteBackend(ctx context.Context, resourceRecipe *recipes.ResourceMetadata) error {
+	name, err := kubernetesSecretName(resourceRecipe)
+	if err != nil {
+		return err
+	}
+
+	err = p.k8sClientSet.CoreV1().Secrets(RadiusNamespace).Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil {
+		return fmt.Errorf("error deleting kubernetes secret for terraform state: %w", err)
+	}
+
+	return nil
+}
+
+// kubernetesSecretName returns the name of the Kubernetes secret generated by Terraform to store the state file of the resource.
+func kubernetesSecretName(resourceRecipe *recipes.ResourceMetadata) (string, error) {
+	secretSuffix, err := generateSecretSuffix(resourceRecipe)
+	if err != nil {
+		return "", err
+	}
+
+	return KubernetesBackendNamePrefix + secretSuffix, nil
+}
+
 // generateSecretSuffix returns a unique string from the resourceID, environmentID, and applicationID
-// which is used as key for kubernetes secret in defining terraform backend.
+// which is used as key of the Terraform state of the resource in defining terraform backend.
 func generateSecretSuffix(resourceRecipe *recipes.ResourceMetadata) (string, error) {
 	parsedResourceID, err := resources.Parse(resourceRecipe.ResourceID)
 	if err != nil {
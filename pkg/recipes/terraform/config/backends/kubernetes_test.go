@@ -124,35 +124,61 @@ func Test_GenerateSecretSuffix_invalid_appid(t *testing.T) {
 }
 
 func Test_ValidateBackendExists(t *testing.T) {
+	_, resourceRecipe := getTestInputs()
+	secretName, err := kubernetesSecretName(&resourceRecipe)
+	require.NoError(t, err)
+
 	clientset := fake.NewSimpleClientset()
 	secret := &v1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      "test-secret",
+			Name:      secretName,
 			Namespace: RadiusNamespace,
 		},
 		Data: map[string][]byte{
 			"key": []byte("value"),
 		},
 	}
-	_, err := clientset.CoreV1().Secrets(RadiusNamespace).Create(context.Background(), secret, metav1.CreateOptions{})
+	_, err = clientset.CoreV1().Secrets(RadiusNamespace).Create(context.Background(), secret, metav1.CreateOptions{})
 	require.NoError(t, err)
 
 	b := NewKubernetesBackend(clientset)
-	exists, err := b.ValidateBackendExists(context.Background(), "test-secret")
+	exists, err := b.ValidateBackendExists(context.Background(), &resourceRecipe)
 	require.NoError(t, err)
 	require.True(t, exists)
 
 	// Validate that the function returns false for a non-existent secret.
-	exists, err = b.ValidateBackendExists(context.Background(), "invalid-secret")
+	otherRecipe := resourceRecipe
+	otherRecipe.ResourceID = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Datastores/redisCaches/other"
+	exists, err = b.ValidateBackendExists(context.Background(), &otherRecipe)
 	require.NoError(t, err)
 	require.False(t, exists)
 
 	// Validate error is returned for errors other than NotFound.
 	clientset.Fake.PrependReactor("get", "secrets", func(action k8stesting.Action) (handled bool, ret runtime.Object, err error) {
-		return true, nil, k8s_errors.NewServerTimeout(schema.GroupResource{Resource: "test-secret"}, "get", 1)
+		return true, nil, k8s_errors.NewServerTimeout(schema.GroupResource{Resource: secretName}, "get", 1)
 	})
-	exists, err = b.ValidateBackendExists(context.Background(), "test-secret")
+	exists, err = b.ValidateBackendExists(context.Background(), &resourceRecipe)
 	require.Error(t, err)
 	require.True(t, k8s_errors.IsServerTimeout(err))
 	require.False(t, exists)
 }
+
+func Test_DeleteBackend(t *testing.T) {
+	_, resourceRecipe := getTestInputs()
+	secretName, err := kubernetesSecretName(&resourceRecipe)
+	require.NoError(t, err)
+
+	clientset := fake.NewSimpleClientset(&v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      secretName,
+			Namespace: RadiusNamespace,
+		},
+	})
+
+	b := NewKubernetesBackend(clientset)
+	err = b.DeleteBackend(context.Background(), &resourceRecipe)
+	require.NoError(t, err)
+
+	_, err = clientset.CoreV1().Secrets(RadiusNamespace).Get(context.Background(), secretName, metav1.GetOptions{})
+	require.True(t, k8s_errors.IsNotFound(err))
+}
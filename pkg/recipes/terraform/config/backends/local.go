@@ -0,0 +1,109 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	BackendLocal = "local"
+)
+
+var _ Backend = (*localBackend)(nil)
+
+type localBackend struct {
+	config datamodel.LocalBackendProperties
+}
+
+// NewLocalBackend creates a new Backend storing the Terraform state in the local filesystem of the Radius control plane.
+// The directory should be backed by a persistent volume, the state is lost otherwise when the control plane restarts.
+func NewLocalBackend(config datamodel.LocalBackendProperties) Backend {
+	return &localBackend{config: config}
+}
+
+// BuildBackend generates the Terraform backend configuration for local backend.
+// https://developer.hashicorp.com/terraform/language/settings/backends/local
+func (b *localBackend) BuildBackend(ctx context.Context, resourceRecipe *recipes.ResourceMetadata) (map[string]any, error) {
+	path, err := b.statePath(resourceRecipe)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		BackendLocal: map[string]any{
+			"path": path,
+		},
+	}, nil
+}
+
+// ValidateBackendExists checks if the Terraform state file of the resource exists.
+func (b *localBackend) ValidateBackendExists(ctx context.Context, resourceRecipe *recipes.ResourceMetadata) (bool, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	path, err := b.statePath(resourceRecipe)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = os.Stat(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			logger.Info(fmt.Sprintf("Terraform state file %q does not exist", path))
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return true, nil
+}
+
+// DeleteBackend deletes the Terraform state file of the resource and its backup.
+func (b *localBackend) DeleteBackend(ctx context.Context, resourceRecipe *recipes.ResourceMetadata) error {
+	path, err := b.statePath(resourceRecipe)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range []string{path, path + ".backup"} {
+		if err := os.Remove(p); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("error deleting terraform state file: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// statePath returns the path of the Terraform state file of the resource.
+func (b *localBackend) statePath(resourceRecipe *recipes.ResourceMetadata) (string, error) {
+	suffix, err := generateSecretSuffix(resourceRecipe)
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(b.config.Path, suffix+".tfstate"), nil
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_LocalBackend(t *testing.T) {
+	ctx := testcontext.New(t)
+	dir := t.TempDir()
+	_, resourceRecipe := getTestInputs()
+	suffix, err := generateSecretSuffix(&resourceRecipe)
+	require.NoError(t, err)
+	statePath := filepath.Join(dir, suffix+".tfstate")
+
+	b := NewLocalBackend(datamodel.LocalBackendProperties{Path: dir})
+	config, err := b.BuildBackend(ctx, &resourceRecipe)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{BackendLocal: map[string]any{"path": statePath}}, config)
+
+	exists, err := b.ValidateBackendExists(ctx, &resourceRecipe)
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	require.NoError(t, os.WriteFile(statePath, []byte("{}"), 0600))
+	require.NoError(t, os.WriteFile(statePath+".backup", []byte("{}"), 0600))
+	exists, err = b.ValidateBackendExists(ctx, &resourceRecipe)
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	err = b.DeleteBackend(ctx, &resourceRecipe)
+	require.NoError(t, err)
+	require.NoFileExists(t, statePath)
+	require.NoFileExists(t, statePath+".backup")
+
+	// Deleting a missing state file is a no-op.
+	err = b.DeleteBackend(ctx, &resourceRecipe)
+	require.NoError(t, err)
+}
@@ -36,22 +36,36 @@ func (m *MockBackend) EXPECT() *MockBackendMockRecorder {
 }
 
 // BuildBackend mocks base method.
-func (m *MockBackend) BuildBackend(arg0 *recipes.ResourceMetadata) (map[string]interface{}, error) {
+func (m *MockBackend) BuildBackend(arg0 context.Context, arg1 *recipes.ResourceMetadata) (map[string]interface{}, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "BuildBackend", arg0)
+	ret := m.ctrl.Call(m, "BuildBackend", arg0, arg1)
 	ret0, _ := ret[0].(map[string]interface{})
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // BuildBackend indicates an expected call of BuildBackend.
-func (mr *MockBackendMockRecorder) BuildBackend(arg0 interface{}) *gomock.Call {
+func (mr *MockBackendMockRecorder) BuildBackend(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildBackend", reflect.TypeOf((*MockBackend)(nil).BuildBackend), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildBackend", reflect.TypeOf((*MockBackend)(nil).BuildBackend), arg0, arg1)
+}
+
+// DeleteBackend mocks base method.
+func (m *MockBackend) DeleteBackend(arg0 context.Context, arg1 *recipes.ResourceMetadata) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBackend", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBackend indicates an expected call of DeleteBackend.
+func (mr *MockBackendMockRecorder) DeleteBackend(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBackend", reflect.TypeOf((*MockBackend)(nil).DeleteBackend), arg0, arg1)
 }
 
 // ValidateBackendExists mocks base method.
-func (m *MockBackend) ValidateBackendExists(arg0 context.Context, arg1 string) (bool, error) {
+func (m *MockBackend) ValidateBackendExists(arg0 context.Context, arg1 *recipes.ResourceMetadata) (bool, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ValidateBackendExists", arg0, arg1)
 	ret0, _ := ret[0].(bool)
@@ -0,0 +1,198 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/lib/pq"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/ucp/secret"
+	ucp_provider "github.com/radius-project/radius/pkg/ucp/secret/provider"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	BackendPostgreSQL = "pg"
+
+	// postgreSQLSchemaPrefix is the prefix of the database schema storing the Terraform state of a resource.
+	postgreSQLSchemaPrefix = "tfstate_"
+	// postgreSQLDriverName is the name of the database/sql driver registered by lib/pq.
+	postgreSQLDriverName = "postgres"
+	// terraformDefaultWorkspace is the Terraform workspace used for recipes deployment.
+	terraformDefaultWorkspace = "default"
+)
+
+var _ Backend = (*postgreSQLBackend)(nil)
+
+// postgreSQLCredentials is the content of the UCP secret holding the credentials of the database.
+type postgreSQLCredentials struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+type postgreSQLBackend struct {
+	config         datamodel.PostgreSQLBackendProperties
+	secretProvider *ucp_provider.SecretProvider
+}
+
+// NewPostgreSQLBackend creates a new Backend storing the Terraform state in a PostgreSQL database. The state of
+// each resource is stored in its own schema. secretProvider is used to fetch the credentials of the database
+// when a secret name is configured.
+func NewPostgreSQLBackend(config datamodel.PostgreSQLBackendProperties, secretProvider *ucp_provider.SecretProvider) Backend {
+	return &postgreSQLBackend{config: config, secretProvider: secretProvider}
+}
+
+// BuildBackend generates the Terraform backend configuration for PostgreSQL backend.
+// https://developer.hashicorp.com/terraform/language/settings/backends/pg
+func (b *postgreSQLBackend) BuildBackend(ctx context.Context, resourceRecipe *recipes.ResourceMetadata) (map[string]any, error) {
+	schema, err := postgreSQLSchemaName(resourceRecipe)
+	if err != nil {
+		return nil, err
+	}
+
+	connStr, err := b.connectionString(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		BackendPostgreSQL: map[string]any{
+			"conn_str":    connStr,
+			"schema_name": schema,
+		},
+	}, nil
+}
+
+// ValidateBackendExists checks if the Terraform state of the resource exists in the database. The schema and
+// the state are created as a part of terraform apply during recipe deployment.
+func (b *postgreSQLBackend) ValidateBackendExists(ctx context.Context, resourceRecipe *recipes.ResourceMetadata) (bool, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	schema, err := postgreSQLSchemaName(resourceRecipe)
+	if err != nil {
+		return false, err
+	}
+
+	db, err := b.open(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer db.Close()
+
+	var tableExists bool
+	err = db.QueryRowContext(ctx, "SELECT to_regclass($1) IS NOT NULL", pq.QuoteIdentifier(schema)+".states").Scan(&tableExists)
+	if err != nil {
+		return false, fmt.Errorf("error retrieving terraform state from postgresql: %w", err)
+	}
+	if !tableExists {
+		logger.Info(fmt.Sprintf("Terraform state schema %q does not exist", schema))
+		return false, nil
+	}
+
+	var stateExists bool
+	query := fmt.Sprintf("SELECT EXISTS (SELECT 1 FROM %s.states WHERE name = $1)", pq.QuoteIdentifier(schema))
+	err = db.QueryRowContext(ctx, query, terraformDefaultWorkspace).Scan(&stateExists)
+	if err != nil {
+		return false, fmt.Errorf("error retrieving terraform state from postgresql: %w", err)
+	}
+
+	return stateExists, nil
+}
+
+// DeleteBackend drops the schema storing the Terraform state of the resource.
+func (b *postgreSQLBackend) DeleteBackend(ctx context.Context, resourceRecipe *recipes.ResourceMetadata) error {
+	schema, err := postgreSQLSchemaName(resourceRecipe)
+	if err != nil {
+		return err
+	}
+
+	db, err := b.open(ctx)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	_, err = db.ExecContext(ctx, fmt.Sprintf("DROP SCHEMA IF EXISTS %s CASCADE", pq.QuoteIdentifier(schema)))
+	if err != nil {
+		return fmt.Errorf("error deleting terraform state from postgresql: %w", err)
+	}
+
+	return nil
+}
+
+func (b *postgreSQLBackend) open(ctx context.Context) (*sql.DB, error) {
+	connStr, err := b.connectionString(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return sql.Open(postgreSQLDriverName, connStr)
+}
+
+// connectionString returns the connection string of the database, with the credentials stored in the UCP secret
+// store if a secret name is configured. The connection string must be in URL format to add the credentials.
+func (b *postgreSQLBackend) connectionString(ctx context.Context) (string, error) {
+	if b.config.SecretName == "" {
+		return b.config.ConnectionString, nil
+	}
+
+	if b.secretProvider == nil {
+		return "", errors.New("secret provider is required to fetch postgresql credentials")
+	}
+
+	client, err := b.secretProvider.GetClient(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	creds, err := secret.GetSecret[postgreSQLCredentials](ctx, client, b.config.SecretName)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch postgresql credentials from secret %q: %w", b.config.SecretName, err)
+	}
+
+	return withCredentials(b.config.ConnectionString, creds)
+}
+
+// withCredentials adds the credentials to a connection string in URL format.
+func withCredentials(connStr string, creds postgreSQLCredentials) (string, error) {
+	u, err := url.Parse(connStr)
+	if err != nil || (u.Scheme != "postgres" && u.Scheme != "postgresql") {
+		return "", fmt.Errorf("postgresql connection string must be a URL with postgres or postgresql scheme when credentials are configured")
+	}
+
+	if creds.Username != "" {
+		u.User = url.UserPassword(creds.Username, creds.Password)
+	}
+
+	return u.String(), nil
+}
+
+// postgreSQLSchemaName returns the name of the database schema storing the Terraform state of the resource.
+func postgreSQLSchemaName(resourceRecipe *recipes.ResourceMetadata) (string, error) {
+	suffix, err := generateSecretSuffix(resourceRecipe)
+	if err != nil {
+		return "", err
+	}
+
+	return postgreSQLSchemaPrefix + suffix, nil
+}
@@ -0,0 +1,229 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/ucp/credentials"
+	"github.com/radius-project/radius/pkg/ucp/secret"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	BackendS3 = "s3"
+
+	// defaultS3KeyPrefix is the prefix of the Terraform state object keys when the environment doesn't specify one.
+	defaultS3KeyPrefix = "radius/"
+	// defaultS3Region is the region used when the environment doesn't specify the region of the bucket.
+	defaultS3Region = "us-east-1"
+
+	// emptyPayloadHash is the SHA256 hash of an empty request body, used to sign the requests without body.
+	emptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+)
+
+var _ Backend = (*s3Backend)(nil)
+
+type s3Backend struct {
+	config             datamodel.S3BackendProperties
+	credentialProvider credentials.CredentialProvider[credentials.AWSCredential]
+	httpClient         *http.Client
+}
+
+// NewS3Backend creates a new Backend storing the Terraform state in S3 or S3-compatible object storage.
+// credentialProvider is used to fetch the AWS credentials registered with UCP, it can be nil if the
+// bucket doesn't require authentication.
+func NewS3Backend(config datamodel.S3BackendProperties, credentialProvider credentials.CredentialProvider[credentials.AWSCredential]) Backend {
+	return &s3Backend{config: config, credentialProvider: credentialProvider, httpClient: http.DefaultClient}
+}
+
+// BuildBackend generates the Terraform backend configuration for S3 backend. The AWS credentials registered
+// with UCP are added to the configuration if present.
+// https://developer.hashicorp.com/terraform/language/settings/backends/s3
+func (b *s3Backend) BuildBackend(ctx context.Context, resourceRecipe *recipes.ResourceMetadata) (map[string]any, error) {
+	key, err := b.stateKey(resourceRecipe)
+	if err != nil {
+		return nil, err
+	}
+
+	config := map[string]any{
+		"bucket": b.config.Bucket,
+		"key":    key,
+		"region": b.region(),
+	}
+
+	if b.config.DynamoDBTable != "" {
+		config["dynamodb_table"] = b.config.DynamoDBTable
+	}
+
+	// S3-compatible storage (e.g. MinIO) doesn't support the AWS specific validations and virtual-hosted style addressing.
+	if b.config.Endpoint != "" {
+		config["endpoints"] = map[string]any{"s3": b.config.Endpoint}
+		config["use_path_style"] = true
+		config["skip_credentials_validation"] = true
+		config["skip_region_validation"] = true
+		config["skip_requesting_account_id"] = true
+		config["skip_metadata_api_check"] = true
+		config["skip_s3_checksum"] = true
+	}
+
+	creds, err := b.fetchCredentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if creds != nil {
+		config["access_key"] = creds.AccessKeyID
+		config["secret_key"] = creds.SecretAccessKey
+	}
+
+	return map[string]any{
+		BackendS3: config,
+	}, nil
+}
+
+// ValidateBackendExists checks if the object storing the Terraform state of the resource exists in the bucket.
+// The object is created as a part of terraform apply during recipe deployment.
+func (b *s3Backend) ValidateBackendExists(ctx context.Context, resourceRecipe *recipes.ResourceMetadata) (bool, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	resp, err := b.do(ctx, http.MethodHead, resourceRecipe)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound:
+		logger.Info(fmt.Sprintf("Terraform state object does not exist in bucket %q", b.config.Bucket))
+		return false, nil
+	default:
+		return false, fmt.Errorf("unexpected status code %d retrieving terraform state from bucket %q", resp.StatusCode, b.config.Bucket)
+	}
+}
+
+// DeleteBackend deletes the object storing the Terraform state of the resource from the bucket.
+func (b *s3Backend) DeleteBackend(ctx context.Context, resourceRecipe *recipes.ResourceMetadata) error {
+	resp, err := b.do(ctx, http.MethodDelete, resourceRecipe)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusNoContent, http.StatusNotFound:
+		return nil
+	default:
+		return fmt.Errorf("unexpected status code %d deleting terraform state from bucket %q", resp.StatusCode, b.config.Bucket)
+	}
+}
+
+// do sends a request for the Terraform state object of the resource using path-style addressing, signed
+// with the AWS credentials registered with UCP if present.
+func (b *s3Backend) do(ctx context.Context, method string, resourceRecipe *recipes.ResourceMetadata) (*http.Response, error) {
+	key, err := b.stateKey(resourceRecipe)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := b.config.Endpoint
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://s3.%s.amazonaws.com", b.region())
+	}
+
+	objectURL, err := url.JoinPath(endpoint, b.config.Bucket, key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid s3 endpoint %q: %w", endpoint, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, objectURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	creds, err := b.fetchCredentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if creds != nil {
+		req.Header.Set("X-Amz-Content-Sha256", emptyPayloadHash)
+		awsCreds := aws.Credentials{AccessKeyID: creds.AccessKeyID, SecretAccessKey: creds.SecretAccessKey}
+		if err := v4.NewSigner().SignHTTP(ctx, awsCreds, req, emptyPayloadHash, BackendS3, b.region(), time.Now()); err != nil {
+			return nil, err
+		}
+	}
+
+	return b.httpClient.Do(req)
+}
+
+// fetchCredentials fetches AWS credentials from UCP. Returns nil if the credentials are not registered.
+func (b *s3Backend) fetchCredentials(ctx context.Context) (*credentials.AWSCredential, error) {
+	if b.credentialProvider == nil {
+		return nil, nil
+	}
+
+	creds, err := b.credentialProvider.Fetch(ctx, credentials.AWSPublic, "default")
+	if err != nil {
+		if errors.Is(err, &secret.ErrNotFound{}) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	if creds == nil || creds.AccessKeyID == "" || creds.SecretAccessKey == "" {
+		return nil, nil
+	}
+
+	return creds, nil
+}
+
+// stateKey returns the key of the object storing the Terraform state of the resource.
+func (b *s3Backend) stateKey(resourceRecipe *recipes.ResourceMetadata) (string, error) {
+	suffix, err := generateSecretSuffix(resourceRecipe)
+	if err != nil {
+		return "", err
+	}
+
+	prefix := b.config.KeyPrefix
+	if prefix == "" {
+		prefix = defaultS3KeyPrefix
+	} else if !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+
+	return prefix + suffix + ".tfstate", nil
+}
+
+func (b *s3Backend) region() string {
+	if b.config.Region == "" {
+		return defaultS3Region
+	}
+
+	return b.config.Region
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/credentials"
+	"github.com/radius-project/radius/pkg/ucp/secret"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeAWSCredentialProvider struct {
+	credential *credentials.AWSCredential
+	err        error
+}
+
+func (p *fakeAWSCredentialProvider) Fetch(ctx context.Context, planeName, name string) (*credentials.AWSCredential, error) {
+	return p.credential, p.err
+}
+
+// newObjectStore returns a server acting as a minimal S3-compatible object storage (like MinIO) using path-style addressing.
+func newObjectStore(t *testing.T, objects map[string]bool) *httptest.Server {
+	var mu sync.Mutex
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") == "" || !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256") {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		switch r.Method {
+		case http.MethodHead:
+			if objects[r.URL.Path] {
+				w.WriteHeader(http.StatusOK)
+				return
+			}
+			w.WriteHeader(http.StatusNotFound)
+		case http.MethodDelete:
+			delete(objects, r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func Test_S3Backend_BuildBackend(t *testing.T) {
+	_, resourceRecipe := getTestInputs()
+	suffix, err := generateSecretSuffix(&resourceRecipe)
+	require.NoError(t, err)
+
+	t.Run("aws s3", func(t *testing.T) {
+		b := NewS3Backend(datamodel.S3BackendProperties{
+			Bucket:        "tfstate",
+			Region:        "us-west-2",
+			DynamoDBTable: "tflock",
+		}, &fakeAWSCredentialProvider{credential: &credentials.AWSCredential{AccessKeyID: "id", SecretAccessKey: "secret"}})
+
+		config, err := b.BuildBackend(testcontext.New(t), &resourceRecipe)
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{
+			BackendS3: map[string]any{
+				"bucket":         "tfstate",
+				"key":            "radius/" + suffix + ".tfstate",
+				"region":         "us-west-2",
+				"dynamodb_table": "tflock",
+				"access_key":     "id",
+				"secret_key":     "secret",
+			},
+		}, config)
+	})
+
+	t.Run("s3-compatible storage without credentials", func(t *testing.T) {
+		b := NewS3Backend(datamodel.S3BackendProperties{
+			Bucket:    "tfstate",
+			Endpoint:  "http://minio:9000",
+			KeyPrefix: "states",
+		}, &fakeAWSCredentialProvider{err: &secret.ErrNotFound{}})
+
+		config, err := b.BuildBackend(testcontext.New(t), &resourceRecipe)
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{
+			BackendS3: map[string]any{
+				"bucket":                      "tfstate",
+				"key":                         "states/" + suffix + ".tfstate",
+				"region":                      defaultS3Region,
+				"endpoints":                   map[string]any{"s3": "http://minio:9000"},
+				"use_path_style":              true,
+				"skip_credentials_validation": true,
+				"skip_region_validation":      true,
+				"skip_requesting_account_id":  true,
+				"skip_metadata_api_check":     true,
+				"skip_s3_checksum":            true,
+			},
+		}, config)
+	})
+
+	t.Run("invalid resource id", func(t *testing.T) {
+		invalid := resourceRecipe
+		invalid.ResourceID = "invalid"
+		b := NewS3Backend(datamodel.S3BackendProperties{Bucket: "tfstate"}, nil)
+		_, err := b.BuildBackend(testcontext.New(t), &invalid)
+		require.EqualError(t, err, "'invalid' is not a valid resource id")
+	})
+}
+
+func Test_S3Backend_ValidateAndDelete(t *testing.T) {
+	_, resourceRecipe := getTestInputs()
+	suffix, err := generateSecretSuffix(&resourceRecipe)
+	require.NoError(t, err)
+
+	objects := map[string]bool{"/tfstate/radius/" + suffix + ".tfstate": true}
+	server := newObjectStore(t, objects)
+
+	b := NewS3Backend(datamodel.S3BackendProperties{
+		Bucket:   "tfstate",
+		Endpoint: server.URL,
+	}, &fakeAWSCredentialProvider{credential: &credentials.AWSCredential{AccessKeyID: "id", SecretAccessKey: "secret"}})
+
+	ctx := testcontext.New(t)
+	exists, err := b.ValidateBackendExists(ctx, &resourceRecipe)
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	err = b.DeleteBackend(ctx, &resourceRecipe)
+	require.NoError(t, err)
+	require.Empty(t, objects)
+
+	exists, err = b.ValidateBackendExists(ctx, &resourceRecipe)
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	// The storage rejects unsigned requests.
+	unauthenticated := NewS3Backend(datamodel.S3BackendProperties{Bucket: "tfstate", Endpoint: server.URL}, nil)
+	_, err = unauthenticated.ValidateBackendExists(ctx, &resourceRecipe)
+	require.ErrorContains(t, err, "unexpected status code 403")
+}
@@ -18,8 +18,15 @@ package backends
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/radius-project/radius/pkg/ucp/credentials"
+	ucp_provider "github.com/radius-project/radius/pkg/ucp/secret/provider"
+	"k8s.io/client-go/kubernetes"
 )
 
 //go:generate mockgen -destination=./mock_backend.go -package=backends -self_package github.com/radius-project/radius/pkg/recipes/terraform/config/backends github.com/radius-project/radius/pkg/recipes/terraform/config/backends Backend
@@ -29,10 +36,58 @@ type Backend interface {
 	// BuildBackend generates the Terraform backend configuration for the backend.
 	// Returns a map of Terraform backend name to values representing the backend configuration.
 	// Returns an error if the backend configuration cannot be generated.
-	BuildBackend(resourceRecipe *recipes.ResourceMetadata) (map[string]any, error)
+	BuildBackend(ctx context.Context, resourceRecipe *recipes.ResourceMetadata) (map[string]any, error)
 
-	// ValidateBackendExists checks if the Terraform state file backend source exists.
+	// ValidateBackendExists checks if the Terraform state file backend source exists for the resource.
 	// For example, for Kubernetes backend, it checks if the Kubernetes secret for Terraform state file exists.
 	// returns true if backend is found, false otherwise.
-	ValidateBackendExists(ctx context.Context, name string) (bool, error)
+	ValidateBackendExists(ctx context.Context, resourceRecipe *recipes.ResourceMetadata) (bool, error)
+
+	// DeleteBackend deletes the Terraform state file backend source of the resource.
+	// It is called after the resources deployed by the recipe are destroyed.
+	DeleteBackend(ctx context.Context, resourceRecipe *recipes.ResourceMetadata) error
+}
+
+// NewBackend returns the Backend configured for the Terraform recipes of the environment. Kubernetes backend is used
+// if the environment doesn't configure a backend. Credentials of the backends are resolved through the UCP credential
+// and secret stores.
+func NewBackend(envConfig *recipes.Configuration, k8sClientSet kubernetes.Interface, ucpConn sdk.Connection, secretProvider *ucp_provider.SecretProvider) (Backend, error) {
+	if envConfig == nil || envConfig.RecipeConfig.Terraform.Backend == nil {
+		return NewKubernetesBackend(k8sClientSet), nil
+	}
+
+	backend := envConfig.RecipeConfig.Terraform.Backend
+	switch backend.Kind {
+	case "", datamodel.TerraformBackendKubernetes:
+		return NewKubernetesBackend(k8sClientSet), nil
+	case datamodel.TerraformBackendS3:
+		if backend.S3 == nil {
+			return nil, fmt.Errorf("s3 configuration is required for terraform backend of kind %q", backend.Kind)
+		}
+
+		var credentialProvider credentials.CredentialProvider[credentials.AWSCredential]
+		if ucpConn != nil && secretProvider != nil {
+			awsProvider, err := credentials.NewAWSCredentialProvider(secretProvider, ucpConn, &tokencredentials.AnonymousCredential{})
+			if err != nil {
+				return nil, err
+			}
+			credentialProvider = awsProvider
+		}
+
+		return NewS3Backend(*backend.S3, credentialProvider), nil
+	case datamodel.TerraformBackendPostgreSQL:
+		if backend.PostgreSQL == nil {
+			return nil, fmt.Errorf("postgresql configuration is required for terraform backend of kind %q", backend.Kind)
+		}
+
+		return NewPostgreSQLBackend(*backend.PostgreSQL, secretProvider), nil
+	case datamodel.TerraformBackendLocal:
+		if backend.Local == nil {
+			return nil, fmt.Errorf("local configuration is required for terraform backend of kind %q", backend.Kind)
+		}
+
+		return NewLocalBackend(*backend.Local), nil
+	default:
+		return nil, fmt.Errorf("unsupported terraform backend kind %q", backend.Kind)
+	}
 }
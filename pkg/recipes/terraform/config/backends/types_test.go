@@ -0,0 +1,101 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func Test_NewBackend(t *testing.T) {
+	withBackend := func(backend *datamodel.TerraformBackendProperties) *recipes.Configuration {
+		return &recipes.Configuration{
+			RecipeConfig: datamodel.RecipeConfigProperties{
+				Terraform: datamodel.TerraformConfigProperties{Backend: backend},
+			},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		envConfig *recipes.Configuration
+		expected  Backend
+		err       string
+	}{
+		{
+			name:      "default",
+			envConfig: &recipes.Configuration{},
+			expected:  &kubernetesBackend{},
+		},
+		{
+			name:      "kubernetes",
+			envConfig: withBackend(&datamodel.TerraformBackendProperties{Kind: datamodel.TerraformBackendKubernetes}),
+			expected:  &kubernetesBackend{},
+		},
+		{
+			name: "s3",
+			envConfig: withBackend(&datamodel.TerraformBackendProperties{
+				Kind: datamodel.TerraformBackendS3,
+				S3:   &datamodel.S3BackendProperties{Bucket: "tfstate"},
+			}),
+			expected: &s3Backend{},
+		},
+		{
+			name: "postgresql",
+			envConfig: withBackend(&datamodel.TerraformBackendProperties{
+				Kind:       datamodel.TerraformBackendPostgreSQL,
+				PostgreSQL: &datamodel.PostgreSQLBackendProperties{ConnectionString: "postgres://db/tfstate"},
+			}),
+			expected: &postgreSQLBackend{},
+		},
+		{
+			name: "local",
+			envConfig: withBackend(&datamodel.TerraformBackendProperties{
+				Kind:  datamodel.TerraformBackendLocal,
+				Local: &datamodel.LocalBackendProperties{Path: "/var/radius/tfstate"},
+			}),
+			expected: &localBackend{},
+		},
+		{
+			name:      "missing configuration",
+			envConfig: withBackend(&datamodel.TerraformBackendProperties{Kind: datamodel.TerraformBackendS3}),
+			err:       `s3 configuration is required for terraform backend of kind "s3"`,
+		},
+		{
+			name:      "unsupported kind",
+			envConfig: withBackend(&datamodel.TerraformBackendProperties{Kind: "gcs"}),
+			err:       `unsupported terraform backend kind "gcs"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			backend, err := NewBackend(tc.envConfig, fake.NewSimpleClientset(), nil, nil)
+			if tc.err != "" {
+				require.EqualError(t, err, tc.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.IsType(t, tc.expected, backend)
+		})
+	}
+}
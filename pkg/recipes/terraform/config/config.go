@@ -22,7 +22,9 @@ import (
 	"errors"
 	"fmt"
 	"io/fs"
+	"maps"
 	"os"
+	"sort"
 
 	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/recipes/recipecontext"
@@ -80,15 +82,44 @@ func (cfg *TerraformConfig) Save(ctx context.Context, workingDir string) error {
 }
 
 // AddProviders adds provider configurations for requiredProviders that are supported
-// by Radius to generate custom provider configurations. Save() must be called to save
-// the generated providers config. requiredProviders contains a list of provider names
-// that are required for the module.
-func (cfg *TerraformConfig) AddProviders(ctx context.Context, requiredProviders []string, supportedProviders map[string]providers.Provider, envConfig *recipes.Configuration) error {
+// by Radius to generate custom provider configurations, and for requiredProviders configured
+// in the recipe configuration of the environment. Save() must be called to save
+// the generated providers config. requiredProviders contains the providers required by the
+// module, keyed by the provider name. secrets contains the secrets referenced by the provider
+// configurations of the environment, keyed by the secret store resource ID.
+func (cfg *TerraformConfig) AddProviders(ctx context.Context, requiredProviders map[string]*RequiredProviderInfo, supportedProviders map[string]providers.Provider, envConfig *recipes.Configuration, secrets map[string]recipes.SecretData) error {
 	providerConfigs, err := getProviderConfigs(ctx, requiredProviders, supportedProviders, envConfig)
 	if err != nil {
 		return err
 	}
 
+	envProviderConfigs, err := getEnvProviderConfigs(requiredProviders, envConfig, secrets)
+	if err != nil {
+		return err
+	}
+
+	for name, envProviderConfig := range envProviderConfigs {
+		// Settings configured on the environment take precedence over the settings generated by Radius.
+		merged := map[string]any{}
+		if generated, ok := providerConfigs[name].(map[string]any); ok {
+			maps.Copy(merged, generated)
+		}
+		maps.Copy(merged, envProviderConfig)
+		providerConfigs[name] = merged
+
+		// The provider requirement is declared in the root module, otherwise Terraform assumes the provider is
+		// published by HashiCorp and the configuration doesn't apply to the provider required by the module.
+		if info := requiredProviders[name]; info != nil && info.Source != "" {
+			if cfg.Terraform == nil {
+				cfg.Terraform = &TerraformDefinition{}
+			}
+			if cfg.Terraform.RequiredProviders == nil {
+				cfg.Terraform.RequiredProviders = map[string]*RequiredProviderInfo{}
+			}
+			cfg.Terraform.RequiredProviders[name] = info
+		}
+	}
+
 	// Add generated provider configs for required providers to the existing terraform json config file
 	if len(providerConfigs) > 0 {
 		cfg.Provider = providerConfigs
@@ -136,9 +167,9 @@ func newModuleConfig(moduleSource string, moduleVersion string, params ...Recipe
 }
 
 // getProviderConfigs generates the Terraform provider configurations for the required providers.
-func getProviderConfigs(ctx context.Context, requiredProviders []string, supportedProviders map[string]providers.Provider, envConfig *recipes.Configuration) (map[string]any, error) {
+func getProviderConfigs(ctx context.Context, requiredProviders map[string]*RequiredProviderInfo, supportedProviders map[string]providers.Provider, envConfig *recipes.Configuration) (map[string]any, error) {
 	providerConfigs := make(map[string]any)
-	for _, provider := range requiredProviders {
+	for _, provider := range sortedProviderNames(requiredProviders) {
 		builder, ok := supportedProviders[provider]
 		if !ok {
 			// No-op: For any other provider, Radius doesn't generate any custom configuration.
@@ -157,17 +188,59 @@ func getProviderConfigs(ctx context.Context, requiredProviders []string, support
 	return providerConfigs, nil
 }
 
+// getEnvProviderConfigs returns the provider configurations of the environment for the required providers. The values
+// of the settings referencing secrets are resolved from secrets.
+func getEnvProviderConfigs(requiredProviders map[string]*RequiredProviderInfo, envConfig *recipes.Configuration, secrets map[string]recipes.SecretData) (map[string]map[string]any, error) {
+	providerConfigs := make(map[string]map[string]any)
+	if envConfig == nil {
+		return providerConfigs, nil
+	}
+
+	for name, provider := range envConfig.RecipeConfig.Terraform.Providers {
+		if _, ok := requiredProviders[name]; !ok {
+			// Configuration for the providers which are not required by the module is not added.
+			continue
+		}
+
+		config := make(map[string]any)
+		maps.Copy(config, provider.AdditionalProperties)
+		for key, ref := range provider.Secrets {
+			value, ok := secrets[ref.Source][ref.Key]
+			if !ok {
+				return nil, fmt.Errorf("secret key %q of secret store %q referenced by setting %q of provider %q is not loaded", ref.Key, ref.Source, key, name)
+			}
+			config[key] = value
+		}
+
+		providerConfigs[name] = config
+	}
+
+	return providerConfigs, nil
+}
+
+// sortedProviderNames returns the names of the required providers in sorted order.
+func sortedProviderNames(requiredProviders map[string]*RequiredProviderInfo) []string {
+	names := make([]string, 0, len(requiredProviders))
+	for name := range requiredProviders {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
 // AddTerraformBackend adds backend configurations to store Terraform state file for the deployment.
 // Save() must be called to save the generated backend config.
-// Currently, the supported backend for Terraform Recipes is Kubernetes secret. https://developer.hashicorp.com/terraform/language/settings/backends/kubernetes
-func (cfg *TerraformConfig) AddTerraformBackend(resourceRecipe *recipes.ResourceMetadata, backend backends.Backend) (map[string]any, error) {
-	backendConfig, err := backend.BuildBackend(resourceRecipe)
+// The backend is configured per environment, Kubernetes secret is used by default. https://developer.hashicorp.com/terraform/language/settings/backends/configuration
+func (cfg *TerraformConfig) AddTerraformBackend(ctx context.Context, resourceRecipe *recipes.ResourceMetadata, backend backends.Backend) (map[string]any, error) {
+	backendConfig, err := backend.BuildBackend(ctx, resourceRecipe)
 	if err != nil {
 		return nil, err
 	}
-	cfg.Terraform = &TerraformDefinition{
-		Backend: backendConfig,
+	if cfg.Terraform == nil {
+		cfg.Terraform = &TerraformDefinition{}
 	}
+	cfg.Terraform.Backend = backendConfig
 
 	return backendConfig, nil
 }
@@ -308,7 +308,8 @@ func Test_AddProviders(t *testing.T) {
 	configTests := []struct {
 		desc               string
 		envConfig          recipes.Configuration
-		requiredProviders  []string
+		requiredProviders  map[string]*RequiredProviderInfo
+		secrets            map[string]recipes.SecretData
 		expectedProviders  []map[string]any
 		expectedConfigFile string
 		Err                error
@@ -338,11 +339,11 @@ func Test_AddProviders(t *testing.T) {
 					},
 				},
 			},
-			requiredProviders: []string{
-				providers.AWSProviderName,
-				providers.AzureProviderName,
-				providers.KubernetesProviderName,
-				"sql",
+			requiredProviders: map[string]*RequiredProviderInfo{
+				providers.AWSProviderName:        {},
+				providers.AzureProviderName:      {},
+				providers.KubernetesProviderName: {},
+				"sql":                            {},
 			},
 
 			expectedConfigFile: "testdata/providers-valid.tf.json",
@@ -358,8 +359,8 @@ func Test_AddProviders(t *testing.T) {
 					},
 				},
 			},
-			requiredProviders: []string{
-				providers.AWSProviderName,
+			requiredProviders: map[string]*RequiredProviderInfo{
+				providers.AWSProviderName: {},
 			},
 		},
 		{
@@ -369,8 +370,8 @@ func Test_AddProviders(t *testing.T) {
 			},
 			Err:       nil,
 			envConfig: recipes.Configuration{},
-			requiredProviders: []string{
-				providers.AWSProviderName,
+			requiredProviders: map[string]*RequiredProviderInfo{
+				providers.AWSProviderName: {},
 			},
 			expectedConfigFile: "testdata/providers-empty.tf.json",
 		},
@@ -390,8 +391,8 @@ func Test_AddProviders(t *testing.T) {
 					},
 				},
 			},
-			requiredProviders: []string{
-				providers.AWSProviderName,
+			requiredProviders: map[string]*RequiredProviderInfo{
+				providers.AWSProviderName: {},
 			},
 			expectedConfigFile: "testdata/providers-empty.tf.json",
 		},
@@ -404,11 +405,64 @@ func Test_AddProviders(t *testing.T) {
 			},
 			Err:       nil,
 			envConfig: recipes.Configuration{},
-			requiredProviders: []string{
-				providers.AzureProviderName,
+			requiredProviders: map[string]*RequiredProviderInfo{
+				providers.AzureProviderName: {},
 			},
 			expectedConfigFile: "testdata/providers-emptyazureconfig.tf.json",
 		},
+		{
+			desc: "environment provider configs",
+			expectedProviders: []map[string]any{
+				{
+					"region": "test-region",
+				},
+			},
+			Err: nil,
+			envConfig: recipes.Configuration{
+				Providers: datamodel.Providers{
+					AWS: datamodel.ProvidersAWS{
+						Scope: "/planes/aws/aws/accounts/0000/regions/test-region",
+					},
+				},
+				RecipeConfig: datamodel.RecipeConfigProperties{
+					Terraform: datamodel.TerraformConfigProperties{
+						Providers: map[string]datamodel.ProviderConfigProperties{
+							providers.AWSProviderName: {
+								AdditionalProperties: map[string]any{
+									"max_retries": 5,
+								},
+							},
+							"datadog": {
+								AdditionalProperties: map[string]any{
+									"api_url": "https://api.datadoghq.eu/",
+								},
+								Secrets: map[string]datamodel.SecretReference{
+									"api_key": {
+										Source: "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/secretStores/datadog",
+										Key:    "apiKey",
+									},
+								},
+							},
+							"vault": {
+								AdditionalProperties: map[string]any{
+									"address": "https://vault.example.com:8200",
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]recipes.SecretData{
+				"/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/secretStores/datadog": {
+					"apiKey": "test-api-key",
+				},
+			},
+			requiredProviders: map[string]*RequiredProviderInfo{
+				providers.AWSProviderName: {Source: "hashicorp/aws", Version: ">= 4.0"},
+				"datadog":                 {Source: "DataDog/datadog", Version: "~> 3.30"},
+			},
+			expectedConfigFile: "testdata/providers-envconfig.tf.json",
+		},
 	}
 
 	for _, tc := range configTests {
@@ -423,14 +477,14 @@ func Test_AddProviders(t *testing.T) {
 			if tc.Err != nil {
 				mProvider.EXPECT().BuildConfig(ctx, &tc.envConfig).Times(1).Return(nil, tc.Err)
 			}
-			err := tfconfig.AddProviders(ctx, tc.requiredProviders, supportedProviders, &tc.envConfig)
+			err := tfconfig.AddProviders(ctx, tc.requiredProviders, supportedProviders, &tc.envConfig, tc.secrets)
 			if tc.Err != nil {
 				require.ErrorContains(t, err, tc.Err.Error())
 				return
 			}
 			require.NoError(t, err)
-			mBackend.EXPECT().BuildBackend(&resourceRecipe).AnyTimes().Return(expectedBackend, nil)
-			_, err = tfconfig.AddTerraformBackend(&resourceRecipe, mBackend)
+			mBackend.EXPECT().BuildBackend(ctx, &resourceRecipe).AnyTimes().Return(expectedBackend, nil)
+			_, err = tfconfig.AddTerraformBackend(ctx, &resourceRecipe, mBackend)
 			require.NoError(t, err)
 			err = tfconfig.Save(ctx, workingDir)
 			require.NoError(t, err)
@@ -445,6 +499,31 @@ func Test_AddProviders(t *testing.T) {
 	}
 }
 
+func Test_AddProviders_SecretNotLoaded(t *testing.T) {
+	_, supportedProviders, _ := setup(t)
+	envRecipe, resourceRecipe := getTestInputs()
+	envConfig := recipes.Configuration{
+		RecipeConfig: datamodel.RecipeConfigProperties{
+			Terraform: datamodel.TerraformConfigProperties{
+				Providers: map[string]datamodel.ProviderConfigProperties{
+					"datadog": {
+						Secrets: map[string]datamodel.SecretReference{
+							"api_key": {
+								Source: "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/secretStores/datadog",
+								Key:    "apiKey",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	tfconfig := New(testRecipeName, &envRecipe, &resourceRecipe)
+	err := tfconfig.AddProviders(testcontext.New(t), map[string]*RequiredProviderInfo{"datadog": {Source: "DataDog/datadog"}}, supportedProviders, &envConfig, nil)
+	require.EqualError(t, err, `secret key "apiKey" of secret store "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/secretStores/datadog" referenced by setting "api_key" of provider "datadog" is not loaded`)
+}
+
 func Test_AddOutputs(t *testing.T) {
 	envRecipe, resourceRecipe := getTestInputs()
 	tests := []struct {
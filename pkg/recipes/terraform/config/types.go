@@ -60,4 +60,17 @@ type TerraformDefinition struct {
 	// Backend defines where Terraform stores its state.
 	// https://developer.hashicorp.com/terraform/language/state
 	Backend map[string]interface{} `json:"backend"`
+
+	// RequiredProviders is the source and version constraints of the providers configured by the environment.
+	// https://developer.hashicorp.com/terraform/language/providers/requirements
+	RequiredProviders map[string]*RequiredProviderInfo `json:"required_providers,omitempty"`
+}
+
+// RequiredProviderInfo represents the source and version constraints of a provider required by a module.
+type RequiredProviderInfo struct {
+	// Source is the global source address of the provider, e.g. "hashicorp/aws".
+	Source string `json:"source,omitempty"`
+
+	// Version is the version constraint of the provider, e.g. ">= 4.0".
+	Version string `json:"version,omitempty"`
 }
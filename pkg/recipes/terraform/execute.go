@@ -26,6 +26,7 @@ import (
 	"time"
 
 	install "github.com/hashicorp/hc-install"
+	"github.com/hashicorp/terraform-exec/tfexec"
 	tfjson "github.com/hashicorp/terraform-json"
 	"github.com/radius-project/radius/pkg/metrics"
 	"github.com/radius-project/radius/pkg/recipes"
@@ -38,12 +39,12 @@ import (
 	ucp_provider "github.com/radius-project/radius/pkg/ucp/secret/provider"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 	"go.opentelemetry.io/otel/attribute"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 )
 
 const (
 	executionSubDir                = "deploy"
+	planFileName                   = "tfplan"
 	workingDirFileMode fs.FileMode = 0700
 )
 
@@ -55,8 +56,9 @@ var (
 var _ TerraformExecutor = (*executor)(nil)
 
 // NewExecutor creates a new Executor with the given UCP connection and secret provider, to execute a Terraform recipe.
-func NewExecutor(ucpConn sdk.Connection, secretProvider *ucp_provider.SecretProvider, k8sClientSet kubernetes.Interface) *executor {
-	return &executor{ucpConn: ucpConn, secretProvider: secretProvider, k8sClientSet: k8sClientSet}
+// The provider plugins and modules are shared with other executions through the given cache, which can be nil.
+func NewExecutor(ucpConn sdk.Connection, secretProvider *ucp_provider.SecretProvider, k8sClientSet kubernetes.Interface, cache *Cache) *executor {
+	return &executor{ucpConn: ucpConn, secretProvider: secretProvider, k8sClientSet: k8sClientSet, cache: cache}
 }
 
 type executor struct {
@@ -68,6 +70,9 @@ type executor struct {
 
 	// k8sClientSet is the Kubernetes client.
 	k8sClientSet kubernetes.Interface
+
+	// cache is the cache of provider plugins and modules shared by the executions. Nothing is cached if it's nil.
+	cache *Cache
 }
 
 // Deploy installs Terraform, creates a working directory, generates a config, and runs Terraform init and
@@ -95,30 +100,105 @@ func (e *executor) Deploy(ctx context.Context, options Options) (*tfjson.State,
 		return nil, err
 	}
 
+	release := e.cache.acquire(ctx, workingDir)
+	defer release()
+
+	// Configure authentication to the private module sources
+	auth, err := newModuleAuth(options.EnvConfig, options.Secrets)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := auth.cleanup(); err != nil {
+			logger.Info(fmt.Sprintf("Failed to cleanup Terraform module authentication: %s", err.Error()))
+		}
+	}()
+
+	backend, err := backends.NewBackend(options.EnvConfig, e.k8sClientSet, e.ucpConn, e.secretProvider)
+	if err != nil {
+		return nil, err
+	}
+
 	// Create Terraform config in the working directory
-	kubernetesBackendSuffix, err := e.generateConfig(ctx, workingDir, execPath, options)
+	env := e.cache.env(auth.env)
+	err = e.generateConfig(ctx, workingDir, execPath, options, backend, env)
 	if err != nil {
 		return nil, err
 	}
 
 	// Run TF Init and Apply in the working directory
-	state, err := initAndApply(ctx, workingDir, execPath)
+	state, err := e.initAndApply(ctx, workingDir, execPath, env)
 	if err != nil {
 		return nil, err
 	}
 
-	// Validate that the terraform state file backend source exists.
-	// Currently only Kubernetes secret backend is supported, which is created by Terraform as a part of Terraform apply.
-	backendExists, err := backends.NewKubernetesBackend(e.k8sClientSet).ValidateBackendExists(ctx, backends.KubernetesBackendNamePrefix+kubernetesBackendSuffix)
+	// Validate that the terraform state file backend source exists, which is created by Terraform as a part of Terraform apply.
+	backendExists, err := backend.ValidateBackendExists(ctx, options.ResourceRecipe)
 	if err != nil {
-		return nil, fmt.Errorf("error retrieving kubernetes secret for terraform state: %w", err)
+		return nil, fmt.Errorf("error retrieving terraform state from backend: %w", err)
 	} else if !backendExists {
-		return nil, errors.New("expected kubernetes secret for terraform state is not found")
+		return nil, errors.New("expected terraform state is not found in backend")
 	}
 
 	return state, nil
 }
 
+// Plan installs Terraform, creates a working directory, generates a config, and runs Terraform init and
+// plan in the working directory, returning the plan without applying it. The plan is computed against the
+// state stored in the Terraform backend, so resources deployed by a previous run of the recipe are taken into account.
+func (e *executor) Plan(ctx context.Context, options Options) (*tfjson.Plan, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	// Install Terraform
+	i := install.NewInstaller()
+	execPath, err := Install(ctx, i, options.RootDir)
+	// The terraform zip for installation is downloaded in a location outside of the install directory and is only accessible through the installer.Remove function -
+	// stored in latestVersion.pathsToRemove. So this needs to be called for complete cleanup even if the root terraform directory is deleted.
+	defer func() {
+		if err := i.Remove(ctx); err != nil {
+			logger.Info(fmt.Sprintf("Failed to cleanup Terraform installation: %s", err.Error()))
+		}
+	}()
+	if err != nil {
+		return nil, err
+	}
+
+	// Create Working Directory
+	workingDir, err := createWorkingDir(ctx, options.RootDir)
+	if err != nil {
+		return nil, err
+	}
+
+	release := e.cache.acquire(ctx, workingDir)
+	defer release()
+
+	// Configure authentication to the private module sources
+	auth, err := newModuleAuth(options.EnvConfig, options.Secrets)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := auth.cleanup(); err != nil {
+			logger.Info(fmt.Sprintf("Failed to cleanup Terraform module authentication: %s", err.Error()))
+		}
+	}()
+
+	backend, err := backends.NewBackend(options.EnvConfig, e.k8sClientSet, e.ucpConn, e.secretProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create Terraform config in the working directory
+	env := e.cache.env(auth.env)
+	err = e.generateConfig(ctx, workingDir, execPath, options, backend, env)
+	if err != nil {
+		return nil, err
+	}
+
+	// Run TF Init and Plan in the working directory
+	return e.initAndPlan(ctx, workingDir, execPath, env)
+}
+
 // Delete installs Terraform, creates a working directory, generates a config, and runs Terraform destroy
 // in the working directory, returning an error if any of these steps fail.
 func (e *executor) Delete(ctx context.Context, options Options) error {
@@ -144,8 +224,28 @@ func (e *executor) Delete(ctx context.Context, options Options) error {
 		return err
 	}
 
+	release := e.cache.acquire(ctx, workingDir)
+	defer release()
+
+	// Configure authentication to the private module sources
+	auth, err := newModuleAuth(options.EnvConfig, options.Secrets)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := auth.cleanup(); err != nil {
+			logger.Info(fmt.Sprintf("Failed to cleanup Terraform module authentication: %s", err.Error()))
+		}
+	}()
+
+	backend, err := backends.NewBackend(options.EnvConfig, e.k8sClientSet, e.ucpConn, e.secretProvider)
+	if err != nil {
+		return err
+	}
+
 	// Create Terraform config in the working directory
-	kubernetesBackendSuffix, err := e.generateConfig(ctx, workingDir, execPath, options)
+	env := e.cache.env(auth.env)
+	err = e.generateConfig(ctx, workingDir, execPath, options, backend, env)
 	if err != nil {
 		return err
 	}
@@ -153,7 +253,7 @@ func (e *executor) Delete(ctx context.Context, options Options) error {
 	// Before running terraform init and destroy, ensure that the Terraform state file storage source exists.
 	// If the state file source has been deleted or wasn't created due to a failure during apply then
 	// terraform initialization will fail due to missing backend source.
-	backendExists, err := backends.NewKubernetesBackend(e.k8sClientSet).ValidateBackendExists(ctx, backends.KubernetesBackendNamePrefix+kubernetesBackendSuffix)
+	backendExists, err := backend.ValidateBackendExists(ctx, options.ResourceRecipe)
 	if err != nil {
 		// Continue with the delete flow for all errors other than backend not found.
 		// If it is an intermittent error then the delete flow will fail and should be retried from the client.
@@ -165,20 +265,13 @@ func (e *executor) Delete(ctx context.Context, options Options) error {
 	}
 
 	// Run TF Destroy in the working directory to delete the resources deployed by the recipe
-	err = initAndDestroy(ctx, workingDir, execPath)
+	err = e.initAndDestroy(ctx, workingDir, execPath, env)
 	if err != nil {
 		return err
 	}
 
-	// Delete the kubernetes secret created for terraform state file.
-	err = e.k8sClientSet.CoreV1().
-		Secrets(backends.RadiusNamespace).
-		Delete(ctx, backends.KubernetesBackendNamePrefix+kubernetesBackendSuffix, metav1.DeleteOptions{})
-	if err != nil {
-		return fmt.Errorf("error deleting kubernetes secret for terraform state: %w", err)
-	}
-
-	return nil
+	// Delete the terraform state file from the backend.
+	return backend.DeleteBackend(ctx, options.ResourceRecipe)
 }
 
 func (e *executor) GetRecipeMetadata(ctx context.Context, options Options) (map[string]any, error) {
@@ -204,12 +297,26 @@ func (e *executor) GetRecipeMetadata(ctx context.Context, options Options) (map[
 		return nil, err
 	}
 
+	release := e.cache.acquire(ctx, workingDir)
+	defer release()
+
+	// Configure authentication to the private module sources
+	auth, err := newModuleAuth(options.EnvConfig, options.Secrets)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := auth.cleanup(); err != nil {
+			logger.Info(fmt.Sprintf("Failed to cleanup Terraform module authentication: %s", err.Error()))
+		}
+	}()
+
 	_, err = getTerraformConfig(ctx, workingDir, options)
 	if err != nil {
 		return nil, err
 	}
 
-	result, err := downloadAndInspect(ctx, workingDir, execPath, options)
+	result, err := e.downloadAndInspect(ctx, workingDir, execPath, options, auth.env)
 	if err != nil {
 		return nil, err
 	}
@@ -232,38 +339,33 @@ func createWorkingDir(ctx context.Context, tfDir string) (string, error) {
 }
 
 // generateConfig generates Terraform configuration with required inputs for the module, providers and backend to be initialized and applied.
-func (e *executor) generateConfig(ctx context.Context, workingDir, execPath string, options Options) (string, error) {
+// env holds the environment variables authenticating to private module sources.
+func (e *executor) generateConfig(ctx context.Context, workingDir, execPath string, options Options, backend backends.Backend, env map[string]string) error {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
 	tfConfig, err := getTerraformConfig(ctx, workingDir, options)
 	if err != nil {
-		return "", err
+		return err
 	}
 
-	loadedModule, err := downloadAndInspect(ctx, workingDir, execPath, options)
+	loadedModule, err := e.downloadAndInspect(ctx, workingDir, execPath, options, env)
 	if err != nil {
-		return "", err
+		return err
 	}
 
 	// Generate Terraform providers configuration for required providers and add it to the Terraform configuration.
-	logger.Info(fmt.Sprintf("Adding provider config for required providers %+v", loadedModule.RequiredProviders))
+	requiredProviderNames := []string{}
+	for name := range loadedModule.RequiredProviders {
+		requiredProviderNames = append(requiredProviderNames, name)
+	}
+	logger.Info(fmt.Sprintf("Adding provider config for required providers %+v", requiredProviderNames))
 	if err := tfConfig.AddProviders(ctx, loadedModule.RequiredProviders, providers.GetSupportedTerraformProviders(e.ucpConn, e.secretProvider),
-		options.EnvConfig); err != nil {
-		return "", err
+		options.EnvConfig, options.Secrets); err != nil {
+		return err
 	}
 
-	backendConfig, err := tfConfig.AddTerraformBackend(options.ResourceRecipe, backends.NewKubernetesBackend(e.k8sClientSet))
-	if err != nil {
-		return "", err
-	}
-	// Retrieving the secret_suffix property from backend config to use it to verify secret creation during terraform init.
-	// This is only used for the backend of type kubernetes and should be moved inside an if block when we add more backends.
-	var secretSuffix string
-	if backendDetails, ok := backendConfig[backends.BackendKubernetes]; ok {
-		backendMap := backendDetails.(map[string]any)
-		if secret, ok := backendMap["secret_suffix"]; ok {
-			secretSuffix = secret.(string)
-		}
+	if _, err := tfConfig.AddTerraformBackend(ctx, options.ResourceRecipe, backend); err != nil {
+		return err
 	}
 
 	// Add recipe context parameter to the generated Terraform config's module parameters.
@@ -274,16 +376,16 @@ func (e *executor) generateConfig(ctx context.Context, workingDir, execPath stri
 		// Create the recipe context object to be passed to the recipe deployment
 		recipectx, err := recipecontext.New(options.ResourceRecipe, options.EnvConfig)
 		if err != nil {
-			return "", err
+			return err
 		}
 
 		if err = tfConfig.AddRecipeContext(ctx, options.EnvRecipe.Name, recipectx); err != nil {
-			return "", err
+			return err
 		}
 	}
 	if loadedModule.ResultOutputExists {
 		if err = tfConfig.AddOutputs(options.EnvRecipe.Name); err != nil {
-			return "", err
+			return err
 		}
 	}
 
@@ -291,28 +393,46 @@ func (e *executor) generateConfig(ctx context.Context, workingDir, execPath stri
 
 	// Ensure that we need to save the configuration after adding providers and recipecontext.
 	if err := tfConfig.Save(ctx, workingDir); err != nil {
-		return "", err
+		return err
 	}
 
-	return secretSuffix, nil
+	return nil
 }
 
-// downloadAndInspect handles downloading the TF module and retrieving the necessary information
-func downloadAndInspect(ctx context.Context, workingDir string, execPath string, options Options) (*moduleInspectResult, error) {
+// downloadAndInspect handles downloading the TF module and retrieving the necessary information. The module is restored
+// from the module cache if it was downloaded by a previous execution.
+func (e *executor) downloadAndInspect(ctx context.Context, workingDir string, execPath string, options Options, env map[string]string) (*moduleInspectResult, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
-	// Download the Terraform module to the working directory.
-	logger.Info(fmt.Sprintf("Downloading Terraform module: %s", options.EnvRecipe.TemplatePath))
-	downloadStartTime := time.Now()
-	if err := downloadModule(ctx, workingDir, execPath, options.EnvRecipe.TemplatePath); err != nil {
+	restored := false
+	cacheKey, cacheable := e.cache.moduleKey(options)
+	if cacheable {
+		var err error
+		if restored, err = e.cache.restoreModule(ctx, cacheKey, workingDir, options.EnvRecipe.Name); err != nil {
+			logger.Info(fmt.Sprintf("Failed to restore Terraform module %q from the cache: %s", options.EnvRecipe.TemplatePath, err.Error()))
+		}
+	}
+
+	if !restored {
+		// Download the Terraform module to the working directory.
+		logger.Info(fmt.Sprintf("Downloading Terraform module: %s", options.EnvRecipe.TemplatePath))
+		downloadStartTime := time.Now()
+		if err := downloadModule(ctx, workingDir, execPath, options.EnvRecipe.TemplatePath, env); err != nil {
+			metrics.DefaultRecipeEngineMetrics.RecordRecipeDownloadDuration(ctx, downloadStartTime,
+				metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDownloadRecipe, options.EnvRecipe.Name,
+					options.EnvRecipe, recipes.RecipeDownloadFailed))
+			return nil, recipes.NewRecipeError(recipes.RecipeDownloadFailed, err.Error(), util.RecipeSetupError, recipes.GetErrorDetails(err))
+		}
 		metrics.DefaultRecipeEngineMetrics.RecordRecipeDownloadDuration(ctx, downloadStartTime,
 			metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDownloadRecipe, options.EnvRecipe.Name,
-				options.EnvRecipe, recipes.RecipeDownloadFailed))
-		return nil, recipes.NewRecipeError(recipes.RecipeDownloadFailed, err.Error(), util.RecipeSetupError, recipes.GetErrorDetails(err))
+				options.EnvRecipe, metrics.SuccessfulOperationState))
+
+		if cacheable {
+			if err := e.cache.storeModule(ctx, cacheKey, workingDir, options.EnvRecipe.Name); err != nil {
+				logger.Info(fmt.Sprintf("Failed to store Terraform module %q in the cache: %s", options.EnvRecipe.TemplatePath, err.Error()))
+			}
+		}
 	}
-	metrics.DefaultRecipeEngineMetrics.RecordRecipeDownloadDuration(ctx, downloadStartTime,
-		metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDownloadRecipe, options.EnvRecipe.Name,
-			options.EnvRecipe, metrics.SuccessfulOperationState))
 
 	// Load the downloaded module to retrieve providers and variables required by the module.
 	// This is needed to add the appropriate providers config and populate the value of recipe context variable.
@@ -349,11 +469,11 @@ func getTerraformConfig(ctx context.Context, workingDir string, options Options)
 	return tfConfig, nil
 }
 
-// initAndApply runs Terraform init and apply in the provided working directory.
-func initAndApply(ctx context.Context, workingDir, execPath string) (*tfjson.State, error) {
+// initAndApply runs Terraform init and apply in the provided working directory. The provider plugins are installed from the plugin cache.
+func (e *executor) initAndApply(ctx context.Context, workingDir, execPath string, env map[string]string) (*tfjson.State, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
-	tf, err := NewTerraform(ctx, workingDir, execPath)
+	tf, err := NewTerraform(ctx, workingDir, execPath, env)
 	if err != nil {
 		return nil, err
 	}
@@ -361,7 +481,7 @@ func initAndApply(ctx context.Context, workingDir, execPath string) (*tfjson.Sta
 	logger.Info("Initializing Terraform")
 
 	terraformInitStartTime := time.Now()
-	if err := tf.Init(ctx); err != nil {
+	if err := e.cache.init(ctx, tf, workingDir); err != nil {
 		metrics.DefaultRecipeEngineMetrics.RecordTerraformInitializationDuration(ctx, terraformInitStartTime,
 			[]attribute.KeyValue{metrics.OperationStateAttrKey.String(metrics.FailedOperationState)})
 
@@ -381,11 +501,40 @@ func initAndApply(ctx context.Context, workingDir, execPath string) (*tfjson.Sta
 	return tf.Show(ctx)
 }
 
-// initAndDestroy runs Terraform init and destroy in the provided working directory.
-func initAndDestroy(ctx context.Context, workingDir, execPath string) error {
+// initAndPlan runs Terraform init and plan in the provided working directory and returns the plan. The provider plugins are installed from the plugin cache.
+func (e *executor) initAndPlan(ctx context.Context, workingDir, execPath string, env map[string]string) (*tfjson.Plan, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	tf, err := NewTerraform(ctx, workingDir, execPath, env)
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize Terraform
+	logger.Info("Initializing Terraform")
+
+	terraformInitStartTime := time.Now()
+	if err := e.cache.init(ctx, tf, workingDir); err != nil {
+		return nil, fmt.Errorf("terraform init failure: %w", err)
+	}
+	metrics.DefaultRecipeEngineMetrics.RecordTerraformInitializationDuration(ctx, terraformInitStartTime, nil)
+
+	// Plan Terraform configuration. The state lock is not acquired since the plan is never applied.
+	logger.Info("Running Terraform plan")
+	planFile := filepath.Join(workingDir, planFileName)
+	if _, err := tf.Plan(ctx, tfexec.Out(planFile), tfexec.Lock(false)); err != nil {
+		return nil, fmt.Errorf("terraform plan failure: %w", err)
+	}
+
+	logger.Info("Fetching Terraform plan")
+	return tf.ShowPlanFile(ctx, planFile)
+}
+
+// initAndDestroy runs Terraform init and destroy in the provided working directory. The provider plugins are installed from the plugin cache.
+func (e *executor) initAndDestroy(ctx context.Context, workingDir, execPath string, env map[string]string) error {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
-	tf, err := NewTerraform(ctx, workingDir, execPath)
+	tf, err := NewTerraform(ctx, workingDir, execPath, env)
 	if err != nil {
 		return err
 	}
@@ -394,7 +543,7 @@ func initAndDestroy(ctx context.Context, workingDir, execPath string) error {
 	logger.Info("Initializing Terraform")
 
 	terraformInitStartTime := time.Now()
-	if err := tf.Init(ctx); err != nil {
+	if err := e.cache.init(ctx, tf, workingDir); err != nil {
 		return fmt.Errorf("terraform init failure: %w", err)
 	}
 	metrics.DefaultRecipeEngineMetrics.RecordTerraformInitializationDuration(ctx, terraformInitStartTime, nil)
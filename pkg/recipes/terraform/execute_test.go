@@ -23,6 +23,7 @@ import (
 
 	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/recipes/terraform/config"
+	"github.com/radius-project/radius/pkg/recipes/terraform/config/backends"
 	"github.com/radius-project/radius/test/testcontext"
 	"github.com/stretchr/testify/require"
 )
@@ -62,7 +63,7 @@ func TestInitAndApply_EmptyWorkingDirPath(t *testing.T) {
 	testDir := t.TempDir()
 	execPath := filepath.Join(testDir, "terraform")
 
-	_, err := initAndApply(testcontext.New(t), "", execPath)
+	_, err := (&executor{}).initAndApply(testcontext.New(t), "", execPath, nil)
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "Terraform cannot be initialised with empty workdir")
 }
@@ -114,7 +115,7 @@ func TestGeneratedConfig(t *testing.T) {
 			}
 			execPath := filepath.Join(tc.workingDir, "terraform")
 			e := executor{}
-			_, err := e.generateConfig(ctx, tc.workingDir, execPath, tc.opts)
+			err := e.generateConfig(ctx, tc.workingDir, execPath, tc.opts, backends.NewKubernetesBackend(nil), nil)
 			require.Error(t, err)
 			require.ErrorContains(t, err, tc.err)
 		})
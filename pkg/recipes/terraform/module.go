@@ -20,10 +20,12 @@ import (
 	"context"
 	"fmt"
 	"path/filepath"
+	"strings"
 
 	"github.com/hashicorp/terraform-config-inspect/tfconfig"
 	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/recipes/recipecontext"
+	"github.com/radius-project/radius/pkg/recipes/terraform/config"
 )
 
 const (
@@ -35,8 +37,8 @@ type moduleInspectResult struct {
 	// ContextVarExists is true if the module has a variable defined for recipe context.
 	ContextVarExists bool
 
-	// RequiredProviders is a list of names of required providers for the module.
-	RequiredProviders []string
+	// RequiredProviders is the source and version constraints of the required providers for the module, keyed by the provider name.
+	RequiredProviders map[string]*config.RequiredProviderInfo
 
 	// ResultOutputExists is true if the module contains an output named "result".
 	ResultOutputExists bool
@@ -53,7 +55,7 @@ type moduleInspectResult struct {
 // It uses terraform-config-inspect to load the module from the directory. An error is returned if the module
 // could not be loaded.
 func inspectModule(workingDir, localModuleName string) (*moduleInspectResult, error) {
-	result := &moduleInspectResult{ContextVarExists: false, RequiredProviders: []string{}, ResultOutputExists: false, Parameters: map[string]any{}}
+	result := &moduleInspectResult{ContextVarExists: false, RequiredProviders: map[string]*config.RequiredProviderInfo{}, ResultOutputExists: false, Parameters: map[string]any{}}
 
 	// Modules are downloaded in a subdirectory in the working directory.
 	// Name of the module specified in the configuration is used as subdirectory name.
@@ -68,9 +70,12 @@ func inspectModule(workingDir, localModuleName string) (*moduleInspectResult, er
 		result.ContextVarExists = true
 	}
 
-	// Extract the list of required providers.
-	for providerName := range mod.RequiredProviders {
-		result.RequiredProviders = append(result.RequiredProviders, providerName)
+	// Extract the required providers.
+	for providerName, requirement := range mod.RequiredProviders {
+		result.RequiredProviders[providerName] = &config.RequiredProviderInfo{
+			Source:  requirement.Source,
+			Version: strings.Join(requirement.VersionConstraints, ", "),
+		}
 	}
 
 	// Check if an output named "result" is defined in the module.
@@ -97,9 +102,10 @@ func inspectModule(workingDir, localModuleName string) (*moduleInspectResult, er
 
 // downloadModule downloads the module to the workingDir from the module source specified in the Terraform configuration.
 // It uses Terraform's Get command to download the module using the Terraform executable available at execPath.
+// env holds the environment variables authenticating to private module sources.
 // An error is returned if the module could not be downloaded.
-func downloadModule(ctx context.Context, workingDir, execPath, templatePath string) error {
-	tf, err := NewTerraform(ctx, workingDir, execPath)
+func downloadModule(ctx context.Context, workingDir, execPath, templatePath string, env map[string]string) error {
+	tf, err := NewTerraform(ctx, workingDir, execPath, env)
 	if err != nil {
 		return err
 	}
@@ -21,6 +21,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-config-inspect/tfconfig"
+	"github.com/radius-project/radius/pkg/recipes/terraform/config"
 	"github.com/radius-project/radius/test/testcontext"
 	"github.com/stretchr/testify/require"
 )
@@ -39,7 +40,7 @@ func Test_InspectTFModuleConfig(t *testing.T) {
 			moduleName: "test-module-provideronly",
 			result: &moduleInspectResult{
 				ContextVarExists:   false,
-				RequiredProviders:  []string{"aws"},
+				RequiredProviders:  map[string]*config.RequiredProviderInfo{"aws": {Source: "hashicorp/aws", Version: ">=3.0"}},
 				ResultOutputExists: false,
 				Parameters:         map[string]any{},
 			},
@@ -50,7 +51,7 @@ func Test_InspectTFModuleConfig(t *testing.T) {
 			moduleName: "test-module-recipe-context-outputs",
 			result: &moduleInspectResult{
 				ContextVarExists:   true,
-				RequiredProviders:  []string{"aws"},
+				RequiredProviders:  map[string]*config.RequiredProviderInfo{"aws": {Source: "hashicorp/aws", Version: ">=3.0"}},
 				ResultOutputExists: true,
 				Parameters: map[string]any{
 					"context": map[string]any{
@@ -95,7 +96,7 @@ func Test_DownloadModule_EmptyWorkingDirPath_Error(t *testing.T) {
 	testDir := t.TempDir()
 	execPath := filepath.Join(testDir, "terraform")
 
-	err := downloadModule(testcontext.New(t), "", execPath, "test/module/source")
+	err := downloadModule(testcontext.New(t), "", execPath, "test/module/source", nil)
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "Terraform cannot be initialised with empty workdir")
 }
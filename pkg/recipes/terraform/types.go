@@ -19,6 +19,8 @@ package terraform
 import (
 	"context"
 	"fmt"
+	"os"
+	"strings"
 
 	"github.com/go-logr/logr"
 	"github.com/hashicorp/terraform-exec/tfexec"
@@ -33,8 +35,12 @@ type TerraformExecutor interface {
 	// Deploy installs terraform and runs terraform init and apply on the terraform module referenced by the recipe using terraform-exec.
 	Deploy(ctx context.Context, options Options) (*tfjson.State, error)
 
+	// Plan installs terraform and runs terraform init and plan on the terraform module referenced by the recipe using terraform-exec,
+	// returning the changes that apply would make without applying them.
+	Plan(ctx context.Context, options Options) (*tfjson.Plan, error)
+
 	// Delete installs terraform and runs terraform destroy on the terraform module referenced by the recipe using terraform-exec,
-	// and deletes the Kubernetes secret created for terraform state store.
+	// and deletes the terraform state from the backend storing it.
 	Delete(ctx context.Context, options Options) error
 
 	// GetRecipeMetadata installs terraform and runs terraform get to retrieve information on the terraform module
@@ -54,15 +60,35 @@ type Options struct {
 
 	// ResourceRecipe is recipe metadata associated with the Radius resource deploying the Terraform recipe.
 	ResourceRecipe *recipes.ResourceMetadata
+
+	// Secrets are the secrets referenced by the recipe configuration of the Radius Environment, keyed by the secret store resource ID.
+	Secrets map[string]recipes.SecretData
 }
 
-// NewTerraform creates a new Terraform executor with Terraform logs enabled.
-func NewTerraform(ctx context.Context, workingDir, execPath string) (*tfexec.Terraform, error) {
+// NewTerraform creates a new Terraform executor with Terraform logs enabled. The given environment variables are
+// added to the environment of the current process for the Terraform commands.
+func NewTerraform(ctx context.Context, workingDir, execPath string, env map[string]string) (*tfexec.Terraform, error) {
 	tf, err := tfexec.NewTerraform(workingDir, execPath)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize Terraform: %w", err)
 	}
 
+	if len(env) > 0 {
+		// SetEnv replaces the environment of the Terraform commands, so the environment of the current process is copied first.
+		tfEnv := map[string]string{}
+		for _, kv := range os.Environ() {
+			if k, v, ok := strings.Cut(kv, "="); ok {
+				tfEnv[k] = v
+			}
+		}
+		for k, v := range env {
+			tfEnv[k] = v
+		}
+		if err := tf.SetEnv(tfexec.CleanEnv(tfEnv)); err != nil {
+			return nil, fmt.Errorf("failed to set Terraform environment: %w", err)
+		}
+	}
+
 	configureTerraformLogs(ctx, tf)
 
 	return tf, nil
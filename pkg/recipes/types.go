@@ -31,8 +31,13 @@ type Configuration struct {
 	Providers datamodel.Providers
 	// Simulated represents whether the environment is simulated or not.
 	Simulated bool
+	// RecipeConfig is the configuration used by the recipes of the environment.
+	RecipeConfig datamodel.RecipeConfigProperties
 }
 
+// SecretData represents the secrets loaded from a secret store, keyed by the secret key.
+type SecretData map[string]string
+
 // RuntimeConfiguration represents Kubernetes Runtime configuration for the environment.
 type RuntimeConfiguration struct {
 	Kubernetes *KubernetesRuntime `json:"kubernetes,omitempty"`
@@ -79,13 +84,15 @@ type ResourceMetadata struct {
 const (
 	TemplateKindBicep     = "bicep"
 	TemplateKindTerraform = "terraform"
+	TemplateKindHelm      = "helm"
+	TemplateKindManifest  = "manifest"
 
 	// Recipe outputs are expected to be wrapped under an object named "result"
 	ResultPropertyName = "result"
 )
 
 var (
-	SupportedTemplateKind = []string{TemplateKindBicep, TemplateKindTerraform}
+	SupportedTemplateKind = []string{TemplateKindBicep, TemplateKindTerraform, TemplateKindHelm, TemplateKindManifest}
 )
 
 // RecipeOutput represents recipe deployment output.
@@ -100,6 +107,59 @@ type RecipeOutput struct {
 	Values map[string]any
 }
 
+// ChangeType represents the kind of change a recipe deployment makes to a resource.
+type ChangeType string
+
+const (
+	// ChangeTypeCreate indicates the resource doesn't exist and will be created.
+	ChangeTypeCreate ChangeType = "Create"
+
+	// ChangeTypeUpdate indicates the resource exists and will be updated in place.
+	ChangeTypeUpdate ChangeType = "Update"
+
+	// ChangeTypeReplace indicates the resource exists and will be deleted and created again.
+	ChangeTypeReplace ChangeType = "Replace"
+
+	// ChangeTypeDelete indicates the resource exists and will be deleted.
+	ChangeTypeDelete ChangeType = "Delete"
+
+	// ChangeTypeNoChange indicates the resource exists and will not be changed.
+	ChangeTypeNoChange ChangeType = "NoChange"
+
+	// ChangeTypeUnknown indicates the resource will be deployed, but whether it is created or updated can't be
+	// determined without deploying the recipe.
+	ChangeTypeUnknown ChangeType = "Unknown"
+)
+
+// RecipePlan represents the changes a recipe deployment would make, computed without deploying the recipe.
+type RecipePlan struct {
+	// Changes is the list of changes to the resources of the recipe.
+	Changes []ResourceChange `json:"changes"`
+}
+
+// ResourceChange represents the change a recipe deployment would make to a single resource.
+type ResourceChange struct {
+	// Resource identifies the resource. It is the resource ID when it is known before the deployment, otherwise
+	// the address of the resource in the recipe template.
+	Resource string `json:"resource"`
+
+	// ResourceType is the type of the resource.
+	ResourceType string `json:"resourceType,omitempty"`
+
+	// ChangeType is the kind of change made to the resource.
+	ChangeType ChangeType `json:"changeType"`
+
+	// Before is the state of the resource before the change, if it is known.
+	Before any `json:"before,omitempty"`
+
+	// After is the state of the resource after the change, if it is known.
+	After any `json:"after,omitempty"`
+
+	// Unresolved are the template expressions of the resource which can't be evaluated before the deployment, with
+	// the reason they can't be. The change is only partially known when there are any.
+	Unresolved []string `json:"unresolved,omitempty"`
+}
+
 // PrepareRecipeOutput populates the recipe output from the recipe deployment output stored in the "result" object.
 // outputs map is the value of "result" output from the recipe deployment response.
 func (ro *RecipeOutput) PrepareRecipeResponse(resultValue map[string]any) error {
@@ -18,12 +18,18 @@ package frontend
 
 import (
 	"context"
+	"fmt"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/rest"
 	"github.com/radius-project/radius/pkg/daprrp/datamodel"
+	pr_dm "github.com/radius-project/radius/pkg/portableresources/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/configloader"
+	"github.com/radius-project/radius/pkg/recipes/engine"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
 )
 
 // PrepareRadiusResource validates the Radius resource and prepare new resource data.
@@ -64,3 +70,55 @@ func PrepareDaprResource[P interface {
 
 	return nil, nil
 }
+
+// ValidateRecipeParameters returns an update filter validating the recipe parameters set on the resource, together with
+// the parameters set by the environment, against the parameters declared by the recipe. The parameters aren't validated
+// if the recipe or its metadata can't be loaded, the failure is reported when the recipe is deployed.
+func ValidateRecipeParameters[P interface {
+	*T
+	rpv1.RadiusResourceModel
+}, T any](eng engine.Engine, configLoader configloader.ConfigurationLoader) controller.UpdateFilter[T] {
+	return func(ctx context.Context, newResource *T, oldResource *T, options *controller.Options) (rest.Response, error) {
+		logger := ucplog.FromContextOrDiscard(ctx)
+		serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+		// 'any' is required here to convert to an interface type, only then can we use a type assertion.
+		recipeDataModel, supportsRecipes := any(P(newResource)).(pr_dm.RecipeDataModel)
+		if !supportsRecipes || recipeDataModel.Recipe() == nil {
+			return nil, nil
+		}
+
+		recipe := recipeDataModel.Recipe()
+		metadata := recipes.ResourceMetadata{
+			Name:          recipe.Name,
+			Parameters:    recipe.Parameters,
+			EnvironmentID: P(newResource).ResourceMetadata().Environment,
+			ApplicationID: P(newResource).ResourceMetadata().Application,
+			ResourceID:    serviceCtx.ResourceID.String(),
+		}
+
+		definition, err := configLoader.LoadRecipe(ctx, &metadata)
+		if err != nil {
+			logger.Info(fmt.Sprintf("Unable to load recipe %q, skipping parameter validation: %s", recipe.Name, err.Error()))
+			return nil, nil
+		}
+
+		configuration, err := configLoader.LoadConfiguration(ctx, metadata)
+		if err != nil {
+			logger.Info(fmt.Sprintf("Unable to load the configuration of recipe %q, skipping parameter validation: %s", recipe.Name, err.Error()))
+			return nil, nil
+		}
+
+		recipeData, err := eng.GetRecipeMetadata(ctx, *definition, configuration.RecipeConfig)
+		if err != nil {
+			logger.Info(fmt.Sprintf("Unable to read the metadata of recipe %q, skipping parameter validation: %s", recipe.Name, err.Error()))
+			return nil, nil
+		}
+
+		if err := recipes.ValidateParameters(recipeData, definition.Parameters, recipe.Parameters); err != nil {
+			return rest.NewBadRequestResponse(fmt.Sprintf("Recipe %q has %s", recipe.Name, err.Error())), nil
+		}
+
+		return nil, nil
+	}
+}
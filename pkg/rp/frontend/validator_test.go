@@ -18,14 +18,23 @@ package frontend
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/rest"
 	"github.com/radius-project/radius/pkg/daprrp/datamodel"
+	ds_dm "github.com/radius-project/radius/pkg/datastoresrp/datamodel"
+	"github.com/radius-project/radius/pkg/portableresources"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/configloader"
+	"github.com/radius-project/radius/pkg/recipes/engine"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/radius-project/radius/test/k8sutil"
+
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -128,3 +137,100 @@ func TestPrepareDaprResource(t *testing.T) {
 	require.Equal(t, expectedResp, resp)
 
 }
+
+func TestValidateRecipeParameters(t *testing.T) {
+	resourceID := "/planes/radius/local/resourceGroups/testGroup/providers/Applications.Datastores/redisCaches/redis0"
+	envID := "/planes/radius/local/resourceGroups/testGroup/providers/Applications.Core/environments/env0"
+
+	definition := &recipes.EnvironmentDefinition{
+		Name:         "default",
+		Driver:       recipes.TemplateKindBicep,
+		TemplatePath: "ghcr.io/radius-project/recipes/redis:latest",
+		ResourceType: "Applications.Datastores/redisCaches",
+		Parameters:   map[string]any{"sku": "Basic"},
+	}
+	recipeData := map[string]any{
+		"parameters": map[string]any{
+			"sku":  map[string]any{"type": "string"},
+			"port": map[string]any{"type": "int", "defaultValue": float64(6379)},
+		},
+	}
+
+	tests := []struct {
+		desc          string
+		provisioning  portableresources.ResourceProvisioning
+		parameters    map[string]any
+		loadRecipeErr error
+		metadataErr   error
+		expectedResp  rest.Response
+	}{
+		{
+			desc:       "valid parameters",
+			parameters: map[string]any{"port": float64(6380)},
+		},
+		{
+			desc:         "invalid parameters",
+			parameters:   map[string]any{"port": "6380", "size": "large"},
+			expectedResp: rest.NewBadRequestResponse(`Recipe "default" has invalid recipe parameters: parameter "port" is expected to be of type int, got string; parameter "size" is not declared by the recipe`),
+		},
+		{
+			desc:         "manual provisioning",
+			provisioning: portableresources.ResourceProvisioningManual,
+			parameters:   map[string]any{"size": "large"},
+		},
+		{
+			desc:          "recipe not found",
+			parameters:    map[string]any{"size": "large"},
+			loadRecipeErr: errors.New("recipe not found"),
+		},
+		{
+			desc:        "metadata unavailable",
+			parameters:  map[string]any{"size": "large"},
+			metadataErr: errors.New("failed to pull the recipe"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			mctrl := gomock.NewController(t)
+			eng := engine.NewMockEngine(mctrl)
+			configLoader := configloader.NewMockConfigurationLoader(mctrl)
+
+			if tt.provisioning != portableresources.ResourceProvisioningManual {
+				configLoader.EXPECT().
+					LoadRecipe(gomock.Any(), &recipes.ResourceMetadata{
+						Name:          "default",
+						Parameters:    tt.parameters,
+						EnvironmentID: envID,
+						ResourceID:    resourceID,
+					}).
+					Return(definition, tt.loadRecipeErr)
+				if tt.loadRecipeErr == nil {
+					configLoader.EXPECT().
+						LoadConfiguration(gomock.Any(), gomock.Any()).
+						Return(&recipes.Configuration{}, nil)
+					eng.EXPECT().
+						GetRecipeMetadata(gomock.Any(), *definition, gomock.Any()).
+						Return(recipeData, tt.metadataErr)
+				}
+			}
+
+			newResource := &ds_dm.RedisCache{
+				Properties: ds_dm.RedisCacheProperties{
+					BasicResourceProperties: rpv1.BasicResourceProperties{Environment: envID},
+					ResourceProvisioning:    tt.provisioning,
+					Recipe:                  portableresources.ResourceRecipe{Name: "default", Parameters: tt.parameters},
+				},
+			}
+
+			id, err := resources.ParseResource(resourceID)
+			require.NoError(t, err)
+			ctx := v1.WithARMRequestContext(context.Background(), &v1.ARMRequestContext{ResourceID: id})
+
+			filter := ValidateRecipeParameters[*ds_dm.RedisCache](eng, configLoader)
+			resp, err := filter(ctx, newResource, nil, &controller.Options{})
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedResp, resp)
+		})
+	}
+}
@@ -18,8 +18,10 @@ package util
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	dockerParser "github.com/novln/docker-parser"
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
@@ -27,12 +29,14 @@ import (
 	recipes_util "github.com/radius-project/radius/pkg/recipes/util"
 	"oras.land/oras-go/v2/content"
 	"oras.land/oras-go/v2/registry/remote"
+	"oras.land/oras-go/v2/registry/remote/auth"
+	"oras.land/oras-go/v2/registry/remote/retry"
 )
 
-// ReadFromRegistry reads data from an OCI compliant registry and stores it in a map. It returns an error if the path is invalid,
-// if the client to the registry fails to be created, if the manifest fails to be fetched, if the bytes fail to be fetched, or if
-// the data fails to be unmarshalled.
-func ReadFromRegistry(ctx context.Context, path string, data *map[string]any) error {
+// ReadFromRegistry reads data from an OCI compliant registry and stores it in a map. The registry is accessed using client,
+// or anonymously if client is nil. It returns an error if the path is invalid, if the client to the registry fails to be created,
+// if the manifest fails to be fetched, if the bytes fail to be fetched, or if the data fails to be unmarshalled.
+func ReadFromRegistry(ctx context.Context, path string, data *map[string]any, client remote.Client) error {
 	registryRepo, tag, err := parsePath(path)
 	if err != nil {
 		return v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid path %s", err.Error()))
@@ -42,6 +46,9 @@ func ReadFromRegistry(ctx context.Context, path string, data *map[string]any) er
 	if err != nil {
 		return fmt.Errorf("failed to create client to registry %s", err.Error())
 	}
+	if client != nil {
+		repo.Client = client
+	}
 
 	digest, err := getDigestFromManifest(ctx, repo, tag)
 	if err != nil {
@@ -127,3 +134,77 @@ func parsePath(path string) (repository string, tag string, err error) {
 	tag = reference.Tag()
 	return
 }
+
+// NewRegistryClient creates a client of OCI registries authenticating with the given credentials, keyed by the registry
+// host. The registries without credentials are accessed anonymously.
+func NewRegistryClient(credentials map[string]auth.Credential) remote.Client {
+	return &auth.Client{
+		Client: retry.DefaultClient,
+		Cache:  auth.NewCache(),
+		Credential: func(_ context.Context, host string) (auth.Credential, error) {
+			if credential, ok := credentials[host]; ok {
+				return credential, nil
+			}
+			// Traffic targeting "docker.io" is redirected to "registry-1.docker.io".
+			if host == "registry-1.docker.io" {
+				if credential, ok := credentials["docker.io"]; ok {
+					return credential, nil
+				}
+			}
+			return auth.EmptyCredential, nil
+		},
+	}
+}
+
+// dockerConfig is the format of the docker config JSON storing registry credentials.
+type dockerConfig struct {
+	Auths map[string]dockerConfigAuth `json:"auths"`
+}
+
+type dockerConfigAuth struct {
+	Auth          string `json:"auth,omitempty"`
+	Username      string `json:"username,omitempty"`
+	Password      string `json:"password,omitempty"`
+	IdentityToken string `json:"identitytoken,omitempty"`
+	RegistryToken string `json:"registrytoken,omitempty"`
+}
+
+// DockerConfigCredential returns the credential of the registry host stored in the docker config JSON. It returns an error
+// if the docker config is invalid or doesn't contain the credential of the host.
+func DockerConfigCredential(config []byte, host string) (auth.Credential, error) {
+	parsed := dockerConfig{}
+	if err := json.Unmarshal(config, &parsed); err != nil {
+		return auth.EmptyCredential, fmt.Errorf("failed to parse docker config: %w", err)
+	}
+
+	for key, entry := range parsed.Auths {
+		// Docker config keys may be URLs, e.g. "https://index.docker.io/v1/".
+		registry := strings.TrimPrefix(strings.TrimPrefix(key, "https://"), "http://")
+		registry, _, _ = strings.Cut(registry, "/")
+		if registry != host && !(host == "docker.io" && registry == "index.docker.io") {
+			continue
+		}
+
+		credential := auth.Credential{
+			Username:     entry.Username,
+			Password:     entry.Password,
+			RefreshToken: entry.IdentityToken,
+			AccessToken:  entry.RegistryToken,
+		}
+		if entry.Auth != "" {
+			decoded, err := base64.StdEncoding.DecodeString(entry.Auth)
+			if err != nil {
+				return auth.EmptyCredential, fmt.Errorf("failed to decode the auth of registry %q in docker config: %w", host, err)
+			}
+			username, password, ok := strings.Cut(string(decoded), ":")
+			if !ok {
+				return auth.EmptyCredential, fmt.Errorf("the auth of registry %q in docker config is not in the format 'username:password'", host)
+			}
+			credential.Username, credential.Password = username, password
+		}
+
+		return credential, nil
+	}
+
+	return auth.EmptyCredential, fmt.Errorf("docker config doesn't contain credentials for registry %q", host)
+}
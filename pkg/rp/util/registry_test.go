@@ -17,9 +17,19 @@ limitations under the License.
 package util
 
 import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
 	"testing"
 
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/radius-project/radius/test/testcontext"
 	"github.com/stretchr/testify/require"
+	"oras.land/oras-go/v2/registry/remote/auth"
 )
 
 func Test_PathParser(t *testing.T) {
@@ -35,3 +45,130 @@ func Test_PathParserErr(t *testing.T) {
 	require.Equal(t, "", repository)
 	require.Equal(t, "", tag)
 }
+
+// newTestRegistry creates a registry stand-in serving a single recipe at recipes/redis:1.0, which requires basic authentication.
+func newTestRegistry(t *testing.T, username, password string, recipe []byte) *httptest.Server {
+	layer := ocispec.Descriptor{
+		MediaType: "application/vnd.ms.bicep.module.layer.v1+json",
+		Digest:    digest.FromBytes(recipe),
+		Size:      int64(len(recipe)),
+	}
+	manifest, err := json.Marshal(ocispec.Manifest{
+		MediaType: ocispec.MediaTypeImageManifest,
+		Config:    ocispec.Descriptor{MediaType: "application/vnd.ms.bicep.module.config.v1+json", Digest: digest.FromBytes(nil)},
+		Layers:    []ocispec.Descriptor{layer},
+	})
+	require.NoError(t, err)
+
+	serve := func(w http.ResponseWriter, r *http.Request, mediaType string, content []byte) {
+		w.Header().Set("Content-Type", mediaType)
+		w.Header().Set("Docker-Content-Digest", digest.FromBytes(content).String())
+		w.Header().Set("Content-Length", strconv.Itoa(len(content)))
+		if r.Method == http.MethodGet {
+			_, _ = w.Write(content)
+		}
+	}
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if u, p, ok := r.BasicAuth(); !ok || u != username || p != password {
+			w.Header().Set("WWW-Authenticate", `Basic realm="test"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		switch r.URL.Path {
+		case "/v2/recipes/redis/manifests/1.0", "/v2/recipes/redis/manifests/" + digest.FromBytes(manifest).String():
+			serve(w, r, ocispec.MediaTypeImageManifest, manifest)
+		case "/v2/recipes/redis/blobs/" + layer.Digest.String():
+			serve(w, r, layer.MediaType, recipe)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func Test_ReadFromRegistry_Authenticated(t *testing.T) {
+	ctx := testcontext.New(t)
+	server := newTestRegistry(t, "user", "secret", []byte(`{"parameters":{"name":{"type":"string"}}}`))
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	host := serverURL.Host
+	path := host + "/recipes/redis:1.0"
+
+	t.Run("authenticated", func(t *testing.T) {
+		client := NewRegistryClient(map[string]auth.Credential{host: {Username: "user", Password: "secret"}}).(*auth.Client)
+		client.Client = server.Client()
+
+		data := map[string]any{}
+		err := ReadFromRegistry(ctx, path, &data, client)
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{"parameters": map[string]any{"name": map[string]any{"type": "string"}}}, data)
+	})
+
+	t.Run("anonymous", func(t *testing.T) {
+		client := NewRegistryClient(nil).(*auth.Client)
+		client.Client = server.Client()
+
+		data := map[string]any{}
+		err := ReadFromRegistry(ctx, path, &data, client)
+		require.ErrorContains(t, err, "credential required for basic auth")
+	})
+}
+
+func Test_NewRegistryClient_Credential(t *testing.T) {
+	ctx := testcontext.New(t)
+	client := NewRegistryClient(map[string]auth.Credential{
+		"myregistry.azurecr.io": {Username: "user", Password: "secret"},
+		"docker.io":             {AccessToken: "token"},
+	}).(*auth.Client)
+
+	credential, err := client.Credential(ctx, "myregistry.azurecr.io")
+	require.NoError(t, err)
+	require.Equal(t, auth.Credential{Username: "user", Password: "secret"}, credential)
+
+	credential, err = client.Credential(ctx, "registry-1.docker.io")
+	require.NoError(t, err)
+	require.Equal(t, auth.Credential{AccessToken: "token"}, credential)
+
+	credential, err = client.Credential(ctx, "ghcr.io")
+	require.NoError(t, err)
+	require.Equal(t, auth.EmptyCredential, credential)
+}
+
+func Test_DockerConfigCredential(t *testing.T) {
+	config := `{
+		"auths": {
+			"myregistry.azurecr.io": {"auth": "` + base64.StdEncoding.EncodeToString([]byte("user:secret")) + `"},
+			"https://index.docker.io/v1/": {"username": "dockeruser", "password": "dockersecret"},
+			"ghcr.io": {"identitytoken": "refresh-token"},
+			"invalid.io": {"auth": "invalid"}
+		}
+	}`
+
+	tests := []struct {
+		host     string
+		expected auth.Credential
+		err      string
+	}{
+		{host: "myregistry.azurecr.io", expected: auth.Credential{Username: "user", Password: "secret"}},
+		{host: "docker.io", expected: auth.Credential{Username: "dockeruser", Password: "dockersecret"}},
+		{host: "ghcr.io", expected: auth.Credential{RefreshToken: "refresh-token"}},
+		{host: "invalid.io", err: `failed to decode the auth of registry "invalid.io" in docker config`},
+		{host: "quay.io", err: `docker config doesn't contain credentials for registry "quay.io"`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.host, func(t *testing.T) {
+			credential, err := DockerConfigCredential([]byte(config), tc.host)
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, credential)
+		})
+	}
+}
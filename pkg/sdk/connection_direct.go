@@ -17,6 +17,10 @@ limitations under the License.
 package sdk
 
 import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -26,15 +30,39 @@ import (
 
 var _ Connection = (*directConnection)(nil)
 
-// directConnection represents a connection to a Radius API endpoint with no authentication
-// or intermediate systems. This is mostly used for test scenarios.
+// TokenSource provides the bearer tokens authenticating the requests of a direct connection.
+type TokenSource interface {
+	// Token returns the bearer token to use for a request. It's called for every request, so that tokens can be refreshed.
+	Token(ctx context.Context) (string, error)
+}
+
+// DirectConnectionOptions represents the options of a direct connection.
+type DirectConnectionOptions struct {
+	// CACertificates is a PEM bundle of the certificate authorities trusted for the endpoint, in addition to the
+	// certificate authorities of the system. This field is optional.
+	CACertificates []byte
+
+	// TokenSource provides the bearer tokens sent in the Authorization header of the requests. This field is optional.
+	TokenSource TokenSource
+}
+
+// directConnection represents a connection to a Radius API endpoint with no intermediate systems, such as
+// UCP exposed through an ingress. The requests can be authenticated with bearer tokens.
 type directConnection struct {
-	endpoint string
+	endpoint  string
+	transport http.RoundTripper
 }
 
 // NewDirectConnection parses the given endpoint string and returns a direct connection if the endpoint uses the http or
 // https scheme, otherwise it returns an error.
 func NewDirectConnection(endpoint string) (Connection, error) {
+	return NewDirectConnectionWithOptions(endpoint, DirectConnectionOptions{})
+}
+
+// NewDirectConnectionWithOptions parses the given endpoint string and returns a direct connection trusting the given
+// certificate authorities and authenticating with the given token source. It returns an error if the endpoint doesn't use
+// the http or https scheme, if the CA bundle doesn't contain certificates, or if bearer tokens would be sent over http.
+func NewDirectConnectionWithOptions(endpoint string, options DirectConnectionOptions) (Connection, error) {
 	parsed, err := url.Parse(endpoint)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse endpoint %q: %w", endpoint, err)
@@ -44,8 +72,32 @@ func NewDirectConnection(endpoint string) (Connection, error) {
 		return nil, fmt.Errorf("the endpoint must use the http or https scheme (got %q)", endpoint)
 	}
 
+	if options.TokenSource != nil && parsed.Scheme != "https" {
+		return nil, fmt.Errorf("the endpoint must use the https scheme to send bearer tokens (got %q)", endpoint)
+	}
+
+	var transport http.RoundTripper = http.DefaultTransport
+	if len(options.CACertificates) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(options.CACertificates) {
+			return nil, errors.New("the CA bundle doesn't contain PEM-encoded certificates")
+		}
+
+		t := http.DefaultTransport.(*http.Transport).Clone()
+		t.TLSClientConfig = &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+		transport = t
+	}
+
+	if options.TokenSource != nil {
+		transport = &bearerTokenTransport{tokenSource: options.TokenSource, next: transport}
+	}
+
 	return &directConnection{
-		endpoint: endpoint,
+		endpoint:  endpoint,
+		transport: transport,
 	}, nil
 }
 
@@ -53,7 +105,7 @@ func NewDirectConnection(endpoint string) (Connection, error) {
 // autorest.Sender interface (autorest Track1 Go SDK) and policy.Transporter interface
 // (autorest Track2 Go SDK).
 func (c *directConnection) Client() *http.Client {
-	return &http.Client{Transport: otelhttp.NewTransport(http.DefaultTransport)}
+	return &http.Client{Transport: otelhttp.NewTransport(c.transport)}
 }
 
 // Endpoint returns the endpoint (aka. base URL) of the Radius API. This definitely includes
@@ -61,3 +113,25 @@ func (c *directConnection) Client() *http.Client {
 func (c *directConnection) Endpoint() string {
 	return c.endpoint
 }
+
+var _ http.RoundTripper = (*bearerTokenTransport)(nil)
+
+// bearerTokenTransport adds the bearer token provided by the token source to the requests.
+type bearerTokenTransport struct {
+	tokenSource TokenSource
+	next        http.RoundTripper
+}
+
+// RoundTrip sets the Authorization header of a copy of the request and sends it with the next transport.
+func (t *bearerTokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.tokenSource.Token(req.Context())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the bearer token: %w", err)
+	}
+
+	// A RoundTripper must not modify the request.
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	return t.next.RoundTrip(req)
+}
@@ -17,7 +17,12 @@ limitations under the License.
 package sdk
 
 import (
+	"context"
+	"encoding/pem"
+	"errors"
+	"io"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -57,3 +62,75 @@ func Test_NewDirectConnection_InvalidWithoutScheme(t *testing.T) {
 	require.Contains(t, err.Error(), "the endpoint must use the http or https scheme")
 	require.Nil(t, connection)
 }
+
+func Test_NewDirectConnectionWithOptions_CAAndToken(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(r.Header.Get("Authorization")))
+	}))
+	defer server.Close()
+
+	ca := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	connection, err := NewDirectConnectionWithOptions(server.URL, DirectConnectionOptions{
+		CACertificates: ca,
+		TokenSource:    &fakeTokenSource{token: "abcd"},
+	})
+	require.NoError(t, err)
+
+	resp, err := connection.Client().Get(server.URL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Equal(t, "Bearer abcd", string(body))
+}
+
+func Test_NewDirectConnectionWithOptions_UntrustedCertificate(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	connection, err := NewDirectConnectionWithOptions(server.URL, DirectConnectionOptions{})
+	require.NoError(t, err)
+
+	_, err = connection.Client().Get(server.URL)
+	require.Error(t, err)
+}
+
+func Test_NewDirectConnectionWithOptions_TokenError(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	ca := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	connection, err := NewDirectConnectionWithOptions(server.URL, DirectConnectionOptions{
+		CACertificates: ca,
+		TokenSource:    &fakeTokenSource{err: errors.New("token expired")},
+	})
+	require.NoError(t, err)
+
+	_, err = connection.Client().Get(server.URL)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "failed to get the bearer token: token expired")
+}
+
+func Test_NewDirectConnectionWithOptions_InvalidCACertificates(t *testing.T) {
+	connection, err := NewDirectConnectionWithOptions("https://example.com", DirectConnectionOptions{CACertificates: []byte("not a certificate")})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "the CA bundle doesn't contain PEM-encoded certificates")
+	require.Nil(t, connection)
+}
+
+func Test_NewDirectConnectionWithOptions_TokenRequiresHttps(t *testing.T) {
+	connection, err := NewDirectConnectionWithOptions("http://example.com", DirectConnectionOptions{TokenSource: &fakeTokenSource{token: "abcd"}})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "the endpoint must use the https scheme to send bearer tokens")
+	require.Nil(t, connection)
+}
+
+type fakeTokenSource struct {
+	token string
+	err   error
+}
+
+func (s *fakeTokenSource) Token(ctx context.Context) (string, error) {
+	return s.token, s.err
+}
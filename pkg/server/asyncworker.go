@@ -91,6 +91,10 @@ func (w *AsyncWorker) Run(ctx context.Context) error {
 		if w.Options.Config.WorkerServer.MaxOperationRetryCount != nil {
 			workerOpts.MaxOperationRetryCount = *w.Options.Config.WorkerServer.MaxOperationRetryCount
 		}
+		if w.Options.Config.WorkerServer.MaxPartitionConcurrency != nil {
+			workerOpts.MaxPartitionConcurrency = *w.Options.Config.WorkerServer.MaxPartitionConcurrency
+		}
+		workerOpts.PartitionConcurrency = w.Options.Config.WorkerServer.PartitionConcurrency
 	}
 
 	return w.Start(ctx, workerOpts)
@@ -63,6 +63,10 @@ func (w *Service) Run(ctx context.Context) error {
 		if w.Options.Config.WorkerServer.MaxOperationRetryCount != nil {
 			workerOpts.MaxOperationRetryCount = *w.Options.Config.WorkerServer.MaxOperationRetryCount
 		}
+		if w.Options.Config.WorkerServer.MaxPartitionConcurrency != nil {
+			workerOpts.MaxPartitionConcurrency = *w.Options.Config.WorkerServer.MaxPartitionConcurrency
+		}
+		workerOpts.PartitionConcurrency = w.Options.Config.WorkerServer.PartitionConcurrency
 	}
 
 	return w.Start(ctx, workerOpts)
@@ -18,6 +18,7 @@ package dataprovider
 
 import (
 	context "context"
+	"database/sql"
 	"errors"
 	"fmt"
 
@@ -25,8 +26,10 @@ import (
 	store "github.com/radius-project/radius/pkg/ucp/store"
 	"github.com/radius-project/radius/pkg/ucp/store/apiserverstore"
 	ucpv1alpha1 "github.com/radius-project/radius/pkg/ucp/store/apiserverstore/api/ucp.dev/v1alpha1"
+	"github.com/radius-project/radius/pkg/ucp/store/boltstore"
 	"github.com/radius-project/radius/pkg/ucp/store/cosmosdb"
 	"github.com/radius-project/radius/pkg/ucp/store/etcdstore"
+	"github.com/radius-project/radius/pkg/ucp/store/postgresstore"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -35,9 +38,11 @@ import (
 type storageFactoryFunc func(context.Context, StorageProviderOptions, string) (store.StorageClient, error)
 
 var storageClientFactory = map[StorageProviderType]storageFactoryFunc{
-	TypeAPIServer: initAPIServerClient,
-	TypeCosmosDB:  initCosmosDBClient,
-	TypeETCD:      InitETCDClient,
+	TypeAPIServer:  initAPIServerClient,
+	TypeCosmosDB:   initCosmosDBClient,
+	TypeETCD:       InitETCDClient,
+	TypePostgreSQL: initPostgreSQLClient,
+	TypeBolt:       initBoltClient,
 }
 
 func initAPIServerClient(ctx context.Context, opt StorageProviderOptions, _ string) (store.StorageClient, error) {
@@ -69,7 +74,8 @@ func initAPIServerClient(ctx context.Context, opt StorageProviderOptions, _ stri
 		},
 	}
 
-	rc, err := runtimeclient.New(cfg, options)
+	// The client must support watch so that the store can implement store.WatchableStorageClient.
+	rc, err := runtimeclient.NewWithWatch(cfg, options)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize APIServer client: %w", err)
 	}
@@ -117,3 +123,36 @@ func InitETCDClient(ctx context.Context, opt StorageProviderOptions, _ string) (
 	etcdClient := etcdstore.NewETCDClient(client)
 	return etcdClient, nil
 }
+
+func initPostgreSQLClient(ctx context.Context, opt StorageProviderOptions, _ string) (store.StorageClient, error) {
+	if opt.PostgreSQL.URL == "" {
+		return nil, errors.New("failed to initialize PostgreSQL client: url is required")
+	}
+
+	db, err := sql.Open(postgresstore.DriverName, opt.PostgreSQL.URL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize PostgreSQL client: %w", err)
+	}
+
+	client := postgresstore.NewPostgreSQLClient(db)
+	if err = client.Init(ctx); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("failed to initialize PostgreSQL client - configuration may be invalid: %w", err)
+	}
+
+	return client, nil
+}
+
+func initBoltClient(ctx context.Context, opt StorageProviderOptions, _ string) (store.StorageClient, error) {
+	db, err := boltstore.Open(opt.Bolt.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize bolt client: %w", err)
+	}
+
+	client, err := boltstore.NewBoltClient(db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize bolt client: %w", err)
+	}
+
+	return client, nil
+}
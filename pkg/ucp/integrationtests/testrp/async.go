@@ -90,6 +90,7 @@ func AsyncResource(t *testing.T, ts *testserver.TestServer, rootScope string, pu
 	frontendOpts := frontend_ctrl.Options{
 		DataProvider:  ts.Clients.StorageProvider,
 		StatusManager: statusManager,
+		RequestQueue:  queueClient,
 	}
 
 	err = server.ConfigureDefaultHandlers(ctx, r, rootScope, false, "System.Test", nil, frontendOpts)
@@ -28,6 +28,15 @@ limitations under the License.
 // 3. FinishMessage: Deletes the leased message CR to remove message in the queue completely if the message is not re-queued.
 // 4. ExtendMessage: Extends the leased message to postpone the re-queue operation.
 //
+// Messages which cannot be processed are moved to the dead-letter destination by adding `ucp.dev/deadletter` label
+// to the leased message CR. Dequeue excludes the messages with this label, and the reason is stored in the annotations.
+// Replaying the message removes the label and resets DequeueCount so that it is dequeued again.
+//
+// The priority of the message is stored in `ucp.dev/priority` label. Dequeue queries the visible messages of each
+// priority from the highest to the lowest. The partition key of the message can't be used as label value, so the hash
+// of the key is stored in `ucp.dev/partition` label to skip the excluded partitions and the key itself is stored
+// in the annotation.
+//
 // To create new QueueMessage resource, we generate the below unique id to avoid the conflict.
 //
 //         applications.core.1656452659.70a6f0f8003943a6abe3319c5a4f1b9d
@@ -57,12 +66,14 @@ import (
 	"crypto/rand"
 	"errors"
 	"fmt"
+	"hash/fnv"
 	"strconv"
 	"time"
 
 	"github.com/radius-project/radius/pkg/ucp/queue/client"
 
 	v1alpha1 "github.com/radius-project/radius/pkg/ucp/store/apiserverstore/api/ucp.dev/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -71,17 +82,36 @@ import (
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+var (
+	// dequeuePriorities is the order of priorities which Dequeue queries the messages.
+	dequeuePriorities = []client.Priority{client.PriorityHigh, client.PriorityNormal, client.PriorityLow}
+)
+
 const (
 	// LabelQueueName is the label representing queue name.
 	LabelQueueName = "ucp.dev/queuename"
 	// LabelNextVisibleAt is the label representing the time when message is visible in the queue or requeued.
 	LabelNextVisibleAt = "ucp.dev/nextvisibleat"
+	// LabelDeadLetter is the label representing the message is dead-lettered.
+	LabelDeadLetter = "ucp.dev/deadletter"
+	// LabelPriority is the label representing the priority of the message.
+	LabelPriority = "ucp.dev/priority"
+	// LabelPartition is the label representing the hash of the partition key of the message.
+	LabelPartition = "ucp.dev/partition"
+
+	// AnnotationDeadLetterReason is the annotation representing the reason why the message is dead-lettered.
+	AnnotationDeadLetterReason = "ucp.dev/deadletterreason"
+	// AnnotationDeadLetteredAt is the annotation representing the time when the message is dead-lettered.
+	AnnotationDeadLetteredAt = "ucp.dev/deadletteredat"
+	// AnnotationPartitionKey is the annotation representing the partition key of the message.
+	AnnotationPartitionKey = "ucp.dev/partitionkey"
 
 	defaultMessageLockDuration = time.Duration(5) * time.Minute
 	defaultExpiryDuration      = time.Duration(10) * time.Hour
 )
 
-var _ client.Client = (*Client)(nil)
+var _ client.DeadLetterClient = (*Client)(nil)
+var _ client.RequeueClient = (*Client)(nil)
 
 // Client is the queue client used for dev and test purpose.
 type Client struct {
@@ -124,6 +154,12 @@ func copyMessage(msg *client.Message, queueMessage *v1alpha1.QueueMessage) {
 		EnqueueAt:     queueMessage.Spec.EnqueueAt.Time,
 		ExpireAt:      queueMessage.Spec.ExpireAt.Time,
 		NextVisibleAt: getTimeFromString(queueMessage.Labels[LabelNextVisibleAt]),
+		Priority:      client.ParsePriority(queueMessage.Labels[LabelPriority]),
+		PartitionKey:  queueMessage.Annotations[AnnotationPartitionKey],
+	}
+	if _, ok := queueMessage.Labels[LabelDeadLetter]; ok {
+		msg.DeadLetterReason = queueMessage.Annotations[AnnotationDeadLetterReason]
+		msg.DeadLetteredAt, _ = time.Parse(time.RFC3339Nano, queueMessage.Annotations[AnnotationDeadLetteredAt])
 	}
 	msg.ContentType = client.JSONContentType
 	msg.Data = make([]byte, len(queueMessage.Spec.Data.Raw))
@@ -164,6 +200,7 @@ func (c *Client) Enqueue(ctx context.Context, msg *client.Message, options ...cl
 		return client.ErrUnsupportedContentType
 	}
 
+	cfg := client.NewEnqueueConfig(options...)
 	now := time.Now()
 	id, err := c.generateID()
 	if err != nil {
@@ -177,6 +214,7 @@ func (c *Client) Enqueue(ctx context.Context, msg *client.Message, options ...cl
 			Labels: map[string]string{
 				LabelNextVisibleAt: int64toa(now.UnixNano()),
 				LabelQueueName:     c.opts.Name,
+				LabelPriority:      cfg.Priority.Normalize().String(),
 			},
 		},
 		Spec: v1alpha1.QueueMessageSpec{
@@ -188,10 +226,22 @@ func (c *Client) Enqueue(ctx context.Context, msg *client.Message, options ...cl
 		},
 	}
 
+	if cfg.PartitionKey != "" {
+		resource.Labels[LabelPartition] = partitionLabelValue(cfg.PartitionKey)
+		resource.Annotations = map[string]string{AnnotationPartitionKey: cfg.PartitionKey}
+	}
+
 	return c.client.Create(ctx, resource)
 }
 
-func newMessageLabelSelector(now time.Time, name string) (labels.Selector, error) {
+// partitionLabelValue returns the hash of the partition key which is valid as the label value.
+func partitionLabelValue(key string) string {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(key))
+	return fmt.Sprintf("%016x", h.Sum64())
+}
+
+func newMessageLabelSelector(now time.Time, name string, priority client.Priority, excludedPartitions []string) (labels.Selector, error) {
 	selector := labels.NewSelector()
 
 	// To determine whether the message is currently leased by client or not, it uses NextVisibleAt timestamp.
@@ -204,6 +254,37 @@ func newMessageLabelSelector(now time.Time, name string) (labels.Selector, error
 	}
 	selector = selector.Add(*nextVisibleLabel)
 
+	// Dead-lettered messages must not be dequeued until they are replayed.
+	deadLetterLabel, err := labels.NewRequirement(LabelDeadLetter, selection.DoesNotExist, nil)
+	if err != nil {
+		return nil, err
+	}
+	selector = selector.Add(*deadLetterLabel)
+
+	// The messages without priority label are treated as the messages with the normal priority.
+	var priorityLabel *labels.Requirement
+	if priority == client.PriorityNormal {
+		priorityLabel, err = labels.NewRequirement(LabelPriority, selection.NotIn, []string{client.PriorityHigh.String(), client.PriorityLow.String()})
+	} else {
+		priorityLabel, err = labels.NewRequirement(LabelPriority, selection.Equals, []string{priority.String()})
+	}
+	if err != nil {
+		return nil, err
+	}
+	selector = selector.Add(*priorityLabel)
+
+	if len(excludedPartitions) > 0 {
+		values := []string{}
+		for _, key := range excludedPartitions {
+			values = append(values, partitionLabelValue(key))
+		}
+		partitionLabel, err := labels.NewRequirement(LabelPartition, selection.NotIn, values)
+		if err != nil {
+			return nil, err
+		}
+		selector = selector.Add(*partitionLabel)
+	}
+
 	nameLabel, err := labels.NewRequirement(LabelQueueName, selection.Equals, []string{name})
 	if err != nil {
 		return nil, err
@@ -212,28 +293,30 @@ func newMessageLabelSelector(now time.Time, name string) (labels.Selector, error
 	return selector.Add(*nameLabel), nil
 }
 
-// getQueueMessage fetches the first item which is the message in the current queue. We can
+// getQueueMessage fetches the first item with the highest priority which is the message in the current queue. We can
 // determine whether the message is leased by another client by checking if `NextVisibleAt“
 // value is less than `now`.
-func (c *Client) getQueueMessage(ctx context.Context, now time.Time) (*v1alpha1.QueueMessage, error) {
-	ql := &v1alpha1.QueueMessageList{}
+func (c *Client) getQueueMessage(ctx context.Context, now time.Time, excludedPartitions []string) (*v1alpha1.QueueMessage, error) {
+	for _, priority := range dequeuePriorities {
+		ql := &v1alpha1.QueueMessageList{}
 
-	selector, err := newMessageLabelSelector(now, c.opts.Name)
-	if err != nil {
-		return nil, err
-	}
+		selector, err := newMessageLabelSelector(now, c.opts.Name, priority, excludedPartitions)
+		if err != nil {
+			return nil, err
+		}
 
-	err = c.client.List(
-		ctx, ql,
-		runtimeclient.InNamespace(c.opts.Namespace),
-		runtimeclient.MatchingLabelsSelector{Selector: selector},
-		runtimeclient.Limit(1))
-	if err != nil {
-		return nil, err
-	}
+		err = c.client.List(
+			ctx, ql,
+			runtimeclient.InNamespace(c.opts.Namespace),
+			runtimeclient.MatchingLabelsSelector{Selector: selector},
+			runtimeclient.Limit(1))
+		if err != nil {
+			return nil, err
+		}
 
-	if len(ql.Items) > 0 {
-		return &ql.Items[0], nil
+		if len(ql.Items) > 0 {
+			return &ql.Items[0], nil
+		}
 	}
 
 	return nil, client.ErrMessageNotFound
@@ -297,7 +380,7 @@ func (c *Client) Dequeue(ctx context.Context, opts client.QueueClientConfig) (*c
 	retryErr := retry.OnError(retry.DefaultRetry, DequeuedMessageError, func() error {
 		// Since multiple clients can get the same message, it tries to get the next queue
 		// message whenever extendItem is failed.
-		item, err := c.getQueueMessage(ctx, now)
+		item, err := c.getQueueMessage(ctx, now, opts.ExcludedPartitions)
 		if err != nil {
 			return err
 		}
@@ -339,6 +422,11 @@ func (c *Client) FinishMessage(ctx context.Context, msg *client.Message) error {
 		return c.client.Delete(ctx, result, options)
 	})
 
+	if apierrors.IsNotFound(retryErr) {
+		// The message was already finished, or it expired.
+		return client.ErrInvalidMessage
+	}
+
 	return retryErr
 }
 
@@ -349,10 +437,168 @@ func (c *Client) ExtendMessage(ctx context.Context, msg *client.Message) error {
 
 	now := time.Now()
 	result, err := c.extendItem(ctx, msg.ID, msg.DequeueCount, now, c.opts.MessageLockDuration, false)
-	if err != nil {
+	if apierrors.IsNotFound(err) {
+		return client.ErrInvalidMessage
+	} else if err != nil {
 		return err
 	}
 
 	copyMessage(msg, result)
 	return nil
 }
+
+// RequeueMessage updates the next visible time of the leased message so that it is dequeued again after nextVisibleAt.
+func (c *Client) RequeueMessage(ctx context.Context, msg *client.Message, nextVisibleAt time.Time) error {
+	if msg == nil {
+		return client.ErrEmptyMessage
+	}
+
+	now := time.Now()
+	_, err := c.extendItem(ctx, msg.ID, msg.DequeueCount, now, nextVisibleAt.Sub(now), false)
+	if apierrors.IsNotFound(err) {
+		return client.ErrInvalidMessage
+	}
+
+	return err
+}
+
+// DeadLetter adds the dead-letter label to the leased message so that it is never dequeued.
+func (c *Client) DeadLetter(ctx context.Context, msg *client.Message, reason string) error {
+	if msg == nil {
+		return client.ErrEmptyMessage
+	}
+
+	result := &v1alpha1.QueueMessage{}
+	retryErr := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		getErr := c.client.Get(ctx, runtimeclient.ObjectKey{Namespace: c.opts.Namespace, Name: msg.ID}, result)
+		if getErr != nil {
+			return getErr
+		}
+
+		if _, ok := result.Labels[LabelDeadLetter]; ok {
+			return client.ErrInvalidMessage
+		}
+
+		// DequeueCount must be mismatched if another client leased this message.
+		if result.Spec.DequeueCount != msg.DequeueCount {
+			return client.ErrDequeuedMessage
+		}
+
+		result.Labels[LabelDeadLetter] = "true"
+		if result.Annotations == nil {
+			result.Annotations = map[string]string{}
+		}
+		result.Annotations[AnnotationDeadLetterReason] = reason
+		result.Annotations[AnnotationDeadLetteredAt] = time.Now().UTC().Format(time.RFC3339Nano)
+
+		return c.client.Update(ctx, result)
+	})
+
+	if apierrors.IsNotFound(retryErr) {
+		return client.ErrInvalidMessage
+	}
+
+	return retryErr
+}
+
+// ListDeadLetters lists the dead-lettered messages in the queue.
+func (c *Client) ListDeadLetters(ctx context.Context) ([]*client.Message, error) {
+	selector := labels.NewSelector()
+	deadLetterLabel, err := labels.NewRequirement(LabelDeadLetter, selection.Exists, nil)
+	if err != nil {
+		return nil, err
+	}
+	nameLabel, err := labels.NewRequirement(LabelQueueName, selection.Equals, []string{c.opts.Name})
+	if err != nil {
+		return nil, err
+	}
+	selector = selector.Add(*deadLetterLabel, *nameLabel)
+
+	ql := &v1alpha1.QueueMessageList{}
+	err = c.client.List(ctx, ql, runtimeclient.InNamespace(c.opts.Namespace), runtimeclient.MatchingLabelsSelector{Selector: selector})
+	if err != nil {
+		return nil, err
+	}
+
+	msgs := []*client.Message{}
+	for i := range ql.Items {
+		msg := &client.Message{}
+		copyMessage(msg, &ql.Items[i])
+		msgs = append(msgs, msg)
+	}
+
+	return msgs, nil
+}
+
+// getDeadLetter gets the dead-lettered message CR by id.
+func (c *Client) getDeadLetter(ctx context.Context, id string) (*v1alpha1.QueueMessage, error) {
+	result := &v1alpha1.QueueMessage{}
+	err := c.client.Get(ctx, runtimeclient.ObjectKey{Namespace: c.opts.Namespace, Name: id}, result)
+	if apierrors.IsNotFound(err) {
+		return nil, client.ErrDeadLetterNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	if _, ok := result.Labels[LabelDeadLetter]; !ok || result.Labels[LabelQueueName] != c.opts.Name {
+		return nil, client.ErrDeadLetterNotFound
+	}
+
+	return result, nil
+}
+
+// GetDeadLetter gets the dead-lettered message by id.
+func (c *Client) GetDeadLetter(ctx context.Context, id string) (*client.Message, error) {
+	result, err := c.getDeadLetter(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &client.Message{}
+	copyMessage(msg, result)
+	return msg, nil
+}
+
+// ReplayDeadLetter removes the dead-letter label and resets DequeueCount so that the message is dequeued again.
+func (c *Client) ReplayDeadLetter(ctx context.Context, id string) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		result, err := c.getDeadLetter(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		delete(result.Labels, LabelDeadLetter)
+		delete(result.Annotations, AnnotationDeadLetterReason)
+		delete(result.Annotations, AnnotationDeadLetteredAt)
+		result.Labels[LabelNextVisibleAt] = int64toa(now.UnixNano())
+		result.Spec.DequeueCount = 0
+		result.Spec.ExpireAt = metav1.Time{Time: now.Add(c.opts.ExpiryDuration).UTC()}
+
+		return c.client.Update(ctx, result)
+	})
+}
+
+// PurgeDeadLetter deletes the dead-lettered message CR.
+func (c *Client) PurgeDeadLetter(ctx context.Context, id string) error {
+	retryErr := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		result, err := c.getDeadLetter(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		options := &runtimeclient.DeleteOptions{
+			Preconditions: &metav1.Preconditions{
+				UID:             &result.UID,
+				ResourceVersion: &result.ResourceVersion,
+			},
+		}
+		return c.client.Delete(ctx, result, options)
+	})
+
+	if apierrors.IsNotFound(retryErr) {
+		return client.ErrDeadLetterNotFound
+	}
+
+	return retryErr
+}
@@ -31,6 +31,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 func TestMustParseInt64(t *testing.T) {
@@ -65,6 +66,11 @@ func TestCopyMessage(t *testing.T) {
 			Labels: map[string]string{
 				LabelNextVisibleAt: int64toa(now.UnixNano()),
 				LabelQueueName:     "applications.core",
+				LabelPriority:      "high",
+				LabelPartition:     partitionLabelValue("/planes/radius/local/resourcegroups/rg"),
+			},
+			Annotations: map[string]string{
+				AnnotationPartitionKey: "/planes/radius/local/resourcegroups/rg",
 			},
 		},
 		Spec: v1alpha1.QueueMessageSpec{
@@ -85,6 +91,15 @@ func TestCopyMessage(t *testing.T) {
 	require.Equal(t, queueM.Spec.ExpireAt.Time, msg.ExpireAt)
 	require.Equal(t, queueM.Spec.EnqueueAt.Time, msg.EnqueueAt)
 	require.Equal(t, getTimeFromString(queueM.ObjectMeta.Labels[LabelNextVisibleAt]), msg.NextVisibleAt)
+	require.Equal(t, client.PriorityHigh, msg.Priority)
+	require.Equal(t, "/planes/radius/local/resourcegroups/rg", msg.PartitionKey)
+}
+
+func TestPartitionLabelValue(t *testing.T) {
+	value := partitionLabelValue("/planes/radius/local/resourcegroups/rg")
+	require.Len(t, value, 16)
+	require.Equal(t, value, partitionLabelValue("/planes/radius/local/resourcegroups/rg"))
+	require.NotEqual(t, value, partitionLabelValue("/planes/radius/local/resourcegroups/rg2"))
 }
 
 func TestGenerateID(t *testing.T) {
@@ -120,6 +135,8 @@ func TestClient(t *testing.T) {
 	}
 
 	sharedtest.RunTest(t, cli, clear)
+	sharedtest.RunDeadLetterTest(t, cli, clear)
+	sharedtest.RunPriorityTest(t, cli, clear)
 
 	t.Run("ExtendMessage is failed when machine's clock is skewed", func(t *testing.T) {
 		clear(t)
@@ -144,3 +161,25 @@ func TestClient(t *testing.T) {
 		require.ErrorIs(t, err, client.ErrDequeuedMessage)
 	})
 }
+
+func TestClient_Fake(t *testing.T) {
+	// The fake client supports label selectors, which allows us to run the shared tests without the
+	// Kubernetes test environment.
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+
+	rc := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	ns := "radius-test"
+	cli, err := New(rc, Options{Name: "applications.core", Namespace: ns, MessageLockDuration: sharedtest.TestMessageLockTime})
+	require.NoError(t, err)
+
+	clear := func(t *testing.T) {
+		err := rc.DeleteAllOf(testcontext.New(t), &v1alpha1.QueueMessage{}, runtimeclient.InNamespace(ns))
+		require.NoError(t, err)
+	}
+
+	sharedtest.RunTest(t, cli, clear)
+	sharedtest.RunDeadLetterTest(t, cli, clear)
+	sharedtest.RunPriorityTest(t, cli, clear)
+}
@@ -0,0 +1,445 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package bolt is a queue implementation backed by an embedded bbolt database file. It is intended for local
+// development so that the control plane can run as plain processes without Kubernetes.
+//
+// Each queue is stored in its own bucket. Messages are keyed by the bucket sequence number so that a cursor scan
+// returns them in enqueue order. Dequeue leases the first message with the highest priority whose NextVisibleAt is in
+// the past by incrementing its DequeueCount and moving NextVisibleAt forward by the lock duration, in a single write
+// transaction. As with the apiserver queue, DequeueCount is used as the revision of the message to detect that
+// another client has leased it.
+//
+// Dead-lettered messages are moved to a separate bucket of the queue with the same key, so that Dequeue never scans them.
+package bolt
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/radius-project/radius/pkg/ucp/queue/client"
+	"go.etcd.io/bbolt"
+)
+
+const (
+	bucketPrefix           = "queue|"
+	deadLetterBucketPrefix = "deadletter|"
+
+	defaultMessageLockDuration = time.Duration(5) * time.Minute
+	defaultExpiryDuration      = time.Duration(10) * time.Hour
+)
+
+var _ client.DeadLetterClient = (*Client)(nil)
+var _ client.RequeueClient = (*Client)(nil)
+
+// Client is the queue client backed by bbolt.
+type Client struct {
+	db   *bbolt.DB
+	opts Options
+}
+
+// Options is the options to create bolt queue client.
+type Options struct {
+	// Name represents the name of queue.
+	Name string
+
+	// MessageLockDuration represents the duration of message lock.
+	MessageLockDuration time.Duration
+	// ExpiryDuration represents the duration of the expiry.
+	ExpiryDuration time.Duration
+}
+
+// New creates the queue backed by the given bbolt database.
+func New(db *bbolt.DB, options Options) (*Client, error) {
+	if options.Name == "" {
+		return nil, errors.New("Name is required")
+	}
+
+	if options.MessageLockDuration == time.Duration(0) {
+		options.MessageLockDuration = defaultMessageLockDuration
+	}
+
+	if options.ExpiryDuration == time.Duration(0) {
+		options.ExpiryDuration = defaultExpiryDuration
+	}
+
+	c := &Client{db: db, opts: options}
+	err := db.Update(func(tx *bbolt.Tx) error {
+		if _, err := tx.CreateBucketIfNotExists(c.bucketName()); err != nil {
+			return err
+		}
+		_, err := tx.CreateBucketIfNotExists(c.deadLetterBucketName())
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (c *Client) bucketName() []byte {
+	return []byte(bucketPrefix + c.opts.Name)
+}
+
+func (c *Client) deadLetterBucketName() []byte {
+	return []byte(deadLetterBucketPrefix + c.opts.Name)
+}
+
+// Enqueue enqueues message to the queue.
+func (c *Client) Enqueue(ctx context.Context, msg *client.Message, options ...client.EnqueueOptions) error {
+	if msg == nil || msg.Data == nil || len(msg.Data) == 0 {
+		return client.ErrEmptyMessage
+	}
+
+	cfg := client.NewEnqueueConfig(options...)
+	now := time.Now().UTC()
+	return c.db.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(c.bucketName())
+		seq, err := bucket.NextSequence()
+		if err != nil {
+			return err
+		}
+
+		stored := client.Message{
+			Metadata: client.Metadata{
+				ID:            strconv.FormatUint(seq, 10),
+				DequeueCount:  0,
+				EnqueueAt:     now,
+				ExpireAt:      now.Add(c.opts.ExpiryDuration),
+				NextVisibleAt: now,
+				Priority:      cfg.Priority.Normalize(),
+				PartitionKey:  cfg.PartitionKey,
+			},
+			ContentType: client.JSONContentType,
+			Data:        msg.Data,
+		}
+
+		return bucket.Put(encodeKey(seq), mustMarshal(&stored))
+	})
+}
+
+// Dequeue leases the visible message with the highest priority in the queue. The messages of the excluded partitions
+// are skipped.
+func (c *Client) Dequeue(ctx context.Context, opts client.QueueClientConfig) (*client.Message, error) {
+	var found *client.Message
+	now := time.Now()
+	err := c.db.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(c.bucketName())
+
+		// Expired messages are removed after the scan, deleting while iterating can make the cursor skip items.
+		expired := [][]byte{}
+		var foundKey []byte
+		cursor := bucket.Cursor()
+		for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
+			msg := &client.Message{}
+			if err := json.Unmarshal(v, msg); err != nil {
+				return err
+			}
+
+			if msg.ExpireAt.Before(now) {
+				expired = append(expired, k)
+			} else if !msg.NextVisibleAt.After(now) && !opts.IsExcluded(msg.PartitionKey) {
+				// Keep the first message for the same priority so that messages are dequeued in the order of enqueue.
+				if found == nil || msg.Priority > found.Priority {
+					foundKey = k
+					found = msg
+				}
+				if found.Priority >= client.PriorityHigh {
+					break
+				}
+			}
+		}
+
+		for _, k := range expired {
+			if err := bucket.Delete(k); err != nil {
+				return err
+			}
+		}
+
+		if found == nil {
+			return nil
+		}
+
+		found.DequeueCount++
+		found.NextVisibleAt = now.Add(c.opts.MessageLockDuration)
+		return bucket.Put(foundKey, mustMarshal(found))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if found == nil {
+		return nil, client.ErrMessageNotFound
+	}
+
+	return found, nil
+}
+
+// FinishMessage deletes the message from the queue.
+func (c *Client) FinishMessage(ctx context.Context, msg *client.Message) error {
+	if msg == nil {
+		return client.ErrEmptyMessage
+	}
+
+	key, err := keyFromID(msg.ID)
+	if err != nil {
+		return client.ErrInvalidMessage
+	}
+
+	return c.db.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(c.bucketName())
+		if bucket.Get(key) == nil {
+			return client.ErrInvalidMessage
+		}
+
+		return bucket.Delete(key)
+	})
+}
+
+// ExtendMessage extends the message lock if the message is still leased by the caller.
+func (c *Client) ExtendMessage(ctx context.Context, msg *client.Message) error {
+	if msg == nil {
+		return client.ErrEmptyMessage
+	}
+
+	key, err := keyFromID(msg.ID)
+	if err != nil {
+		return client.ErrInvalidMessage
+	}
+
+	now := time.Now()
+	return c.db.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(c.bucketName())
+		v := bucket.Get(key)
+		if v == nil {
+			return client.ErrInvalidMessage
+		}
+
+		stored := &client.Message{}
+		if err := json.Unmarshal(v, stored); err != nil {
+			return err
+		}
+
+		// DequeueCount must be mismatched if another client leased this message.
+		if stored.DequeueCount != msg.DequeueCount {
+			return client.ErrDequeuedMessage
+		}
+
+		// The message was already requeued, so the lock can no longer be extended.
+		if stored.NextVisibleAt.Before(now) {
+			return client.ErrInvalidMessage
+		}
+
+		stored.NextVisibleAt = now.Add(c.opts.MessageLockDuration)
+		if err := bucket.Put(key, mustMarshal(stored)); err != nil {
+			return err
+		}
+
+		msg.NextVisibleAt = stored.NextVisibleAt
+		return nil
+	})
+}
+
+// RequeueMessage releases the lock of the leased message so that it is dequeued again after nextVisibleAt.
+func (c *Client) RequeueMessage(ctx context.Context, msg *client.Message, nextVisibleAt time.Time) error {
+	if msg == nil {
+		return client.ErrEmptyMessage
+	}
+
+	key, err := keyFromID(msg.ID)
+	if err != nil {
+		return client.ErrInvalidMessage
+	}
+
+	return c.db.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(c.bucketName())
+		v := bucket.Get(key)
+		if v == nil {
+			return client.ErrInvalidMessage
+		}
+
+		stored := &client.Message{}
+		if err := json.Unmarshal(v, stored); err != nil {
+			return err
+		}
+
+		// DequeueCount must be mismatched if another client leased this message.
+		if stored.DequeueCount != msg.DequeueCount {
+			return client.ErrDequeuedMessage
+		}
+
+		stored.NextVisibleAt = nextVisibleAt
+		return bucket.Put(key, mustMarshal(stored))
+	})
+}
+
+// DeadLetter moves the leased message to the dead-letter bucket.
+func (c *Client) DeadLetter(ctx context.Context, msg *client.Message, reason string) error {
+	if msg == nil {
+		return client.ErrEmptyMessage
+	}
+
+	key, err := keyFromID(msg.ID)
+	if err != nil {
+		return client.ErrInvalidMessage
+	}
+
+	return c.db.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(c.bucketName())
+		v := bucket.Get(key)
+		if v == nil {
+			return client.ErrInvalidMessage
+		}
+
+		stored := &client.Message{}
+		if err := json.Unmarshal(v, stored); err != nil {
+			return err
+		}
+
+		// DequeueCount must be mismatched if another client leased this message.
+		if stored.DequeueCount != msg.DequeueCount {
+			return client.ErrDequeuedMessage
+		}
+
+		stored.DeadLetterReason = reason
+		stored.DeadLetteredAt = time.Now().UTC()
+		if err := tx.Bucket(c.deadLetterBucketName()).Put(key, mustMarshal(stored)); err != nil {
+			return err
+		}
+
+		return bucket.Delete(key)
+	})
+}
+
+// ListDeadLetters lists the dead-lettered messages in enqueue order.
+func (c *Client) ListDeadLetters(ctx context.Context) ([]*client.Message, error) {
+	msgs := []*client.Message{}
+	err := c.db.View(func(tx *bbolt.Tx) error {
+		return tx.Bucket(c.deadLetterBucketName()).ForEach(func(k, v []byte) error {
+			msg := &client.Message{}
+			if err := json.Unmarshal(v, msg); err != nil {
+				return err
+			}
+			msgs = append(msgs, msg)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return msgs, nil
+}
+
+// GetDeadLetter gets the dead-lettered message by id.
+func (c *Client) GetDeadLetter(ctx context.Context, id string) (*client.Message, error) {
+	key, err := keyFromID(id)
+	if err != nil {
+		return nil, client.ErrDeadLetterNotFound
+	}
+
+	msg := &client.Message{}
+	err = c.db.View(func(tx *bbolt.Tx) error {
+		v := tx.Bucket(c.deadLetterBucketName()).Get(key)
+		if v == nil {
+			return client.ErrDeadLetterNotFound
+		}
+
+		return json.Unmarshal(v, msg)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// ReplayDeadLetter moves the dead-lettered message back to the queue and resets its DequeueCount.
+func (c *Client) ReplayDeadLetter(ctx context.Context, id string) error {
+	key, err := keyFromID(id)
+	if err != nil {
+		return client.ErrDeadLetterNotFound
+	}
+
+	now := time.Now().UTC()
+	return c.db.Update(func(tx *bbolt.Tx) error {
+		deadLetters := tx.Bucket(c.deadLetterBucketName())
+		v := deadLetters.Get(key)
+		if v == nil {
+			return client.ErrDeadLetterNotFound
+		}
+
+		stored := &client.Message{}
+		if err := json.Unmarshal(v, stored); err != nil {
+			return err
+		}
+
+		stored.DequeueCount = 0
+		stored.NextVisibleAt = now
+		stored.ExpireAt = now.Add(c.opts.ExpiryDuration)
+		stored.DeadLetterReason = ""
+		stored.DeadLetteredAt = time.Time{}
+		if err := tx.Bucket(c.bucketName()).Put(key, mustMarshal(stored)); err != nil {
+			return err
+		}
+
+		return deadLetters.Delete(key)
+	})
+}
+
+// PurgeDeadLetter deletes the dead-lettered message.
+func (c *Client) PurgeDeadLetter(ctx context.Context, id string) error {
+	key, err := keyFromID(id)
+	if err != nil {
+		return client.ErrDeadLetterNotFound
+	}
+
+	return c.db.Update(func(tx *bbolt.Tx) error {
+		deadLetters := tx.Bucket(c.deadLetterBucketName())
+		if deadLetters.Get(key) == nil {
+			return client.ErrDeadLetterNotFound
+		}
+
+		return deadLetters.Delete(key)
+	})
+}
+
+func mustMarshal(msg *client.Message) []byte {
+	// Message only contains JSON-safe types, so marshalling cannot fail.
+	b, _ := json.Marshal(msg)
+	return b
+}
+
+// encodeKey encodes the sequence number as big-endian so that keys sort in enqueue order.
+func encodeKey(seq uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, seq)
+	return b
+}
+
+func keyFromID(id string) ([]byte, error) {
+	seq, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	return encodeKey(seq), nil
+}
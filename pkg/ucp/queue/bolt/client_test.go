@@ -0,0 +1,56 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bolt
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/ucp/store/boltstore"
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/bbolt"
+
+	sharedtest "github.com/radius-project/radius/test/ucp/queuetest"
+)
+
+func TestClient(t *testing.T) {
+	db, err := boltstore.Open(filepath.Join(t.TempDir(), "radius.db"))
+	require.NoError(t, err)
+
+	cli, err := New(db, Options{Name: "radius", MessageLockDuration: sharedtest.TestMessageLockTime})
+	require.NoError(t, err)
+
+	clean := func(t *testing.T) {
+		err := db.Update(func(tx *bbolt.Tx) error {
+			for _, name := range [][]byte{cli.bucketName(), cli.deadLetterBucketName()} {
+				if err := tx.DeleteBucket(name); err != nil {
+					return err
+				}
+
+				if _, err := tx.CreateBucket(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		require.NoError(t, err)
+	}
+
+	sharedtest.RunTest(t, cli, clean)
+	sharedtest.RunDeadLetterTest(t, cli, clean)
+	sharedtest.RunPriorityTest(t, cli, clean)
+}
@@ -39,9 +39,12 @@ var (
 
 	// ErrEmptyMessage represents nil or empty Message.
 	ErrEmptyMessage = errors.New("message must not be nil or message is empty")
+
+	// ErrDeadLetterNotFound represents the error when the dead-lettered message does not exist.
+	ErrDeadLetterNotFound = errors.New("dead-lettered message is not found")
 )
 
-//go:generate mockgen -destination=./mock_client.go -package=client -self_package github.com/radius-project/radius/pkg/ucp/queue/client github.com/radius-project/radius/pkg/ucp/queue/client Client
+//go:generate mockgen -destination=./mock_client.go -package=client -self_package github.com/radius-project/radius/pkg/ucp/queue/client github.com/radius-project/radius/pkg/ucp/queue/client Client,RequeueClient,DeadLetterClient
 
 // Client is an interface to implement queue operations.
 type Client interface {
@@ -58,6 +61,42 @@ type Client interface {
 	ExtendMessage(ctx context.Context, msg *Message) error
 }
 
+// RequeueClient is an optional interface implemented by queue clients which can release the lock of a message before
+// it expires. Messages of the other clients are dequeued again when their lock expires.
+type RequeueClient interface {
+	Client
+
+	// RequeueMessage releases the lock of the message leased by the caller so that the message is dequeued again
+	// after nextVisibleAt. DequeueCount of the message is kept, and the message passed by the caller is not modified.
+	// It returns ErrDequeuedMessage if the message was leased by the other client, and ErrInvalidMessage if the
+	// message was already finished.
+	RequeueMessage(ctx context.Context, msg *Message, nextVisibleAt time.Time) error
+}
+
+// DeadLetterClient is an optional interface implemented by queue clients which can move messages to a dead-letter
+// destination. Dead-lettered messages are never dequeued until they are replayed.
+type DeadLetterClient interface {
+	Client
+
+	// DeadLetter moves the message leased by the caller to the dead-letter destination with the reason. It returns
+	// ErrDequeuedMessage if the message was leased by the other client, and ErrInvalidMessage if the message
+	// was already finished or dead-lettered.
+	DeadLetter(ctx context.Context, msg *Message, reason string) error
+
+	// ListDeadLetters lists the dead-lettered messages.
+	ListDeadLetters(ctx context.Context) ([]*Message, error)
+
+	// GetDeadLetter gets the dead-lettered message by id. It returns ErrDeadLetterNotFound if the message does not exist.
+	GetDeadLetter(ctx context.Context, id string) (*Message, error)
+
+	// ReplayDeadLetter moves the dead-lettered message back to the queue and resets its DequeueCount. It returns
+	// ErrDeadLetterNotFound if the message does not exist.
+	ReplayDeadLetter(ctx context.Context, id string) error
+
+	// PurgeDeadLetter deletes the dead-lettered message. It returns ErrDeadLetterNotFound if the message does not exist.
+	PurgeDeadLetter(ctx context.Context, id string) error
+}
+
 // StartDequeuer starts a dequeuer to consume the message from the queue and return the output channel.
 func StartDequeuer(ctx context.Context, cli Client, opts ...DequeueOptions) (<-chan *Message, error) {
 	log := ucplog.FromContextOrDiscard(ctx)
@@ -26,6 +26,56 @@ const (
 	JSONContentType = "application/json"
 )
 
+// Priority represents the priority of the queue message. Dequeue returns the visible message with the highest
+// priority first, and the messages with the same priority in the order of enqueue.
+type Priority int
+
+const (
+	// PriorityLow is the priority of the background work, such as reconciliation.
+	PriorityLow Priority = -1
+	// PriorityNormal is the default priority of the message.
+	PriorityNormal Priority = 0
+	// PriorityHigh is the priority of the message which must be processed ahead of the others.
+	PriorityHigh Priority = 1
+)
+
+// String returns the name of the priority.
+func (p Priority) String() string {
+	switch {
+	case p >= PriorityHigh:
+		return "high"
+	case p <= PriorityLow:
+		return "low"
+	default:
+		return "normal"
+	}
+}
+
+// Normalize returns the closest priority defined in this package. Queue implementations support only PriorityLow,
+// PriorityNormal and PriorityHigh.
+func (p Priority) Normalize() Priority {
+	switch {
+	case p >= PriorityHigh:
+		return PriorityHigh
+	case p <= PriorityLow:
+		return PriorityLow
+	default:
+		return PriorityNormal
+	}
+}
+
+// ParsePriority parses the name of the priority. It returns PriorityNormal if the name is unknown.
+func ParsePriority(s string) Priority {
+	switch s {
+	case "high":
+		return PriorityHigh
+	case "low":
+		return PriorityLow
+	default:
+		return PriorityNormal
+	}
+}
+
 // Message represents message managed by queue.
 type Message struct {
 	Metadata
@@ -46,6 +96,14 @@ type Metadata struct {
 	ExpireAt time.Time
 	// NextVisibleAt represents the next visible time after dequeuing the message.
 	NextVisibleAt time.Time
+	// DeadLetterReason represents the reason why the message was dead-lettered.
+	DeadLetterReason string
+	// DeadLetteredAt represents the time when the message was dead-lettered.
+	DeadLetteredAt time.Time
+	// Priority represents the priority of the message.
+	Priority Priority
+	// PartitionKey represents the key of the partition which the message belongs to.
+	PartitionKey string
 }
 
 // NewMessage creates Message.
@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/radius-project/radius/pkg/ucp/queue/client (interfaces: Client)
+// Source: github.com/radius-project/radius/pkg/ucp/queue/client (interfaces: Client,RequeueClient,DeadLetterClient)
 
 // Package client is a generated GoMock package.
 package client
@@ -7,6 +7,7 @@ package client
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 )
@@ -95,3 +96,259 @@ func (mr *MockClientMockRecorder) FinishMessage(arg0, arg1 interface{}) *gomock.
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishMessage", reflect.TypeOf((*MockClient)(nil).FinishMessage), arg0, arg1)
 }
+
+// MockRequeueClient is a mock of RequeueClient interface.
+type MockRequeueClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockRequeueClientMockRecorder
+}
+
+// MockRequeueClientMockRecorder is the mock recorder for MockRequeueClient.
+type MockRequeueClientMockRecorder struct {
+	mock *MockRequeueClient
+}
+
+// NewMockRequeueClient creates a new mock instance.
+func NewMockRequeueClient(ctrl *gomock.Controller) *MockRequeueClient {
+	mock := &MockRequeueClient{ctrl: ctrl}
+	mock.recorder = &MockRequeueClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRequeueClient) EXPECT() *MockRequeueClientMockRecorder {
+	return m.recorder
+}
+
+// Dequeue mocks base method.
+func (m *MockRequeueClient) Dequeue(arg0 context.Context, arg1 QueueClientConfig) (*Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Dequeue", arg0, arg1)
+	ret0, _ := ret[0].(*Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Dequeue indicates an expected call of Dequeue.
+func (mr *MockRequeueClientMockRecorder) Dequeue(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Dequeue", reflect.TypeOf((*MockRequeueClient)(nil).Dequeue), arg0, arg1)
+}
+
+// Enqueue mocks base method.
+func (m *MockRequeueClient) Enqueue(arg0 context.Context, arg1 *Message, arg2 ...EnqueueOptions) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Enqueue", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Enqueue indicates an expected call of Enqueue.
+func (mr *MockRequeueClientMockRecorder) Enqueue(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enqueue", reflect.TypeOf((*MockRequeueClient)(nil).Enqueue), varargs...)
+}
+
+// ExtendMessage mocks base method.
+func (m *MockRequeueClient) ExtendMessage(arg0 context.Context, arg1 *Message) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExtendMessage", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExtendMessage indicates an expected call of ExtendMessage.
+func (mr *MockRequeueClientMockRecorder) ExtendMessage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtendMessage", reflect.TypeOf((*MockRequeueClient)(nil).ExtendMessage), arg0, arg1)
+}
+
+// FinishMessage mocks base method.
+func (m *MockRequeueClient) FinishMessage(arg0 context.Context, arg1 *Message) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinishMessage", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FinishMessage indicates an expected call of FinishMessage.
+func (mr *MockRequeueClientMockRecorder) FinishMessage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishMessage", reflect.TypeOf((*MockRequeueClient)(nil).FinishMessage), arg0, arg1)
+}
+
+// RequeueMessage mocks base method.
+func (m *MockRequeueClient) RequeueMessage(arg0 context.Context, arg1 *Message, arg2 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequeueMessage", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequeueMessage indicates an expected call of RequeueMessage.
+func (mr *MockRequeueClientMockRecorder) RequeueMessage(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequeueMessage", reflect.TypeOf((*MockRequeueClient)(nil).RequeueMessage), arg0, arg1, arg2)
+}
+
+// MockDeadLetterClient is a mock of DeadLetterClient interface.
+type MockDeadLetterClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeadLetterClientMockRecorder
+}
+
+// MockDeadLetterClientMockRecorder is the mock recorder for MockDeadLetterClient.
+type MockDeadLetterClientMockRecorder struct {
+	mock *MockDeadLetterClient
+}
+
+// NewMockDeadLetterClient creates a new mock instance.
+func NewMockDeadLetterClient(ctrl *gomock.Controller) *MockDeadLetterClient {
+	mock := &MockDeadLetterClient{ctrl: ctrl}
+	mock.recorder = &MockDeadLetterClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeadLetterClient) EXPECT() *MockDeadLetterClientMockRecorder {
+	return m.recorder
+}
+
+// DeadLetter mocks base method.
+func (m *MockDeadLetterClient) DeadLetter(arg0 context.Context, arg1 *Message, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeadLetter", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeadLetter indicates an expected call of DeadLetter.
+func (mr *MockDeadLetterClientMockRecorder) DeadLetter(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeadLetter", reflect.TypeOf((*MockDeadLetterClient)(nil).DeadLetter), arg0, arg1, arg2)
+}
+
+// Dequeue mocks base method.
+func (m *MockDeadLetterClient) Dequeue(arg0 context.Context, arg1 QueueClientConfig) (*Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Dequeue", arg0, arg1)
+	ret0, _ := ret[0].(*Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Dequeue indicates an expected call of Dequeue.
+func (mr *MockDeadLetterClientMockRecorder) Dequeue(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Dequeue", reflect.TypeOf((*MockDeadLetterClient)(nil).Dequeue), arg0, arg1)
+}
+
+// Enqueue mocks base method.
+func (m *MockDeadLetterClient) Enqueue(arg0 context.Context, arg1 *Message, arg2 ...EnqueueOptions) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Enqueue", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Enqueue indicates an expected call of Enqueue.
+func (mr *MockDeadLetterClientMockRecorder) Enqueue(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enqueue", reflect.TypeOf((*MockDeadLetterClient)(nil).Enqueue), varargs...)
+}
+
+// ExtendMessage mocks base method.
+func (m *MockDeadLetterClient) ExtendMessage(arg0 context.Context, arg1 *Message) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExtendMessage", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExtendMessage indicates an expected call of ExtendMessage.
+func (mr *MockDeadLetterClientMockRecorder) ExtendMessage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtendMessage", reflect.TypeOf((*MockDeadLetterClient)(nil).ExtendMessage), arg0, arg1)
+}
+
+// FinishMessage mocks base method.
+func (m *MockDeadLetterClient) FinishMessage(arg0 context.Context, arg1 *Message) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinishMessage", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FinishMessage indicates an expected call of FinishMessage.
+func (mr *MockDeadLetterClientMockRecorder) FinishMessage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishMessage", reflect.TypeOf((*MockDeadLetterClient)(nil).FinishMessage), arg0, arg1)
+}
+
+// GetDeadLetter mocks base method.
+func (m *MockDeadLetterClient) GetDeadLetter(arg0 context.Context, arg1 string) (*Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeadLetter", arg0, arg1)
+	ret0, _ := ret[0].(*Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeadLetter indicates an expected call of GetDeadLetter.
+func (mr *MockDeadLetterClientMockRecorder) GetDeadLetter(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeadLetter", reflect.TypeOf((*MockDeadLetterClient)(nil).GetDeadLetter), arg0, arg1)
+}
+
+// ListDeadLetters mocks base method.
+func (m *MockDeadLetterClient) ListDeadLetters(arg0 context.Context) ([]*Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeadLetters", arg0)
+	ret0, _ := ret[0].([]*Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeadLetters indicates an expected call of ListDeadLetters.
+func (mr *MockDeadLetterClientMockRecorder) ListDeadLetters(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeadLetters", reflect.TypeOf((*MockDeadLetterClient)(nil).ListDeadLetters), arg0)
+}
+
+// PurgeDeadLetter mocks base method.
+func (m *MockDeadLetterClient) PurgeDeadLetter(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeadLetter", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeDeadLetter indicates an expected call of PurgeDeadLetter.
+func (mr *MockDeadLetterClientMockRecorder) PurgeDeadLetter(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeadLetter", reflect.TypeOf((*MockDeadLetterClient)(nil).PurgeDeadLetter), arg0, arg1)
+}
+
+// ReplayDeadLetter mocks base method.
+func (m *MockDeadLetterClient) ReplayDeadLetter(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplayDeadLetter", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplayDeadLetter indicates an expected call of ReplayDeadLetter.
+func (mr *MockDeadLetterClientMockRecorder) ReplayDeadLetter(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplayDeadLetter", reflect.TypeOf((*MockDeadLetterClient)(nil).ReplayDeadLetter), arg0, arg1)
+}
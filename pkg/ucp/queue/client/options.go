@@ -21,6 +21,8 @@ import "time"
 type (
 	// EnqueueOptions applies an option to Enqueue().
 	EnqueueOptions interface {
+		// ApplyEnqueueOption applies EnqueueOptions to EnqueueConfig.
+		ApplyEnqueueOption(EnqueueConfig) EnqueueConfig
 		// A private method to prevent users implementing the
 		// interface and so future additions to it will not
 		// violate compatibility.
@@ -41,6 +43,73 @@ type (
 type QueueClientConfig struct {
 	// DequeueIntervalDuration is the time duration between 2 successive dequeue attempts on the queue
 	DequeueIntervalDuration time.Duration
+
+	// ExcludedPartitions is the list of partition keys which Dequeue must skip. It is used by the consumer to
+	// stop leasing messages of the partitions which reached their concurrency limit.
+	ExcludedPartitions []string
+}
+
+// IsExcluded returns true if the messages of the partition must be skipped by Dequeue. The messages without
+// partition key are never skipped.
+func (cfg QueueClientConfig) IsExcluded(partitionKey string) bool {
+	if partitionKey == "" {
+		return false
+	}
+	for _, key := range cfg.ExcludedPartitions {
+		if key == partitionKey {
+			return true
+		}
+	}
+	return false
+}
+
+// EnqueueConfig is a configuration for Enqueue().
+type EnqueueConfig struct {
+	// Priority is the priority of the message.
+	Priority Priority
+
+	// PartitionKey is the key of the partition which the message belongs to.
+	PartitionKey string
+}
+
+type enqueueOptions struct {
+	fn func(EnqueueConfig) EnqueueConfig
+}
+
+// ApplyEnqueueOption applies the configuration to the enqueued message.
+func (q *enqueueOptions) ApplyEnqueueOption(cfg EnqueueConfig) EnqueueConfig {
+	return q.fn(cfg)
+}
+
+func (q enqueueOptions) private() {}
+
+// WithPriority sets the priority of the enqueued message.
+func WithPriority(p Priority) EnqueueOptions {
+	return &enqueueOptions{
+		fn: func(cfg EnqueueConfig) EnqueueConfig {
+			cfg.Priority = p
+			return cfg
+		},
+	}
+}
+
+// WithPartitionKey sets the partition key of the enqueued message.
+func WithPartitionKey(key string) EnqueueOptions {
+	return &enqueueOptions{
+		fn: func(cfg EnqueueConfig) EnqueueConfig {
+			cfg.PartitionKey = key
+			return cfg
+		},
+	}
+}
+
+// NewEnqueueConfig returns new enqueue config for Enqueue().
+func NewEnqueueConfig(opts ...EnqueueOptions) EnqueueConfig {
+	cfg := EnqueueConfig{}
+	for _, opt := range opts {
+		cfg = opt.ApplyEnqueueOption(cfg)
+	}
+	return cfg
 }
 
 type dequeueOptions struct {
@@ -62,6 +131,16 @@ func WithDequeueInterval(t time.Duration) DequeueOptions {
 	}
 }
 
+// WithExcludedPartitions sets the partition keys which Dequeue must skip.
+func WithExcludedPartitions(keys ...string) DequeueOptions {
+	return &dequeueOptions{
+		fn: func(cfg QueueClientConfig) QueueClientConfig {
+			cfg.ExcludedPartitions = keys
+			return cfg
+		},
+	}
+}
+
 func (q dequeueOptions) private() {}
 
 // NewDequeueConfig returns new queue config for StartDequeuer().
@@ -0,0 +1,66 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEnqueueConfig(t *testing.T) {
+	cfg := NewEnqueueConfig()
+	require.Equal(t, EnqueueConfig{}, cfg)
+
+	cfg = NewEnqueueConfig(WithPriority(PriorityHigh), WithPartitionKey("/planes/radius/local/resourcegroups/rg"))
+	require.Equal(t, EnqueueConfig{Priority: PriorityHigh, PartitionKey: "/planes/radius/local/resourcegroups/rg"}, cfg)
+}
+
+func TestNewDequeueConfig(t *testing.T) {
+	cfg := NewDequeueConfig(WithDequeueInterval(time.Second), WithExcludedPartitions("a", "b"))
+	require.Equal(t, time.Second, cfg.DequeueIntervalDuration)
+	require.Equal(t, []string{"a", "b"}, cfg.ExcludedPartitions)
+
+	require.True(t, cfg.IsExcluded("a"))
+	require.False(t, cfg.IsExcluded("c"))
+	require.False(t, cfg.IsExcluded(""))
+}
+
+func TestPriority(t *testing.T) {
+	tests := []struct {
+		priority   Priority
+		name       string
+		normalized Priority
+	}{
+		{PriorityLow, "low", PriorityLow},
+		{PriorityNormal, "normal", PriorityNormal},
+		{PriorityHigh, "high", PriorityHigh},
+		{Priority(5), "high", PriorityHigh},
+		{Priority(-5), "low", PriorityLow},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.name, tc.priority.String())
+			require.Equal(t, tc.normalized, tc.priority.Normalize())
+			require.Equal(t, tc.normalized, ParsePriority(tc.name))
+		})
+	}
+
+	require.Equal(t, PriorityNormal, ParsePriority("unknown"))
+}
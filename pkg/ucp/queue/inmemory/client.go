@@ -19,12 +19,14 @@ package inmemory
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/radius-project/radius/pkg/ucp/queue/client"
 )
 
 var namedQueue = &sync.Map{}
-var _ client.Client = (*Client)(nil)
+var _ client.DeadLetterClient = (*Client)(nil)
+var _ client.RequeueClient = (*Client)(nil)
 
 // Client is the queue client used for dev and test purpose.
 type Client struct {
@@ -55,13 +57,16 @@ func (c *Client) Enqueue(ctx context.Context, msg *client.Message, options ...cl
 	if msg == nil || msg.Data == nil || len(msg.Data) == 0 {
 		return client.ErrEmptyMessage
 	}
+	cfg := client.NewEnqueueConfig(options...)
+	msg.Priority = cfg.Priority.Normalize()
+	msg.PartitionKey = cfg.PartitionKey
 	c.queue.Enqueue(msg)
 	return nil
 }
 
 // Dequeue dequeues message from the in-memory queue.
 func (c *Client) Dequeue(ctx context.Context, opts client.QueueClientConfig) (*client.Message, error) {
-	msg := c.queue.Dequeue()
+	msg := c.queue.Dequeue(opts.ExcludedPartitions...)
 	if msg == nil {
 		return nil, client.ErrMessageNotFound
 	}
@@ -89,3 +94,41 @@ func (c *Client) ExtendMessage(ctx context.Context, msg *client.Message) error {
 	}
 	return err
 }
+
+// RequeueMessage makes the message visible again after nextVisibleAt.
+func (c *Client) RequeueMessage(ctx context.Context, msg *client.Message, nextVisibleAt time.Time) error {
+	if msg == nil {
+		return client.ErrEmptyMessage
+	}
+
+	return c.queue.Requeue(msg, nextVisibleAt)
+}
+
+// DeadLetter moves the message to the dead-letter list of the in-memory queue.
+func (c *Client) DeadLetter(ctx context.Context, msg *client.Message, reason string) error {
+	if msg == nil {
+		return client.ErrEmptyMessage
+	}
+
+	return c.queue.DeadLetter(msg, reason)
+}
+
+// ListDeadLetters lists the dead-lettered messages.
+func (c *Client) ListDeadLetters(ctx context.Context) ([]*client.Message, error) {
+	return c.queue.DeadLetters(), nil
+}
+
+// GetDeadLetter gets the dead-lettered message by id.
+func (c *Client) GetDeadLetter(ctx context.Context, id string) (*client.Message, error) {
+	return c.queue.GetDeadLetter(id)
+}
+
+// ReplayDeadLetter moves the dead-lettered message back to the queue.
+func (c *Client) ReplayDeadLetter(ctx context.Context, id string) error {
+	return c.queue.ReplayDeadLetter(id)
+}
+
+// PurgeDeadLetter deletes the dead-lettered message.
+func (c *Client) PurgeDeadLetter(ctx context.Context, id string) error {
+	return c.queue.PurgeDeadLetter(id)
+}
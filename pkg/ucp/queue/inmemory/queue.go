@@ -43,12 +43,16 @@ type InmemQueue struct {
 	v   *list.List
 	vMu sync.Mutex
 
+	// deadLetters holds the dead-lettered messages. It is guarded by vMu.
+	deadLetters *list.List
+
 	lockDuration time.Duration
 }
 
 func NewInMemQueue(lockDuration time.Duration) *InmemQueue {
 	return &InmemQueue{
 		v:            &list.List{},
+		deadLetters:  &list.List{},
 		lockDuration: lockDuration,
 	}
 }
@@ -63,6 +67,7 @@ func (q *InmemQueue) DeleteAll() {
 	q.vMu.Lock()
 	defer q.vMu.Unlock()
 	_ = q.v.Init()
+	_ = q.deadLetters.Init()
 }
 
 func (q *InmemQueue) Enqueue(msg *client.Message) {
@@ -79,23 +84,40 @@ func (q *InmemQueue) Enqueue(msg *client.Message) {
 	q.v.PushBack(&element{val: msg, visible: true})
 }
 
-func (q *InmemQueue) Dequeue() *client.Message {
+// Dequeue leases the visible message with the highest priority. The messages of the excluded partitions are skipped.
+func (q *InmemQueue) Dequeue(excludedPartitions ...string) *client.Message {
 	q.updateQueue()
 
-	var found *client.Message
+	q.vMu.Lock()
+	defer q.vMu.Unlock()
 
-	q.elementRange(func(e *list.Element, elem *element) bool {
-		if elem.visible {
-			elem.val.DequeueCount++
-			elem.val.NextVisibleAt = time.Now().Add(q.lockDuration)
-			elem.visible = false
-			found = elem.val
-			return true
+	cfg := client.QueueClientConfig{ExcludedPartitions: excludedPartitions}
+	var selected *element
+	for e := q.v.Front(); e != nil; e = e.Next() {
+		elem := e.Value.(*element)
+		if !elem.visible || cfg.IsExcluded(elem.val.PartitionKey) {
+			continue
 		}
-		return false
-	})
+		// Keep the first message for the same priority so that messages are dequeued in the order of enqueue.
+		if selected == nil || elem.val.Priority > selected.val.Priority {
+			selected = elem
+		}
+		if selected.val.Priority >= client.PriorityHigh {
+			break
+		}
+	}
 
-	return found
+	if selected == nil {
+		return nil
+	}
+
+	selected.val.DequeueCount++
+	selected.val.NextVisibleAt = time.Now().Add(q.lockDuration)
+	selected.visible = false
+	// Return a copy so that the lease held by the caller is not changed when the message is
+	// dequeued again.
+	copied := *selected.val
+	return &copied
 }
 
 func (q *InmemQueue) Complete(msg *client.Message) error {
@@ -118,10 +140,15 @@ func (q *InmemQueue) Complete(msg *client.Message) error {
 
 func (q *InmemQueue) Extend(msg *client.Message) error {
 	found := false
+	dequeued := false
 	now := time.Now()
 	q.elementRange(func(e *list.Element, elem *element) bool {
 		if elem.val.ID == msg.ID {
-			if elem.val.NextVisibleAt.UnixNano() < now.UnixNano() || elem.val.DequeueCount != msg.DequeueCount {
+			if elem.val.DequeueCount != msg.DequeueCount {
+				// DequeueCount must be mismatched if another client leased this message.
+				dequeued = true
+				return true
+			} else if elem.val.NextVisibleAt.UnixNano() < now.UnixNano() {
 				elem.visible = false
 				return false
 			} else {
@@ -134,6 +161,10 @@ func (q *InmemQueue) Extend(msg *client.Message) error {
 		return false
 	})
 
+	if dequeued {
+		return client.ErrDequeuedMessage
+	}
+
 	if !found {
 		return client.ErrInvalidMessage
 	}
@@ -141,6 +172,125 @@ func (q *InmemQueue) Extend(msg *client.Message) error {
 	return nil
 }
 
+// Requeue releases the lock of the leased message and makes it visible again after nextVisibleAt.
+func (q *InmemQueue) Requeue(msg *client.Message, nextVisibleAt time.Time) error {
+	q.vMu.Lock()
+	defer q.vMu.Unlock()
+
+	for e := q.v.Front(); e != nil; e = e.Next() {
+		elem := e.Value.(*element)
+		if elem.val.ID != msg.ID {
+			continue
+		}
+
+		// DequeueCount must be mismatched if another client leased this message.
+		if elem.val.DequeueCount != msg.DequeueCount {
+			return client.ErrDequeuedMessage
+		}
+
+		elem.val.NextVisibleAt = nextVisibleAt
+		elem.visible = !nextVisibleAt.After(time.Now())
+		return nil
+	}
+
+	return client.ErrInvalidMessage
+}
+
+// DeadLetter moves the leased message to the dead-letter list.
+func (q *InmemQueue) DeadLetter(msg *client.Message, reason string) error {
+	q.vMu.Lock()
+	defer q.vMu.Unlock()
+
+	for e := q.v.Front(); e != nil; e = e.Next() {
+		elem := e.Value.(*element)
+		if elem.val.ID != msg.ID {
+			continue
+		}
+
+		// DequeueCount must be mismatched if another client leased this message.
+		if elem.val.DequeueCount != msg.DequeueCount {
+			return client.ErrDequeuedMessage
+		}
+
+		q.v.Remove(e)
+		elem.val.DeadLetterReason = reason
+		elem.val.DeadLetteredAt = time.Now().UTC()
+		q.deadLetters.PushBack(elem.val)
+		return nil
+	}
+
+	return client.ErrInvalidMessage
+}
+
+// DeadLetters returns the copies of dead-lettered messages.
+func (q *InmemQueue) DeadLetters() []*client.Message {
+	q.vMu.Lock()
+	defer q.vMu.Unlock()
+
+	msgs := []*client.Message{}
+	for e := q.deadLetters.Front(); e != nil; e = e.Next() {
+		copied := *e.Value.(*client.Message)
+		msgs = append(msgs, &copied)
+	}
+	return msgs
+}
+
+// GetDeadLetter returns the copy of dead-lettered message by id.
+func (q *InmemQueue) GetDeadLetter(id string) (*client.Message, error) {
+	q.vMu.Lock()
+	defer q.vMu.Unlock()
+
+	e := q.findDeadLetter(id)
+	if e == nil {
+		return nil, client.ErrDeadLetterNotFound
+	}
+	copied := *e.Value.(*client.Message)
+	return &copied, nil
+}
+
+// ReplayDeadLetter moves the dead-lettered message back to the queue.
+func (q *InmemQueue) ReplayDeadLetter(id string) error {
+	q.vMu.Lock()
+	defer q.vMu.Unlock()
+
+	e := q.findDeadLetter(id)
+	if e == nil {
+		return client.ErrDeadLetterNotFound
+	}
+	q.deadLetters.Remove(e)
+
+	msg := e.Value.(*client.Message)
+	msg.DequeueCount = 0
+	msg.NextVisibleAt = time.Time{}
+	msg.DeadLetterReason = ""
+	msg.DeadLetteredAt = time.Time{}
+	msg.ExpireAt = time.Now().UTC().Add(messageExpireDuration)
+	q.v.PushBack(&element{val: msg, visible: true})
+	return nil
+}
+
+// PurgeDeadLetter deletes the dead-lettered message.
+func (q *InmemQueue) PurgeDeadLetter(id string) error {
+	q.vMu.Lock()
+	defer q.vMu.Unlock()
+
+	e := q.findDeadLetter(id)
+	if e == nil {
+		return client.ErrDeadLetterNotFound
+	}
+	q.deadLetters.Remove(e)
+	return nil
+}
+
+func (q *InmemQueue) findDeadLetter(id string) *list.Element {
+	for e := q.deadLetters.Front(); e != nil; e = e.Next() {
+		if e.Value.(*client.Message).ID == id {
+			return e
+		}
+	}
+	return nil
+}
+
 func (q *InmemQueue) updateQueue() {
 	q.elementRange(func(e *list.Element, elem *element) bool {
 		now := time.Now().UTC()
@@ -29,6 +29,9 @@ type QueueProviderOptions struct {
 
 	// APIServer configures options for the Kubernetes APIServer store. (Optional)
 	APIServer APIServerOptions `yaml:"apiserver,omitempty"`
+
+	// Bolt configures options for the embedded single-file queue. (Optional)
+	Bolt BoltOptions `yaml:"bolt,omitempty"`
 }
 
 // InMemoryQueueOptions represents the inmemory queue options.
@@ -44,3 +47,10 @@ type APIServerOptions struct {
 	// Namespace configures the Kubernetes namespace used for data-storage. The namespace must already exist.
 	Namespace string `yaml:"namespace"`
 }
+
+// BoltOptions represents options for the configuring the embedded single-file queue.
+type BoltOptions struct {
+	// Path configures the path of the database file. The file can be shared with the storage and secret providers of
+	// the same process, but not with other processes since the process holds an exclusive lock on the file.
+	Path string `yaml:"path"`
+}
@@ -25,4 +25,7 @@ const (
 
 	// TypeAPIServer represents the Kubernetes APIServer provider.
 	TypeAPIServer QueueProviderType = "apiserver"
+
+	// TypeBolt represents the embedded single-file queue provider.
+	TypeBolt QueueProviderType = "bolt"
 )
@@ -0,0 +1,105 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bolt
+
+import (
+	"context"
+
+	"github.com/radius-project/radius/pkg/ucp/secret"
+	"github.com/radius-project/radius/pkg/ucp/util"
+	"go.etcd.io/bbolt"
+)
+
+const (
+	// BucketName is the name of the bucket used to store secrets.
+	BucketName = "secrets"
+)
+
+var _ secret.Client = (*Client)(nil)
+
+// Client represents radius secret client to manage radius secret using bbolt.
+type Client struct {
+	DB *bbolt.DB
+}
+
+// Save checks if the name and value of the secret are valid and saves the value in the database, returning an error if unsuccessful.
+func (c *Client) Save(ctx context.Context, name string, value []byte) error {
+	if name == "" {
+		return &secret.ErrInvalid{Message: "invalid argument. 'name' is required"}
+	}
+
+	if value == nil {
+		return &secret.ErrInvalid{Message: "invalid argument. 'value' is required"}
+	}
+
+	return c.DB.Update(func(tx *bbolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists([]byte(BucketName))
+		if err != nil {
+			return err
+		}
+
+		return bucket.Put([]byte(util.NormalizeStringToLower(name)), value)
+	})
+}
+
+// Delete deletes a secret from the database and returns an error if the secret is not found.
+func (c *Client) Delete(ctx context.Context, name string) error {
+	if name == "" {
+		return &secret.ErrInvalid{Message: "invalid argument. 'name' is required"}
+	}
+
+	return c.DB.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket([]byte(BucketName))
+		key := []byte(util.NormalizeStringToLower(name))
+		if bucket == nil || bucket.Get(key) == nil {
+			return &secret.ErrNotFound{}
+		}
+
+		return bucket.Delete(key)
+	})
+}
+
+// Get retrieves a secret from the database given a name and returns it as a byte slice, or returns an error if the
+// secret is not found or an invalid argument is provided.
+func (c *Client) Get(ctx context.Context, name string) ([]byte, error) {
+	if name == "" {
+		return nil, &secret.ErrInvalid{Message: "invalid argument. 'name' is required"}
+	}
+
+	var value []byte
+	err := c.DB.View(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket([]byte(BucketName))
+		if bucket == nil {
+			return &secret.ErrNotFound{}
+		}
+
+		b := bucket.Get([]byte(util.NormalizeStringToLower(name)))
+		if b == nil {
+			return &secret.ErrNotFound{}
+		}
+
+		// The value is only valid for the lifetime of the transaction.
+		value = make([]byte, len(b))
+		copy(value, b)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
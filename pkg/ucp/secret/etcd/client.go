@@ -56,6 +56,10 @@ func (c *Client) Save(ctx context.Context, name string, value []byte) error {
 
 // Delete deletes a secret from the etcd store and returns an error if the secret is not found.
 func (c *Client) Delete(ctx context.Context, name string) error {
+	if name == "" {
+		return &secret.ErrInvalid{Message: "invalid argument. 'name' is required"}
+	}
+
 	secretName := generateSecretResourceName(name)
 	resp, err := c.ETCDClient.Delete(ctx, secretName)
 	if err != nil {
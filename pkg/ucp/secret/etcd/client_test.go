@@ -26,6 +26,7 @@ import (
 	"github.com/radius-project/radius/pkg/ucp/hosting"
 	"github.com/radius-project/radius/pkg/ucp/secret"
 	"github.com/radius-project/radius/test/testcontext"
+	shared "github.com/radius-project/radius/test/ucp/secrettest"
 	"github.com/stretchr/testify/require"
 	etcdclient "go.etcd.io/etcd/client/v3"
 )
@@ -60,6 +61,13 @@ func Test_ETCD(t *testing.T) {
 
 	runSaveTests(t, etcdc)
 
+	clear := func(t *testing.T) {
+		_, err := etcdc.Delete(ctx, secretResourcePrefix, etcdclient.WithPrefix())
+		require.NoError(t, err)
+	}
+
+	// The shared tests cover the behavior that every secret client is expected to have.
+	shared.RunTest(t, &Client{ETCDClient: etcdc}, clear)
 }
 
 func runSaveTests(t *testing.T, etcdClient *etcdclient.Client) {
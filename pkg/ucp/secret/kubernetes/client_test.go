@@ -23,9 +23,13 @@ import (
 
 	"github.com/radius-project/radius/pkg/ucp/secret"
 	"github.com/radius-project/radius/test/k8sutil"
+	"github.com/radius-project/radius/test/testcontext"
+	shared "github.com/radius-project/radius/test/ucp/secrettest"
 
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/kubectl/pkg/scheme"
+	controller_runtime "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 const (
@@ -146,3 +150,15 @@ func Test_Delete(t *testing.T) {
 		})
 	}
 }
+
+func Test_Shared(t *testing.T) {
+	k8sFakeClient := k8sutil.NewFakeKubeClient(scheme.Scheme)
+
+	clear := func(t *testing.T) {
+		err := k8sFakeClient.DeleteAllOf(testcontext.New(t), &corev1.Secret{}, controller_runtime.InNamespace(RadiusNamespace))
+		require.NoError(t, err)
+	}
+
+	// The actual test logic lives in a shared package, we're just doing the setup here.
+	shared.RunTest(t, &Client{K8sClient: k8sFakeClient}, clear)
+}
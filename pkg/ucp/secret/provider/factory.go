@@ -23,8 +23,10 @@ import (
 	"github.com/radius-project/radius/pkg/kubeutil"
 	"github.com/radius-project/radius/pkg/ucp/dataprovider"
 	"github.com/radius-project/radius/pkg/ucp/secret"
+	"github.com/radius-project/radius/pkg/ucp/secret/bolt"
 	"github.com/radius-project/radius/pkg/ucp/secret/etcd"
 	kubernetes_client "github.com/radius-project/radius/pkg/ucp/secret/kubernetes"
+	"github.com/radius-project/radius/pkg/ucp/store/boltstore"
 	"github.com/radius-project/radius/pkg/ucp/store/etcdstore"
 	"k8s.io/kubectl/pkg/scheme"
 	controller_runtime "sigs.k8s.io/controller-runtime/pkg/client"
@@ -35,6 +37,7 @@ type secretFactoryFunc func(context.Context, SecretProviderOptions) (secret.Clie
 var secretClientFactory = map[SecretProviderType]secretFactoryFunc{
 	TypeETCDSecret:       initETCDSecretClient,
 	TypeKubernetesSecret: initKubernetesSecretClient,
+	TypeBoltSecret:       initBoltSecretClient,
 }
 
 func initETCDSecretClient(ctx context.Context, opts SecretProviderOptions) (secret.Client, error) {
@@ -70,3 +73,12 @@ func initKubernetesSecretClient(ctx context.Context, opt SecretProviderOptions)
 	}
 	return &kubernetes_client.Client{K8sClient: client}, nil
 }
+
+func initBoltSecretClient(ctx context.Context, opts SecretProviderOptions) (secret.Client, error) {
+	// The database file can be shared with the data provider, boltstore.Open returns the same database for the same path.
+	db, err := boltstore.Open(opts.Bolt.Path)
+	if err != nil {
+		return nil, err
+	}
+	return &bolt.Client{DB: db}, nil
+}
@@ -44,6 +44,7 @@ import (
 	"context"
 	"crypto/sha1"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 	"unicode"
@@ -59,6 +60,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/watch"
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -88,6 +90,8 @@ func NewAPIServerClient(client runtimeclient.Client, namespace string) *APIServe
 }
 
 var _ store.StorageClient = (*APIServerClient)(nil)
+var _ store.WatchableStorageClient = (*APIServerClient)(nil)
+var _ store.BatchStorageClient = (*APIServerClient)(nil)
 
 type APIServerClient struct {
 	client    runtimeclient.Client
@@ -111,6 +115,9 @@ func (c *APIServerClient) Query(ctx context.Context, query store.Query, options
 	if query.IsScopeQuery && query.RoutingScopePrefix != "" {
 		return nil, &store.ErrInvalid{Message: "invalid argument. 'query.RoutingScopePrefix' is not supported for scope queries"}
 	}
+	if err := store.ValidateQuery(query); err != nil {
+		return nil, err
+	}
 
 	selector, err := createLabelSelector(query)
 	if err != nil {
@@ -153,6 +160,11 @@ func (c *APIServerClient) Query(ctx context.Context, query store.Query, options
 		}
 	}
 
+	err = store.ApplyQuery(&results, query)
+	if err != nil {
+		return nil, err
+	}
+
 	return &results, nil
 }
 
@@ -355,6 +367,230 @@ func (c *APIServerClient) Save(ctx context.Context, obj *store.Object, options .
 	return err
 }
 
+// SaveBatch saves all of the objects or none of them.
+//
+// The API server has no transactions that span multiple Kubernetes objects, so the preconditions of every item are
+// checked before anything is written, and the objects are then saved in order. If a write fails the items that
+// were already written are restored to their previous state. This protects against concurrency failures, but
+// unlike the etcd store it is not atomic if the process exits part way through the batch.
+func (c *APIServerClient) SaveBatch(ctx context.Context, items []store.BatchItem) error {
+	err := store.ValidateBatch(ctx, items)
+	if err != nil {
+		return err
+	}
+
+	previous := make([]*store.Object, len(items))
+	for i, item := range items {
+		existing, err := c.Get(ctx, item.Object.ID)
+		if errors.Is(err, &store.ErrNotFound{}) {
+			if item.ETag != "" {
+				return &store.ErrConcurrency{}
+			}
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		if item.ETag != "" && item.ETag != existing.ETag {
+			return &store.ErrConcurrency{}
+		}
+
+		previous[i] = existing
+	}
+
+	for i, item := range items {
+		// Use the ETag we observed so that a concurrent change since the check above fails the batch.
+		options := []store.SaveOptions{}
+		if previous[i] != nil {
+			options = append(options, store.WithETag(previous[i].ETag))
+		}
+
+		err := c.Save(ctx, item.Object, options...)
+		if err != nil {
+			c.rollback(ctx, items[:i], previous[:i])
+			return err
+		}
+	}
+
+	return nil
+}
+
+// rollback restores the items of a failed batch to their previous state. Failures are logged since the batch
+// has already failed.
+func (c *APIServerClient) rollback(ctx context.Context, items []store.BatchItem, previous []*store.Object) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	for i, item := range items {
+		var err error
+		if previous[i] == nil {
+			err = c.Delete(ctx, item.Object.ID, store.WithETag(item.Object.ETag))
+		} else {
+			restored := *previous[i]
+			err = c.Save(ctx, &restored, store.WithETag(item.Object.ETag))
+		}
+
+		if err != nil {
+			logger.Error(err, "failed to roll back batch item", "id", item.Object.ID)
+		}
+	}
+}
+
+// Watch streams the changes to the objects that match the query. The underlying Kubernetes client must support watch
+// (runtimeclient.WithWatch), otherwise store.ErrWatchNotSupported is returned.
+//
+// Since each Kubernetes object can hold multiple UCP resources, the watch keeps track of the entries of every object
+// it has seen and reports the difference between the previous and current entries of an object as events.
+func (c *APIServerClient) Watch(ctx context.Context, query store.Query) (<-chan store.WatchEvent, error) {
+	if ctx == nil {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	if query.RootScope == "" {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'query.RootScope' is required"}
+	}
+	if query.IsScopeQuery && query.RoutingScopePrefix != "" {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'query.RoutingScopePrefix' is not supported for scope queries"}
+	}
+	if err := store.ValidateQuery(query); err != nil {
+		return nil, err
+	}
+
+	wc, ok := c.client.(runtimeclient.WithWatch)
+	if !ok {
+		return nil, store.ErrWatchNotSupported
+	}
+
+	selector, err := createLabelSelector(query)
+	if err != nil {
+		return nil, err
+	}
+
+	// List first so that we know the current entries, then watch from the resource version of the list. This way we
+	// only report changes that happen after Watch is called.
+	rs := ucpv1alpha1.ResourceList{}
+	err = c.client.List(ctx, &rs, runtimeclient.InNamespace(c.namespace), runtimeclient.MatchingLabelsSelector{Selector: selector})
+	if err != nil {
+		return nil, err
+	}
+
+	known := map[string]map[string]store.Object{}
+	for i := range rs.Items {
+		known[rs.Items[i].Name] = readEntries(ctx, &rs.Items[i], query)
+	}
+
+	w, err := wc.Watch(
+		ctx, &ucpv1alpha1.ResourceList{},
+		runtimeclient.InNamespace(c.namespace),
+		runtimeclient.MatchingLabelsSelector{Selector: selector},
+		&runtimeclient.ListOptions{Raw: &v1.ListOptions{ResourceVersion: rs.ResourceVersion}})
+	if err != nil {
+		return nil, err
+	}
+
+	logger := ucplog.FromContextOrDiscard(ctx)
+	out := make(chan store.WatchEvent)
+	go func() {
+		defer close(out)
+		defer w.Stop()
+
+		for {
+			var ev watch.Event
+			select {
+			case <-ctx.Done():
+				return
+			case ev, ok = <-w.ResultChan():
+				if !ok {
+					return
+				}
+			}
+
+			if ev.Type == watch.Error {
+				logger.Error(apierrors.FromObject(ev.Object), "apiserver watch failed", "rootScope", query.RootScope)
+				return
+			}
+
+			resource, ok := ev.Object.(*ucpv1alpha1.Resource)
+			if !ok {
+				// Bookmarks and other object types are not interesting.
+				continue
+			}
+
+			current := map[string]store.Object{}
+			if ev.Type != watch.Deleted {
+				current = readEntries(ctx, resource, query)
+			}
+
+			for _, event := range diffEntries(known[resource.Name], current) {
+				select {
+				case out <- event:
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			if len(current) == 0 {
+				delete(known, resource.Name)
+			} else {
+				known[resource.Name] = current
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// readEntries returns the entries of the resource that match the query, keyed by their normalized id.
+func readEntries(ctx context.Context, resource *ucpv1alpha1.Resource, query store.Query) map[string]store.Object {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	results := map[string]store.Object{}
+	for _, entry := range resource.Entries {
+		id, err := resources.Parse(entry.ID)
+		if err != nil {
+			logger.Error(err, "found an invalid resource id as part of a watch", "name", resource.Name, "namespace", resource.Namespace)
+			continue
+		}
+
+		if !storeutil.IDMatchesQuery(id, query) {
+			continue
+		}
+
+		converted, err := readEntry(&entry)
+		if err != nil {
+			logger.Error(err, "failed to read resource entry as part of a watch", "name", resource.Name, "namespace", resource.Namespace)
+			continue
+		}
+
+		match, err := converted.MatchesFilters(query.Filters)
+		if err != nil || !match {
+			continue
+		}
+
+		results[strings.ToLower(entry.ID)] = *converted
+	}
+
+	return results
+}
+
+// diffEntries computes the watch events that transform previous into current.
+func diffEntries(previous map[string]store.Object, current map[string]store.Object) []store.WatchEvent {
+	events := []store.WatchEvent{}
+	for id, obj := range current {
+		old, ok := previous[id]
+		if !ok {
+			events = append(events, store.WatchEvent{Type: store.WatchEventCreated, Object: obj})
+		} else if old.ETag != obj.ETag {
+			events = append(events, store.WatchEvent{Type: store.WatchEventUpdated, Object: obj})
+		}
+	}
+
+	for id, obj := range previous {
+		if _, ok := current[id]; !ok {
+			events = append(events, store.WatchEvent{Type: store.WatchEventDeleted, Object: obj})
+		}
+	}
+
+	return events
+}
+
 func (c *APIServerClient) doWithRetry(ctx context.Context, action func() (bool, error)) error {
 	for i := 0; i < RetryCount; i++ {
 		retryable, err := action()
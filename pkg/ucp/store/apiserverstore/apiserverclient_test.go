@@ -17,6 +17,8 @@ limitations under the License.
 package apiserverstore
 
 import (
+	"context"
+	"errors"
 	"path/filepath"
 	"strings"
 	"testing"
@@ -27,6 +29,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/radius-project/radius/pkg/ucp/store"
@@ -129,7 +132,7 @@ func Test_APIServer_Client(t *testing.T) {
 	}
 
 	// The actual test logic lives in a shared package, we're just doing the setup here.
-	shared.RunTest(t, client, clear)
+	shared.RunConformanceTest(t, client, clear)
 
 	// The APIServer implementation is complex enough that we have some of our tests in addition
 	// to the standard suite.
@@ -857,3 +860,85 @@ func Test_CreateLabelSelector_ScopeQuery(t *testing.T) {
 	set = assignLabels(&resource)
 	require.True(t, selector.Matches(set))
 }
+
+func Test_APIServer_Client_Fake(t *testing.T) {
+	// The fake client supports label selectors and watch, which allows us to run the shared tests without the
+	// Kubernetes test environment.
+	scheme := runtime.NewScheme()
+	require.NoError(t, ucpv1alpha1.AddToScheme(scheme))
+
+	rc := fake.NewClientBuilder().WithScheme(scheme).Build()
+	client := NewAPIServerClient(rc, "radius-test")
+
+	clear := func(t *testing.T) {
+		err := rc.DeleteAllOf(testcontext.New(t), &ucpv1alpha1.Resource{}, runtimeclient.InNamespace("radius-test"))
+		require.NoError(t, err)
+	}
+
+	shared.RunConformanceTest(t, client, clear)
+}
+
+// failingCreateClient fails the creation of the Kubernetes object with the given name.
+type failingCreateClient struct {
+	runtimeclient.Client
+	name string
+}
+
+func (c *failingCreateClient) Create(ctx context.Context, obj runtimeclient.Object, opts ...runtimeclient.CreateOption) error {
+	if obj.GetName() == c.name {
+		return errors.New("create failed")
+	}
+
+	return c.Client.Create(ctx, obj, opts...)
+}
+
+func Test_APIServer_Client_SaveBatch_RollbackOnFailure(t *testing.T) {
+	ctx := testcontext.New(t)
+	scheme := runtime.NewScheme()
+	require.NoError(t, ucpv1alpha1.AddToScheme(scheme))
+
+	rc := fake.NewClientBuilder().WithScheme(scheme).Build()
+	client := NewAPIServerClient(&failingCreateClient{Client: rc, name: resourceName(shared.Resource2ID)}, "radius-test")
+
+	existing := store.Object{Metadata: store.Metadata{ID: shared.Resource1ID.String()}, Data: shared.Data1}
+	err := client.Save(ctx, &existing)
+	require.NoError(t, err)
+
+	updated := store.Object{Metadata: store.Metadata{ID: shared.Resource1ID.String()}, Data: shared.Data2}
+	created := store.Object{Metadata: store.Metadata{ID: shared.Resource2ID.String()}, Data: shared.Data2}
+	nested := store.Object{Metadata: store.Metadata{ID: shared.NestedResource1ID.String()}, Data: shared.NestedData1}
+	err = client.SaveBatch(ctx, []store.BatchItem{{Object: &nested}, {Object: &updated, ETag: existing.ETag}, {Object: &created}})
+	require.Error(t, err)
+
+	// The items written before the failure are restored.
+	actual, err := client.Get(ctx, shared.Resource1ID.String())
+	require.NoError(t, err)
+	require.Equal(t, existing.ETag, actual.ETag)
+
+	_, err = client.Get(ctx, shared.NestedResource1ID.String())
+	require.ErrorIs(t, err, &store.ErrNotFound{})
+}
+
+func Test_APIServer_Client_Watch_NotSupported(t *testing.T) {
+	client := NewAPIServerClient(struct{ runtimeclient.Client }{}, "radius-test")
+	_, err := client.Watch(testcontext.New(t), store.Query{RootScope: shared.ResourceGroup1Scope})
+	require.ErrorIs(t, err, store.ErrWatchNotSupported)
+}
+
+func Test_diffEntries(t *testing.T) {
+	obj1 := store.Object{Metadata: store.Metadata{ID: "1", ETag: "a"}}
+	obj2 := store.Object{Metadata: store.Metadata{ID: "2", ETag: "b"}}
+	obj2Updated := store.Object{Metadata: store.Metadata{ID: "2", ETag: "c"}}
+	obj3 := store.Object{Metadata: store.Metadata{ID: "3", ETag: "d"}}
+
+	previous := map[string]store.Object{"1": obj1, "2": obj2}
+	current := map[string]store.Object{"2": obj2Updated, "3": obj3}
+
+	expected := []store.WatchEvent{
+		{Type: store.WatchEventDeleted, Object: obj1},
+		{Type: store.WatchEventUpdated, Object: obj2Updated},
+		{Type: store.WatchEventCreated, Object: obj3},
+	}
+	require.ElementsMatch(t, expected, diffEntries(previous, current))
+	require.Empty(t, diffEntries(current, current))
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"context"
+	"strings"
+)
+
+// BatchItem is an object to save as part of a batch.
+type BatchItem struct {
+	// Object is the object to save. The ETag of the object is updated when the batch is saved.
+	Object *Object
+
+	// ETag is the optional precondition for the object. When set, the batch is only saved if the stored
+	// object has the same ETag. This has the same meaning as WithETag for Save.
+	ETag ETag
+}
+
+// BatchStorageClient is an optional capability of a StorageClient that saves multiple objects together.
+//
+// Implementations must be able to save objects of any resource type, regardless of the collection the client
+// was created for, so that related records such as a resource and its operation status can be saved together.
+type BatchStorageClient interface {
+	StorageClient
+
+	// SaveBatch saves all of the objects or none of them. ErrConcurrency is returned if the precondition of
+	// any item does not match, in which case none of the objects are modified.
+	SaveBatch(ctx context.Context, items []BatchItem) error
+}
+
+// ValidateBatch validates the arguments of SaveBatch. It is intended for use by implementations of
+// BatchStorageClient.
+func ValidateBatch(ctx context.Context, items []BatchItem) error {
+	if ctx == nil {
+		return &ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+
+	ids := map[string]bool{}
+	for _, item := range items {
+		if item.Object == nil {
+			return &ErrInvalid{Message: "invalid argument. 'items' must not contain a nil object"}
+		}
+
+		id := strings.ToLower(item.Object.ID)
+		if ids[id] {
+			return &ErrInvalid{Message: "invalid argument. 'items' must not contain the same object more than once"}
+		}
+		ids[id] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,366 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package boltstore stores resources in an embedded single-file database using bbolt. This is intended for
+// local development where running the control plane as plain processes with no external dependencies is
+// desirable. It is not suitable for production use.
+//
+// The key scheme is the same as etcdstore, which makes queries a prefix scan of a single bucket:
+//
+//	scope|/planes/radius/local/|/resourceGroups/cool-group/
+//	resource|/planes/radius/local/resourceGroups/cool-group/|/Applications.Core/applications/cool-app/
+//
+// ETags are computed from the bucket sequence number, which increments on every write.
+package boltstore
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/store/storeutil"
+	"github.com/radius-project/radius/pkg/ucp/util/etag"
+	"go.etcd.io/bbolt"
+)
+
+const (
+	// SectionSeparator is the separator used to build the key of a resource.
+	SectionSeparator = "|"
+
+	// BucketName is the name of the bucket used to store resources.
+	BucketName = "resources"
+)
+
+// NewBoltClient creates a new BoltClient instance with the given database. The bucket used for storage is
+// created if it does not exist.
+func NewBoltClient(db *bbolt.DB) (*BoltClient, error) {
+	err := db.Update(func(tx *bbolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists([]byte(BucketName))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &BoltClient{db: db}, nil
+}
+
+var _ store.StorageClient = (*BoltClient)(nil)
+var _ store.BatchStorageClient = (*BoltClient)(nil)
+
+// BoltClient implements store.StorageClient using bbolt.
+type BoltClient struct {
+	db *bbolt.DB
+}
+
+// Query retrieves objects from the store that match the given query and filters, and returns them in a store.ObjectQueryResult.
+// If store.WithMaxQueryItemCount is specified the results are paginated and the returned PaginationToken can be passed
+// to store.WithPaginationToken to retrieve the next page.
+func (c *BoltClient) Query(ctx context.Context, query store.Query, options ...store.QueryOptions) (*store.ObjectQueryResult, error) {
+	if ctx == nil {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	if query.RootScope == "" {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'query.RootScope' is required"}
+	}
+	if query.IsScopeQuery && query.RoutingScopePrefix != "" {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'query.RoutingScopePrefix' is not supported for scope queries"}
+	}
+	if err := store.ValidateQuery(query); err != nil {
+		return nil, err
+	}
+
+	config := store.NewQueryConfig(options...)
+	if len(query.OrderBy) > 0 && config.MaxQueryItemCount > 0 {
+		// Pages are based on the key order, so they can't be combined with sorting.
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'query.OrderBy' is not supported with pagination"}
+	}
+
+	var lastKey []byte
+	if config.PaginationToken != "" {
+		var err error
+		lastKey, err = base64.RawURLEncoding.DecodeString(config.PaginationToken)
+		if err != nil {
+			return nil, &store.ErrInvalid{Message: "invalid argument. 'PaginationToken' is invalid"}
+		}
+	}
+
+	prefix := []byte(keyFromQuery(query))
+	results := store.ObjectQueryResult{}
+	err := c.db.View(func(tx *bbolt.Tx) error {
+		cursor := tx.Bucket([]byte(BucketName)).Cursor()
+
+		k, v := cursor.Seek(prefix)
+		if lastKey != nil {
+			k, v = cursor.Seek(lastKey)
+			if bytes.Equal(k, lastKey) {
+				k, v = cursor.Next()
+			}
+		}
+
+		for ; k != nil && bytes.HasPrefix(k, prefix); k, v = cursor.Next() {
+			value := store.Object{}
+			err := json.Unmarshal(v, &value)
+			if err != nil {
+				return err
+			}
+
+			id, err := resources.Parse(value.ID)
+			if err != nil || !storeutil.IDMatchesQuery(id, query) {
+				continue
+			}
+
+			match, err := value.MatchesFilters(query.Filters)
+			if err != nil {
+				return err
+			} else if !match {
+				continue
+			}
+
+			results.Items = append(results.Items, value)
+			if config.MaxQueryItemCount > 0 && len(results.Items) == config.MaxQueryItemCount {
+				results.PaginationToken = base64.RawURLEncoding.EncodeToString(k)
+				break
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = store.ApplyQuery(&results, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return &results, nil
+}
+
+// Get checks if the provided context, id and options are valid, then retrieves the corresponding object from
+// the store and returns it, or an error if the object is not found or an error occurs.
+func (c *BoltClient) Get(ctx context.Context, id string, options ...store.GetOptions) (*store.Object, error) {
+	if ctx == nil {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	parsed, err := parseID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	var value *store.Object
+	err = c.db.View(func(tx *bbolt.Tx) error {
+		value, err = read(tx.Bucket([]byte(BucketName)), keyFromID(parsed))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if value == nil {
+		return nil, &store.ErrNotFound{ID: id}
+	}
+
+	return value, nil
+}
+
+// Delete checks if the given resource ID is valid, and if so, deletes it from the store, returning an error if the
+// resource does not exist or if an ETag is provided and does not match.
+func (c *BoltClient) Delete(ctx context.Context, id string, options ...store.DeleteOptions) error {
+	if ctx == nil {
+		return &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	parsed, err := parseID(id)
+	if err != nil {
+		return err
+	}
+
+	key := keyFromID(parsed)
+	config := store.NewDeleteConfig(options...)
+
+	return c.db.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket([]byte(BucketName))
+		existing, err := read(bucket, key)
+		if err != nil {
+			return err
+		}
+
+		if config.ETag != "" && (existing == nil || existing.ETag != config.ETag) {
+			return &store.ErrConcurrency{}
+		} else if existing == nil {
+			return &store.ErrNotFound{ID: id}
+		}
+
+		return bucket.Delete([]byte(key))
+	})
+}
+
+// Save checks the context and object parameters, parses the object ID, marshals the object into JSON, saves the object to
+// the store, and sets the object's ETag. If an ETag is provided the object is only updated when the stored ETag matches.
+func (c *BoltClient) Save(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+	if ctx == nil {
+		return &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	if obj == nil {
+		return &store.ErrInvalid{Message: "invalid argument. 'obj' is required"}
+	}
+
+	config := store.NewSaveConfig(options...)
+	var revision string
+	err := c.db.Update(func(tx *bbolt.Tx) error {
+		var err error
+		revision, err = save(tx.Bucket([]byte(BucketName)), obj, config.ETag)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	obj.ETag = revision
+	return nil
+}
+
+// SaveBatch saves all of the objects in a single write transaction, so either all of the objects are saved or none
+// of them are.
+func (c *BoltClient) SaveBatch(ctx context.Context, items []store.BatchItem) error {
+	err := store.ValidateBatch(ctx, items)
+	if err != nil {
+		return err
+	}
+
+	etags := make([]string, len(items))
+	err = c.db.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket([]byte(BucketName))
+		for i, item := range items {
+			var err error
+			etags[i], err = save(bucket, item.Object, item.ETag)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// The caller's objects are only updated once the transaction commits.
+	for i, item := range items {
+		item.Object.ETag = etags[i]
+	}
+
+	return nil
+}
+
+// DB returns the bbolt database used by the BoltClient.
+func (c *BoltClient) DB() *bbolt.DB {
+	return c.db
+}
+
+// save writes the object to the bucket and returns its new ETag. The caller is responsible for updating the ETag
+// of the object once the transaction commits.
+func save(bucket *bbolt.Bucket, obj *store.Object, expectedETag string) (string, error) {
+	parsed, err := resources.Parse(obj.ID)
+	if err != nil {
+		return "", err
+	}
+
+	key := keyFromID(parsed)
+	if expectedETag != "" {
+		existing, err := read(bucket, key)
+		if err != nil {
+			return "", err
+		}
+
+		if existing == nil || existing.ETag != expectedETag {
+			return "", &store.ErrConcurrency{}
+		}
+	}
+
+	revision, err := bucket.NextSequence()
+	if err != nil {
+		return "", err
+	}
+
+	stored := *obj
+	stored.ETag = etag.NewFromRevision(int64(revision))
+	b, err := json.Marshal(stored)
+	if err != nil {
+		return "", err
+	}
+
+	err = bucket.Put([]byte(key), b)
+	if err != nil {
+		return "", err
+	}
+
+	return stored.ETag, nil
+}
+
+func read(bucket *bbolt.Bucket, key string) (*store.Object, error) {
+	b := bucket.Get([]byte(key))
+	if b == nil {
+		return nil, nil
+	}
+
+	value := store.Object{}
+	err := json.Unmarshal(b, &value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &value, nil
+}
+
+func parseID(id string) (resources.ID, error) {
+	parsed, err := resources.Parse(id)
+	if err != nil {
+		return resources.ID{}, &store.ErrInvalid{Message: "invalid argument. 'id' must be a valid resource id"}
+	}
+	if parsed.IsEmpty() {
+		return resources.ID{}, &store.ErrInvalid{Message: "invalid argument. 'id' must not be empty"}
+	}
+	if parsed.IsResourceCollection() || parsed.IsScopeCollection() {
+		return resources.ID{}, &store.ErrInvalid{Message: "invalid argument. 'id' must refer to a named resource, not a collection"}
+	}
+
+	return parsed, nil
+}
+
+// keyFromID returns the key to use for an ID. They key should be used as an exact match.
+func keyFromID(id resources.ID) string {
+	prefix, rootScope, routingScope, _ := storeutil.ExtractStorageParts(id)
+	return prefix + SectionSeparator + rootScope + SectionSeparator + routingScope
+}
+
+// keyFromQuery returns the key to use for an for executing a query. The key should be used as a prefix.
+func keyFromQuery(query store.Query) string {
+	prefix := storeutil.ResourcePrefix
+	if query.IsScopeQuery {
+		prefix = storeutil.ScopePrefix
+	}
+
+	if query.ScopeRecursive {
+		return prefix + SectionSeparator + storeutil.NormalizePart(query.RootScope)
+	} else {
+		return prefix + SectionSeparator + storeutil.NormalizePart(query.RootScope) + SectionSeparator + storeutil.NormalizePart(query.RoutingScopePrefix)
+	}
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package boltstore
+
+import (
+	"path/filepath"
+	"testing"
+
+	shared "github.com/radius-project/radius/test/ucp/storetest"
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/bbolt"
+)
+
+func Test_BoltClient(t *testing.T) {
+	db, err := Open(filepath.Join(t.TempDir(), "radius.db"))
+	require.NoError(t, err)
+
+	client, err := NewBoltClient(db)
+	require.NoError(t, err)
+
+	clear := func(t *testing.T) {
+		err := db.Update(func(tx *bbolt.Tx) error {
+			err := tx.DeleteBucket([]byte(BucketName))
+			if err != nil {
+				return err
+			}
+
+			_, err = tx.CreateBucket([]byte(BucketName))
+			return err
+		})
+		require.NoError(t, err)
+	}
+
+	// The actual test logic lives in a shared package, we're just doing the setup here.
+	shared.RunConformanceTest(t, client, clear)
+}
+
+func Test_Open_SharesDatabase(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "data", "radius.db")
+
+	db1, err := Open(path)
+	require.NoError(t, err)
+
+	db2, err := Open(path)
+	require.NoError(t, err)
+	require.Same(t, db1, db2)
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package boltstore
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"go.etcd.io/bbolt"
+)
+
+const (
+	// openTimeout is the amount of time to wait for the file lock. bbolt only allows one process
+	// to open a database file at a time.
+	openTimeout = 5 * time.Second
+)
+
+var (
+	databases   = map[string]*bbolt.DB{}
+	databasesMu sync.Mutex
+)
+
+// Open opens (or creates) the database file at path. The data store, secret store and queue can all share
+// the same file, so databases are cached by path and the same *bbolt.DB is returned for every caller in
+// the process. The database remains open for the lifetime of the process.
+func Open(path string) (*bbolt.DB, error) {
+	if path == "" {
+		return nil, fmt.Errorf("failed to open database: path is required")
+	}
+
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+
+	databasesMu.Lock()
+	defer databasesMu.Unlock()
+
+	if db, ok := databases[abs]; ok {
+		return db, nil
+	}
+
+	err = os.MkdirAll(filepath.Dir(abs), 0755)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create data directory: %w", err)
+	}
+
+	db, err := bbolt.Open(abs, 0600, &bbolt.Options{Timeout: openTimeout})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database %q (is another process using it?): %w", abs, err)
+	}
+
+	databases[abs] = db
+	return db, nil
+}
@@ -71,14 +71,46 @@ type Query struct {
 	// 	set RootScope to /planes/radius/local and ScopeRecursive = True and IsScopeQuery to False.
 	IsScopeQuery bool
 
-	// TODO: Revisit filter design
-
-	// Filters is an query filter to filter the specific property value.
+	// Filters is the optional list of filters on the data of the objects. An object must match all of the filters
+	// to be returned.
 	Filters []QueryFilter
+
+	// OrderBy is the optional list of fields used to sort the results, in order of precedence. String values are
+	// compared byte-wise, and objects where the field is missing or is not a string sort before strings.
+	//
+	// Stores that can't sort the results across pages may not support OrderBy together with WithMaxQueryItemCount
+	// or WithPaginationToken, in which case ErrInvalid is returned.
+	OrderBy []QueryOrder
+
+	// Projection is the optional list of fields to return. When set, the data of each object only contains the
+	// given fields, and fields that do not exist are omitted. Filters and OrderBy still apply to the full data.
+	Projection []string
 }
 
 // QueryFilter is the filter which filters property in resource entity.
+//
+// Field is a '.' separated path to a property of the data, for example 'properties.application'. Comparisons are
+// case-insensitive, like the comparisons of resource IDs, and only match string values. Every store implements the
+// same semantics.
 type QueryFilter struct {
+	// Field is the path of the property to filter on.
 	Field string
+
+	// Operator is the comparison to perform. FilterOperatorEquals is used when not set.
+	Operator FilterOperator
+
+	// Value is the value to compare with for FilterOperatorEquals, FilterOperatorNotEquals and FilterOperatorPrefix.
 	Value string
+
+	// Values is the set of values to compare with for FilterOperatorIn.
+	Values []string
+}
+
+// QueryOrder sorts the results of a query by a field.
+type QueryOrder struct {
+	// Field is the '.' separated path of the property to sort by.
+	Field string
+
+	// Descending sorts the results in descending order instead of ascending.
+	Descending bool
 }
@@ -19,6 +19,7 @@ package cosmosdb
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/radius-project/radius/pkg/ucp/resources"
@@ -43,6 +44,9 @@ const (
 
 var _ store.StorageClient = (*CosmosDBStorageClient)(nil)
 
+// fieldSegmentRegex matches a segment of a field path that can be used in a query.
+var fieldSegmentRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
 // ResourceEntity represents the default envelope model to store resource metadata.
 type ResourceEntity struct {
 	// CosmosDB system-related properties.
@@ -212,24 +216,63 @@ func constructCosmosDBQuery(query store.Query) (*cosmosapi.Query, error) {
 	}
 
 	for i, filter := range query.Filters {
+		field, err := entityField(filter.Field)
+		if err != nil {
+			return nil, err
+		}
+
 		if whereParam != "" {
 			whereParam += " and "
 		}
-		filterParam := fmt.Sprintf("filter%d", i)
-		whereParam += fmt.Sprintf("STRINGEQUALS(c.entity.%s, @%s, true)", filter.Field, filterParam)
-		queryParams = append(queryParams, cosmosapi.QueryParam{
-			Name:  "@" + filterParam,
-			Value: filter.Value,
-		})
+		filterParam := fmt.Sprintf("@filter%d", i)
+
+		// Comparisons only match strings and are case-insensitive, consistent with store.Object.MatchesFilters.
+		switch filter.Operator {
+		case "", store.FilterOperatorEquals:
+			whereParam += fmt.Sprintf("STRINGEQUALS(%s, %s, true)", field, filterParam)
+			queryParams = append(queryParams, cosmosapi.QueryParam{Name: filterParam, Value: filter.Value})
+		case store.FilterOperatorNotEquals:
+			whereParam += fmt.Sprintf("NOT (IS_STRING(%s) and STRINGEQUALS(%s, %s, true))", field, field, filterParam)
+			queryParams = append(queryParams, cosmosapi.QueryParam{Name: filterParam, Value: filter.Value})
+		case store.FilterOperatorIn:
+			values := []string{}
+			for _, value := range filter.Values {
+				values = append(values, strings.ToLower(value))
+			}
+			whereParam += fmt.Sprintf("(IS_STRING(%s) and ARRAY_CONTAINS(%s, LOWER(%s)))", field, filterParam, field)
+			queryParams = append(queryParams, cosmosapi.QueryParam{Name: filterParam, Value: values})
+		case store.FilterOperatorPrefix:
+			whereParam += fmt.Sprintf("STARTSWITH(%s, %s, true)", field, filterParam)
+			queryParams = append(queryParams, cosmosapi.QueryParam{Name: filterParam, Value: filter.Value})
+		case store.FilterOperatorExists:
+			whereParam += fmt.Sprintf("IS_DEFINED(%s)", field)
+		default:
+			return nil, &store.ErrInvalid{Message: fmt.Sprintf("invalid argument. 'query.Filters' operator %q is not supported", filter.Operator)}
+		}
 	}
 
 	if whereParam == "" {
 		return nil, &store.ErrInvalid{Message: "invalid Query parameters"}
 	}
 
+	// OrderBy isn't translated to ORDER BY since CosmosDB omits the documents where the field is undefined from the
+	// results. The results are sorted by Query instead.
+
 	return &cosmosapi.Query{Query: queryString + whereParam, Params: queryParams}, nil
 }
 
+// entityField returns the path of a field of the entity in a query. Field names are part of the query text
+// rather than parameters, so they are restricted to identifiers.
+func entityField(field string) (string, error) {
+	for _, segment := range strings.Split(field, ".") {
+		if !fieldSegmentRegex.MatchString(segment) {
+			return "", &store.ErrInvalid{Message: fmt.Sprintf("invalid argument. field %q must be a '.' separated path of identifiers", field)}
+		}
+	}
+
+	return "c.entity." + field, nil
+}
+
 // Query builds and executes a CosmosDB query based on the provided store.Query and returns the results.
 func (c *CosmosDBStorageClient) Query(ctx context.Context, query store.Query, opts ...store.QueryOptions) (*store.ObjectQueryResult, error) {
 	if ctx == nil {
@@ -241,8 +284,15 @@ func (c *CosmosDBStorageClient) Query(ctx context.Context, query store.Query, op
 	if query.IsScopeQuery && query.RoutingScopePrefix != "" {
 		return nil, &store.ErrInvalid{Message: "invalid argument. 'query.RoutingScopePrefix' is not supported for scope queries"}
 	}
+	if err := store.ValidateQuery(query); err != nil {
+		return nil, err
+	}
 
 	cfg := store.NewQueryConfig(opts...)
+	if len(query.OrderBy) > 0 && (cfg.MaxQueryItemCount > 0 || cfg.PaginationToken != "") {
+		// The results are sorted after all the pages are read, so they can't be paged.
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'query.OrderBy' is not supported with pagination"}
+	}
 
 	resourceID, err := resources.ParseScope(query.RootScope)
 	if err != nil {
@@ -254,8 +304,6 @@ func (c *CosmosDBStorageClient) Query(ctx context.Context, query store.Query, op
 		return nil, err
 	}
 
-	entities := []ResourceEntity{}
-
 	maxItemCount := c.options.DefaultQueryItemCount
 	if cfg.MaxQueryItemCount > 0 {
 		maxItemCount = cfg.MaxQueryItemCount
@@ -283,26 +331,43 @@ func (c *CosmosDBStorageClient) Query(ctx context.Context, query store.Query, op
 		qops.Continuation = cfg.PaginationToken
 	}
 
-	resp, err := c.client.QueryDocuments(ctx, c.options.DatabaseName, c.options.CollectionName, *qry, &entities, qops)
-	if err != nil {
-		return nil, err
-	}
-
 	output := []store.Object{}
-	for _, entity := range entities {
-		output = append(output, store.Object{
-			Metadata: store.Metadata{
-				ID:   entity.ResourceID,
-				ETag: entity.ETag,
-			},
-			Data: entity.Entity,
-		})
+	continuation := ""
+	for {
+		entities := []ResourceEntity{}
+		resp, err := c.client.QueryDocuments(ctx, c.options.DatabaseName, c.options.CollectionName, *qry, &entities, qops)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, entity := range entities {
+			output = append(output, store.Object{
+				Metadata: store.Metadata{
+					ID:   entity.ResourceID,
+					ETag: entity.ETag,
+				},
+				Data: entity.Entity,
+			})
+		}
+
+		// Sorted queries read all the pages.
+		continuation = resp.Continuation
+		if len(query.OrderBy) == 0 || continuation == "" {
+			break
+		}
+		qops.Continuation = continuation
 	}
 
-	return &store.ObjectQueryResult{
-		PaginationToken: resp.Continuation,
+	// Filters are evaluated by CosmosDB, the sorting and the projection are applied here.
+	result := &store.ObjectQueryResult{
+		PaginationToken: continuation,
 		Items:           output,
-	}, nil
+	}
+	if err := store.ApplyQuery(result, query); err != nil {
+		return nil, err
+	}
+
+	return result, nil
 }
 
 // Get retrieves an object using CosmosDBStorageClient using the provided ID and optional GetOptions. It returns an error
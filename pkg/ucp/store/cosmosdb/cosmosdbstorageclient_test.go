@@ -30,6 +30,7 @@ import (
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/radius-project/radius/pkg/ucp/store"
+	shared "github.com/radius-project/radius/test/ucp/storetest"
 	"github.com/stretchr/testify/require"
 	"github.com/vippsas/go-cosmosdb/cosmosapi"
 )
@@ -227,6 +228,40 @@ func TestConstructCosmosDBQuery(t *testing.T) {
 			}},
 			err: nil,
 		},
+		{
+			// OrderBy is applied to the results rather than translated to ORDER BY.
+			desc: "filter-operators-and-order-by",
+			storeQuery: store.Query{
+				RootScope: "/planes/radius/local/resourcegroups/testgroup",
+				Filters: []store.QueryFilter{
+					{Field: "name", Operator: store.FilterOperatorNotEquals, Value: "a"},
+					{Field: "properties.status", Operator: store.FilterOperatorIn, Values: []string{"B", "c"}},
+					{Field: "properties.application", Operator: store.FilterOperatorPrefix, Value: "/planes/"},
+					{Field: "properties.environment", Operator: store.FilterOperatorExists},
+				},
+				OrderBy: []store.QueryOrder{{Field: "name"}, {Field: "properties.status", Descending: true}},
+			},
+			queryString: "SELECT * FROM c WHERE c.rootScope = @rootScope" +
+				" and NOT (IS_STRING(c.entity.name) and STRINGEQUALS(c.entity.name, @filter0, true))" +
+				" and (IS_STRING(c.entity.properties.status) and ARRAY_CONTAINS(@filter1, LOWER(c.entity.properties.status)))" +
+				" and STARTSWITH(c.entity.properties.application, @filter2, true)" +
+				" and IS_DEFINED(c.entity.properties.environment)",
+			params: []cosmosapi.QueryParam{
+				{Name: "@rootScope", Value: "/planes/radius/local/resourcegroups/testgroup"},
+				{Name: "@filter0", Value: "a"},
+				{Name: "@filter1", Value: []string{"b", "c"}},
+				{Name: "@filter2", Value: "/planes/"},
+			},
+			err: nil,
+		},
+		{
+			desc: "invalid-field",
+			storeQuery: store.Query{
+				RootScope: "/planes/radius/local/resourcegroups/testgroup",
+				Filters:   []store.QueryFilter{{Field: "name) or (true", Value: "a"}},
+			},
+			err: &store.ErrInvalid{Message: "invalid argument. field \"name) or (true\" must be a '.' separated path of identifiers"},
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -241,6 +276,35 @@ func TestConstructCosmosDBQuery(t *testing.T) {
 	}
 }
 
+// TestConformance runs the shared tests that apply to CosmosDB. RunTest is not run because CosmosDB does not support
+// RoutingScopePrefix.
+func TestConformance(t *testing.T) {
+	ctx := context.Background()
+	client := mustGetTestClient(t)
+
+	clear := func(t *testing.T) {
+		token := ""
+		for {
+			result, err := client.Query(ctx, store.Query{RootScope: shared.RadiusScope, ScopeRecursive: true}, store.WithPaginationToken(token))
+			require.NoError(t, err)
+
+			for _, item := range result.Items {
+				err := client.Delete(ctx, item.ID)
+				require.NoError(t, err)
+			}
+
+			if result.PaginationToken == "" {
+				return
+			}
+			token = result.PaginationToken
+		}
+	}
+
+	shared.RunConcurrencyTest(t, client, clear)
+	shared.RunPaginationTest(t, client, clear)
+	shared.RunQueryTest(t, client, clear)
+}
+
 func TestGetNotFound(t *testing.T) {
 	ctx := context.Background()
 	client := mustGetTestClient(t)
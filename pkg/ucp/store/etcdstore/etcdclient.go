@@ -54,7 +54,9 @@ import (
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/radius-project/radius/pkg/ucp/store"
 	"github.com/radius-project/radius/pkg/ucp/store/storeutil"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
 	"github.com/radius-project/radius/pkg/ucp/util/etag"
+	"go.etcd.io/etcd/api/v3/mvccpb"
 	etcdclient "go.etcd.io/etcd/client/v3"
 )
 
@@ -68,6 +70,8 @@ func NewETCDClient(c *etcdclient.Client) *ETCDClient {
 }
 
 var _ store.StorageClient = (*ETCDClient)(nil)
+var _ store.WatchableStorageClient = (*ETCDClient)(nil)
+var _ store.BatchStorageClient = (*ETCDClient)(nil)
 
 type ETCDClient struct {
 	client *etcdclient.Client
@@ -84,6 +88,9 @@ func (c *ETCDClient) Query(ctx context.Context, query store.Query, options ...st
 	if query.IsScopeQuery && query.RoutingScopePrefix != "" {
 		return nil, &store.ErrInvalid{Message: "invalid argument. 'query.RoutingScopePrefix' is not supported for scope queries"}
 	}
+	if err := store.ValidateQuery(query); err != nil {
+		return nil, err
+	}
 
 	key := keyFromQuery(query)
 
@@ -117,6 +124,11 @@ func (c *ETCDClient) Query(ctx context.Context, query store.Query, options ...st
 		}
 	}
 
+	err = store.ApplyQuery(&results, query)
+	if err != nil {
+		return nil, err
+	}
+
 	return &results, nil
 }
 
@@ -279,6 +291,117 @@ func (c *ETCDClient) Save(ctx context.Context, obj *store.Object, options ...sto
 	return nil
 }
 
+// SaveBatch saves all of the objects in a single etcd transaction. The transaction compares the revision of every
+// item that has an ETag, so either all of the objects are saved or none of them are.
+func (c *ETCDClient) SaveBatch(ctx context.Context, items []store.BatchItem) error {
+	err := store.ValidateBatch(ctx, items)
+	if err != nil {
+		return err
+	}
+
+	cmps := []etcdclient.Cmp{}
+	ops := []etcdclient.Op{}
+	for _, item := range items {
+		parsed, err := resources.Parse(item.Object.ID)
+		if err != nil {
+			return err
+		}
+
+		b, err := json.Marshal(item.Object)
+		if err != nil {
+			return err
+		}
+
+		key := keyFromID(parsed)
+		if item.ETag != "" {
+			revision, err := etag.ParseRevision(item.ETag)
+			if err != nil {
+				// Treat an invalid ETag as a concurrency failure, since it will never match.
+				return &store.ErrConcurrency{}
+			}
+
+			cmps = append(cmps, etcdclient.Compare(etcdclient.ModRevision(key), "=", revision))
+		}
+
+		ops = append(ops, etcdclient.OpPut(key, string(b)))
+	}
+
+	txn, err := c.client.Txn(ctx).If(cmps...).Then(ops...).Commit()
+	if err != nil {
+		return err
+	}
+
+	if !txn.Succeeded {
+		return &store.ErrConcurrency{}
+	}
+
+	// All of the writes in a transaction share the same revision.
+	for _, item := range items {
+		item.Object.ETag = etag.NewFromRevision(txn.Header.Revision)
+	}
+
+	return nil
+}
+
+// Watch streams the changes to the objects that match the query using an etcd watch on the query key prefix. The
+// returned channel is closed when the context is cancelled or the watch fails.
+func (c *ETCDClient) Watch(ctx context.Context, query store.Query) (<-chan store.WatchEvent, error) {
+	if ctx == nil {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	if query.RootScope == "" {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'query.RootScope' is required"}
+	}
+	if query.IsScopeQuery && query.RoutingScopePrefix != "" {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'query.RoutingScopePrefix' is not supported for scope queries"}
+	}
+	if err := store.ValidateQuery(query); err != nil {
+		return nil, err
+	}
+
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	// WithPrevKV is needed so that we can report the last known state of deleted objects.
+	watch := c.client.Watch(ctx, keyFromQuery(query), etcdclient.WithPrefix(), etcdclient.WithPrevKV())
+
+	out := make(chan store.WatchEvent)
+	go func() {
+		defer close(out)
+
+		for response := range watch {
+			if err := response.Err(); err != nil {
+				logger.Error(err, "etcd watch failed", "rootScope", query.RootScope)
+				return
+			}
+
+			for _, ev := range response.Events {
+				if !keyMatchesQuery(ev.Kv.Key, query) {
+					continue
+				}
+
+				event, err := convertEvent(ev)
+				if err != nil {
+					logger.Error(err, "failed to read etcd watch event", "key", string(ev.Kv.Key))
+					continue
+				}
+
+				match, err := event.Object.MatchesFilters(query.Filters)
+				if err != nil || !match {
+					continue
+				}
+
+				select {
+				case out <- *event:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return out, nil
+}
+
 // Client returns the etcdclient.Client instance stored in the ETCDClient struct.
 func (c *ETCDClient) Client() *etcdclient.Client {
 	return c.client
@@ -332,6 +455,43 @@ func keyFromQuery(query store.Query) string {
 	}
 }
 
+func convertEvent(ev *etcdclient.Event) (*store.WatchEvent, error) {
+	if ev.Type == mvccpb.DELETE {
+		event := store.WatchEvent{Type: store.WatchEventDeleted}
+		if ev.PrevKv == nil {
+			// The previous value has been compacted, so the best we can do is report the id.
+			id, err := idFromKey(ev.Kv.Key)
+			if err != nil {
+				return nil, err
+			}
+
+			event.Object.ID = id.String()
+			return &event, nil
+		}
+
+		err := json.Unmarshal(ev.PrevKv.Value, &event.Object)
+		if err != nil {
+			return nil, err
+		}
+
+		event.Object.ETag = etag.NewFromRevision(ev.PrevKv.ModRevision)
+		return &event, nil
+	}
+
+	event := store.WatchEvent{Type: store.WatchEventUpdated}
+	if ev.IsCreate() {
+		event.Type = store.WatchEventCreated
+	}
+
+	err := json.Unmarshal(ev.Kv.Value, &event.Object)
+	if err != nil {
+		return nil, err
+	}
+
+	event.Object.ETag = etag.NewFromRevision(ev.Kv.ModRevision)
+	return &event, nil
+}
+
 func keyMatchesQuery(key []byte, query store.Query) bool {
 	// Ignore invalid keys, we don't expect to find them.
 	id, err := idFromKey(key)
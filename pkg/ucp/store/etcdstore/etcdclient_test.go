@@ -66,5 +66,5 @@ func Test_ETCDClient(t *testing.T) {
 	}
 
 	// The actual test logic lives in a shared package, we're just doing the setup here.
-	shared.RunTest(t, client, clear)
+	shared.RunConformanceTest(t, client, clear)
 }
@@ -17,10 +17,77 @@ limitations under the License.
 package store
 
 import (
-	"reflect"
+	"fmt"
+	"sort"
 	"strings"
 )
 
+// FilterOperator is the comparison performed by a QueryFilter.
+type FilterOperator string
+
+const (
+	// FilterOperatorEquals matches when the field is a string equal to the filter value. This is the default
+	// when no operator is specified.
+	FilterOperatorEquals FilterOperator = "eq"
+
+	// FilterOperatorNotEquals matches when the field is not a string equal to the filter value. This includes
+	// objects where the field does not exist.
+	FilterOperatorNotEquals FilterOperator = "ne"
+
+	// FilterOperatorIn matches when the field is a string equal to any of the filter values.
+	FilterOperatorIn FilterOperator = "in"
+
+	// FilterOperatorPrefix matches when the field is a string that starts with the filter value.
+	FilterOperatorPrefix FilterOperator = "prefix"
+
+	// FilterOperatorExists matches when the field exists, regardless of its value.
+	FilterOperatorExists FilterOperator = "exists"
+)
+
+// ValidateQuery validates the filters, ordering and projection of the query. It is intended for use by
+// implementations of StorageClient.
+func ValidateQuery(query Query) error {
+	for _, filter := range query.Filters {
+		if err := validateFieldPath(filter.Field, "query.Filters"); err != nil {
+			return err
+		}
+
+		switch filter.Operator {
+		case "", FilterOperatorEquals, FilterOperatorNotEquals, FilterOperatorPrefix, FilterOperatorExists:
+		case FilterOperatorIn:
+			if len(filter.Values) == 0 {
+				return &ErrInvalid{Message: fmt.Sprintf("invalid argument. 'query.Filters' operator %q requires at least one value", filter.Operator)}
+			}
+		default:
+			return &ErrInvalid{Message: fmt.Sprintf("invalid argument. 'query.Filters' operator %q is not supported", filter.Operator)}
+		}
+	}
+
+	for _, order := range query.OrderBy {
+		if err := validateFieldPath(order.Field, "query.OrderBy"); err != nil {
+			return err
+		}
+	}
+
+	for _, field := range query.Projection {
+		if err := validateFieldPath(field, "query.Projection"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateFieldPath(field string, name string) error {
+	for _, segment := range strings.Split(field, ".") {
+		if segment == "" {
+			return &ErrInvalid{Message: fmt.Sprintf("invalid argument. '%s' field %q must be a '.' separated path", name, field)}
+		}
+	}
+
+	return nil
+}
+
 // MatchesFilters checks if the object's data matches the given filters and returns a boolean and an error.
 func (o Object) MatchesFilters(filters []QueryFilter) (bool, error) {
 	if len(filters) == 0 {
@@ -28,48 +95,208 @@ func (o Object) MatchesFilters(filters []QueryFilter) (bool, error) {
 		return true, nil
 	}
 
-	data := o.Data
-	if data == nil {
-		// Treat nil as "empty" data
-		data = map[string]any{}
-	} else if reflect.TypeOf(o.Data).Kind() != reflect.Map ||
-		reflect.TypeOf(o.Data).Key().Kind() != reflect.String {
-		// It's most likely for our use case that the data is a map[string]interface{}. However, if it's not
-		// then we need to convert This is basically just here for safety and completeness.
-		data = map[string]any{}
-		err := o.As(&data)
+	data, err := o.dataMap()
+	if err != nil {
+		return false, err
+	}
+
+	for _, filter := range filters {
+		value, found := lookupField(data, filter.Field)
+		str, isString := value.(string)
+
+		var match bool
+		switch filter.Operator {
+		case "", FilterOperatorEquals:
+			match = isString && strings.EqualFold(str, filter.Value)
+		case FilterOperatorNotEquals:
+			match = !isString || !strings.EqualFold(str, filter.Value)
+		case FilterOperatorIn:
+			match = isString && contains(filter.Values, str)
+		case FilterOperatorPrefix:
+			match = isString && strings.HasPrefix(strings.ToLower(str), strings.ToLower(filter.Value))
+		case FilterOperatorExists:
+			match = found
+		default:
+			return false, &ErrInvalid{Message: fmt.Sprintf("invalid argument. 'query.Filters' operator %q is not supported", filter.Operator)}
+		}
+
+		if !match {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// SortObjects sorts the objects in place by the given fields. String values are compared byte-wise, and objects
+// where the field is missing or is not a string sort before strings. The sort is stable, so objects that compare
+// equal keep the order in which the store returned them.
+func SortObjects(objects []Object, orderBy []QueryOrder) error {
+	if len(orderBy) == 0 {
+		return nil
+	}
+
+	type sortKey struct {
+		value    string
+		isString bool
+	}
+
+	keys := make([][]sortKey, len(objects))
+	for i := range objects {
+		data, err := objects[i].dataMap()
 		if err != nil {
-			return false, err
+			return err
+		}
+
+		keys[i] = make([]sortKey, len(orderBy))
+		for j, order := range orderBy {
+			value, _ := lookupField(data, order.Field)
+			str, ok := value.(string)
+			keys[i][j] = sortKey{value: str, isString: ok}
 		}
 	}
 
-	for _, filter := range filters {
-		value := reflect.ValueOf(data)
-		fields := strings.Split(filter.Field, ".")
-		for i, field := range fields {
-			value = value.MapIndex(reflect.ValueOf(field))
-			if i < len(fields)-1 {
-				// Need to go further into the nested fields
-				value = reflect.ValueOf(value.Interface())
+	indexes := make([]int, len(objects))
+	for i := range indexes {
+		indexes[i] = i
+	}
+
+	sort.SliceStable(indexes, func(a, b int) bool {
+		for j, order := range orderBy {
+			left, right := keys[indexes[a]][j], keys[indexes[b]][j]
+
+			cmp := 0
+			if left.isString != right.isString {
+				cmp = 1
+				if right.isString {
+					cmp = -1
+				}
+			} else {
+				cmp = strings.Compare(left.value, right.value)
+			}
+
+			if order.Descending {
+				cmp = -cmp
+			}
+
+			if cmp != 0 {
+				return cmp < 0
 			}
 		}
-		comparator := reflect.ValueOf(filter.Value)
 
-		if value.Type().Kind() == reflect.Interface {
-			// Unwrap interface{}
-			value = reflect.ValueOf(value.Interface())
+		return false
+	})
+
+	sorted := make([]Object, len(objects))
+	for i, index := range indexes {
+		sorted[i] = objects[index]
+	}
+	copy(objects, sorted)
+
+	return nil
+}
+
+// Project returns a copy of the object where the data only contains the given fields. Fields that do not exist
+// are omitted. The object is returned unchanged if no fields are given.
+func (o Object) Project(fields []string) (*Object, error) {
+	if len(fields) == 0 {
+		return &o, nil
+	}
+
+	data, err := o.dataMap()
+	if err != nil {
+		return nil, err
+	}
+
+	projected := map[string]any{}
+	for _, field := range fields {
+		value, found := lookupField(data, field)
+		if !found {
+			continue
 		}
 
-		if value.Type().Kind() != reflect.String {
-			// not a string, can't compare!
-			return false, nil
+		segments := strings.Split(field, ".")
+		current := projected
+		for _, segment := range segments[:len(segments)-1] {
+			next, ok := current[segment].(map[string]any)
+			if !ok {
+				next = map[string]any{}
+				current[segment] = next
+			}
+			current = next
 		}
+		current[segments[len(segments)-1]] = value
+	}
 
-		if value.String() != comparator.String() {
-			// not the same value!
-			return false, nil
+	o.Data = projected
+	return &o, nil
+}
+
+// ApplyQuery sorts and projects the results of a query. It is intended for use by implementations of StorageClient
+// that evaluate queries in memory.
+func ApplyQuery(result *ObjectQueryResult, query Query) error {
+	err := SortObjects(result.Items, query.OrderBy)
+	if err != nil {
+		return err
+	}
+
+	for i := range result.Items {
+		projected, err := result.Items[i].Project(query.Projection)
+		if err != nil {
+			return err
 		}
+		result.Items[i] = *projected
 	}
 
-	return true, nil
+	return nil
+}
+
+// dataMap returns the data of the object as a map so that fields can be looked up by path.
+func (o Object) dataMap() (map[string]any, error) {
+	if o.Data == nil {
+		// Treat nil as "empty" data
+		return map[string]any{}, nil
+	}
+
+	if data, ok := o.Data.(map[string]any); ok {
+		return data, nil
+	}
+
+	// It's most likely for our use case that the data is a map[string]any. However, if it's not then we need
+	// to convert. This is basically just here for safety and completeness.
+	data := map[string]any{}
+	err := o.As(&data)
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// lookupField returns the value at the '.' separated path, and whether it was found.
+func lookupField(data map[string]any, field string) (any, bool) {
+	var current any = data
+	for _, segment := range strings.Split(field, ".") {
+		m, ok := current.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+
+		current, ok = m[segment]
+		if !ok {
+			return nil, false
+		}
+	}
+
+	return current, true
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+
+	return false
 }
@@ -113,6 +113,98 @@ func Test_MatchesFilters(t *testing.T) {
 			Filters:       []QueryFilter{{Field: "properties.value", Value: "warm"}},
 			ExpectedMatch: false,
 		},
+		{
+			Description:   "missing_field_not_match",
+			Obj:           &Object{Data: map[string]any{"properties": map[string]any{}}},
+			Filters:       []QueryFilter{{Field: "properties.value.nested", Value: "warm"}},
+			ExpectedMatch: false,
+		},
+
+		// Operators
+		{
+			Description:   "equals_operator_match",
+			Obj:           &Object{Data: map[string]any{"value": "cool"}},
+			Filters:       []QueryFilter{{Field: "value", Operator: FilterOperatorEquals, Value: "cool"}},
+			ExpectedMatch: true,
+		},
+		{
+			Description:   "equals_case_insensitive",
+			Obj:           &Object{Data: map[string]any{"value": "cool"}},
+			Filters:       []QueryFilter{{Field: "value", Value: "COOL"}},
+			ExpectedMatch: true,
+		},
+		{
+			Description:   "not_equals_case_insensitive",
+			Obj:           &Object{Data: map[string]any{"value": "cool"}},
+			Filters:       []QueryFilter{{Field: "value", Operator: FilterOperatorNotEquals, Value: "COOL"}},
+			ExpectedMatch: false,
+		},
+		{
+			Description:   "in_case_insensitive",
+			Obj:           &Object{Data: map[string]any{"value": "cool"}},
+			Filters:       []QueryFilter{{Field: "value", Operator: FilterOperatorIn, Values: []string{"Cool"}}},
+			ExpectedMatch: true,
+		},
+		{
+			Description:   "prefix_case_insensitive",
+			Obj:           &Object{Data: map[string]any{"value": "cool"}},
+			Filters:       []QueryFilter{{Field: "value", Operator: FilterOperatorPrefix, Value: "CO"}},
+			ExpectedMatch: true,
+		},
+		{
+			Description:   "not_equals_match",
+			Obj:           &Object{Data: map[string]any{"value": "cool"}},
+			Filters:       []QueryFilter{{Field: "value", Operator: FilterOperatorNotEquals, Value: "uncool"}},
+			ExpectedMatch: true,
+		},
+		{
+			Description:   "not_equals_not_match",
+			Obj:           &Object{Data: map[string]any{"value": "cool"}},
+			Filters:       []QueryFilter{{Field: "value", Operator: FilterOperatorNotEquals, Value: "cool"}},
+			ExpectedMatch: false,
+		},
+		{
+			Description:   "not_equals_missing_field_match",
+			Obj:           &Object{Data: map[string]any{}},
+			Filters:       []QueryFilter{{Field: "value", Operator: FilterOperatorNotEquals, Value: "cool"}},
+			ExpectedMatch: true,
+		},
+		{
+			Description:   "in_match",
+			Obj:           &Object{Data: map[string]any{"value": "cool"}},
+			Filters:       []QueryFilter{{Field: "value", Operator: FilterOperatorIn, Values: []string{"warm", "cool"}}},
+			ExpectedMatch: true,
+		},
+		{
+			Description:   "in_not_match",
+			Obj:           &Object{Data: map[string]any{"value": "cool"}},
+			Filters:       []QueryFilter{{Field: "value", Operator: FilterOperatorIn, Values: []string{"warm", "hot"}}},
+			ExpectedMatch: false,
+		},
+		{
+			Description:   "prefix_match",
+			Obj:           &Object{Data: map[string]any{"value": "/planes/radius/local"}},
+			Filters:       []QueryFilter{{Field: "value", Operator: FilterOperatorPrefix, Value: "/planes/"}},
+			ExpectedMatch: true,
+		},
+		{
+			Description:   "prefix_not_match",
+			Obj:           &Object{Data: map[string]any{"value": "/subscriptions/123"}},
+			Filters:       []QueryFilter{{Field: "value", Operator: FilterOperatorPrefix, Value: "/planes/"}},
+			ExpectedMatch: false,
+		},
+		{
+			Description:   "exists_match_any_type",
+			Obj:           &Object{Data: map[string]any{"properties": map[string]any{"value": 3}}},
+			Filters:       []QueryFilter{{Field: "properties.value", Operator: FilterOperatorExists}},
+			ExpectedMatch: true,
+		},
+		{
+			Description:   "exists_not_match",
+			Obj:           &Object{Data: map[string]any{"properties": map[string]any{}}},
+			Filters:       []QueryFilter{{Field: "properties.value", Operator: FilterOperatorExists}},
+			ExpectedMatch: false,
+		},
 	}
 
 	for _, testcase := range cases {
@@ -123,3 +215,78 @@ func Test_MatchesFilters(t *testing.T) {
 		})
 	}
 }
+
+func Test_ValidateQuery(t *testing.T) {
+	valid := Query{
+		RootScope:  "/planes/radius/local",
+		Filters:    []QueryFilter{{Field: "properties.value", Operator: FilterOperatorIn, Values: []string{"a"}}},
+		OrderBy:    []QueryOrder{{Field: "name"}},
+		Projection: []string{"name", "properties.value"},
+	}
+	require.NoError(t, ValidateQuery(valid))
+
+	invalid := []Query{
+		{Filters: []QueryFilter{{Field: "value", Operator: "gt", Value: "a"}}},
+		{Filters: []QueryFilter{{Field: "value", Operator: FilterOperatorIn}}},
+		{Filters: []QueryFilter{{Field: "", Value: "a"}}},
+		{OrderBy: []QueryOrder{{Field: "properties..value"}}},
+		{Projection: []string{"name."}},
+	}
+	for _, query := range invalid {
+		require.ErrorIs(t, ValidateQuery(query), &ErrInvalid{})
+	}
+}
+
+func Test_SortObjects(t *testing.T) {
+	objects := []Object{
+		{Metadata: Metadata{ID: "1"}, Data: map[string]any{"name": "b", "group": "x"}},
+		{Metadata: Metadata{ID: "2"}, Data: map[string]any{"name": "a", "group": "y"}},
+		{Metadata: Metadata{ID: "3"}, Data: map[string]any{"group": "x"}},
+		{Metadata: Metadata{ID: "4"}, Data: map[string]any{"name": "c", "group": "x"}},
+	}
+
+	ids := func() []string {
+		result := []string{}
+		for _, obj := range objects {
+			result = append(result, obj.ID)
+		}
+		return result
+	}
+
+	err := SortObjects(objects, []QueryOrder{{Field: "name"}})
+	require.NoError(t, err)
+	require.Equal(t, []string{"3", "2", "1", "4"}, ids())
+
+	err = SortObjects(objects, []QueryOrder{{Field: "name", Descending: true}})
+	require.NoError(t, err)
+	require.Equal(t, []string{"4", "1", "2", "3"}, ids())
+
+	err = SortObjects(objects, []QueryOrder{{Field: "group"}, {Field: "name", Descending: true}})
+	require.NoError(t, err)
+	require.Equal(t, []string{"4", "1", "3", "2"}, ids())
+}
+
+func Test_Project(t *testing.T) {
+	obj := Object{
+		Metadata: Metadata{ID: "1", ETag: "etag"},
+		Data: map[string]any{
+			"name": "cool",
+			"properties": map[string]any{
+				"application": "app",
+				"environment": "env",
+			},
+		},
+	}
+
+	projected, err := obj.Project([]string{"name", "properties.application", "properties.missing"})
+	require.NoError(t, err)
+	require.Equal(t, obj.Metadata, projected.Metadata)
+	require.Equal(t, map[string]any{"name": "cool", "properties": map[string]any{"application": "app"}}, projected.Data)
+
+	// The original object is not modified.
+	require.Equal(t, "env", obj.Data.(map[string]any)["properties"].(map[string]any)["environment"])
+
+	unchanged, err := obj.Project(nil)
+	require.NoError(t, err)
+	require.Equal(t, obj, *unchanged)
+}
@@ -0,0 +1,494 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package postgresstore stores resources using PostgreSQL. The storage scheme mirrors the one used by etcdstore:
+// each resource id is split into (prefix, rootScope, routingScope, resourceType) using storeutil.ExtractStorageParts
+// and each part is stored in its own column. This allows every part of a store.Query other than the field filters
+// to be evaluated by the database with simple equality and prefix comparisons.
+//
+// The table looks like the following:
+//
+//	CREATE TABLE resources (
+//		key           TEXT PRIMARY KEY,   -- resource|/planes/radius/local/resourcegroups/cool-group/|/applications.core/applications/cool-app/
+//		prefix        TEXT NOT NULL,      -- resource or scope
+//		root_scope    TEXT NOT NULL,      -- /planes/radius/local/resourcegroups/cool-group/
+//		routing_scope TEXT NOT NULL,      -- /applications.core/applications/cool-app/
+//		resource_type TEXT NOT NULL,      -- applications.core/applications
+//		resource_id   TEXT NOT NULL,      -- the original (non-normalized) resource id
+//		api_version   TEXT NOT NULL,
+//		content_type  TEXT NOT NULL,
+//		revision      BIGINT NOT NULL,    -- used to compute the ETag
+//		data          JSONB
+//	)
+//
+// ETags are computed from a monotonically increasing revision number assigned from a sequence on every write, in the
+// same way that etcdstore computes ETags from the etcd ModRevision.
+package postgresstore
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/lib/pq"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/store/storeutil"
+	"github.com/radius-project/radius/pkg/ucp/util/etag"
+)
+
+const (
+	// DriverName is the name of the database/sql driver used by the client.
+	DriverName = "postgres"
+
+	// SectionSeparator is the separator used to build the primary key of a resource.
+	SectionSeparator = "|"
+
+	schema = `
+CREATE SEQUENCE IF NOT EXISTS resources_revision;
+CREATE TABLE IF NOT EXISTS resources (
+	key           TEXT PRIMARY KEY,
+	prefix        TEXT NOT NULL,
+	root_scope    TEXT NOT NULL,
+	routing_scope TEXT NOT NULL,
+	resource_type TEXT NOT NULL,
+	resource_id   TEXT NOT NULL,
+	api_version   TEXT NOT NULL,
+	content_type  TEXT NOT NULL,
+	revision      BIGINT NOT NULL,
+	data          JSONB
+);
+CREATE INDEX IF NOT EXISTS resources_root_scope ON resources (prefix, root_scope text_pattern_ops);
+`
+
+	selectColumns = "key, resource_id, api_version, content_type, revision, data"
+)
+
+// NewPostgreSQLClient creates a new PostgreSQLClient instance with the given database handle. The database handle
+// must use the 'postgres' driver.
+func NewPostgreSQLClient(db *sql.DB) *PostgreSQLClient {
+	return &PostgreSQLClient{db: db}
+}
+
+var _ store.StorageClient = (*PostgreSQLClient)(nil)
+var _ store.BatchStorageClient = (*PostgreSQLClient)(nil)
+
+// PostgreSQLClient implements store.StorageClient using PostgreSQL.
+type PostgreSQLClient struct {
+	db *sql.DB
+}
+
+// Init creates the tables and indexes used by the client if they do not already exist.
+func (c *PostgreSQLClient) Init(ctx context.Context) error {
+	_, err := c.db.ExecContext(ctx, schema)
+	return err
+}
+
+// Query retrieves objects from the store that match the given query and filters, and returns them in a store.ObjectQueryResult.
+// If store.WithMaxQueryItemCount is specified the results are paginated and the returned PaginationToken can be passed
+// to store.WithPaginationToken to retrieve the next page.
+func (c *PostgreSQLClient) Query(ctx context.Context, query store.Query, options ...store.QueryOptions) (*store.ObjectQueryResult, error) {
+	if ctx == nil {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	if query.RootScope == "" {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'query.RootScope' is required"}
+	}
+	if query.IsScopeQuery && query.RoutingScopePrefix != "" {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'query.RoutingScopePrefix' is not supported for scope queries"}
+	}
+	if err := store.ValidateQuery(query); err != nil {
+		return nil, err
+	}
+
+	config := store.NewQueryConfig(options...)
+	statement, args, err := buildQuery(query, config)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := c.db.QueryContext(ctx, statement, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	results := store.ObjectQueryResult{}
+	lastKey := ""
+	for rows.Next() {
+		key, obj, err := scanObject(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		lastKey = key
+		results.Items = append(results.Items, *obj)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// Filters and OrderBy are evaluated by the database, only the projection is applied here.
+	for i := range results.Items {
+		projected, err := results.Items[i].Project(query.Projection)
+		if err != nil {
+			return nil, err
+		}
+		results.Items[i] = *projected
+	}
+
+	if config.MaxQueryItemCount > 0 && len(results.Items) == config.MaxQueryItemCount {
+		results.PaginationToken = base64.RawURLEncoding.EncodeToString([]byte(lastKey))
+	}
+
+	return &results, nil
+}
+
+// Get checks if the provided context, id and options are valid, then retrieves the corresponding object from
+// the store and returns it, or an error if the object is not found or an error occurs.
+func (c *PostgreSQLClient) Get(ctx context.Context, id string, options ...store.GetOptions) (*store.Object, error) {
+	if ctx == nil {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	parsed, err := parseID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	row := c.db.QueryRowContext(ctx, "SELECT "+selectColumns+" FROM resources WHERE key = $1", keyFromID(parsed))
+	_, obj, err := scanObject(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, &store.ErrNotFound{ID: id}
+	} else if err != nil {
+		return nil, err
+	}
+
+	return obj, nil
+}
+
+// Delete checks if the given resource ID is valid, and if so, deletes it from the store, returning an error if the
+// resource does not exist or if an ETag is provided and does not match.
+func (c *PostgreSQLClient) Delete(ctx context.Context, id string, options ...store.DeleteOptions) error {
+	if ctx == nil {
+		return &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	parsed, err := parseID(id)
+	if err != nil {
+		return err
+	}
+
+	key := keyFromID(parsed)
+	config := store.NewDeleteConfig(options...)
+
+	// If we have an ETag then the delete must also match the revision.
+	if config.ETag != "" {
+		revision, err := etag.ParseRevision(config.ETag)
+		if err != nil {
+			// Treat an invalid ETag as a concurrency failure, since it will never match.
+			return &store.ErrConcurrency{}
+		}
+
+		result, err := c.db.ExecContext(ctx, "DELETE FROM resources WHERE key = $1 AND revision = $2", key, revision)
+		if err != nil {
+			return err
+		}
+
+		if affected, err := result.RowsAffected(); err != nil {
+			return err
+		} else if affected == 0 {
+			return &store.ErrConcurrency{}
+		}
+
+		return nil
+	}
+
+	result, err := c.db.ExecContext(ctx, "DELETE FROM resources WHERE key = $1", key)
+	if err != nil {
+		return err
+	}
+
+	if affected, err := result.RowsAffected(); err != nil {
+		return err
+	} else if affected == 0 {
+		return &store.ErrNotFound{ID: id}
+	}
+
+	return nil
+}
+
+// Save checks the context and object parameters, parses the object ID, marshals the object data into JSON, saves the
+// object to the store, and sets the object's ETag. If an ETag is provided the object is only updated when the stored
+// revision matches.
+func (c *PostgreSQLClient) Save(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+	if ctx == nil {
+		return &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	if obj == nil {
+		return &store.ErrInvalid{Message: "invalid argument. 'obj' is required"}
+	}
+
+	config := store.NewSaveConfig(options...)
+	revision, err := save(ctx, c.db, obj, config.ETag)
+	if err != nil {
+		return err
+	}
+
+	obj.ETag = etag.NewFromRevision(revision)
+	return nil
+}
+
+// SaveBatch saves all of the objects in a single SQL transaction, so either all of the objects are saved or none
+// of them are.
+func (c *PostgreSQLClient) SaveBatch(ctx context.Context, items []store.BatchItem) error {
+	err := store.ValidateBatch(ctx, items)
+	if err != nil {
+		return err
+	}
+
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		// Rollback is a no-op after a successful commit.
+		_ = tx.Rollback()
+	}()
+
+	revisions := make([]int64, len(items))
+	for i, item := range items {
+		revisions[i], err = save(ctx, tx, item.Object, item.ETag)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	for i, item := range items {
+		item.Object.ETag = etag.NewFromRevision(revisions[i])
+	}
+
+	return nil
+}
+
+// DB returns the database handle used by the PostgreSQLClient.
+func (c *PostgreSQLClient) DB() *sql.DB {
+	return c.db
+}
+
+// queryRower is implemented by both *sql.DB and *sql.Tx.
+type queryRower interface {
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// save saves the object using q and returns the new revision. The caller is responsible for updating the ETag of
+// the object.
+func save(ctx context.Context, q queryRower, obj *store.Object, expectedETag store.ETag) (int64, error) {
+	parsed, err := resources.Parse(obj.ID)
+	if err != nil {
+		return 0, err
+	}
+
+	b, err := json.Marshal(obj.Data)
+	if err != nil {
+		return 0, err
+	}
+
+	prefix, rootScope, routingScope, resourceType := storeutil.ExtractStorageParts(parsed)
+	key := keyFromID(parsed)
+
+	var revision int64
+	if expectedETag != "" {
+		expected, err := etag.ParseRevision(expectedETag)
+		if err != nil {
+			// Treat an invalid ETag as a concurrency failure, since it will never match.
+			return 0, &store.ErrConcurrency{}
+		}
+
+		row := q.QueryRowContext(ctx, `
+UPDATE resources
+SET resource_id = $2, api_version = $3, content_type = $4, data = $5, revision = nextval('resources_revision')
+WHERE key = $1 AND revision = $6
+RETURNING revision`,
+			key, obj.ID, obj.APIVersion, obj.ContentType, string(b), expected)
+		err = row.Scan(&revision)
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, &store.ErrConcurrency{}
+		} else if err != nil {
+			return 0, err
+		}
+
+		return revision, nil
+	}
+
+	row := q.QueryRowContext(ctx, `
+INSERT INTO resources (key, prefix, root_scope, routing_scope, resource_type, resource_id, api_version, content_type, revision, data)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, nextval('resources_revision'), $9)
+ON CONFLICT (key) DO UPDATE
+SET resource_id = EXCLUDED.resource_id, api_version = EXCLUDED.api_version, content_type = EXCLUDED.content_type,
+	data = EXCLUDED.data, revision = EXCLUDED.revision
+RETURNING revision`,
+		key, prefix, rootScope, routingScope, resourceType, obj.ID, obj.APIVersion, obj.ContentType, string(b))
+	if err := row.Scan(&revision); err != nil {
+		return 0, err
+	}
+
+	return revision, nil
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanObject(row scanner) (string, *store.Object, error) {
+	var key string
+	var revision int64
+	var data []byte
+	obj := store.Object{}
+	err := row.Scan(&key, &obj.ID, &obj.APIVersion, &obj.ContentType, &revision, &data)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &obj.Data); err != nil {
+			return "", nil, err
+		}
+	}
+
+	obj.ETag = etag.NewFromRevision(revision)
+	return key, &obj, nil
+}
+
+func parseID(id string) (resources.ID, error) {
+	parsed, err := resources.Parse(id)
+	if err != nil {
+		return resources.ID{}, &store.ErrInvalid{Message: "invalid argument. 'id' must be a valid resource id"}
+	}
+	if parsed.IsEmpty() {
+		return resources.ID{}, &store.ErrInvalid{Message: "invalid argument. 'id' must not be empty"}
+	}
+	if parsed.IsResourceCollection() || parsed.IsScopeCollection() {
+		return resources.ID{}, &store.ErrInvalid{Message: "invalid argument. 'id' must refer to a named resource, not a collection"}
+	}
+
+	return parsed, nil
+}
+
+// keyFromID returns the primary key to use for an ID.
+func keyFromID(id resources.ID) string {
+	prefix, rootScope, routingScope, _ := storeutil.ExtractStorageParts(id)
+	return prefix + SectionSeparator + rootScope + SectionSeparator + routingScope
+}
+
+// buildQuery translates a store.Query into a SQL statement and its arguments.
+func buildQuery(query store.Query, config store.StoreConfig) (string, []any, error) {
+	prefix := storeutil.ResourcePrefix
+	if query.IsScopeQuery {
+		prefix = storeutil.ScopePrefix
+	}
+
+	args := []any{prefix, storeutil.NormalizePart(query.RootScope)}
+	conditions := []string{"prefix = $1"}
+	if query.ScopeRecursive {
+		conditions = append(conditions, "starts_with(root_scope, $2)")
+	} else {
+		conditions = append(conditions, "root_scope = $2")
+	}
+
+	if query.RoutingScopePrefix != "" {
+		args = append(args, storeutil.NormalizePart(query.RoutingScopePrefix))
+		conditions = append(conditions, fmt.Sprintf("starts_with(routing_scope, $%d)", len(args)))
+	}
+
+	if query.ResourceType != "" {
+		args = append(args, strings.ToLower(query.ResourceType))
+		conditions = append(conditions, fmt.Sprintf("resource_type = $%d", len(args)))
+	}
+
+	// Filters only match string values and are case-insensitive, consistent with store.Object.MatchesFilters.
+	for _, filter := range query.Filters {
+		args = append(args, pq.Array(strings.Split(filter.Field, ".")))
+		path := len(args)
+		isString := fmt.Sprintf("jsonb_typeof(data #> $%d) = 'string'", path)
+
+		switch filter.Operator {
+		case "", store.FilterOperatorEquals:
+			args = append(args, filter.Value)
+			conditions = append(conditions, fmt.Sprintf("%s AND lower(data #>> $%d) = lower($%d)", isString, path, len(args)))
+		case store.FilterOperatorNotEquals:
+			args = append(args, filter.Value)
+			conditions = append(conditions, fmt.Sprintf("NOT COALESCE(%s AND lower(data #>> $%d) = lower($%d), false)", isString, path, len(args)))
+		case store.FilterOperatorIn:
+			values := []string{}
+			for _, value := range filter.Values {
+				values = append(values, strings.ToLower(value))
+			}
+			args = append(args, pq.Array(values))
+			conditions = append(conditions, fmt.Sprintf("%s AND lower(data #>> $%d) = ANY($%d)", isString, path, len(args)))
+		case store.FilterOperatorPrefix:
+			args = append(args, filter.Value)
+			conditions = append(conditions, fmt.Sprintf("%s AND starts_with(lower(data #>> $%d), lower($%d))", isString, path, len(args)))
+		case store.FilterOperatorExists:
+			conditions = append(conditions, fmt.Sprintf("data #> $%d IS NOT NULL", path))
+		default:
+			return "", nil, &store.ErrInvalid{Message: fmt.Sprintf("invalid argument. 'query.Filters' operator %q is not supported", filter.Operator)}
+		}
+	}
+
+	// Sorting uses the "C" collation to compare strings byte-wise, and non-string values sort as NULL so that they
+	// come before strings, consistent with store.SortObjects.
+	orderBy := []string{}
+	for _, order := range query.OrderBy {
+		args = append(args, pq.Array(strings.Split(order.Field, ".")))
+		direction := "ASC NULLS FIRST"
+		if order.Descending {
+			direction = "DESC NULLS LAST"
+		}
+		orderBy = append(orderBy, fmt.Sprintf(`(CASE WHEN jsonb_typeof(data #> $%d) = 'string' THEN data #>> $%d END) COLLATE "C" %s`, len(args), len(args), direction))
+	}
+	orderBy = append(orderBy, "key")
+
+	if len(query.OrderBy) > 0 && config.MaxQueryItemCount > 0 {
+		// Pages are based on the key order, so they can't be combined with sorting.
+		return "", nil, &store.ErrInvalid{Message: "invalid argument. 'query.OrderBy' is not supported with pagination"}
+	}
+
+	if config.PaginationToken != "" {
+		lastKey, err := base64.RawURLEncoding.DecodeString(config.PaginationToken)
+		if err != nil {
+			return "", nil, &store.ErrInvalid{Message: "invalid argument. 'PaginationToken' is invalid"}
+		}
+
+		args = append(args, string(lastKey))
+		conditions = append(conditions, fmt.Sprintf("key > $%d", len(args)))
+	}
+
+	statement := "SELECT " + selectColumns + " FROM resources WHERE " + strings.Join(conditions, " AND ") + " ORDER BY " + strings.Join(orderBy, ", ")
+	if config.MaxQueryItemCount > 0 {
+		statement += fmt.Sprintf(" LIMIT %d", config.MaxQueryItemCount)
+	}
+
+	return statement, args, nil
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrWatchNotSupported is returned by Watch when the storage client does not support watching for changes.
+var ErrWatchNotSupported = errors.New("the storage client does not support watch")
+
+// WatchEventType represents the kind of change described by a WatchEvent.
+type WatchEventType string
+
+const (
+	// WatchEventCreated indicates that the object was created.
+	WatchEventCreated WatchEventType = "Created"
+
+	// WatchEventUpdated indicates that the object was updated.
+	WatchEventUpdated WatchEventType = "Updated"
+
+	// WatchEventDeleted indicates that the object was deleted.
+	WatchEventDeleted WatchEventType = "Deleted"
+)
+
+// WatchEvent represents a change to an object in the store.
+type WatchEvent struct {
+	// Type is the kind of change.
+	Type WatchEventType
+
+	// Object is the state of the object after the change, including its new ETag. For a deleted object
+	// this is the last known state of the object.
+	Object Object
+}
+
+// WatchableStorageClient is an optional capability of a StorageClient that streams changes instead of
+// requiring callers to poll with Query.
+type WatchableStorageClient interface {
+	StorageClient
+
+	// Watch streams the changes to the objects that match the query. Only changes made after Watch is called
+	// are reported. Query filters are evaluated against the state of the object after the change, or its last
+	// known state for a delete.
+	//
+	// The returned channel is closed when the context is cancelled or when the underlying watch fails. Callers
+	// that need a complete view should Query again and restart the watch when the channel is closed.
+	Watch(ctx context.Context, query Query) (<-chan WatchEvent, error)
+}
+
+// Watch starts watching the objects that match the query if the client supports watch, or returns
+// ErrWatchNotSupported otherwise.
+func Watch(ctx context.Context, client StorageClient, query Query) (<-chan WatchEvent, error) {
+	watchable, ok := client.(WatchableStorageClient)
+	if !ok {
+		return nil, ErrWatchNotSupported
+	}
+
+	return watchable.Watch(ctx, query)
+}
@@ -400,7 +400,7 @@ func getSecretSuffix(resourceID, envName, appName string) (string, error) {
 	}
 
 	backend := backends.NewKubernetesBackend(nil)
-	secretMap, err := backend.BuildBackend(&resourceRecipe)
+	secretMap, err := backend.BuildBackend(context.Background(), &resourceRecipe)
 	if err != nil {
 		return "", err
 	}
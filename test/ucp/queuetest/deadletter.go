@@ -0,0 +1,189 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package queuetest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/ucp/queue/client"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+const testDeadLetterReason = "poison message"
+
+// dequeueOrFail dequeues the next message and fails the test if the queue is empty.
+func dequeueOrFail(t *testing.T, cli client.Client) *client.Message {
+	msg, err := cli.Dequeue(testcontext.New(t), client.QueueClientConfig{})
+	require.NoError(t, err)
+	require.NotNil(t, msg)
+	return msg
+}
+
+// RunDeadLetterTest tests the client's DeadLetter, ListDeadLetters, GetDeadLetter, ReplayDeadLetter and
+// PurgeDeadLetter methods. The clear function must delete both queued and dead-lettered messages.
+func RunDeadLetterTest(t *testing.T, cli client.DeadLetterClient, clear func(t *testing.T)) {
+	ctx, cancel := testcontext.NewWithCancel(t)
+	t.Cleanup(cancel)
+
+	t.Run("nil message", func(t *testing.T) {
+		err := cli.DeadLetter(ctx, nil, testDeadLetterReason)
+		require.ErrorIs(t, err, client.ErrEmptyMessage)
+	})
+
+	t.Run("dead-lettered message is not dequeued", func(t *testing.T) {
+		clear(t)
+
+		err := queueTestMessage(cli, 1)
+		require.NoError(t, err)
+
+		msg := dequeueOrFail(t, cli)
+		err = cli.DeadLetter(ctx, msg, testDeadLetterReason)
+		require.NoError(t, err)
+
+		// The message must not be requeued after the lock is expired.
+		time.Sleep(TestMessageLockTime + pollingInterval)
+		_, err = cli.Dequeue(ctx, client.QueueClientConfig{})
+		require.ErrorIs(t, err, client.ErrMessageNotFound)
+
+		msgs, err := cli.ListDeadLetters(ctx)
+		require.NoError(t, err)
+		require.Len(t, msgs, 1)
+		require.Equal(t, msg.ID, msgs[0].ID)
+		require.Equal(t, msg.Data, msgs[0].Data)
+		require.Equal(t, 1, msgs[0].DequeueCount)
+		require.Equal(t, testDeadLetterReason, msgs[0].DeadLetterReason)
+		require.False(t, msgs[0].DeadLetteredAt.IsZero())
+
+		deadLetter, err := cli.GetDeadLetter(ctx, msg.ID)
+		require.NoError(t, err)
+		require.Equal(t, msgs[0].ID, deadLetter.ID)
+		require.Equal(t, msgs[0].Data, deadLetter.Data)
+		require.Equal(t, testDeadLetterReason, deadLetter.DeadLetterReason)
+
+		// The message can be dead-lettered only once.
+		err = cli.DeadLetter(ctx, msg, testDeadLetterReason)
+		require.ErrorIs(t, err, client.ErrInvalidMessage)
+	})
+
+	t.Run("dead letter message leased by the other client", func(t *testing.T) {
+		clear(t)
+
+		err := queueTestMessage(cli, 1)
+		require.NoError(t, err)
+
+		msg1 := dequeueOrFail(t, cli)
+
+		// Dequeue until message is requeued and leased again.
+		var msg2 *client.Message
+		for {
+			msg2, err = cli.Dequeue(ctx, client.QueueClientConfig{})
+			if err == nil {
+				break
+			}
+			time.Sleep(pollingInterval)
+		}
+		require.Equal(t, msg1.ID, msg2.ID)
+
+		err = cli.DeadLetter(ctx, msg1, testDeadLetterReason)
+		require.ErrorIs(t, err, client.ErrDequeuedMessage)
+
+		msgs, err := cli.ListDeadLetters(ctx)
+		require.NoError(t, err)
+		require.Empty(t, msgs)
+
+		err = cli.FinishMessage(ctx, msg2)
+		require.NoError(t, err)
+	})
+
+	t.Run("replay dead-lettered message", func(t *testing.T) {
+		clear(t)
+
+		err := queueTestMessage(cli, 1)
+		require.NoError(t, err)
+
+		msg := dequeueOrFail(t, cli)
+		err = cli.DeadLetter(ctx, msg, testDeadLetterReason)
+		require.NoError(t, err)
+
+		err = cli.ReplayDeadLetter(ctx, msg.ID)
+		require.NoError(t, err)
+
+		msgs, err := cli.ListDeadLetters(ctx)
+		require.NoError(t, err)
+		require.Empty(t, msgs)
+
+		_, err = cli.GetDeadLetter(ctx, msg.ID)
+		require.ErrorIs(t, err, client.ErrDeadLetterNotFound)
+
+		// The replayed message is dequeued again as if it was never dequeued.
+		replayed := dequeueOrFail(t, cli)
+		require.Equal(t, msg.ID, replayed.ID)
+		require.Equal(t, msg.Data, replayed.Data)
+		require.Equal(t, 1, replayed.DequeueCount)
+		require.Empty(t, replayed.DeadLetterReason)
+
+		err = cli.FinishMessage(ctx, replayed)
+		require.NoError(t, err)
+	})
+
+	t.Run("purge dead-lettered message", func(t *testing.T) {
+		clear(t)
+
+		err := queueTestMessage(cli, 2)
+		require.NoError(t, err)
+
+		msg1 := dequeueOrFail(t, cli)
+		err = cli.DeadLetter(ctx, msg1, testDeadLetterReason)
+		require.NoError(t, err)
+
+		msg2 := dequeueOrFail(t, cli)
+		err = cli.DeadLetter(ctx, msg2, testDeadLetterReason)
+		require.NoError(t, err)
+
+		err = cli.PurgeDeadLetter(ctx, msg1.ID)
+		require.NoError(t, err)
+
+		msgs, err := cli.ListDeadLetters(ctx)
+		require.NoError(t, err)
+		require.Len(t, msgs, 1)
+		require.Equal(t, msg2.ID, msgs[0].ID)
+
+		_, err = cli.GetDeadLetter(ctx, msg1.ID)
+		require.ErrorIs(t, err, client.ErrDeadLetterNotFound)
+		err = cli.PurgeDeadLetter(ctx, msg1.ID)
+		require.ErrorIs(t, err, client.ErrDeadLetterNotFound)
+		err = cli.ReplayDeadLetter(ctx, msg1.ID)
+		require.ErrorIs(t, err, client.ErrDeadLetterNotFound)
+	})
+
+	t.Run("dead-lettered message is not found", func(t *testing.T) {
+		clear(t)
+
+		msgs, err := cli.ListDeadLetters(ctx)
+		require.NoError(t, err)
+		require.Empty(t, msgs)
+
+		_, err = cli.GetDeadLetter(ctx, "unknown")
+		require.ErrorIs(t, err, client.ErrDeadLetterNotFound)
+		err = cli.ReplayDeadLetter(ctx, "unknown")
+		require.ErrorIs(t, err, client.ErrDeadLetterNotFound)
+		err = cli.PurgeDeadLetter(ctx, "unknown")
+		require.ErrorIs(t, err, client.ErrDeadLetterNotFound)
+	})
+}
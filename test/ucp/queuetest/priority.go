@@ -0,0 +1,95 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package queuetest
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/ucp/queue/client"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+// dequeueTestMessage dequeues the next message with the config and returns its test message id.
+func dequeueTestMessage(t *testing.T, cli client.Client, cfg client.QueueClientConfig) (*client.Message, string) {
+	msg, err := cli.Dequeue(testcontext.New(t), cfg)
+	require.NoError(t, err)
+
+	tm := &testQueueMessage{}
+	err = json.Unmarshal(msg.Data, tm)
+	require.NoError(t, err)
+
+	err = cli.FinishMessage(testcontext.New(t), msg)
+	require.NoError(t, err)
+
+	return msg, tm.ID
+}
+
+// RunPriorityTest tests that the client's Dequeue method returns the message with the highest priority first and skips
+// the messages of the excluded partitions.
+func RunPriorityTest(t *testing.T, cli client.Client, clear func(t *testing.T)) {
+	ctx, cancel := testcontext.NewWithCancel(t)
+	t.Cleanup(cancel)
+
+	t.Run("dequeue message with higher priority first", func(t *testing.T) {
+		clear(t)
+
+		for _, p := range []client.Priority{client.PriorityLow, client.PriorityNormal, client.PriorityHigh} {
+			err := cli.Enqueue(ctx, client.NewMessage(&testQueueMessage{ID: p.String()}), client.WithPriority(p))
+			require.NoError(t, err)
+		}
+
+		for _, p := range []client.Priority{client.PriorityHigh, client.PriorityNormal, client.PriorityLow} {
+			msg, id := dequeueTestMessage(t, cli, client.QueueClientConfig{})
+			require.Equal(t, p.String(), id)
+			require.Equal(t, p, msg.Priority)
+		}
+
+		_, err := cli.Dequeue(ctx, client.QueueClientConfig{})
+		require.ErrorIs(t, err, client.ErrMessageNotFound)
+	})
+
+	t.Run("skip messages of excluded partitions", func(t *testing.T) {
+		clear(t)
+
+		err := cli.Enqueue(ctx, client.NewMessage(&testQueueMessage{ID: "p1"}), client.WithPartitionKey("/planes/radius/local/resourcegroups/p1"), client.WithPriority(client.PriorityHigh))
+		require.NoError(t, err)
+		err = cli.Enqueue(ctx, client.NewMessage(&testQueueMessage{ID: "p2"}), client.WithPartitionKey("/planes/radius/local/resourcegroups/p2"))
+		require.NoError(t, err)
+		err = cli.Enqueue(ctx, client.NewMessage(&testQueueMessage{ID: "none"}), client.WithPriority(client.PriorityLow))
+		require.NoError(t, err)
+
+		cfg := client.QueueClientConfig{ExcludedPartitions: []string{"/planes/radius/local/resourcegroups/p1"}}
+
+		msg, id := dequeueTestMessage(t, cli, cfg)
+		require.Equal(t, "p2", id)
+		require.Equal(t, "/planes/radius/local/resourcegroups/p2", msg.PartitionKey)
+
+		msg, id = dequeueTestMessage(t, cli, cfg)
+		require.Equal(t, "none", id)
+		require.Empty(t, msg.PartitionKey)
+
+		_, err = cli.Dequeue(ctx, cfg)
+		require.ErrorIs(t, err, client.ErrMessageNotFound)
+
+		msg, id = dequeueTestMessage(t, cli, client.QueueClientConfig{})
+		require.Equal(t, "p1", id)
+		require.Equal(t, "/planes/radius/local/resourcegroups/p1", msg.PartitionKey)
+		require.Equal(t, client.PriorityHigh, msg.Priority)
+	})
+}
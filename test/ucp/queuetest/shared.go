@@ -20,7 +20,9 @@ package queuetest
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"sync"
 	"testing"
 	"time"
 
@@ -56,10 +58,10 @@ func queueTestMessage(cli client.Client, num int) error {
 	return nil
 }
 
-// RunTest tests the client's Enqueue, FinishMessage, ExtendMessage, Dequeue methods by enqueuing and dequeuing messages,
-// and checking for errors when nil messages are passed. It also tests the StartDequeuer method by dequeuing messages via a
-//
-//	channel.
+// RunTest tests the client's Enqueue, FinishMessage, ExtendMessage, RequeueMessage, Dequeue methods by enqueuing and dequeuing messages,
+// and checking for errors when nil messages are passed. It checks the lease semantics shared by every implementation:
+// an expired lease is requeued, only the current lease can be extended, and each message is leased by one client at a
+// time. It also tests the StartDequeuer method by dequeuing messages via a channel.
 func RunTest(t *testing.T, cli client.Client, clear func(t *testing.T)) {
 	ctx, cancel := testcontext.NewWithCancel(t)
 	t.Cleanup(cancel)
@@ -75,6 +77,10 @@ func RunTest(t *testing.T, cli client.Client, clear func(t *testing.T)) {
 		require.ErrorIs(t, err, client.ErrEmptyMessage)
 		err = cli.ExtendMessage(ctx, nil)
 		require.ErrorIs(t, err, client.ErrEmptyMessage)
+		if requeuer, ok := cli.(client.RequeueClient); ok {
+			err = requeuer.RequeueMessage(ctx, nil, time.Now())
+			require.ErrorIs(t, err, client.ErrEmptyMessage)
+		}
 	})
 
 	t.Run("enqueue and dequeue messages", func(t *testing.T) {
@@ -204,6 +210,170 @@ func RunTest(t *testing.T, cli client.Client, clear func(t *testing.T)) {
 		require.ErrorIs(t, err, client.ErrInvalidMessage)
 	})
 
+	t.Run("finish message removes message", func(t *testing.T) {
+		clear(t)
+
+		err := queueTestMessage(cli, 1)
+		require.NoError(t, err)
+
+		msg, err := cli.Dequeue(ctx, client.QueueClientConfig{})
+		require.NoError(t, err)
+		require.Equal(t, 1, msg.DequeueCount)
+
+		err = cli.FinishMessage(ctx, msg)
+		require.NoError(t, err)
+
+		// The message must not be requeued after it is finished.
+		time.Sleep(TestMessageLockTime + pollingInterval)
+		_, err = cli.Dequeue(ctx, client.QueueClientConfig{})
+		require.ErrorIs(t, err, client.ErrMessageNotFound)
+
+		err = cli.FinishMessage(ctx, msg)
+		require.ErrorIs(t, err, client.ErrInvalidMessage)
+
+		err = cli.ExtendMessage(ctx, msg)
+		require.ErrorIs(t, err, client.ErrInvalidMessage)
+	})
+
+	t.Run("extend message leased by the other client", func(t *testing.T) {
+		clear(t)
+
+		err := queueTestMessage(cli, 1)
+		require.NoError(t, err)
+
+		msg1, err := cli.Dequeue(ctx, client.QueueClientConfig{})
+		require.NoError(t, err)
+
+		// Dequeue until message is requeued and leased again.
+		var msg2 *client.Message
+		for {
+			msg2, err = cli.Dequeue(ctx, client.QueueClientConfig{})
+			if err == nil {
+				break
+			}
+			time.Sleep(pollingInterval)
+		}
+
+		require.Equal(t, msg1.ID, msg2.ID)
+		require.Equal(t, 1, msg1.DequeueCount)
+		require.Equal(t, 2, msg2.DequeueCount)
+
+		// The first lease is no longer valid, only the current lease can be extended.
+		err = cli.ExtendMessage(ctx, msg1)
+		require.ErrorIs(t, err, client.ErrDequeuedMessage)
+
+		err = cli.ExtendMessage(ctx, msg2)
+		require.NoError(t, err)
+		require.True(t, msg2.NextVisibleAt.After(time.Now()))
+
+		err = cli.FinishMessage(ctx, msg2)
+		require.NoError(t, err)
+	})
+
+	t.Run("requeue message with next visible time", func(t *testing.T) {
+		requeuer, ok := cli.(client.RequeueClient)
+		if !ok {
+			t.Skip("the client does not support requeueing messages")
+		}
+		clear(t)
+
+		err := queueTestMessage(cli, 1)
+		require.NoError(t, err)
+
+		msg1, err := cli.Dequeue(ctx, client.QueueClientConfig{})
+		require.NoError(t, err)
+
+		// The message must be invisible until the next visible time.
+		nextVisibleAt := time.Now().Add(TestMessageLockTime / 2)
+		leasedUntil := msg1.NextVisibleAt
+		err = requeuer.RequeueMessage(ctx, msg1, nextVisibleAt)
+		require.NoError(t, err)
+		require.Equal(t, leasedUntil, msg1.NextVisibleAt)
+		_, err = cli.Dequeue(ctx, client.QueueClientConfig{})
+		require.ErrorIs(t, err, client.ErrMessageNotFound)
+
+		var msg2 *client.Message
+		for {
+			msg2, err = cli.Dequeue(ctx, client.QueueClientConfig{})
+			if err == nil {
+				break
+			}
+			time.Sleep(pollingInterval)
+		}
+		require.Equal(t, msg1.ID, msg2.ID)
+		require.Equal(t, 2, msg2.DequeueCount)
+		require.False(t, time.Now().Before(nextVisibleAt))
+
+		// The first lease is no longer valid.
+		err = requeuer.RequeueMessage(ctx, msg1, time.Now())
+		require.ErrorIs(t, err, client.ErrDequeuedMessage)
+
+		// The message is dequeued immediately if the next visible time has passed.
+		err = requeuer.RequeueMessage(ctx, msg2, time.Now())
+		require.NoError(t, err)
+		msg3, err := cli.Dequeue(ctx, client.QueueClientConfig{})
+		require.NoError(t, err)
+		require.Equal(t, msg1.ID, msg3.ID)
+		require.Equal(t, 3, msg3.DequeueCount)
+
+		err = cli.FinishMessage(ctx, msg3)
+		require.NoError(t, err)
+		err = requeuer.RequeueMessage(ctx, msg3, time.Now())
+		require.ErrorIs(t, err, client.ErrInvalidMessage)
+	})
+
+	t.Run("concurrent dequeue leases each message once", func(t *testing.T) {
+		clear(t)
+
+		num := 10
+		workers := 5
+
+		err := queueTestMessage(cli, num)
+		require.NoError(t, err)
+
+		mu := sync.Mutex{}
+		leased := map[string]*client.Message{}
+		errs := make(chan error, workers)
+		wg := sync.WaitGroup{}
+		for i := 0; i < workers; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for {
+					msg, err := cli.Dequeue(ctx, client.QueueClientConfig{})
+					if errors.Is(err, client.ErrMessageNotFound) {
+						return
+					} else if errors.Is(err, client.ErrDequeuedMessage) {
+						// Lost the race to the other worker, try the next message.
+						continue
+					} else if err != nil {
+						errs <- err
+						return
+					}
+
+					mu.Lock()
+					if _, ok := leased[msg.ID]; ok {
+						errs <- fmt.Errorf("message %s was leased more than once", msg.ID)
+					}
+					leased[msg.ID] = msg
+					mu.Unlock()
+				}
+			}()
+		}
+		wg.Wait()
+		close(errs)
+
+		for err := range errs {
+			require.NoError(t, err)
+		}
+		require.Len(t, leased, num)
+
+		for _, msg := range leased {
+			err = cli.FinishMessage(ctx, msg)
+			require.NoError(t, err)
+		}
+	})
+
 	t.Run("StartDequeuer dequeues message via channel", func(t *testing.T) {
 		clear(t)
 		msgCh, err := client.StartDequeuer(ctx, cli, client.WithDequeueInterval(defaultTestDequeueInterval))
@@ -0,0 +1,190 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// package secrettest contains SHARED testing logic that is common to our secret.Client implementations.
+package secrettest
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/ucp/secret"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// SecretName1 is the name of a secret used by the tests. Names are lowercase DNS labels so that they are valid
+	// for every implementation.
+	SecretName1 = "test-secret-1"
+
+	// SecretName2 is the name of a secret used by the tests.
+	SecretName2 = "test-secret-2"
+
+	concurrentWriters = 5
+)
+
+var (
+	// Value1 is a secret value used by the tests.
+	Value1 = []byte(`{"username":"admin","password":"p@ssw0rd"}`)
+
+	// Value2 is a secret value used by the tests.
+	Value2 = []byte(`{"username":"admin","password":"changed"}`)
+
+	// BinaryValue is a secret value that is not valid UTF-8.
+	BinaryValue = []byte{0x00, 0xff, 0xfe, 0x80, 0x7f}
+)
+
+// RunTest tests the secret.Client's Save, Get and Delete methods. Every implementation of secret.Client is expected
+// to pass these tests. The clear function must delete every secret used by the tests.
+func RunTest(t *testing.T, client secret.Client, clear func(t *testing.T)) {
+	t.Run("get_not_found", func(t *testing.T) {
+		clear(t)
+		ctx := testcontext.New(t)
+
+		_, err := client.Get(ctx, SecretName1)
+		require.ErrorIs(t, err, &secret.ErrNotFound{})
+	})
+
+	t.Run("delete_not_found", func(t *testing.T) {
+		clear(t)
+		ctx := testcontext.New(t)
+
+		err := client.Delete(ctx, SecretName1)
+		require.ErrorIs(t, err, &secret.ErrNotFound{})
+	})
+
+	t.Run("save_and_get", func(t *testing.T) {
+		clear(t)
+		ctx := testcontext.New(t)
+
+		err := client.Save(ctx, SecretName1, Value1)
+		require.NoError(t, err)
+
+		value, err := client.Get(ctx, SecretName1)
+		require.NoError(t, err)
+		require.Equal(t, Value1, value)
+	})
+
+	t.Run("save_and_get_binary", func(t *testing.T) {
+		clear(t)
+		ctx := testcontext.New(t)
+
+		err := client.Save(ctx, SecretName1, BinaryValue)
+		require.NoError(t, err)
+
+		value, err := client.Get(ctx, SecretName1)
+		require.NoError(t, err)
+		require.Equal(t, BinaryValue, value)
+	})
+
+	t.Run("save_can_update", func(t *testing.T) {
+		clear(t)
+		ctx := testcontext.New(t)
+
+		err := client.Save(ctx, SecretName1, Value1)
+		require.NoError(t, err)
+
+		err = client.Save(ctx, SecretName1, Value2)
+		require.NoError(t, err)
+
+		value, err := client.Get(ctx, SecretName1)
+		require.NoError(t, err)
+		require.Equal(t, Value2, value)
+	})
+
+	t.Run("save_does_not_affect_other_secrets", func(t *testing.T) {
+		clear(t)
+		ctx := testcontext.New(t)
+
+		err := client.Save(ctx, SecretName1, Value1)
+		require.NoError(t, err)
+
+		err = client.Save(ctx, SecretName2, Value2)
+		require.NoError(t, err)
+
+		value, err := client.Get(ctx, SecretName1)
+		require.NoError(t, err)
+		require.Equal(t, Value1, value)
+
+		value, err = client.Get(ctx, SecretName2)
+		require.NoError(t, err)
+		require.Equal(t, Value2, value)
+	})
+
+	t.Run("save_and_delete", func(t *testing.T) {
+		clear(t)
+		ctx := testcontext.New(t)
+
+		err := client.Save(ctx, SecretName1, Value1)
+		require.NoError(t, err)
+
+		err = client.Delete(ctx, SecretName1)
+		require.NoError(t, err)
+
+		_, err = client.Get(ctx, SecretName1)
+		require.ErrorIs(t, err, &secret.ErrNotFound{})
+
+		err = client.Delete(ctx, SecretName1)
+		require.ErrorIs(t, err, &secret.ErrNotFound{})
+	})
+
+	t.Run("invalid_arguments", func(t *testing.T) {
+		clear(t)
+		ctx := testcontext.New(t)
+
+		err := client.Save(ctx, "", Value1)
+		require.ErrorIs(t, err, &secret.ErrInvalid{Message: "invalid argument. 'name' is required"})
+
+		err = client.Save(ctx, SecretName1, nil)
+		require.ErrorIs(t, err, &secret.ErrInvalid{Message: "invalid argument. 'value' is required"})
+
+		_, err = client.Get(ctx, "")
+		require.ErrorIs(t, err, &secret.ErrInvalid{Message: "invalid argument. 'name' is required"})
+
+		err = client.Delete(ctx, "")
+		require.ErrorIs(t, err, &secret.ErrInvalid{Message: "invalid argument. 'name' is required"})
+
+		// Nothing should have been saved.
+		_, err = client.Get(ctx, SecretName1)
+		require.ErrorIs(t, err, &secret.ErrNotFound{})
+	})
+
+	t.Run("concurrent_save", func(t *testing.T) {
+		clear(t)
+		ctx := testcontext.New(t)
+
+		errs := make([]error, concurrentWriters)
+		wg := sync.WaitGroup{}
+		for i := 0; i < concurrentWriters; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				errs[i] = client.Save(ctx, fmt.Sprintf("%s-%d", SecretName1, i), []byte(fmt.Sprintf("value-%d", i)))
+			}(i)
+		}
+		wg.Wait()
+
+		for i := 0; i < concurrentWriters; i++ {
+			require.NoError(t, errs[i])
+
+			value, err := client.Get(ctx, fmt.Sprintf("%s-%d", SecretName1, i))
+			require.NoError(t, err)
+			require.Equal(t, []byte(fmt.Sprintf("value-%d", i)), value)
+		}
+	})
+}
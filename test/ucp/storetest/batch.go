@@ -0,0 +1,127 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storetest
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+// RunBatchTest tests the StorageClient's SaveBatch method by checking that objects of different resource types are
+// saved together, and that a failed precondition on any item leaves every object unchanged.
+func RunBatchTest(t *testing.T, client store.BatchStorageClient, clear func(t *testing.T)) {
+	t.Run("save_batch_create", func(t *testing.T) {
+		clear(t)
+		ctx := testcontext.New(t)
+
+		obj1 := createObject(Resource1ID, Data1)
+		obj2 := createObject(NestedResource1ID, NestedData1)
+		err := client.SaveBatch(ctx, []store.BatchItem{{Object: &obj1}, {Object: &obj2}})
+		require.NoError(t, err)
+		require.NotEmpty(t, obj1.ETag)
+		require.NotEmpty(t, obj2.ETag)
+
+		actual, err := client.Get(ctx, Resource1ID.String())
+		require.NoError(t, err)
+		compareObjects(t, &obj1, actual)
+		require.Equal(t, obj1.ETag, actual.ETag)
+
+		actual, err = client.Get(ctx, NestedResource1ID.String())
+		require.NoError(t, err)
+		compareObjects(t, &obj2, actual)
+		require.Equal(t, obj2.ETag, actual.ETag)
+	})
+
+	t.Run("save_batch_update_with_etags", func(t *testing.T) {
+		clear(t)
+		ctx := testcontext.New(t)
+
+		obj1 := createObject(Resource1ID, Data1)
+		err := client.Save(ctx, &obj1)
+		require.NoError(t, err)
+
+		obj2 := createObject(Resource2ID, Data2)
+		err = client.Save(ctx, &obj2)
+		require.NoError(t, err)
+
+		obj1.Data = Data2
+		obj2.Data = Data1
+		err = client.SaveBatch(ctx, []store.BatchItem{{Object: &obj1, ETag: obj1.ETag}, {Object: &obj2, ETag: obj2.ETag}})
+		require.NoError(t, err)
+
+		actual, err := client.Get(ctx, Resource1ID.String())
+		require.NoError(t, err)
+		compareObjects(t, &obj1, actual)
+
+		actual, err = client.Get(ctx, Resource2ID.String())
+		require.NoError(t, err)
+		compareObjects(t, &obj2, actual)
+	})
+
+	t.Run("save_batch_etag_mismatch_saves_nothing", func(t *testing.T) {
+		clear(t)
+		ctx := testcontext.New(t)
+
+		obj1 := createObject(Resource1ID, Data1)
+		err := client.Save(ctx, &obj1)
+		require.NoError(t, err)
+
+		obj2 := createObject(Resource2ID, Data2)
+		err = client.Save(ctx, &obj2)
+		require.NoError(t, err)
+
+		updated1 := createObject(Resource1ID, Data2)
+		updated2 := createObject(Resource2ID, Data1)
+		err = client.SaveBatch(ctx, []store.BatchItem{{Object: &updated1, ETag: obj1.ETag}, {Object: &updated2, ETag: "not-matching"}})
+		require.ErrorIs(t, err, &store.ErrConcurrency{})
+
+		actual, err := client.Get(ctx, Resource1ID.String())
+		require.NoError(t, err)
+		compareObjects(t, &obj1, actual)
+		require.Equal(t, obj1.ETag, actual.ETag)
+
+		actual, err = client.Get(ctx, Resource2ID.String())
+		require.NoError(t, err)
+		compareObjects(t, &obj2, actual)
+	})
+
+	t.Run("save_batch_etag_missing_object_saves_nothing", func(t *testing.T) {
+		clear(t)
+		ctx := testcontext.New(t)
+
+		obj1 := createObject(Resource1ID, Data1)
+		obj2 := createObject(Resource2ID, Data2)
+		err := client.SaveBatch(ctx, []store.BatchItem{{Object: &obj1}, {Object: &obj2, ETag: "not-matching"}})
+		require.ErrorIs(t, err, &store.ErrConcurrency{})
+
+		_, err = client.Get(ctx, Resource1ID.String())
+		require.ErrorIs(t, err, &store.ErrNotFound{ID: Resource1ID.String()})
+	})
+
+	t.Run("save_batch_duplicate_object", func(t *testing.T) {
+		clear(t)
+		ctx := testcontext.New(t)
+
+		obj1 := createObject(Resource1ID, Data1)
+		obj2 := createObject(Resource1ID, Data2)
+		err := client.SaveBatch(ctx, []store.BatchItem{{Object: &obj1}, {Object: &obj2}})
+		require.ErrorIs(t, err, &store.ErrInvalid{})
+	})
+}
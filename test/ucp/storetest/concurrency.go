@@ -0,0 +1,179 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storetest
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+// ConcurrentWriters is the number of concurrent writers used by RunConcurrencyTest.
+const ConcurrentWriters = 5
+
+// RunConcurrencyTest tests the ETag semantics of the StorageClient when it is used concurrently. Writers that share
+// the same ETag precondition race against each other, and exactly one of them is expected to win.
+func RunConcurrencyTest(t *testing.T, client store.StorageClient, clear func(t *testing.T)) {
+	t.Run("save_etag_matches_get", func(t *testing.T) {
+		clear(t)
+		ctx := testcontext.New(t)
+
+		obj1 := createObject(Resource1ID, Data1)
+		err := client.Save(ctx, &obj1)
+		require.NoError(t, err)
+		require.NotEmpty(t, obj1.ETag)
+
+		actual, err := client.Get(ctx, Resource1ID.String())
+		require.NoError(t, err)
+		require.Equal(t, obj1.ETag, actual.ETag)
+	})
+
+	t.Run("save_changes_etag", func(t *testing.T) {
+		clear(t)
+		ctx := testcontext.New(t)
+
+		obj1 := createObject(Resource1ID, Data1)
+		err := client.Save(ctx, &obj1)
+		require.NoError(t, err)
+		original := obj1.ETag
+
+		obj1.Data = Data2
+		err = client.Save(ctx, &obj1, store.WithETag(original))
+		require.NoError(t, err)
+		require.NotEqual(t, original, obj1.ETag)
+
+		// The original ETag is now stale.
+		err = client.Save(ctx, &obj1, store.WithETag(original))
+		require.ErrorIs(t, err, &store.ErrConcurrency{})
+
+		err = client.Delete(ctx, Resource1ID.String(), store.WithETag(original))
+		require.ErrorIs(t, err, &store.ErrConcurrency{})
+	})
+
+	t.Run("concurrent_save_with_same_etag", func(t *testing.T) {
+		clear(t)
+		ctx := testcontext.New(t)
+
+		obj1 := createObject(Resource1ID, Data1)
+		err := client.Save(ctx, &obj1)
+		require.NoError(t, err)
+
+		errs := make([]error, ConcurrentWriters)
+		objs := make([]store.Object, ConcurrentWriters)
+		wg := sync.WaitGroup{}
+		for i := 0; i < ConcurrentWriters; i++ {
+			objs[i] = createObject(Resource1ID, map[string]any{"value": fmt.Sprintf("writer-%d", i)})
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				errs[i] = client.Save(ctx, &objs[i], store.WithETag(obj1.ETag))
+			}(i)
+		}
+		wg.Wait()
+
+		winner := requireSingleWinner(t, errs)
+
+		actual, err := client.Get(ctx, Resource1ID.String())
+		require.NoError(t, err)
+		compareObjects(t, &objs[winner], actual)
+	})
+
+	t.Run("concurrent_delete_with_same_etag", func(t *testing.T) {
+		clear(t)
+		ctx := testcontext.New(t)
+
+		obj1 := createObject(Resource1ID, Data1)
+		err := client.Save(ctx, &obj1)
+		require.NoError(t, err)
+
+		errs := make([]error, ConcurrentWriters)
+		wg := sync.WaitGroup{}
+		for i := 0; i < ConcurrentWriters; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				errs[i] = client.Delete(ctx, Resource1ID.String(), store.WithETag(obj1.ETag))
+			}(i)
+		}
+		wg.Wait()
+
+		// A loser can observe either the deleted object or a mismatched ETag depending on the timing.
+		successes := 0
+		for _, err := range errs {
+			if err == nil {
+				successes++
+				continue
+			}
+			require.True(t, errors.Is(err, &store.ErrConcurrency{}) || errors.Is(err, &store.ErrNotFound{}), "unexpected error: %v", err)
+		}
+		require.Equal(t, 1, successes)
+
+		_, err = client.Get(ctx, Resource1ID.String())
+		require.ErrorIs(t, err, &store.ErrNotFound{})
+	})
+
+	t.Run("concurrent_save_distinct_objects", func(t *testing.T) {
+		clear(t)
+		ctx := testcontext.New(t)
+
+		errs := make([]error, ConcurrentWriters)
+		objs := make([]store.Object, ConcurrentWriters)
+		wg := sync.WaitGroup{}
+		for i := 0; i < ConcurrentWriters; i++ {
+			id := parseOrPanic(fmt.Sprintf("%s/providers/%s/concurrent%d", ResourceGroup1Scope, ResourceType1, i))
+			objs[i] = createObject(id, Data1)
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				errs[i] = client.Save(ctx, &objs[i])
+			}(i)
+		}
+		wg.Wait()
+
+		for _, err := range errs {
+			require.NoError(t, err)
+		}
+
+		result, err := client.Query(ctx, store.Query{RootScope: ResourceGroup1Scope, ResourceType: ResourceType1})
+		require.NoError(t, err)
+		CompareObjectLists(t, objs, result.Items)
+	})
+}
+
+// requireSingleWinner checks that exactly one of the errors is nil and the rest are ErrConcurrency, and returns
+// the index of the winner.
+func requireSingleWinner(t *testing.T, errs []error) int {
+	t.Helper()
+
+	winner := -1
+	for i, err := range errs {
+		if err == nil {
+			require.Equal(t, -1, winner, "more than one concurrent write succeeded")
+			winner = i
+			continue
+		}
+		require.ErrorIs(t, err, &store.ErrConcurrency{})
+	}
+	require.NotEqual(t, -1, winner, "no concurrent write succeeded")
+
+	return winner
+}
@@ -0,0 +1,43 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storetest
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+// RunConformanceTest runs every shared test that applies to the StorageClient. The batch and watch tests are run
+// when the client implements store.BatchStorageClient or store.WatchableStorageClient.
+//
+// New implementations of StorageClient should call this from their tests, with a clear function that deletes all
+// of the data in the store.
+func RunConformanceTest(t *testing.T, client store.StorageClient, clear func(t *testing.T)) {
+	RunTest(t, client, clear)
+	RunConcurrencyTest(t, client, clear)
+	RunPaginationTest(t, client, clear)
+	RunQueryTest(t, client, clear)
+
+	if batch, ok := client.(store.BatchStorageClient); ok {
+		RunBatchTest(t, batch, clear)
+	}
+
+	if watchable, ok := client.(store.WatchableStorageClient); ok {
+		RunWatchTest(t, watchable, clear)
+	}
+}
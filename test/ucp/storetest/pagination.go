@@ -0,0 +1,103 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storetest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// paginationItemCount is the number of objects created by RunPaginationTest.
+	paginationItemCount = 7
+
+	// paginationPageSize is the page size used by RunPaginationTest. It does not divide paginationItemCount so
+	// that the last page is partial.
+	paginationPageSize = 3
+)
+
+// RunPaginationTest tests the StorageClient's Query method with store.WithMaxQueryItemCount and
+// store.WithPaginationToken. Following the pagination tokens must return every object exactly once. Implementations
+// that do not support pagination may ignore the page size and return every object in a single page.
+func RunPaginationTest(t *testing.T, client store.StorageClient, clear func(t *testing.T)) {
+	t.Run("query_pagination", func(t *testing.T) {
+		clear(t)
+		ctx := testcontext.New(t)
+
+		expected := []store.Object{}
+		for i := 0; i < paginationItemCount; i++ {
+			id := parseOrPanic(fmt.Sprintf("%s/providers/%s/page%d", ResourceGroup1Scope, ResourceType1, i))
+			obj := createObject(id, Data1)
+			err := client.Save(ctx, &obj)
+			require.NoError(t, err)
+			expected = append(expected, obj)
+		}
+
+		// Objects that don't match the query must not affect pagination.
+		other := createObject(Resource2ID, Data2)
+		err := client.Save(ctx, &other)
+		require.NoError(t, err)
+
+		query := store.Query{RootScope: ResourceGroup1Scope, ResourceType: ResourceType1}
+		actual := []store.Object{}
+		seen := map[string]bool{}
+		token := ""
+		for pages := 0; ; pages++ {
+			// Guard against implementations that never stop returning a token.
+			require.LessOrEqual(t, pages, paginationItemCount, "pagination did not terminate")
+
+			result, err := client.Query(ctx, query, store.WithMaxQueryItemCount(paginationPageSize), store.WithPaginationToken(token))
+			require.NoError(t, err)
+			if result.PaginationToken != "" {
+				require.LessOrEqual(t, len(result.Items), paginationPageSize)
+			}
+
+			for _, item := range result.Items {
+				require.False(t, seen[item.ID], "object %s was returned more than once", item.ID)
+				seen[item.ID] = true
+			}
+			actual = append(actual, result.Items...)
+
+			if result.PaginationToken == "" {
+				break
+			}
+			token = result.PaginationToken
+		}
+
+		CompareObjectLists(t, expected, actual)
+	})
+
+	t.Run("query_pagination_invalid_token", func(t *testing.T) {
+		clear(t)
+		ctx := testcontext.New(t)
+
+		obj1 := createObject(Resource1ID, Data1)
+		err := client.Save(ctx, &obj1)
+		require.NoError(t, err)
+
+		// Implementations that ignore pagination tokens may return results, but they must not return an unexpected
+		// error type.
+		_, err = client.Query(ctx, store.Query{RootScope: ResourceGroup1Scope}, store.WithMaxQueryItemCount(1), store.WithPaginationToken("!"))
+		if err != nil {
+			require.ErrorIs(t, err, &store.ErrInvalid{})
+		}
+	})
+}
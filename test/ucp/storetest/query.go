@@ -0,0 +1,183 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storetest
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+var queryData1 = map[string]any{
+	"name":  "b",
+	"value": "1",
+	"properties": map[string]any{
+		"tag": "prod-east",
+	},
+}
+
+var queryData2 = map[string]any{
+	"name":  "d",
+	"value": "2",
+	"properties": map[string]any{
+		"tag": "prod-west",
+	},
+}
+
+var queryData3 = map[string]any{
+	"name":  "a",
+	"value": "3",
+	"properties": map[string]any{
+		"tag": "dev",
+	},
+}
+
+var queryNestedData1 = map[string]any{
+	"name":  "c",
+	"value": "4",
+}
+
+// RunQueryTest tests the StorageClient's Query method with filter operators, sorting and projection. Every
+// implementation of StorageClient is expected to return the same results, including the case-insensitive comparisons
+// of the filters.
+func RunQueryTest(t *testing.T, client store.StorageClient, clear func(t *testing.T)) {
+	ctx, cancel := testcontext.NewWithCancel(t)
+	t.Cleanup(cancel)
+
+	clear(t)
+
+	obj1 := createObject(Resource1ID, queryData1)
+	obj2 := createObject(Resource2ID, queryData2)
+	obj3 := createObject(Resource3ID, queryData3)
+	nested1 := createObject(NestedResource1ID, queryNestedData1)
+	for _, obj := range []*store.Object{&obj1, &obj2, &obj3, &nested1} {
+		err := client.Save(ctx, obj)
+		require.NoError(t, err)
+	}
+
+	query := func(filters []store.QueryFilter, orderBy []store.QueryOrder, projection []string) []store.Object {
+		t.Helper()
+		result, err := client.Query(ctx, store.Query{
+			RootScope:      RadiusScope,
+			ScopeRecursive: true,
+			Filters:        filters,
+			OrderBy:        orderBy,
+			Projection:     projection,
+		})
+		require.NoError(t, err)
+		return result.Items
+	}
+
+	t.Run("filter_equals", func(t *testing.T) {
+		items := query([]store.QueryFilter{{Field: "value", Operator: store.FilterOperatorEquals, Value: "1"}}, nil, nil)
+		CompareObjectLists(t, []store.Object{obj1}, items)
+	})
+
+	t.Run("filter_equals_case_insensitive", func(t *testing.T) {
+		items := query([]store.QueryFilter{{Field: "properties.tag", Value: "DEV"}}, nil, nil)
+		CompareObjectLists(t, []store.Object{obj3}, items)
+	})
+
+	t.Run("filter_not_equals_case_insensitive", func(t *testing.T) {
+		items := query([]store.QueryFilter{{Field: "properties.tag", Operator: store.FilterOperatorNotEquals, Value: "DEV"}}, nil, nil)
+		CompareObjectLists(t, []store.Object{obj1, obj2, nested1}, items)
+	})
+
+	t.Run("filter_in_case_insensitive", func(t *testing.T) {
+		items := query([]store.QueryFilter{{Field: "properties.tag", Operator: store.FilterOperatorIn, Values: []string{"DEV", "Prod-East"}}}, nil, nil)
+		CompareObjectLists(t, []store.Object{obj1, obj3}, items)
+	})
+
+	t.Run("filter_prefix_case_insensitive", func(t *testing.T) {
+		items := query([]store.QueryFilter{{Field: "properties.tag", Operator: store.FilterOperatorPrefix, Value: "PROD-"}}, nil, nil)
+		CompareObjectLists(t, []store.Object{obj1, obj2}, items)
+	})
+
+	t.Run("filter_not_equals_includes_missing_fields", func(t *testing.T) {
+		items := query([]store.QueryFilter{{Field: "properties.tag", Operator: store.FilterOperatorNotEquals, Value: "dev"}}, nil, nil)
+		CompareObjectLists(t, []store.Object{obj1, obj2, nested1}, items)
+	})
+
+	t.Run("filter_in", func(t *testing.T) {
+		items := query([]store.QueryFilter{{Field: "value", Operator: store.FilterOperatorIn, Values: []string{"1", "4", "5"}}}, nil, nil)
+		CompareObjectLists(t, []store.Object{obj1, nested1}, items)
+	})
+
+	t.Run("filter_prefix", func(t *testing.T) {
+		items := query([]store.QueryFilter{{Field: "properties.tag", Operator: store.FilterOperatorPrefix, Value: "prod-"}}, nil, nil)
+		CompareObjectLists(t, []store.Object{obj1, obj2}, items)
+	})
+
+	t.Run("filter_exists", func(t *testing.T) {
+		items := query([]store.QueryFilter{{Field: "properties.tag", Operator: store.FilterOperatorExists}}, nil, nil)
+		CompareObjectLists(t, []store.Object{obj1, obj2, obj3}, items)
+	})
+
+	t.Run("filter_multiple", func(t *testing.T) {
+		items := query([]store.QueryFilter{
+			{Field: "properties.tag", Operator: store.FilterOperatorPrefix, Value: "prod-"},
+			{Field: "value", Operator: store.FilterOperatorNotEquals, Value: "1"},
+		}, nil, nil)
+		CompareObjectLists(t, []store.Object{obj2}, items)
+	})
+
+	t.Run("order_by_ascending", func(t *testing.T) {
+		items := query(nil, []store.QueryOrder{{Field: "name"}}, nil)
+		require.Equal(t, []string{obj3.ID, obj1.ID, nested1.ID, obj2.ID}, objectIDs(items))
+	})
+
+	t.Run("order_by_descending", func(t *testing.T) {
+		items := query(nil, []store.QueryOrder{{Field: "name", Descending: true}}, nil)
+		require.Equal(t, []string{obj2.ID, nested1.ID, obj1.ID, obj3.ID}, objectIDs(items))
+	})
+
+	t.Run("order_by_with_filter", func(t *testing.T) {
+		filters := []store.QueryFilter{{Field: "properties.tag", Operator: store.FilterOperatorExists}}
+		items := query(filters, []store.QueryOrder{{Field: "properties.tag"}}, nil)
+		require.Equal(t, []string{obj3.ID, obj1.ID, obj2.ID}, objectIDs(items))
+	})
+
+	t.Run("projection", func(t *testing.T) {
+		filters := []store.QueryFilter{{Field: "value", Operator: store.FilterOperatorIn, Values: []string{"1", "4"}}}
+		items := query(filters, []store.QueryOrder{{Field: "name"}}, []string{"name", "properties.tag"})
+		require.Len(t, items, 2)
+
+		require.Equal(t, obj1.ID, items[0].ID)
+		require.Equal(t, map[string]any{"name": "b", "properties": map[string]any{"tag": "prod-east"}}, items[0].Data)
+		require.Equal(t, nested1.ID, items[1].ID)
+		require.Equal(t, map[string]any{"name": "c"}, items[1].Data)
+	})
+
+	t.Run("invalid_operator", func(t *testing.T) {
+		_, err := client.Query(ctx, store.Query{
+			RootScope: RadiusScope,
+			Filters:   []store.QueryFilter{{Field: "value", Operator: "gt", Value: "1"}},
+		})
+		require.ErrorIs(t, err, &store.ErrInvalid{})
+	})
+}
+
+func objectIDs(items []store.Object) []string {
+	ids := []string{}
+	for _, item := range items {
+		ids = append(ids, item.ID)
+	}
+
+	return ids
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storetest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+const watchTimeout = 10 * time.Second
+
+func receiveEvent(t *testing.T, events <-chan store.WatchEvent) store.WatchEvent {
+	t.Helper()
+
+	select {
+	case event, ok := <-events:
+		require.True(t, ok, "watch channel was closed")
+		return event
+	case <-time.After(watchTimeout):
+		require.Fail(t, "timed out waiting for watch event")
+		return store.WatchEvent{}
+	}
+}
+
+// RunWatchTest tests the StorageClient's Watch method by saving, updating and deleting objects and checking that the
+// expected events are delivered, including ETags, and that objects that do not match the query are not reported.
+func RunWatchTest(t *testing.T, client store.WatchableStorageClient, clear func(t *testing.T)) {
+	t.Run("watch_create_update_delete", func(t *testing.T) {
+		clear(t)
+
+		ctx, cancel := context.WithCancel(testcontext.New(t))
+		defer cancel()
+
+		events, err := client.Watch(ctx, store.Query{RootScope: ResourceGroup1Scope})
+		require.NoError(t, err)
+
+		// This object is in a different scope and should not be reported.
+		other := createObject(Resource2ID, Data2)
+		err = client.Save(ctx, &other)
+		require.NoError(t, err)
+
+		obj1 := createObject(Resource1ID, Data1)
+		err = client.Save(ctx, &obj1)
+		require.NoError(t, err)
+
+		event := receiveEvent(t, events)
+		require.Equal(t, store.WatchEventCreated, event.Type)
+		compareObjects(t, &obj1, &event.Object)
+		require.Equal(t, obj1.ETag, event.Object.ETag)
+
+		obj1.Data = Data2
+		err = client.Save(ctx, &obj1)
+		require.NoError(t, err)
+
+		event = receiveEvent(t, events)
+		require.Equal(t, store.WatchEventUpdated, event.Type)
+		compareObjects(t, &obj1, &event.Object)
+		require.Equal(t, obj1.ETag, event.Object.ETag)
+
+		err = client.Delete(ctx, Resource1ID.String())
+		require.NoError(t, err)
+
+		event = receiveEvent(t, events)
+		require.Equal(t, store.WatchEventDeleted, event.Type)
+		require.Equal(t, obj1.ID, event.Object.ID)
+	})
+
+	t.Run("watch_with_field_filter", func(t *testing.T) {
+		clear(t)
+
+		ctx, cancel := context.WithCancel(testcontext.New(t))
+		defer cancel()
+
+		filters := []store.QueryFilter{{Field: "value", Value: "2"}}
+		events, err := client.Watch(ctx, store.Query{RootScope: RadiusScope, ScopeRecursive: true, Filters: filters})
+		require.NoError(t, err)
+
+		obj1 := createObject(Resource1ID, Data1)
+		err = client.Save(ctx, &obj1)
+		require.NoError(t, err)
+
+		obj2 := createObject(Resource2ID, Data2)
+		err = client.Save(ctx, &obj2)
+		require.NoError(t, err)
+
+		event := receiveEvent(t, events)
+		require.Equal(t, store.WatchEventCreated, event.Type)
+		compareObjects(t, &obj2, &event.Object)
+	})
+
+	t.Run("watch_closed_on_cancel", func(t *testing.T) {
+		clear(t)
+
+		ctx, cancel := context.WithCancel(testcontext.New(t))
+		events, err := client.Watch(ctx, store.Query{RootScope: ResourceGroup1Scope})
+		require.NoError(t, err)
+
+		cancel()
+
+		select {
+		case _, ok := <-events:
+			require.False(t, ok, "expected watch channel to be closed")
+		case <-time.After(watchTimeout):
+			require.Fail(t, "timed out waiting for watch channel to close")
+		}
+	})
+}